@@ -66,17 +66,19 @@ func main() {
 	auditLogger := audit.NewInMemoryAuditLogger("hustlex-api")
 
 	// Initialize rate limiters
-	var authLimiter, txnLimiter, otpLimiter, pinLimiter ratelimit.RateLimiter
+	var authLimiter, txnLimiter, otpLimiter, pinLimiter, kycLimiter ratelimit.RateLimiter
 	if useRedis {
 		authLimiter = ratelimit.NewRedisRateLimiter(redisClient, ratelimit.RateLimitAuth, "auth")
 		txnLimiter = ratelimit.NewRedisRateLimiter(redisClient, ratelimit.RateLimitTransaction, "txn")
 		otpLimiter = ratelimit.NewRedisRateLimiter(redisClient, ratelimit.RateLimitOTP, "otp")
 		pinLimiter = ratelimit.NewRedisRateLimiter(redisClient, ratelimit.RateLimitPIN, "pin")
+		kycLimiter = ratelimit.NewRedisRateLimiter(redisClient, ratelimit.RateLimitBVN, "kyc")
 	} else {
 		authLimiter = ratelimit.NewInMemoryRateLimiter(ratelimit.RateLimitAuth, "auth")
 		txnLimiter = ratelimit.NewInMemoryRateLimiter(ratelimit.RateLimitTransaction, "txn")
 		otpLimiter = ratelimit.NewInMemoryRateLimiter(ratelimit.RateLimitOTP, "otp")
 		pinLimiter = ratelimit.NewInMemoryRateLimiter(ratelimit.RateLimitPIN, "pin")
+		kycLimiter = ratelimit.NewInMemoryRateLimiter(ratelimit.RateLimitBVN, "kyc")
 	}
 
 	// Initialize JWT validator and auth middleware
@@ -92,6 +94,7 @@ func main() {
 		TxnRateLimiter:  txnLimiter,
 		OTPRateLimiter:  otpLimiter,
 		PINRateLimiter:  pinLimiter,
+		KYCRateLimiter:  kycLimiter,
 	}
 
 	// Initialize handlers (nil for now - endpoints will return not implemented)
@@ -1,16 +1,23 @@
 package command
 
 import (
+	"time"
+
 	"hustlex/internal/domain/shared/valueobject"
 )
 
 // ApplyForLoan represents a loan application command
 type ApplyForLoan struct {
 	UserID       string
+	ProductID    string
 	Amount       int64
 	Currency     string
 	TenureMonths int
 	Purpose      string
+
+	// Secured products only
+	CollateralType     string // circle_payout, locked_savings
+	CollateralSourceID string // circle ID or savings goal ID
 }
 
 func (c ApplyForLoan) GetUserID() (valueobject.UserID, error) {
@@ -21,28 +28,49 @@ func (c ApplyForLoan) GetAmount() (valueobject.Money, error) {
 	return valueobject.NewMoney(c.Amount, valueobject.Currency(c.Currency))
 }
 
+func (c ApplyForLoan) IsSecured() bool {
+	return c.CollateralType != "" && c.CollateralType != "none"
+}
+
 // ApplyForLoanResult contains the result of loan application
 type ApplyForLoanResult struct {
 	LoanID         string
+	ProductID      string
 	Principal      int64
 	InterestRate   float64
 	InterestAmount int64
 	TotalAmount    int64
+	OriginationFee int64
 	TenureMonths   int
 	MonthlyPayment int64
 	Status         string
+
+	// Outcome of the automated decision engine
+	Decision        string
+	DecisionReasons []string
 }
 
 // ApproveLoan represents a loan approval command
 type ApproveLoan struct {
 	LoanID  string
 	AdminID string
+	Reason  string
 }
 
 func (c ApproveLoan) GetLoanID() (valueobject.LoanID, error) {
 	return valueobject.NewLoanID(c.LoanID)
 }
 
+// LoanDecisionResult contains the loan state after an underwriting decision
+type LoanDecisionResult struct {
+	LoanID       string
+	Status       string
+	Principal    int64
+	TenureMonths int
+	TotalAmount  int64
+	NeedsChecker bool // Approval recorded, second underwriter sign-off outstanding
+}
+
 // RejectLoan represents a loan rejection command
 type RejectLoan struct {
 	LoanID  string
@@ -54,6 +82,34 @@ func (c RejectLoan) GetLoanID() (valueobject.LoanID, error) {
 	return valueobject.NewLoanID(c.LoanID)
 }
 
+// CounterOfferLoan represents an underwriter counter-offer on a referred loan
+type CounterOfferLoan struct {
+	LoanID       string
+	AdminID      string
+	Amount       int64
+	TenureMonths int
+	Reason       string
+}
+
+func (c CounterOfferLoan) GetLoanID() (valueobject.LoanID, error) {
+	return valueobject.NewLoanID(c.LoanID)
+}
+
+// RespondToCounterOffer represents the borrower accepting or declining a counter-offer
+type RespondToCounterOffer struct {
+	LoanID string
+	UserID string
+	Accept bool
+}
+
+func (c RespondToCounterOffer) GetLoanID() (valueobject.LoanID, error) {
+	return valueobject.NewLoanID(c.LoanID)
+}
+
+func (c RespondToCounterOffer) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
 // DisburseLoan represents loan disbursement command
 type DisburseLoan struct {
 	LoanID        string
@@ -170,3 +226,73 @@ type InitializeCreditScore struct {
 func (c InitializeCreditScore) GetUserID() (valueobject.UserID, error) {
 	return valueobject.NewUserID(c.UserID)
 }
+
+// ApplyCollateralProceeds applies the lien settled from a circle payout to a secured loan.
+// Reference and TransactionID identify the repayment transaction the payout already posted.
+type ApplyCollateralProceeds struct {
+	LoanID        string
+	UserID        string
+	CircleID      string
+	Amount        int64
+	Reference     string
+	TransactionID string
+}
+
+func (c ApplyCollateralProceeds) GetLoanID() (valueobject.LoanID, error) {
+	return valueobject.NewLoanID(c.LoanID)
+}
+
+func (c ApplyCollateralProceeds) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c ApplyCollateralProceeds) GetTransactionID() (valueobject.TransactionID, error) {
+	return valueobject.NewTransactionID(c.TransactionID)
+}
+
+// ApplyCollateralProceedsResult contains the deduction made from the payout
+type ApplyCollateralProceedsResult struct {
+	LoanID           string
+	AmountApplied    int64
+	RemainingBalance int64
+	IsFullyRepaid    bool
+}
+
+// GenerateBureauReport builds the monthly credit bureau file for one bureau
+type GenerateBureauReport struct {
+	Bureau string // crc, first_central, credit_registry
+	Period string // Reporting month, YYYY-MM
+}
+
+func (c GenerateBureauReport) GetPeriod() (time.Time, error) {
+	return time.Parse("2006-01", c.Period)
+}
+
+// GenerateBureauReportResult describes a generated bureau file
+type GenerateBureauReportResult struct {
+	SubmissionID string
+	Bureau       string
+	FileName     string
+	RecordCount  int
+	Checksum     string
+	Status       string
+}
+
+// SubmitBureauReport sends a generated bureau file
+type SubmitBureauReport struct {
+	SubmissionID string
+}
+
+// RecordBureauResponse records the bureau's acceptance or rejection of a file
+type RecordBureauResponse struct {
+	SubmissionID string
+	Accepted     bool
+	Errors       []string // Bureau validation errors on rejection
+}
+
+// CorrectBureauReport regenerates a bureau file after a rejection or a data correction.
+// Rejected files are resubmitted in full; corrections to accepted files may be limited to LoanIDs.
+type CorrectBureauReport struct {
+	SubmissionID string
+	LoanIDs      []string
+}
@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/application/credit/command"
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/domain/credit/repository"
+	"hustlex/internal/domain/credit/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Errors
+var (
+	ErrSubmissionNotFound = errors.New("bureau submission not found")
+	ErrSubmissionExists   = errors.New("a bureau submission already exists for this period; submit a correction instead")
+	ErrNothingToReport    = errors.New("no loans to report for this period")
+)
+
+// BureauGateway delivers submission files to a credit bureau
+// This is a PORT - infrastructure will provide the ADAPTER (bureau SFTP drop or API)
+type BureauGateway interface {
+	// Submit uploads a file and returns the bureau's acknowledgement reference
+	Submit(ctx context.Context, bureau aggregate.CreditBureau, fileName string, content []byte) (string, error)
+}
+
+// BureauHandler builds and submits monthly credit bureau files
+type BureauHandler struct {
+	loanRepo        repository.LoanRepository
+	repaymentRepo   repository.RepaymentRepository
+	borrowerRepo    repository.BorrowerProfileRepository
+	productRepo     repository.LoanProductRepository
+	submissionRepo  repository.BureauSubmissionRepository
+	gateway         BureauGateway
+	institutionCode string
+}
+
+// NewBureauHandler creates a new bureau reporting handler
+func NewBureauHandler(
+	loanRepo repository.LoanRepository,
+	repaymentRepo repository.RepaymentRepository,
+	borrowerRepo repository.BorrowerProfileRepository,
+	productRepo repository.LoanProductRepository,
+	submissionRepo repository.BureauSubmissionRepository,
+	gateway BureauGateway,
+	institutionCode string,
+) *BureauHandler {
+	return &BureauHandler{
+		loanRepo:        loanRepo,
+		repaymentRepo:   repaymentRepo,
+		borrowerRepo:    borrowerRepo,
+		productRepo:     productRepo,
+		submissionRepo:  submissionRepo,
+		gateway:         gateway,
+		institutionCode: institutionCode,
+	}
+}
+
+// HandleGenerateBureauReport builds and records the file for a bureau and month
+func (h *BureauHandler) HandleGenerateBureauReport(ctx context.Context, cmd command.GenerateBureauReport) (*command.GenerateBureauReportResult, error) {
+	bureau := aggregate.CreditBureau(cmd.Bureau)
+	if !bureau.IsValid() {
+		return nil, aggregate.ErrUnknownBureau
+	}
+
+	period, err := cmd.GetPeriod()
+	if err != nil {
+		return nil, aggregate.ErrInvalidReportingPeriod
+	}
+
+	// Only one live submission per bureau and month; later changes go through corrections
+	existing, err := h.submissionRepo.FindByPeriod(ctx, bureau, period)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range existing {
+		if s.Status() != aggregate.SubmissionSuperseded && s.Status() != aggregate.SubmissionRejected {
+			return nil, ErrSubmissionExists
+		}
+	}
+
+	submission, err := h.generate(ctx, bureau, period, service.SubmissionTypeNew, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.submissionRepo.Save(ctx, submission); err != nil {
+		return nil, err
+	}
+
+	return toSubmissionResult(submission), nil
+}
+
+// HandleSubmitBureauReport sends a generated file to its bureau
+func (h *BureauHandler) HandleSubmitBureauReport(ctx context.Context, cmd command.SubmitBureauReport) error {
+	submission, err := h.submissionRepo.FindByID(ctx, cmd.SubmissionID)
+	if err != nil {
+		return ErrSubmissionNotFound
+	}
+
+	if submission.Status() != aggregate.SubmissionGenerated {
+		return aggregate.ErrSubmissionNotGenerated
+	}
+
+	reference, err := h.gateway.Submit(ctx, submission.Bureau(), submission.FileName(), submission.Content())
+	if err != nil {
+		return err
+	}
+
+	if err := submission.MarkSubmitted(reference); err != nil {
+		return err
+	}
+
+	return h.submissionRepo.Save(ctx, submission)
+}
+
+// HandleRecordBureauResponse records whether the bureau accepted a file
+func (h *BureauHandler) HandleRecordBureauResponse(ctx context.Context, cmd command.RecordBureauResponse) error {
+	submission, err := h.submissionRepo.FindByID(ctx, cmd.SubmissionID)
+	if err != nil {
+		return ErrSubmissionNotFound
+	}
+
+	if cmd.Accepted {
+		err = submission.Accept()
+	} else {
+		err = submission.Reject(cmd.Errors)
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.submissionRepo.Save(ctx, submission)
+}
+
+// HandleCorrectBureauReport regenerates a file from current loan data.
+// A rejected file is resubmitted in full; an accepted file is amended with a
+// correction file, optionally limited to the loans that changed.
+func (h *BureauHandler) HandleCorrectBureauReport(ctx context.Context, cmd command.CorrectBureauReport) (*command.GenerateBureauReportResult, error) {
+	original, err := h.submissionRepo.FindByID(ctx, cmd.SubmissionID)
+	if err != nil {
+		return nil, ErrSubmissionNotFound
+	}
+
+	submissionType := service.SubmissionTypeCorrection
+	loanIDs := cmd.LoanIDs
+	if original.Status() == aggregate.SubmissionRejected {
+		submissionType = service.SubmissionTypeResubmission
+		loanIDs = nil
+	}
+
+	if err := original.Supersede(); err != nil {
+		return nil, err
+	}
+
+	correction, err := h.generate(ctx, original.Bureau(), original.Period(), submissionType, loanIDs, original.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.submissionRepo.Save(ctx, original); err != nil {
+		return nil, err
+	}
+	if err := h.submissionRepo.Save(ctx, correction); err != nil {
+		return nil, err
+	}
+
+	return toSubmissionResult(correction), nil
+}
+
+// ReportPeriod generates and submits the month's file to every bureau.
+// Bureaus that already have a live submission for the month are skipped so the job can be retried.
+func (h *BureauHandler) ReportPeriod(ctx context.Context, period time.Time) error {
+	for _, bureau := range aggregate.AllCreditBureaus() {
+		result, err := h.HandleGenerateBureauReport(ctx, command.GenerateBureauReport{
+			Bureau: bureau.String(),
+			Period: period.Format("2006-01"),
+		})
+		if errors.Is(err, ErrSubmissionExists) || errors.Is(err, ErrNothingToReport) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := h.HandleSubmitBureauReport(ctx, command.SubmitBureauReport{SubmissionID: result.SubmissionID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generate builds, validates and renders a submission file
+func (h *BureauHandler) generate(
+	ctx context.Context,
+	bureau aggregate.CreditBureau,
+	period time.Time,
+	submissionType service.SubmissionType,
+	loanIDs []string,
+	correctionOf string,
+) (*aggregate.BureauSubmission, error) {
+	period = aggregate.ReportingPeriod(period)
+	periodEnd := period.AddDate(0, 1, 0)
+	asOf := periodEnd.Add(-time.Second)
+
+	records, err := h.buildRecords(ctx, period, periodEnd, asOf, loanIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrNothingToReport
+	}
+
+	header := service.BureauFileHeader{
+		InstitutionCode: h.institutionCode,
+		Bureau:          bureau,
+		Period:          period,
+		GeneratedAt:     time.Now().UTC(),
+		Type:            submissionType,
+	}
+
+	content, err := service.RenderBureauFile(header, records)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.NewBureauSubmission(
+		valueobject.GenerateTransactionID().String(),
+		bureau,
+		period,
+		service.BureauFileName(header),
+		content,
+		len(records),
+		correctionOf,
+	)
+}
+
+// buildRecords computes the bureau record for every facility open during the period
+func (h *BureauHandler) buildRecords(ctx context.Context, periodStart, periodEnd, asOf time.Time, loanIDs []string) ([]service.BureauRecord, error) {
+	loans, err := h.loanRepo.FindReportable(ctx, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	only := make(map[string]bool, len(loanIDs))
+	for _, id := range loanIDs {
+		only[id] = true
+	}
+
+	facilityTypes := make(map[string]string)
+	records := make([]service.BureauRecord, 0, len(loans))
+
+	for _, loan := range loans {
+		if loan.DisbursedAt() == nil {
+			continue
+		}
+		if len(only) > 0 && !only[loan.ID().String()] {
+			continue
+		}
+
+		facilityType, ok := facilityTypes[loan.ProductID()]
+		if !ok {
+			product, err := h.productRepo.FindByID(ctx, loan.ProductID())
+			if err != nil {
+				return nil, ErrLoanProductNotFound
+			}
+			facilityType = product.Category().String()
+			facilityTypes[loan.ProductID()] = facilityType
+		}
+
+		profile, err := h.borrowerRepo.FindByUserID(ctx, loan.UserID())
+		if err != nil {
+			return nil, ErrBorrowerNotFound
+		}
+
+		repayments, err := h.repaymentRepo.FindByLoanID(ctx, loan.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		borrower := service.BorrowerIdentity{
+			BVN:         profile.BVN,
+			FullName:    profile.FullName,
+			PhoneNumber: profile.PhoneNumber,
+		}
+		records = append(records, service.BuildBureauRecord(loan, borrower, facilityType, repayments, asOf))
+	}
+
+	return records, nil
+}
+
+func toSubmissionResult(s *aggregate.BureauSubmission) *command.GenerateBureauReportResult {
+	return &command.GenerateBureauReportResult{
+		SubmissionID: s.ID(),
+		Bureau:       s.Bureau().String(),
+		FileName:     s.FileName(),
+		RecordCount:  s.RecordCount(),
+		Checksum:     s.Checksum(),
+		Status:       s.Status().String(),
+	}
+}
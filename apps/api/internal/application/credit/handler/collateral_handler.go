@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/application/credit/command"
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/domain/credit/repository"
+	savingsevent "hustlex/internal/domain/savings/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// CollateralHandler settles secured loans from pledged collateral
+type CollateralHandler struct {
+	loanRepo repository.LoanRepository
+}
+
+// NewCollateralHandler creates a new collateral handler
+func NewCollateralHandler(loanRepo repository.LoanRepository) *CollateralHandler {
+	return &CollateralHandler{loanRepo: loanRepo}
+}
+
+// HandleApplyCollateralProceeds applies a lien the payout has already settled to the loan.
+// The repayment is keyed by the payout's settlement reference, so it is applied at most once.
+func (h *CollateralHandler) HandleApplyCollateralProceeds(ctx context.Context, cmd command.ApplyCollateralProceeds) (*command.ApplyCollateralProceedsResult, error) {
+	loanID, err := cmd.GetLoanID()
+	if err != nil {
+		return nil, errors.New("invalid loan ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	transactionID, err := cmd.GetTransactionID()
+	if err != nil {
+		return nil, errors.New("invalid transaction ID")
+	}
+
+	if cmd.Reference == "" {
+		return nil, errors.New("settlement reference is required")
+	}
+
+	loan, err := h.loanRepo.FindByID(ctx, loanID)
+	if err != nil {
+		return nil, ErrLoanNotFound
+	}
+
+	if loan.UserID() != userID {
+		return nil, ErrUnauthorized
+	}
+
+	collateral := loan.Collateral()
+	if collateral == nil || collateral.Type() != aggregate.CollateralCirclePayout || collateral.SourceID() != cmd.CircleID {
+		return nil, aggregate.ErrLoanNotSecured
+	}
+
+	result := &command.ApplyCollateralProceedsResult{LoanID: loan.ID().String()}
+	if !loan.HasRepayment(cmd.Reference) {
+		proceeds, err := valueobject.NewMoney(cmd.Amount, loan.Principal().Currency())
+		if err != nil {
+			return nil, err
+		}
+
+		applied, err := loan.ApplyCollateralProceeds(cmd.Reference, proceeds, transactionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := h.loanRepo.SaveWithEvents(ctx, loan); err != nil {
+			return nil, err
+		}
+		result.AmountApplied = applied.Amount()
+	}
+
+	result.RemainingBalance = loan.RemainingBalance().Amount()
+	result.IsFullyRepaid = loan.IsFullyRepaid()
+	return result, nil
+}
+
+// OutstandingLien returns how much a payout lien still has to cover on a loan.
+// Loans that are not disbursed, already repaid or not secured by a payout owe nothing.
+func (h *CollateralHandler) OutstandingLien(ctx context.Context, loanID string) (valueobject.Money, error) {
+	id, err := valueobject.NewLoanID(loanID)
+	if err != nil {
+		return valueobject.Money{}, errors.New("invalid loan ID")
+	}
+
+	loan, err := h.loanRepo.FindByID(ctx, id)
+	if err != nil {
+		return valueobject.Money{}, ErrLoanNotFound
+	}
+
+	currency := loan.Principal().Currency()
+	collateral := loan.Collateral()
+	if collateral == nil || collateral.Type() != aggregate.CollateralCirclePayout {
+		return valueobject.Zero(currency), nil
+	}
+	if loan.Status() != aggregate.LoanStatusDisbursed && loan.Status() != aggregate.LoanStatusRepaying {
+		return valueobject.Zero(currency), nil
+	}
+
+	return loan.RemainingBalance(), nil
+}
+
+// OnPayoutLienSettled applies the share of a circle payout that was paid to the loan
+func (h *CollateralHandler) OnPayoutLienSettled(ctx context.Context, e sharedevent.DomainEvent) error {
+	settled, ok := e.(*savingsevent.PayoutLienSettled)
+	if !ok {
+		return nil
+	}
+
+	_, err := h.HandleApplyCollateralProceeds(ctx, command.ApplyCollateralProceeds{
+		LoanID:        settled.LoanID,
+		UserID:        settled.UserID,
+		CircleID:      settled.CircleID,
+		Amount:        settled.Amount,
+		Reference:     settled.Reference,
+		TransactionID: settled.TransactionID,
+	})
+	return err
+}
@@ -8,6 +8,10 @@ import (
 	"hustlex/internal/application/credit/command"
 	"hustlex/internal/domain/credit/aggregate"
 	"hustlex/internal/domain/credit/repository"
+	gigevent "hustlex/internal/domain/gig/event"
+	savingsevent "hustlex/internal/domain/savings/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
 )
 
 // CreditScoreHandler handles credit score commands
@@ -128,6 +132,25 @@ func (h *CreditScoreHandler) HandleUpdateCreditStats(ctx context.Context, cmd co
 	}, nil
 }
 
+// UpdateVerificationScore records the identity verifications behind a user's score.
+// It is the ADAPTER for identity's VerificationScoreSink port.
+func (h *CreditScoreHandler) UpdateVerificationScore(ctx context.Context, userID string, hasPhone, hasEmail, hasBVN, hasNIN bool) error {
+	id, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	creditScore, err := h.creditScoreRepo.FindByUserID(ctx, id)
+	if err != nil {
+		return ErrCreditScoreNotFound
+	}
+
+	creditScore.UpdateVerificationScore(hasPhone, hasEmail, hasBVN, hasNIN)
+	creditScore.Recalculate()
+
+	return h.creditScoreRepo.Save(ctx, creditScore)
+}
+
 // HandleRecalculateCreditScore recalculates a user's credit score
 func (h *CreditScoreHandler) HandleRecalculateCreditScore(ctx context.Context, cmd command.RecalculateCreditScore) (*command.RecalculateCreditScoreResult, error) {
 	userID, err := cmd.GetUserID()
@@ -155,3 +178,61 @@ func (h *CreditScoreHandler) HandleRecalculateCreditScore(ctx context.Context, c
 		InterestRate:  creditScore.InterestRate(),
 	}, nil
 }
+
+// OnMemberDefaulted penalises a user who defaults on a savings circle after receiving their payout.
+// A redelivered default is skipped.
+func (h *CreditScoreHandler) OnMemberDefaulted(ctx context.Context, e sharedevent.DomainEvent) error {
+	defaulted, ok := e.(*savingsevent.MemberDefaulted)
+	if !ok {
+		return nil
+	}
+
+	userID, err := valueobject.NewUserID(defaulted.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	creditScore, err := h.creditScoreRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return ErrCreditScoreNotFound
+	}
+
+	if err := creditScore.RecordCircleDefault(defaulted.CircleID, defaulted.Round, defaulted.MemberID); err != nil {
+		if errors.Is(err, aggregate.ErrDefaultAlreadyRecorded) {
+			return nil
+		}
+		return err
+	}
+	creditScore.Recalculate()
+
+	return h.creditScoreRepo.Save(ctx, creditScore)
+}
+
+// OnDisputeResolved penalises the party a gig dispute was decided against in full.
+// A split ruling counts against neither side, and a redelivered resolution is skipped.
+func (h *CreditScoreHandler) OnDisputeResolved(ctx context.Context, e sharedevent.DomainEvent) error {
+	resolved, ok := e.(*gigevent.DisputeResolved)
+	if !ok || resolved.LosingPartyID == "" {
+		return nil
+	}
+
+	userID, err := valueobject.NewUserID(resolved.LosingPartyID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	creditScore, err := h.creditScoreRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return ErrCreditScoreNotFound
+	}
+
+	if err := creditScore.RecordDisputeLost(resolved.DisputeID); err != nil {
+		if errors.Is(err, aggregate.ErrDisputeAlreadyRecorded) {
+			return nil
+		}
+		return err
+	}
+	creditScore.Recalculate()
+
+	return h.creditScoreRepo.Save(ctx, creditScore)
+}
@@ -3,35 +3,61 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
 	"hustlex/internal/application/credit/command"
 	"hustlex/internal/domain/credit/aggregate"
 	"hustlex/internal/domain/credit/repository"
+	"hustlex/internal/domain/credit/service"
 	"hustlex/internal/domain/shared/valueobject"
 )
 
 // Errors
 var (
-	ErrLoanNotFound       = errors.New("loan not found")
+	ErrLoanNotFound        = errors.New("loan not found")
 	ErrCreditScoreNotFound = errors.New("credit score not found")
-	ErrUnauthorized       = errors.New("unauthorized to perform this action")
-	ErrInsufficientCredit = errors.New("insufficient credit score for this loan")
+	ErrUnauthorized        = errors.New("unauthorized to perform this action")
+	ErrInsufficientCredit  = errors.New("insufficient credit score for this loan")
+	ErrLoanProductNotFound = errors.New("loan product not found")
+	ErrBorrowerNotFound    = errors.New("borrower profile not found")
 )
 
+// CollateralValuer values what a borrower can pledge as loan collateral
+// This is a PORT - infrastructure reads the savings circle or wallet
+type CollateralValuer interface {
+	// PledgeableValue returns the unencumbered value of the collateral source
+	PledgeableValue(ctx context.Context, userID valueobject.UserID, collateralType aggregate.CollateralType, sourceID string) (valueobject.Money, error)
+}
+
 // LoanHandler handles loan-related commands
 type LoanHandler struct {
-	loanRepo       repository.LoanRepository
-	creditScoreRepo repository.CreditScoreRepository
+	loanRepo         repository.LoanRepository
+	creditScoreRepo  repository.CreditScoreRepository
+	productRepo      repository.LoanProductRepository
+	borrowerRepo     repository.BorrowerProfileRepository
+	statsRepo        repository.CreditStatisticsRepository
+	collateralValuer CollateralValuer
+	decisionEngine   *service.DecisionEngine
 }
 
 // NewLoanHandler creates a new loan handler
 func NewLoanHandler(
 	loanRepo repository.LoanRepository,
 	creditScoreRepo repository.CreditScoreRepository,
+	productRepo repository.LoanProductRepository,
+	borrowerRepo repository.BorrowerProfileRepository,
+	statsRepo repository.CreditStatisticsRepository,
+	collateralValuer CollateralValuer,
+	decisionEngine *service.DecisionEngine,
 ) *LoanHandler {
 	return &LoanHandler{
-		loanRepo:       loanRepo,
-		creditScoreRepo: creditScoreRepo,
+		loanRepo:         loanRepo,
+		creditScoreRepo:  creditScoreRepo,
+		productRepo:      productRepo,
+		borrowerRepo:     borrowerRepo,
+		statsRepo:        statsRepo,
+		collateralValuer: collateralValuer,
+		decisionEngine:   decisionEngine,
 	}
 }
 
@@ -53,73 +79,134 @@ func (h *LoanHandler) HandleApplyForLoan(ctx context.Context, cmd command.ApplyF
 		return nil, aggregate.ErrActiveLoanExists
 	}
 
-	// Get user's credit score
-	creditScore, err := h.creditScoreRepo.FindByUserID(ctx, userID)
+	// Load the requested product
+	product, err := h.productRepo.FindByID(ctx, cmd.ProductID)
+	if err != nil {
+		return nil, ErrLoanProductNotFound
+	}
+
+	// Evaluate the applicant against the product's eligibility rules
+	applicant, err := h.loadApplicant(ctx, userID)
 	if err != nil {
-		return nil, ErrCreditScoreNotFound
+		return nil, err
 	}
 
-	// Check if loan amount is within allowed limit
-	maxAllowed := creditScore.MaxLoanAmount()
-	if amount.Amount() > maxAllowed {
-		return nil, aggregate.ErrLoanExceedsLimit
+	if err := product.CheckEligibility(applicant); err != nil {
+		return nil, err
 	}
 
-	// Get interest rate based on tier
-	interestRate := creditScore.InterestRate()
+	// Value any pledged collateral; the product decides whether it is required
+	var collateral *aggregate.Collateral
+	if cmd.IsSecured() {
+		collateralType := aggregate.CollateralType(cmd.CollateralType)
+		value, err := h.collateralValuer.PledgeableValue(ctx, userID, collateralType, cmd.CollateralSourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		collateral, err = aggregate.NewCollateral(collateralType, cmd.CollateralSourceID, value)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Create loan
 	loanID := valueobject.GenerateLoanID()
 	loan, err := aggregate.NewLoan(
 		loanID,
 		userID,
+		product,
+		applicant.Tier,
 		amount,
-		interestRate,
 		cmd.TenureMonths,
 		cmd.Purpose,
-		maxAllowed,
+		collateral,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Run the decision engine; referred applications join the underwriting queue
+	history, err := h.loadHistory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := h.decisionEngine.Evaluate(loan, applicant, history)
+	if err := loan.ApplyAutomatedDecision(decision.Outcome, decision.Reasons); err != nil {
+		return nil, err
+	}
+
 	// Save loan
 	if err := h.loanRepo.SaveWithEvents(ctx, loan); err != nil {
 		return nil, err
 	}
 
 	return &command.ApplyForLoanResult{
-		LoanID:         loan.ID().String(),
-		Principal:      loan.Principal().Amount(),
-		InterestRate:   loan.InterestRate(),
-		InterestAmount: loan.InterestAmount().Amount(),
-		TotalAmount:    loan.TotalAmount().Amount(),
-		TenureMonths:   loan.TenureMonths(),
-		MonthlyPayment: loan.MonthlyPayment().Amount(),
-		Status:         loan.Status().String(),
+		LoanID:          loan.ID().String(),
+		ProductID:       loan.ProductID(),
+		Principal:       loan.Principal().Amount(),
+		InterestRate:    loan.InterestRate(),
+		InterestAmount:  loan.InterestAmount().Amount(),
+		TotalAmount:     loan.TotalAmount().Amount(),
+		OriginationFee:  loan.OriginationFee().Amount(),
+		TenureMonths:    loan.TenureMonths(),
+		MonthlyPayment:  loan.MonthlyPayment().Amount(),
+		Status:          loan.Status().String(),
+		Decision:        decision.Outcome.String(),
+		DecisionReasons: decision.Reasons,
 	}, nil
 }
 
-// HandleApproveLoan approves a pending loan
-func (h *LoanHandler) HandleApproveLoan(ctx context.Context, cmd command.ApproveLoan) error {
+// HandleApproveLoan records an underwriter approval on a loan under review.
+// Amounts above the dual-control threshold need approvals from two different underwriters.
+func (h *LoanHandler) HandleApproveLoan(ctx context.Context, cmd command.ApproveLoan) (*command.LoanDecisionResult, error) {
 	loanID, err := cmd.GetLoanID()
 	if err != nil {
-		return errors.New("invalid loan ID")
+		return nil, errors.New("invalid loan ID")
+	}
+
+	if cmd.Reason == "" {
+		return nil, aggregate.ErrDecisionReasonNeeded
 	}
 
 	loan, err := h.loanRepo.FindByID(ctx, loanID)
 	if err != nil {
-		return ErrLoanNotFound
+		return nil, ErrLoanNotFound
 	}
 
-	if err := loan.Approve(); err != nil {
-		return err
+	// Re-evaluate against the product in case the borrower's standing changed
+	product, err := h.productRepo.FindByID(ctx, loan.ProductID())
+	if err != nil {
+		return nil, ErrLoanProductNotFound
 	}
 
-	return h.loanRepo.SaveWithEvents(ctx, loan)
+	applicant, err := h.loadApplicant(ctx, loan.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.CheckEligibility(applicant); err != nil {
+		return nil, err
+	}
+
+	if err := product.ValidateTerms(loan.Principal(), loan.TenureMonths(), applicant.Tier); err != nil {
+		return nil, err
+	}
+
+	dualControl := h.decisionEngine.Policy().RequiresDualControl(loan.Principal())
+	if err := loan.Approve(cmd.AdminID, []string{cmd.Reason}, dualControl); err != nil {
+		return nil, err
+	}
+
+	if err := h.loanRepo.SaveWithEvents(ctx, loan); err != nil {
+		return nil, err
+	}
+
+	return toDecisionResult(loan), nil
 }
 
-// HandleRejectLoan rejects a pending loan
+// HandleRejectLoan rejects a loan under review
 func (h *LoanHandler) HandleRejectLoan(ctx context.Context, cmd command.RejectLoan) error {
 	loanID, err := cmd.GetLoanID()
 	if err != nil {
@@ -131,13 +218,95 @@ func (h *LoanHandler) HandleRejectLoan(ctx context.Context, cmd command.RejectLo
 		return ErrLoanNotFound
 	}
 
-	if err := loan.Reject(cmd.Reason); err != nil {
+	if err := loan.Reject(cmd.AdminID, []string{cmd.Reason}); err != nil {
 		return err
 	}
 
 	return h.loanRepo.SaveWithEvents(ctx, loan)
 }
 
+// HandleCounterOfferLoan proposes a lower amount or shorter tenure on a referred loan
+func (h *LoanHandler) HandleCounterOfferLoan(ctx context.Context, cmd command.CounterOfferLoan) (*command.LoanDecisionResult, error) {
+	loanID, err := cmd.GetLoanID()
+	if err != nil {
+		return nil, errors.New("invalid loan ID")
+	}
+
+	loan, err := h.loanRepo.FindByID(ctx, loanID)
+	if err != nil {
+		return nil, ErrLoanNotFound
+	}
+
+	amount, err := valueobject.NewMoney(cmd.Amount, loan.Principal().Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	// Revised terms must still fit the product the borrower applied for
+	product, err := h.productRepo.FindByID(ctx, loan.ProductID())
+	if err != nil {
+		return nil, ErrLoanProductNotFound
+	}
+
+	applicant, err := h.loadApplicant(ctx, loan.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.ValidateTerms(amount, cmd.TenureMonths, applicant.Tier); err != nil {
+		return nil, err
+	}
+
+	if err := loan.CounterOffer(cmd.AdminID, amount, cmd.TenureMonths, []string{cmd.Reason}); err != nil {
+		return nil, err
+	}
+
+	if err := h.loanRepo.SaveWithEvents(ctx, loan); err != nil {
+		return nil, err
+	}
+
+	return toDecisionResult(loan), nil
+}
+
+// HandleRespondToCounterOffer records the borrower's answer to a counter-offer
+func (h *LoanHandler) HandleRespondToCounterOffer(ctx context.Context, cmd command.RespondToCounterOffer) (*command.LoanDecisionResult, error) {
+	loanID, err := cmd.GetLoanID()
+	if err != nil {
+		return nil, errors.New("invalid loan ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	loan, err := h.loanRepo.FindByID(ctx, loanID)
+	if err != nil {
+		return nil, ErrLoanNotFound
+	}
+
+	// Verify ownership
+	if loan.UserID() != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if cmd.Accept {
+		dualControl := h.decisionEngine.Policy().RequiresDualControl(loan.Principal())
+		err = loan.AcceptCounterOffer(dualControl)
+	} else {
+		err = loan.DeclineCounterOffer()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.loanRepo.SaveWithEvents(ctx, loan); err != nil {
+		return nil, err
+	}
+
+	return toDecisionResult(loan), nil
+}
+
 // HandleDisburseLoan disburses an approved loan
 func (h *LoanHandler) HandleDisburseLoan(ctx context.Context, cmd command.DisburseLoan) (*command.DisburseLoanResult, error) {
 	loanID, err := cmd.GetLoanID()
@@ -160,7 +329,7 @@ func (h *LoanHandler) HandleDisburseLoan(ctx context.Context, cmd command.Disbur
 
 	return &command.DisburseLoanResult{
 		LoanID:      loan.ID().String(),
-		Amount:      loan.Principal().Amount(),
+		Amount:      loan.DisbursementAmount().Amount(),
 		DisbursedAt: loan.DisbursedAt().Format("2006-01-02T15:04:05Z07:00"),
 		DueDate:     loan.DueDate().Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
@@ -237,3 +406,48 @@ func (h *LoanHandler) HandleMarkLoanDefaulted(ctx context.Context, cmd command.M
 
 	return h.loanRepo.SaveWithEvents(ctx, loan)
 }
+
+// loadHistory summarises the borrower's previous loans for the decision engine
+func (h *LoanHandler) loadHistory(ctx context.Context, userID valueobject.UserID) (service.BorrowerHistory, error) {
+	stats, err := h.statsRepo.GetUserLoanStats(ctx, userID)
+	if err != nil {
+		return service.BorrowerHistory{}, err
+	}
+
+	return service.BorrowerHistory{
+		CompletedLoans:     stats.CompletedLoans,
+		DefaultedLoans:     stats.DefaultedLoans,
+		CurrentOutstanding: stats.CurrentOutstanding,
+	}, nil
+}
+
+func toDecisionResult(loan *aggregate.Loan) *command.LoanDecisionResult {
+	return &command.LoanDecisionResult{
+		LoanID:       loan.ID().String(),
+		Status:       loan.Status().String(),
+		Principal:    loan.Principal().Amount(),
+		TenureMonths: loan.TenureMonths(),
+		TotalAmount:  loan.TotalAmount().Amount(),
+		NeedsChecker: loan.Status() == aggregate.LoanStatusAwaitingChecker,
+	}
+}
+
+// loadApplicant builds the eligibility snapshot for a borrower
+func (h *LoanHandler) loadApplicant(ctx context.Context, userID valueobject.UserID) (aggregate.LoanApplicant, error) {
+	creditScore, err := h.creditScoreRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return aggregate.LoanApplicant{}, ErrCreditScoreNotFound
+	}
+
+	profile, err := h.borrowerRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return aggregate.LoanApplicant{}, ErrBorrowerNotFound
+	}
+
+	return aggregate.LoanApplicant{
+		Score:      creditScore.Score(),
+		Tier:       creditScore.Tier(),
+		KYCLevel:   profile.KYCLevel,
+		AccountAge: time.Since(profile.AccountCreatedAt),
+	}, nil
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"hustlex/internal/domain/credit/aggregate"
@@ -34,6 +35,41 @@ type CreditScoreDTO struct {
 	CreatedAt          time.Time `json:"created_at"`
 }
 
+// GetLoanProducts retrieves the active loan product catalog
+type GetLoanProducts struct{}
+
+// LoanProductDTO represents a loan product for API responses
+type LoanProductDTO struct {
+	ID                 string  `json:"id"`
+	Code               string  `json:"code"`
+	Name               string  `json:"name"`
+	Description        string  `json:"description"`
+	Category           string  `json:"category"`
+	Currency           string  `json:"currency"`
+	MinAmount          int64   `json:"min_amount"`
+	MaxAmount          int64   `json:"max_amount"`
+	MinTenureMonths    int     `json:"min_tenure_months"`
+	MaxTenureMonths    int     `json:"max_tenure_months"`
+	OriginationFeeRate float64 `json:"origination_fee_rate"`
+	GracePeriodDays    int     `json:"grace_period_days"`
+}
+
+// GetLoanEligibility evaluates a user against one or all active products
+type GetLoanEligibility struct {
+	UserID    string
+	ProductID string // optional; all active products when empty
+}
+
+// LoanEligibilityDTO represents a user's eligibility for a product
+type LoanEligibilityDTO struct {
+	Product      LoanProductDTO `json:"product"`
+	Eligible     bool           `json:"eligible"`
+	Reason       string         `json:"reason,omitempty"`
+	Tier         string         `json:"tier"`
+	MaxAmount    int64          `json:"max_amount"`
+	InterestRate float64        `json:"interest_rate"`
+}
+
 // GetLoan retrieves a single loan
 type GetLoan struct {
 	LoanID string
@@ -44,10 +80,12 @@ type GetLoan struct {
 type LoanDTO struct {
 	ID               string     `json:"id"`
 	UserID           string     `json:"user_id"`
+	ProductID        string     `json:"product_id"`
 	Principal        int64      `json:"principal"`
 	InterestRate     float64    `json:"interest_rate"`
 	InterestAmount   int64      `json:"interest_amount"`
 	TotalAmount      int64      `json:"total_amount"`
+	OriginationFee   int64      `json:"origination_fee"`
 	AmountRepaid     int64      `json:"amount_repaid"`
 	RemainingBalance int64      `json:"remaining_balance"`
 	Currency         string     `json:"currency"`
@@ -61,9 +99,20 @@ type LoanDTO struct {
 	CompletedAt      *time.Time `json:"completed_at,omitempty"`
 	IsOverdue        bool       `json:"is_overdue"`
 	Repayments       []RepaymentDTO `json:"repayments,omitempty"`
+	Decisions        []LoanDecisionDTO `json:"decisions,omitempty"`
 	CreatedAt        time.Time  `json:"created_at"`
 }
 
+// LoanDecisionDTO represents an underwriting decision on a loan
+type LoanDecisionDTO struct {
+	Outcome      string    `json:"outcome"`
+	DecidedBy    string    `json:"decided_by"`
+	Reasons      []string  `json:"reasons"`
+	Principal    int64     `json:"principal"`
+	TenureMonths int       `json:"tenure_months"`
+	DecidedAt    time.Time `json:"decided_at"`
+}
+
 // RepaymentDTO represents repayment data
 type RepaymentDTO struct {
 	ID            string    `json:"id"`
@@ -100,6 +149,45 @@ type GetOverdueLoans struct {
 	AdminID string
 }
 
+// GetUnderwritingQueue retrieves loans waiting for an underwriter (admin)
+type GetUnderwritingQueue struct {
+	Status string // optional: referred or awaiting_checker; both when empty
+	Page   int
+	Limit  int
+}
+
+// UnderwritingQueueResult represents a page of the underwriting queue
+type UnderwritingQueueResult struct {
+	Loans      []*repository.LoanDTO `json:"loans"`
+	Total      int64                 `json:"total"`
+	Page       int                   `json:"page"`
+	Limit      int                   `json:"limit"`
+	TotalPages int                   `json:"total_pages"`
+}
+
+// GetBureauSubmissions retrieves the submissions made to a bureau for a month (admin)
+type GetBureauSubmissions struct {
+	Bureau string
+	Period string // YYYY-MM
+}
+
+// BureauSubmissionDTO represents a credit bureau submission record
+type BureauSubmissionDTO struct {
+	ID           string     `json:"id"`
+	Bureau       string     `json:"bureau"`
+	Period       string     `json:"period"`
+	FileName     string     `json:"file_name"`
+	Checksum     string     `json:"checksum"`
+	RecordCount  int        `json:"record_count"`
+	CorrectionOf string     `json:"correction_of,omitempty"`
+	Status       string     `json:"status"`
+	Reference    string     `json:"reference,omitempty"`
+	Errors       []string   `json:"errors,omitempty"`
+	SubmittedAt  *time.Time `json:"submitted_at,omitempty"`
+	RespondedAt  *time.Time `json:"responded_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
 // GetLoanStats retrieves loan statistics for a user
 type GetLoanStats struct {
 	UserID string
@@ -147,6 +235,9 @@ type CreditQueryHandler struct {
 	loanRepo        repository.LoanRepository
 	repaymentRepo   repository.RepaymentRepository
 	statsRepo       repository.CreditStatisticsRepository
+	productRepo     repository.LoanProductRepository
+	borrowerRepo    repository.BorrowerProfileRepository
+	submissionRepo  repository.BureauSubmissionRepository
 }
 
 // NewCreditQueryHandler creates a new query handler
@@ -155,12 +246,18 @@ func NewCreditQueryHandler(
 	loanRepo repository.LoanRepository,
 	repaymentRepo repository.RepaymentRepository,
 	statsRepo repository.CreditStatisticsRepository,
+	productRepo repository.LoanProductRepository,
+	borrowerRepo repository.BorrowerProfileRepository,
+	submissionRepo repository.BureauSubmissionRepository,
 ) *CreditQueryHandler {
 	return &CreditQueryHandler{
 		creditScoreRepo: creditScoreRepo,
 		loanRepo:        loanRepo,
 		repaymentRepo:   repaymentRepo,
 		statsRepo:       statsRepo,
+		productRepo:     productRepo,
+		borrowerRepo:    borrowerRepo,
+		submissionRepo:  submissionRepo,
 	}
 }
 
@@ -179,6 +276,108 @@ func (h *CreditQueryHandler) HandleGetCreditScore(ctx context.Context, q GetCred
 	return creditScoreToDTO(creditScore), nil
 }
 
+// CreditTiers returns the credit tier of each user keyed by user ID.
+// Users who have no score yet are left out rather than failing the lookup.
+func (h *CreditQueryHandler) CreditTiers(ctx context.Context, userIDs []string) (map[string]string, error) {
+	tiers := make(map[string]string, len(userIDs))
+	for _, id := range userIDs {
+		if _, seen := tiers[id]; seen {
+			continue
+		}
+
+		userID, err := valueobject.NewUserID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		creditScore, err := h.creditScoreRepo.FindByUserID(ctx, userID)
+		if errors.Is(err, repository.ErrCreditScoreNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tiers[id] = string(creditScore.Tier())
+	}
+
+	return tiers, nil
+}
+
+// HandleGetLoanProducts retrieves the active loan product catalog
+func (h *CreditQueryHandler) HandleGetLoanProducts(ctx context.Context, q GetLoanProducts) ([]LoanProductDTO, error) {
+	products, err := h.productRepo.FindActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]LoanProductDTO, len(products))
+	for i, product := range products {
+		dtos[i] = loanProductToDTO(product)
+	}
+
+	return dtos, nil
+}
+
+// HandleGetLoanEligibility evaluates a user against the loan product catalog
+func (h *CreditQueryHandler) HandleGetLoanEligibility(ctx context.Context, q GetLoanEligibility) ([]LoanEligibilityDTO, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	creditScore, err := h.creditScoreRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.borrowerRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var products []*aggregate.LoanProduct
+	if q.ProductID != "" {
+		product, err := h.productRepo.FindByID(ctx, q.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		products = []*aggregate.LoanProduct{product}
+	} else {
+		products, err = h.productRepo.FindActive(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	applicant := aggregate.LoanApplicant{
+		Score:      creditScore.Score(),
+		Tier:       creditScore.Tier(),
+		KYCLevel:   profile.KYCLevel,
+		AccountAge: time.Since(profile.AccountCreatedAt),
+	}
+
+	results := make([]LoanEligibilityDTO, len(products))
+	for i, product := range products {
+		result := LoanEligibilityDTO{
+			Product:  loanProductToDTO(product),
+			Eligible: true,
+			Tier:     applicant.Tier.String(),
+		}
+		if err := product.CheckEligibility(applicant); err != nil {
+			result.Eligible = false
+			result.Reason = err.Error()
+		} else {
+			pricing, _ := product.PricingFor(applicant.Tier)
+			result.MaxAmount = product.MaxAmountFor(applicant.Tier)
+			result.InterestRate = pricing.MonthlyRate
+		}
+		results[i] = result
+	}
+
+	return results, nil
+}
+
 // HandleGetLoan retrieves a single loan
 func (h *CreditQueryHandler) HandleGetLoan(ctx context.Context, q GetLoan) (*LoanDTO, error) {
 	loanID, err := valueobject.NewLoanID(q.LoanID)
@@ -275,6 +474,82 @@ func (h *CreditQueryHandler) HandleGetOverdueLoans(ctx context.Context, q GetOve
 	return h.loanRepo.FindOverdue(ctx)
 }
 
+// HandleGetUnderwritingQueue lists referred loans and loans awaiting checker sign-off, oldest first
+func (h *CreditQueryHandler) HandleGetUnderwritingQueue(ctx context.Context, q GetUnderwritingQueue) (*UnderwritingQueueResult, error) {
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 || q.Limit > 100 {
+		q.Limit = 20
+	}
+
+	statuses := []aggregate.LoanStatus{aggregate.LoanStatusReferred, aggregate.LoanStatusAwaitingChecker}
+	if q.Status != "" {
+		statuses = []aggregate.LoanStatus{aggregate.LoanStatus(q.Status)}
+	}
+
+	loans, total, err := h.loanRepo.List(ctx, repository.LoanFilter{
+		Statuses: statuses,
+		Offset:   (q.Page - 1) * q.Limit,
+		Limit:    q.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(total) / q.Limit
+	if int(total)%q.Limit > 0 {
+		totalPages++
+	}
+
+	return &UnderwritingQueueResult{
+		Loans:      loans,
+		Total:      total,
+		Page:       q.Page,
+		Limit:      q.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// HandleGetBureauSubmissions lists the submission history for a bureau and month
+func (h *CreditQueryHandler) HandleGetBureauSubmissions(ctx context.Context, q GetBureauSubmissions) ([]BureauSubmissionDTO, error) {
+	bureau := aggregate.CreditBureau(q.Bureau)
+	if !bureau.IsValid() {
+		return nil, aggregate.ErrUnknownBureau
+	}
+
+	period, err := time.Parse("2006-01", q.Period)
+	if err != nil {
+		return nil, aggregate.ErrInvalidReportingPeriod
+	}
+
+	submissions, err := h.submissionRepo.FindByPeriod(ctx, bureau, period)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]BureauSubmissionDTO, len(submissions))
+	for i, s := range submissions {
+		dtos[i] = BureauSubmissionDTO{
+			ID:           s.ID(),
+			Bureau:       s.Bureau().String(),
+			Period:       s.Period().Format("2006-01"),
+			FileName:     s.FileName(),
+			Checksum:     s.Checksum(),
+			RecordCount:  s.RecordCount(),
+			CorrectionOf: s.CorrectionOf(),
+			Status:       s.Status().String(),
+			Reference:    s.Reference(),
+			Errors:       s.Errors(),
+			SubmittedAt:  s.SubmittedAt(),
+			RespondedAt:  s.RespondedAt(),
+			CreatedAt:    s.CreatedAt(),
+		}
+	}
+
+	return dtos, nil
+}
+
 func creditScoreToDTO(cs *aggregate.CreditScore) *CreditScoreDTO {
 	return &CreditScoreDTO{
 		ID:                 cs.ID(),
@@ -296,14 +571,33 @@ func creditScoreToDTO(cs *aggregate.CreditScore) *CreditScoreDTO {
 	}
 }
 
+func loanProductToDTO(p *aggregate.LoanProduct) LoanProductDTO {
+	return LoanProductDTO{
+		ID:                 p.ID(),
+		Code:               p.Code(),
+		Name:               p.Name(),
+		Description:        p.Description(),
+		Category:           p.Category().String(),
+		Currency:           string(p.Currency()),
+		MinAmount:          p.MinAmount(),
+		MaxAmount:          p.MaxAmount(),
+		MinTenureMonths:    p.MinTenureMonths(),
+		MaxTenureMonths:    p.MaxTenureMonths(),
+		OriginationFeeRate: p.OriginationFeeRate(),
+		GracePeriodDays:    p.GracePeriodDays(),
+	}
+}
+
 func loanToDTO(loan *aggregate.Loan) *LoanDTO {
 	dto := &LoanDTO{
 		ID:               loan.ID().String(),
 		UserID:           loan.UserID().String(),
+		ProductID:        loan.ProductID(),
 		Principal:        loan.Principal().Amount(),
 		InterestRate:     loan.InterestRate(),
 		InterestAmount:   loan.InterestAmount().Amount(),
 		TotalAmount:      loan.TotalAmount().Amount(),
+		OriginationFee:   loan.OriginationFee().Amount(),
 		AmountRepaid:     loan.AmountRepaid().Amount(),
 		RemainingBalance: loan.RemainingBalance().Amount(),
 		Currency:         string(loan.Principal().Currency()),
@@ -332,6 +626,20 @@ func loanToDTO(loan *aggregate.Loan) *LoanDTO {
 	}
 	dto.Repayments = repayments
 
+	// Add underwriting decisions
+	decisions := make([]LoanDecisionDTO, len(loan.Decisions()))
+	for i, d := range loan.Decisions() {
+		decisions[i] = LoanDecisionDTO{
+			Outcome:      d.Outcome().String(),
+			DecidedBy:    d.DecidedBy(),
+			Reasons:      d.Reasons(),
+			Principal:    d.Principal().Amount(),
+			TenureMonths: d.TenureMonths(),
+			DecidedAt:    d.DecidedAt(),
+		}
+	}
+	dto.Decisions = decisions
+
 	return dto
 }
 
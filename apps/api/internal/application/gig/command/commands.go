@@ -20,6 +20,8 @@ type CreateGig struct {
 	Deadline     *time.Time
 	IsRemote     bool
 	Location     string
+	Latitude     *float64 // pins an on-site gig on the map
+	Longitude    *float64
 	Tags         []string
 	Attachments  []string
 }
@@ -51,6 +53,8 @@ type UpdateGig struct {
 	Deadline     *time.Time
 	IsRemote     bool
 	Location     string
+	Latitude     *float64 // pins an on-site gig on the map
+	Longitude    *float64
 	Tags         []string
 	Attachments  []string
 }
@@ -70,6 +74,7 @@ type SubmitProposal struct {
 	ProposedPrice int64
 	Currency      string
 	DeliveryDays  int
+	Revisions     *int // revision rounds offered; the platform default when nil
 	Attachments   []string
 }
 
@@ -90,11 +95,81 @@ type WithdrawProposal struct {
 	HustlerID  string
 }
 
-// AcceptProposal accepts a proposal and creates a contract
-type AcceptProposal struct {
+// SetGigVisibility makes a gig public or invite-only
+type SetGigVisibility struct {
+	GigID      string
+	ClientID   string
+	Visibility string // public, invite_only
+}
+
+// InviteHustlers asks specific hustlers to propose on a gig
+type InviteHustlers struct {
+	GigID      string
+	ClientID   string
+	HustlerIDs []string
+	Message    string
+}
+
+// InviteHustlersResult reports who was invited and who could not be
+type InviteHustlersResult struct {
+	Invitations []InvitationResult  `json:"invitations"`
+	Failed      []InvitationFailure `json:"failed,omitempty"`
+}
+
+// InvitationResult is one invitation that was sent
+type InvitationResult struct {
+	InvitationID string    `json:"invitation_id"`
+	HustlerID    string    `json:"hustler_id"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// InvitationFailure is a hustler who could not be invited, and why
+type InvitationFailure struct {
+	HustlerID string `json:"hustler_id"`
+	Reason    string `json:"reason"`
+}
+
+// AcceptInvitation answers an invitation with a proposal, under the gig's usual budget checks
+type AcceptInvitation struct {
+	SubmitProposal
+}
+
+// DeclineInvitation turns down an invitation to propose
+type DeclineInvitation struct {
+	GigID     string
+	HustlerID string
+	Reason    string
+}
+
+// LabelProposal shortlists or archives a proposal, or clears its label
+type LabelProposal struct {
 	GigID      string
 	ProposalID string
 	ClientID   string
+	Label      string // shortlisted, archived, or empty to clear
+}
+
+// MessageShortlist sends a message to every hustler shortlisted on a gig
+type MessageShortlist struct {
+	GigID    string
+	ClientID string
+	Body     string
+}
+
+// AcceptProposal accepts a proposal and creates a contract
+type AcceptProposal struct {
+	GigID       string
+	ProposalID  string
+	ClientID    string
+	Milestones  []MilestoneSpec // optional; the whole price is one milestone when empty
+	FundingMode string          // upfront (default), just_in_time
+}
+
+// MilestoneSpec describes one stage of the work and its share of the price
+type MilestoneSpec struct {
+	Title   string    `json:"title"`
+	Amount  int64     `json:"amount"`
+	DueDate time.Time `json:"due_date"`
 }
 
 // AcceptProposalResult is the result of accepting a proposal
@@ -107,42 +182,131 @@ type AcceptProposalResult struct {
 	DeliveryDays int       `json:"delivery_days"`
 	DeadlineAt   time.Time `json:"deadline_at"`
 	Status       string    `json:"status"`
+	FundingMode  string    `json:"funding_mode"`
+	Milestones   int       `json:"milestones"`
+	EscrowHeld   int64     `json:"escrow_held"`
 }
 
 // DeliverWork marks work as delivered
 type DeliverWork struct {
 	ContractID   string
 	HustlerID    string
+	MilestoneID  string // optional; the milestone in progress when empty
 	Deliverables []string
+	Notes        string
 }
 
 // DeliverWorkResult is the result of delivering work
 type DeliverWorkResult struct {
 	ContractID  string    `json:"contract_id"`
+	MilestoneID string    `json:"milestone_id"`
+	DeliveryID  string    `json:"delivery_id"`
+	Version     int       `json:"version"`
 	Status      string    `json:"status"`
 	DeliveredAt time.Time `json:"delivered_at"`
+	ReviewDueAt time.Time `json:"review_due_at"` // approved automatically if the client hasn't responded by then
 }
 
-// ApproveDelivery approves delivered work
+// ApproveDelivery approves a delivered milestone and releases its escrow
 type ApproveDelivery struct {
-	ContractID string
-	ClientID   string
-	Notes      string
+	ContractID  string
+	ClientID    string
+	MilestoneID string // optional; the earliest delivered milestone when empty
+	Notes       string
 }
 
 // ApproveDeliveryResult is the result of approving delivery
 type ApproveDeliveryResult struct {
-	ContractID  string    `json:"contract_id"`
-	Status      string    `json:"status"`
-	CompletedAt time.Time `json:"completed_at"`
-	PaidAmount  int64     `json:"paid_amount"`
+	ContractID  string     `json:"contract_id"`
+	MilestoneID string     `json:"milestone_id"`
+	Status      string     `json:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"` // Set once every milestone is released
+	PaidAmount  int64      `json:"paid_amount"`
+}
+
+// FundMilestone holds the client's money for the next milestone in escrow
+type FundMilestone struct {
+	ContractID  string
+	ClientID    string
+	MilestoneID string
+}
+
+// RequestRevision sends a delivered milestone back to the hustler
+type RequestRevision struct {
+	ContractID  string
+	ClientID    string
+	MilestoneID string
+	Reason      string
+	ExtendDays  int // optional extra days for the hustler
+}
+
+// DisputeMilestone freezes a single milestone's escrow pending resolution
+type DisputeMilestone struct {
+	ContractID  string
+	MilestoneID string
+	UserID      string
+	Reason      string
+	Statement   string   // optional opening evidence
+	Attachments []string // references to uploaded files
 }
 
 // DisputeContract raises a dispute on a contract
 type DisputeContract struct {
-	ContractID string
-	UserID     string
-	Reason     string
+	ContractID  string
+	UserID      string
+	Reason      string
+	Statement   string   // optional opening evidence
+	Attachments []string // references to uploaded files
+}
+
+// DisputeResult contains the result of opening a dispute
+type DisputeResult struct {
+	DisputeID     string     `json:"dispute_id"`
+	Status        string     `json:"status"`
+	Amount        int64      `json:"amount"`
+	MilestoneIDs  []string   `json:"milestone_ids"`
+	ResponseDueAt *time.Time `json:"response_due_at,omitempty"`
+}
+
+// SubmitDisputeEvidence adds a party's statement and attachments to a dispute
+type SubmitDisputeEvidence struct {
+	DisputeID   string
+	UserID      string
+	Statement   string
+	Attachments []string
+}
+
+// AssignDisputeMediator hands a dispute to a mediator
+type AssignDisputeMediator struct {
+	DisputeID  string
+	MediatorID string
+}
+
+// RequestDisputeResponse has the mediator ask a party to respond by a deadline
+type RequestDisputeResponse struct {
+	DisputeID   string
+	MediatorID  string
+	PartyID     string
+	WindowHours int
+}
+
+// ResolveDispute records the mediator's ruling on a dispute
+type ResolveDispute struct {
+	DisputeID     string
+	MediatorID    string
+	Outcome       string // refund, release, split
+	ClientPercent int    // share refunded to the client, for a split
+	Notes         string
+}
+
+// ResolveDisputeResult contains how the disputed escrow was divided
+type ResolveDisputeResult struct {
+	DisputeID     string    `json:"dispute_id"`
+	Outcome       string    `json:"outcome"`
+	RefundAmount  int64     `json:"refund_amount"`
+	ReleaseAmount int64     `json:"release_amount"`
+	PlatformFee   int64     `json:"platform_fee"`
+	ResolvedAt    time.Time `json:"resolved_at"`
 }
 
 // CancelContract cancels a contract
@@ -168,9 +332,279 @@ type SubmitReviewResult struct {
 	ReviewID   string    `json:"review_id"`
 	ContractID string    `json:"contract_id"`
 	Rating     int       `json:"rating"`
+	Revealed   bool      `json:"revealed"` // false until the other party reviews or the window closes
+	Held       bool      `json:"held"`     // awaiting moderation
 	CreatedAt  time.Time `json:"created_at"`
 }
 
+// RespondToReview posts the reviewee's public response to a review
+type RespondToReview struct {
+	ReviewID   string
+	RevieweeID string
+	Response   string
+}
+
+// FlagReview reports a review to the moderators
+type FlagReview struct {
+	ReviewID   string
+	ReporterID string
+	Note       string
+}
+
+// ModerateReview records a moderator's decision on a flagged review
+type ModerateReview struct {
+	ReviewID    string
+	ModeratorID string
+	Decision    string // keep, remove
+	Note        string
+}
+
+// PackageTierSpec describes one tier of a service package
+type PackageTierSpec struct {
+	Tier         string   `json:"tier"` // basic, standard, premium
+	Summary      string   `json:"summary"`
+	Price        int64    `json:"price"`
+	DeliveryDays int      `json:"delivery_days"`
+	Revisions    int      `json:"revisions"`
+	Features     []string `json:"features,omitempty"`
+}
+
+// PackageAddOnSpec describes an optional extra on a service package
+type PackageAddOnSpec struct {
+	Title     string `json:"title"`
+	Price     int64  `json:"price"`
+	ExtraDays int    `json:"extra_days"`
+}
+
+// CreateServicePackage publishes a hustler's fixed-price offering
+type CreateServicePackage struct {
+	HustlerID   string
+	Title       string
+	Description string
+	Category    string
+	SkillID     string
+	Currency    string
+	Tags        []string
+	Tiers       []PackageTierSpec
+	AddOns      []PackageAddOnSpec
+}
+
+// UpdateServicePackage changes a package's details and pricing
+type UpdateServicePackage struct {
+	PackageID   string
+	HustlerID   string // for ownership verification
+	Title       string
+	Description string
+	Category    string
+	SkillID     string
+	Tags        []string
+	Tiers       []PackageTierSpec
+	AddOns      []PackageAddOnSpec
+}
+
+// ServicePackageResult is the result of publishing or updating a package
+type ServicePackageResult struct {
+	PackageID     string    `json:"package_id"`
+	Title         string    `json:"title"`
+	Category      string    `json:"category"`
+	StartingPrice int64     `json:"starting_price"`
+	Currency      string    `json:"currency"`
+	Tiers         int       `json:"tiers"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// SetServicePackageStatus pauses, resumes or archives a package
+type SetServicePackageStatus struct {
+	PackageID string
+	HustlerID string
+	Status    string // active, paused, archived
+}
+
+// OrderServicePackage buys a package tier, creating a contract with escrow held
+type OrderServicePackage struct {
+	PackageID string
+	ClientID  string
+	Tier      string
+	AddOnIDs  []string
+}
+
+// OrderServicePackageResult is the result of ordering a package
+type OrderServicePackageResult struct {
+	ContractID   string    `json:"contract_id"`
+	PackageID    string    `json:"package_id"`
+	Tier         string    `json:"tier"`
+	HustlerID    string    `json:"hustler_id"`
+	AgreedPrice  int64     `json:"agreed_price"`
+	PlatformFee  int64     `json:"platform_fee"`
+	DeliveryDays int       `json:"delivery_days"`
+	DeadlineAt   time.Time `json:"deadline_at"`
+	Revisions    int       `json:"revisions"`
+	Status       string    `json:"status"`
+	EscrowHeld   int64     `json:"escrow_held"`
+}
+
+// WeeklyHoursSpec is one working-hours window, as "HH:MM" in the hustler's timezone
+type WeeklyHoursSpec struct {
+	Weekday   int // 0 = Sunday
+	StartTime string
+	EndTime   string
+}
+
+// SetAvailability replaces a hustler's working hours and visit fee
+type SetAvailability struct {
+	HustlerID   string
+	Timezone    string // IANA name, e.g. Africa/Lagos
+	WeeklyHours []WeeklyHoursSpec
+	VisitFee    int64
+	Currency    string
+}
+
+// SetServiceArea sets where a hustler travels for on-site work
+type SetServiceArea struct {
+	HustlerID           string
+	Latitude            float64
+	Longitude           float64
+	RadiusKm            float64
+	TravelBufferMinutes int // gap kept clear between visits
+}
+
+// AddBlackout marks dates a hustler is unavailable
+type AddBlackout struct {
+	HustlerID string
+	StartDate time.Time
+	EndDate   time.Time // inclusive
+	Reason    string
+}
+
+// RemoveBlackout makes blacked out dates bookable again
+type RemoveBlackout struct {
+	HustlerID  string
+	BlackoutID string
+}
+
+// BookVisit books an on-site visit in a hustler's calendar
+type BookVisit struct {
+	ClientID   string
+	HustlerID  string
+	ContractID string // optional
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Latitude   float64
+	Longitude  float64
+	Address    string
+	Notes      string
+}
+
+// RescheduleBooking moves a booking to another slot
+type RescheduleBooking struct {
+	BookingID string
+	UserID    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+}
+
+// CancelBooking cancels a booking under the cancellation policy
+type CancelBooking struct {
+	BookingID string
+	UserID    string
+	Reason    string
+}
+
+// CompleteBooking marks an on-site visit done
+type CompleteBooking struct {
+	BookingID string
+	HustlerID string
+}
+
+// BookingResult is the result of a booking command
+type BookingResult struct {
+	BookingID       string    `json:"booking_id"`
+	HustlerID       string    `json:"hustler_id"`
+	ClientID        string    `json:"client_id"`
+	Status          string    `json:"status"`
+	StartsAt        time.Time `json:"starts_at"`
+	EndsAt          time.Time `json:"ends_at"`
+	VisitFee        int64     `json:"visit_fee"`
+	Currency        string    `json:"currency"`
+	RescheduleCount int       `json:"reschedule_count"`
+	CancellationFee int64     `json:"cancellation_fee,omitempty"`
+	Refund          int64     `json:"refund,omitempty"`
+}
+
+// StartConversation opens, or returns the existing, conversation about a gig,
+// proposal or contract. Set ContractID, or GigID with an optional ProposalID;
+// a client messaging about a gig also names the hustler.
+type StartConversation struct {
+	UserID     string
+	GigID      string
+	ProposalID string
+	ContractID string
+	HustlerID  string
+}
+
+// ConversationResult is the result of starting a conversation
+type ConversationResult struct {
+	ConversationID string `json:"conversation_id"`
+	Subject        string `json:"subject"`
+	SubjectID      string `json:"subject_id"`
+	ClientID       string `json:"client_id"`
+	HustlerID      string `json:"hustler_id"`
+	Screened       bool   `json:"screened"`
+}
+
+// SendMessage posts a message to a conversation
+type SendMessage struct {
+	ConversationID string
+	SenderID       string
+	Body           string
+	Attachments    []string
+}
+
+// SendMessageResult is the result of sending a message
+type SendMessageResult struct {
+	MessageID string    `json:"message_id"`
+	Seq       int       `json:"seq"`
+	Body      string    `json:"body"`
+	Redacted  []string  `json:"redacted,omitempty"` // kinds of contact info hidden from the body
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// MarkConversationRead records a read receipt; UpToSeq zero means everything so far
+type MarkConversationRead struct {
+	ConversationID string
+	UserID         string
+	UpToSeq        int
+}
+
+// SendTypingIndicator tells the other participant someone is typing
+type SendTypingIndicator struct {
+	ConversationID string
+	UserID         string
+}
+
+// ExportConversation exports a conversation transcript
+type ExportConversation struct {
+	ConversationID string
+	UserID         string
+}
+
+// ExportConversationResult is the result of exporting a transcript
+type ExportConversationResult struct {
+	Reference    string    `json:"reference"` // where the stored transcript can be fetched from
+	MessageCount int       `json:"message_count"`
+	Checksum     string    `json:"checksum"`
+	ExportedAt   time.Time `json:"exported_at"`
+}
+
+// AttachConversationToDispute exports a conversation and submits it as dispute evidence
+type AttachConversationToDispute struct {
+	DisputeID      string
+	ConversationID string
+	UserID         string
+	Statement      string
+}
+
 // Helper methods for validation
 
 func (c CreateGig) GetClientID() (valueobject.UserID, error) {
@@ -207,6 +641,26 @@ func (c CreateGig) GetSkillID() (*valueobject.SkillID, error) {
 	return &skillID, nil
 }
 
+func (c CreateGig) GetCoordinates() (*valueobject.GeoPoint, error) {
+	return geoPoint(c.Latitude, c.Longitude)
+}
+
+func (c UpdateGig) GetCoordinates() (*valueobject.GeoPoint, error) {
+	return geoPoint(c.Latitude, c.Longitude)
+}
+
+// geoPoint builds a map pin when both coordinates are given
+func geoPoint(latitude, longitude *float64) (*valueobject.GeoPoint, error) {
+	if latitude == nil || longitude == nil {
+		return nil, nil
+	}
+	point, err := valueobject.NewGeoPoint(*latitude, *longitude)
+	if err != nil {
+		return nil, err
+	}
+	return &point, nil
+}
+
 func (c SubmitProposal) GetGigID() (valueobject.GigID, error) {
 	return valueobject.NewGigID(c.GigID)
 }
@@ -258,3 +712,42 @@ func (c SubmitReview) GetContractID() (valueobject.ContractID, error) {
 func (c SubmitReview) GetReviewerID() (valueobject.UserID, error) {
 	return valueobject.NewUserID(c.ReviewerID)
 }
+
+func (c CreateServicePackage) GetHustlerID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.HustlerID)
+}
+
+func (c OrderServicePackage) GetPackageID() (valueobject.PackageID, error) {
+	return valueobject.NewPackageID(c.PackageID)
+}
+
+func (c OrderServicePackage) GetClientID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.ClientID)
+}
+
+func (c SetServiceArea) GetCenter() (valueobject.GeoPoint, error) {
+	return valueobject.NewGeoPoint(c.Latitude, c.Longitude)
+}
+
+func (c BookVisit) GetClientID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.ClientID)
+}
+
+func (c BookVisit) GetHustlerID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.HustlerID)
+}
+
+func (c BookVisit) GetContractID() (*valueobject.ContractID, error) {
+	if c.ContractID == "" {
+		return nil, nil
+	}
+	id, err := valueobject.NewContractID(c.ContractID)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (c BookVisit) GetLocation() (valueobject.GeoPoint, error) {
+	return valueobject.NewGeoPoint(c.Latitude, c.Longitude)
+}
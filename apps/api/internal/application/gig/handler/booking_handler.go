@@ -0,0 +1,312 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/gig/command"
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// BookingHandler handles hustlers' availability calendars and clients' on-site bookings
+type BookingHandler struct {
+	availabilityRepo repository.AvailabilityRepository
+	bookingSvc       *service.BookingService
+	scheduler        BookingScheduler
+}
+
+// NewBookingHandler creates a new booking handler
+func NewBookingHandler(
+	availabilityRepo repository.AvailabilityRepository,
+	bookingSvc *service.BookingService,
+	scheduler BookingScheduler,
+) *BookingHandler {
+	return &BookingHandler{
+		availabilityRepo: availabilityRepo,
+		bookingSvc:       bookingSvc,
+		scheduler:        scheduler,
+	}
+}
+
+// HandleSetAvailability replaces a hustler's working hours and visit fee,
+// creating their calendar on first use
+func (h *BookingHandler) HandleSetAvailability(ctx context.Context, cmd command.SetAvailability) error {
+	hustlerID, err := valueobject.NewUserID(cmd.HustlerID)
+	if err != nil {
+		return errors.New("invalid hustler ID")
+	}
+
+	windows := make([]aggregate.WeeklyWindow, len(cmd.WeeklyHours))
+	for i, spec := range cmd.WeeklyHours {
+		start, err := parseClock(spec.StartTime)
+		if err != nil {
+			return err
+		}
+		end, err := parseClock(spec.EndTime)
+		if err != nil {
+			return err
+		}
+		windows[i], err = aggregate.NewWeeklyWindow(time.Weekday(spec.Weekday), start, end)
+		if err != nil {
+			return err
+		}
+	}
+
+	currency := valueobject.Currency(cmd.Currency)
+	if cmd.Currency == "" {
+		currency = valueobject.NGN
+	}
+	visitFee, err := valueobject.NewMoney(cmd.VisitFee, currency)
+	if err != nil {
+		return err
+	}
+
+	availability, err := h.availabilityRepo.FindByHustlerID(ctx, hustlerID)
+	if err != nil {
+		availability, err = aggregate.NewAvailability(hustlerID, cmd.Timezone)
+		if err != nil {
+			return err
+		}
+	} else if cmd.Timezone != "" {
+		if err := availability.SetTimezone(cmd.Timezone); err != nil {
+			return err
+		}
+	}
+
+	if err := availability.SetWeeklyHours(windows); err != nil {
+		return err
+	}
+	availability.SetVisitFee(visitFee)
+
+	return h.availabilityRepo.SaveWithEvents(ctx, availability)
+}
+
+// HandleSetServiceArea sets where a hustler travels and the gap they keep between visits
+func (h *BookingHandler) HandleSetServiceArea(ctx context.Context, cmd command.SetServiceArea) error {
+	availability, err := h.loadAvailability(ctx, cmd.HustlerID)
+	if err != nil {
+		return err
+	}
+
+	center, err := cmd.GetCenter()
+	if err != nil {
+		return err
+	}
+	area, err := aggregate.NewServiceArea(center, cmd.RadiusKm)
+	if err != nil {
+		return err
+	}
+
+	buffer := time.Duration(cmd.TravelBufferMinutes) * time.Minute
+	if err := availability.SetServiceArea(area, buffer); err != nil {
+		return err
+	}
+
+	return h.availabilityRepo.SaveWithEvents(ctx, availability)
+}
+
+// HandleAddBlackout blocks out dates in a hustler's calendar
+func (h *BookingHandler) HandleAddBlackout(ctx context.Context, cmd command.AddBlackout) (string, error) {
+	availability, err := h.loadAvailability(ctx, cmd.HustlerID)
+	if err != nil {
+		return "", err
+	}
+
+	blackout, err := availability.AddBlackout(cmd.StartDate, cmd.EndDate, cmd.Reason)
+	if err != nil {
+		return "", err
+	}
+
+	if err := h.availabilityRepo.SaveWithEvents(ctx, availability); err != nil {
+		return "", err
+	}
+
+	return blackout.ID(), nil
+}
+
+// HandleRemoveBlackout reopens blacked out dates
+func (h *BookingHandler) HandleRemoveBlackout(ctx context.Context, cmd command.RemoveBlackout) error {
+	availability, err := h.loadAvailability(ctx, cmd.HustlerID)
+	if err != nil {
+		return err
+	}
+
+	if err := availability.RemoveBlackout(cmd.BlackoutID); err != nil {
+		return err
+	}
+
+	return h.availabilityRepo.SaveWithEvents(ctx, availability)
+}
+
+// HandleBookVisit books an on-site visit and schedules its reminders
+func (h *BookingHandler) HandleBookVisit(ctx context.Context, cmd command.BookVisit) (*command.BookingResult, error) {
+	clientID, err := cmd.GetClientID()
+	if err != nil {
+		return nil, errors.New("invalid client ID")
+	}
+
+	hustlerID, err := cmd.GetHustlerID()
+	if err != nil {
+		return nil, errors.New("invalid hustler ID")
+	}
+
+	contractID, err := cmd.GetContractID()
+	if err != nil {
+		return nil, errors.New("invalid contract ID")
+	}
+
+	location, err := cmd.GetLocation()
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := aggregate.NewTimeSlot(cmd.StartsAt, cmd.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.bookingSvc.BookVisit(ctx, service.BookVisitRequest{
+		HustlerID:  hustlerID,
+		ClientID:   clientID,
+		ContractID: contractID,
+		Slot:       slot,
+		Location:   location,
+		Address:    cmd.Address,
+		Notes:      cmd.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.scheduleReminders(ctx, booking)
+
+	return bookingResult(booking), nil
+}
+
+// HandleRescheduleBooking moves a booking to another slot and reschedules its reminders
+func (h *BookingHandler) HandleRescheduleBooking(ctx context.Context, cmd command.RescheduleBooking) (*command.BookingResult, error) {
+	bookingID, userID, err := bookingParty(cmd.BookingID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := aggregate.NewTimeSlot(cmd.StartsAt, cmd.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.bookingSvc.Reschedule(ctx, bookingID, userID, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	h.scheduleReminders(ctx, booking)
+
+	return bookingResult(booking), nil
+}
+
+// HandleCancelBooking cancels a booking under the cancellation policy
+func (h *BookingHandler) HandleCancelBooking(ctx context.Context, cmd command.CancelBooking) (*command.BookingResult, error) {
+	bookingID, userID, err := bookingParty(cmd.BookingID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.bookingSvc.Cancel(ctx, bookingID, userID, cmd.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return bookingResult(booking), nil
+}
+
+// HandleCompleteBooking marks an on-site visit done
+func (h *BookingHandler) HandleCompleteBooking(ctx context.Context, cmd command.CompleteBooking) (*command.BookingResult, error) {
+	bookingID, hustlerID, err := bookingParty(cmd.BookingID, cmd.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.bookingSvc.Complete(ctx, bookingID, hustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return bookingResult(booking), nil
+}
+
+func (h *BookingHandler) loadAvailability(ctx context.Context, hustlerIDStr string) (*aggregate.Availability, error) {
+	hustlerID, err := valueobject.NewUserID(hustlerIDStr)
+	if err != nil {
+		return nil, errors.New("invalid hustler ID")
+	}
+
+	availability, err := h.availabilityRepo.FindByHustlerID(ctx, hustlerID)
+	if err != nil {
+		return nil, service.ErrNotTakingBookings
+	}
+
+	return availability, nil
+}
+
+func (h *BookingHandler) scheduleReminders(ctx context.Context, booking *aggregate.Booking) {
+	// TODO: Log scheduling failures - the booking stands but nobody is reminded
+	_ = h.scheduler.ScheduleBookingReminders(
+		ctx,
+		booking.ID().String(),
+		booking.Slot().Start(),
+		h.bookingSvc.Policy().ReminderTimes(booking),
+	)
+}
+
+func bookingParty(bookingIDStr, userIDStr string) (valueobject.BookingID, valueobject.UserID, error) {
+	bookingID, err := valueobject.NewBookingID(bookingIDStr)
+	if err != nil {
+		return valueobject.BookingID{}, valueobject.UserID{}, errors.New("invalid booking ID")
+	}
+
+	userID, err := valueobject.NewUserID(userIDStr)
+	if err != nil {
+		return valueobject.BookingID{}, valueobject.UserID{}, errors.New("invalid user ID")
+	}
+
+	return bookingID, userID, nil
+}
+
+// parseClock turns "HH:MM" into minutes after midnight. "24:00" ends a day.
+func parseClock(clock string) (int, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &hour, &minute); err != nil {
+		return 0, fmt.Errorf("invalid time %q: use HH:MM", clock)
+	}
+	if hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("invalid time %q: use HH:MM", clock)
+	}
+	return hour*60 + minute, nil
+}
+
+func bookingResult(booking *aggregate.Booking) *command.BookingResult {
+	result := &command.BookingResult{
+		BookingID:       booking.ID().String(),
+		HustlerID:       booking.HustlerID().String(),
+		ClientID:        booking.ClientID().String(),
+		Status:          booking.Status().String(),
+		StartsAt:        booking.Slot().Start(),
+		EndsAt:          booking.Slot().End(),
+		VisitFee:        booking.VisitFee().Amount(),
+		Currency:        string(booking.VisitFee().Currency()),
+		RescheduleCount: booking.RescheduleCount(),
+	}
+
+	if c := booking.Cancellation(); c != nil {
+		result.CancellationFee = c.Fee().Amount()
+		result.Refund = c.Refund().Amount()
+	}
+
+	return result
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// BookingScheduler queues the reminders for a booked on-site visit
+// This is a PORT - infrastructure runs the delayed tasks
+type BookingScheduler interface {
+	ScheduleBookingReminders(ctx context.Context, bookingID string, startsAt time.Time, reminders []time.Time) error
+}
+
+// BookingReminder describes an upcoming on-site visit
+type BookingReminder struct {
+	BookingID string
+	ClientID  string
+	HustlerID string
+	Address   string
+	StartsAt  time.Time
+	EndsAt    time.Time
+}
+
+// BookingNotifier reminds the client and the hustler that a visit is coming up
+// This is a PORT - infrastructure delivers the notification
+type BookingNotifier interface {
+	NotifyBookingUpcoming(ctx context.Context, reminder BookingReminder) error
+}
+
+// BookingReminderHandler runs the reminder tasks scheduled when a visit is booked or moved
+type BookingReminderHandler struct {
+	bookingRepo repository.BookingRepository
+	notifier    BookingNotifier
+}
+
+// NewBookingReminderHandler creates a new booking reminder handler
+func NewBookingReminderHandler(
+	bookingRepo repository.BookingRepository,
+	notifier BookingNotifier,
+) *BookingReminderHandler {
+	return &BookingReminderHandler{
+		bookingRepo: bookingRepo,
+		notifier:    notifier,
+	}
+}
+
+// SendBookingReminder reminds both parties of an upcoming visit. Nothing is sent if
+// the booking was cancelled, or moved, since the new slot scheduled its own reminders.
+func (h *BookingReminderHandler) SendBookingReminder(ctx context.Context, bookingID string, startsAt time.Time) error {
+	id, err := valueobject.NewBookingID(bookingID)
+	if err != nil {
+		return errors.New("invalid booking ID")
+	}
+
+	booking, err := h.bookingRepo.FindByID(ctx, id)
+	if err != nil {
+		return service.ErrBookingNotFound
+	}
+
+	if booking.Status() != aggregate.BookingStatusConfirmed || !booking.Slot().Start().Equal(startsAt) {
+		return nil
+	}
+
+	return h.notifier.NotifyBookingUpcoming(ctx, BookingReminder{
+		BookingID: booking.ID().String(),
+		ClientID:  booking.ClientID().String(),
+		HustlerID: booking.HustlerID().String(),
+		Address:   booking.Address(),
+		StartsAt:  booking.Slot().Start(),
+		EndsAt:    booking.Slot().End(),
+	})
+}
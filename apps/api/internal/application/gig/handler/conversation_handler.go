@@ -0,0 +1,546 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"hustlex/internal/application/gig/command"
+	"hustlex/internal/domain/gig/aggregate"
+	gigevent "hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// ErrCannotDiscussGig is returned when a hustler has no standing to talk about a gig
+var ErrCannotDiscussGig = errors.New("hustler has not proposed on or been invited to this gig")
+
+// ErrConversationNotInDispute is returned when a transcript is attached to a dispute it has nothing to do with
+var ErrConversationNotInDispute = errors.New("conversation is not about the disputed work")
+
+// PresenceTTL is how long a heartbeat keeps a user shown as online
+const PresenceTTL = time.Minute
+
+// Realtime signal types pushed to connected clients
+const (
+	SignalMessage = "message"
+	SignalRead    = "read"
+	SignalTyping  = "typing"
+)
+
+// RealtimeSignal is pushed to a participant's open WebSocket or SSE stream
+type RealtimeSignal struct {
+	Type           string      `json:"type"`
+	ConversationID string      `json:"conversation_id"`
+	UserID         string      `json:"user_id"`
+	Data           interface{} `json:"data,omitempty"`
+}
+
+// ConversationRealtime pushes signals to users' live connections
+// This is a PORT - the WebSocket/SSE hub provides the ADAPTER
+type ConversationRealtime interface {
+	Publish(ctx context.Context, userIDs []string, signal RealtimeSignal) error
+}
+
+// PresenceTracker remembers who currently has a live connection
+// This is a PORT - infrastructure provides the ADAPTER (e.g., Redis keys with a TTL)
+type PresenceTracker interface {
+	SetOnline(ctx context.Context, userID string, ttl time.Duration) error
+	OnlineUsers(ctx context.Context, userIDs []string) (map[string]bool, error)
+}
+
+// TranscriptStore keeps exported transcripts where mediators can fetch them
+// This is a PORT - infrastructure provides the ADAPTER (e.g., rendered PDF in object storage)
+type TranscriptStore interface {
+	StoreTranscript(ctx context.Context, transcript *aggregate.Transcript) (string, error)
+}
+
+// ConversationHandler handles messaging between clients and hustlers
+type ConversationHandler struct {
+	conversationRepo repository.ConversationRepository
+	gigRepo          repository.GigRepository
+	contractRepo     repository.ContractRepository
+	disputeRepo      repository.DisputeRepository
+	realtime         ConversationRealtime
+	presence         PresenceTracker
+	transcripts      TranscriptStore
+}
+
+// NewConversationHandler creates a new conversation handler
+func NewConversationHandler(
+	conversationRepo repository.ConversationRepository,
+	gigRepo repository.GigRepository,
+	contractRepo repository.ContractRepository,
+	disputeRepo repository.DisputeRepository,
+	realtime ConversationRealtime,
+	presence PresenceTracker,
+	transcripts TranscriptStore,
+) *ConversationHandler {
+	return &ConversationHandler{
+		conversationRepo: conversationRepo,
+		gigRepo:          gigRepo,
+		contractRepo:     contractRepo,
+		disputeRepo:      disputeRepo,
+		realtime:         realtime,
+		presence:         presence,
+		transcripts:      transcripts,
+	}
+}
+
+// HandleStartConversation opens a conversation, or returns the one the parties already have
+func (h *ConversationHandler) HandleStartConversation(ctx context.Context, cmd command.StartConversation) (*command.ConversationResult, error) {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	var conversation *aggregate.Conversation
+	switch {
+	case cmd.ContractID != "":
+		conversation, err = h.contractConversation(ctx, cmd.ContractID, userID)
+	case cmd.GigID != "":
+		conversation, err = h.startGigConversation(ctx, cmd, userID)
+	default:
+		return nil, aggregate.ErrInvalidConversationSubject
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &command.ConversationResult{
+		ConversationID: conversation.ID().String(),
+		Subject:        string(conversation.Subject()),
+		SubjectID:      conversation.SubjectID(),
+		ClientID:       conversation.ClientID().String(),
+		HustlerID:      conversation.HustlerID().String(),
+		Screened:       conversation.ScreensContactInfo(),
+	}, nil
+}
+
+// HandleSendMessage posts a message and pushes it to the other participant
+func (h *ConversationHandler) HandleSendMessage(ctx context.Context, cmd command.SendMessage) (*command.SendMessageResult, error) {
+	conversation, senderID, err := h.loadConversation(ctx, cmd.ConversationID, cmd.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := conversation.SendMessage(senderID, cmd.Body, cmd.Attachments, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.conversationRepo.SaveWithEvents(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	redacted := make([]string, len(message.Redacted()))
+	for i, kind := range message.Redacted() {
+		redacted[i] = string(kind)
+	}
+	result := &command.SendMessageResult{
+		MessageID: message.ID(),
+		Seq:       message.Seq(),
+		Body:      message.Body(),
+		Redacted:  redacted,
+		SentAt:    message.SentAt(),
+	}
+
+	// The message is saved; a missed push is picked up when the recipient next loads the conversation
+	_ = h.realtime.Publish(ctx, []string{conversation.OtherParty(senderID).String()}, RealtimeSignal{
+		Type:           SignalMessage,
+		ConversationID: conversation.ID().String(),
+		UserID:         senderID.String(),
+		Data:           result,
+	})
+
+	return result, nil
+}
+
+// HandleMarkRead records a read receipt and lets the sender know
+func (h *ConversationHandler) HandleMarkRead(ctx context.Context, cmd command.MarkConversationRead) error {
+	conversation, readerID, err := h.loadConversation(ctx, cmd.ConversationID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	before := conversation.ReadReceipts()[readerID.String()].UpToSeq
+	if err := conversation.MarkRead(readerID, cmd.UpToSeq, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	receipt := conversation.ReadReceipts()[readerID.String()]
+	if receipt.UpToSeq == before {
+		return nil
+	}
+
+	if err := h.conversationRepo.SaveWithEvents(ctx, conversation); err != nil {
+		return err
+	}
+
+	_ = h.realtime.Publish(ctx, []string{conversation.OtherParty(readerID).String()}, RealtimeSignal{
+		Type:           SignalRead,
+		ConversationID: conversation.ID().String(),
+		UserID:         readerID.String(),
+		Data:           map[string]interface{}{"up_to_seq": receipt.UpToSeq, "read_at": receipt.ReadAt},
+	})
+
+	return nil
+}
+
+// HandleTyping pushes a typing indicator to the other participant. Nothing is stored.
+func (h *ConversationHandler) HandleTyping(ctx context.Context, cmd command.SendTypingIndicator) error {
+	conversation, userID, err := h.loadConversation(ctx, cmd.ConversationID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !conversation.IsParticipant(userID) {
+		return aggregate.ErrNotConversationParty
+	}
+
+	return h.realtime.Publish(ctx, []string{conversation.OtherParty(userID).String()}, RealtimeSignal{
+		Type:           SignalTyping,
+		ConversationID: conversation.ID().String(),
+		UserID:         userID.String(),
+	})
+}
+
+// HandleHeartbeat keeps a user with an open stream shown as online
+func (h *ConversationHandler) HandleHeartbeat(ctx context.Context, userID string) error {
+	if _, err := valueobject.NewUserID(userID); err != nil {
+		return errors.New("invalid user ID")
+	}
+	return h.presence.SetOnline(ctx, userID, PresenceTTL)
+}
+
+// HandleExportConversation stores a transcript of the conversation for a participant
+func (h *ConversationHandler) HandleExportConversation(ctx context.Context, cmd command.ExportConversation) (*command.ExportConversationResult, error) {
+	conversation, userID, err := h.loadConversation(ctx, cmd.ConversationID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.export(ctx, conversation, userID)
+}
+
+// HandleAttachConversationToDispute exports the conversation about the disputed work
+// and submits the transcript as the party's evidence
+func (h *ConversationHandler) HandleAttachConversationToDispute(ctx context.Context, cmd command.AttachConversationToDispute) (string, error) {
+	disputeID, err := valueobject.NewDisputeID(cmd.DisputeID)
+	if err != nil {
+		return "", errors.New("invalid dispute ID")
+	}
+
+	conversation, userID, err := h.loadConversation(ctx, cmd.ConversationID, cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	dispute, err := h.disputeRepo.FindByID(ctx, disputeID)
+	if err != nil {
+		return "", service.ErrDisputeNotFound
+	}
+
+	if !concernsDispute(conversation, dispute) {
+		return "", ErrConversationNotInDispute
+	}
+
+	exported, err := h.export(ctx, conversation, userID)
+	if err != nil {
+		return "", err
+	}
+
+	statement := strings.TrimSpace(cmd.Statement)
+	if statement == "" {
+		statement = fmt.Sprintf("Conversation transcript: %d messages, SHA-256 %s", exported.MessageCount, exported.Checksum)
+	}
+
+	evidence, err := dispute.SubmitEvidence(valueobject.GenerateEvidenceID().String(), userID, statement, []string{exported.Reference})
+	if err != nil {
+		return "", err
+	}
+
+	if err := h.disputeRepo.SaveWithEvents(ctx, dispute); err != nil {
+		return "", err
+	}
+
+	return evidence.ID(), nil
+}
+
+// OnContractCreated carries the parties' gig conversation over to their new contract,
+// which lifts contact-info screening. Subscribe it to ContractCreated.
+func (h *ConversationHandler) OnContractCreated(ctx context.Context, e sharedevent.DomainEvent) error {
+	created, ok := e.(*gigevent.ContractCreated)
+	if !ok || created.GigID == "" {
+		return nil
+	}
+
+	gigID, err := valueobject.NewGigID(created.GigID)
+	if err != nil {
+		return errors.New("invalid gig ID")
+	}
+	hustlerID, err := valueobject.NewUserID(created.HustlerID)
+	if err != nil {
+		return errors.New("invalid hustler ID")
+	}
+	contractID, err := valueobject.NewContractID(created.ContractID)
+	if err != nil {
+		return errors.New("invalid contract ID")
+	}
+
+	conversation, err := h.conversationRepo.FindByGigAndHustler(ctx, gigID, hustlerID)
+	if errors.Is(err, repository.ErrConversationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := conversation.LinkContract(contractID, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.conversationRepo.SaveWithEvents(ctx, conversation)
+}
+
+// MessageHustlers sends a client's message to each hustler's conversation about the
+// gig, starting any that do not exist yet. It satisfies GigMessenger.
+func (h *ConversationHandler) MessageHustlers(ctx context.Context, gigIDStr, clientIDStr string, hustlerIDs []string, body string) error {
+	clientID, err := valueobject.NewUserID(clientIDStr)
+	if err != nil {
+		return errors.New("invalid client ID")
+	}
+
+	for _, id := range hustlerIDs {
+		conversation, err := h.startGigConversation(ctx, command.StartConversation{
+			GigID:     gigIDStr,
+			HustlerID: id,
+		}, clientID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := h.HandleSendMessage(ctx, command.SendMessage{
+			ConversationID: conversation.ID().String(),
+			SenderID:       clientIDStr,
+			Body:           body,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *ConversationHandler) contractConversation(ctx context.Context, contractIDStr string, userID valueobject.UserID) (*aggregate.Conversation, error) {
+	contractID, err := valueobject.NewContractID(contractIDStr)
+	if err != nil {
+		return nil, errors.New("invalid contract ID")
+	}
+
+	contract, err := h.contractRepo.FindByID(ctx, contractID)
+	if err != nil {
+		return nil, service.ErrContractNotFound
+	}
+
+	if !userID.Equals(contract.ClientID()) && !userID.Equals(contract.HustlerID()) {
+		return nil, aggregate.ErrNotConversationParty
+	}
+
+	existing, err := h.conversationRepo.FindByContractID(ctx, contractID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, repository.ErrConversationNotFound) {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var gigID *valueobject.GigID
+	if !contract.GigID().IsEmpty() {
+		id := contract.GigID()
+		gigID = &id
+
+		// Carry on the conversation the parties had before the contract
+		existing, err := h.conversationRepo.FindByGigAndHustler(ctx, id, contract.HustlerID())
+		if err == nil {
+			if err := existing.LinkContract(contractID, now); err != nil {
+				return nil, err
+			}
+			if err := h.conversationRepo.SaveWithEvents(ctx, existing); err != nil {
+				return nil, err
+			}
+			return existing, nil
+		}
+		if !errors.Is(err, repository.ErrConversationNotFound) {
+			return nil, err
+		}
+	}
+
+	conversation, err := aggregate.NewConversation(
+		valueobject.GenerateConversationID(),
+		aggregate.ConversationSubjectContract,
+		contractID.String(),
+		gigID,
+		&contractID,
+		contract.ClientID(),
+		contract.HustlerID(),
+		userID,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.conversationRepo.SaveWithEvents(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	return conversation, nil
+}
+
+// startGigConversation resolves who the hustler is and checks they have standing to
+// talk about the gig
+func (h *ConversationHandler) startGigConversation(ctx context.Context, cmd command.StartConversation, userID valueobject.UserID) (*aggregate.Conversation, error) {
+	gigID, err := valueobject.NewGigID(cmd.GigID)
+	if err != nil {
+		return nil, errors.New("invalid gig ID")
+	}
+
+	gig, err := h.gigRepo.FindByID(ctx, gigID)
+	if err != nil {
+		return nil, service.ErrGigNotFound
+	}
+
+	subject, subjectID := aggregate.ConversationSubjectGig, gigID.String()
+	var hustlerID valueobject.UserID
+	switch {
+	case cmd.ProposalID != "":
+		proposalID, err := valueobject.NewProposalID(cmd.ProposalID)
+		if err != nil {
+			return nil, errors.New("invalid proposal ID")
+		}
+		proposal := gig.FindProposal(proposalID)
+		if proposal == nil {
+			return nil, aggregate.ErrProposalNotFound
+		}
+		hustlerID = proposal.HustlerID()
+		subject, subjectID = aggregate.ConversationSubjectProposal, proposalID.String()
+	case userID.Equals(gig.ClientID()):
+		hustlerID, err = valueobject.NewUserID(cmd.HustlerID)
+		if err != nil {
+			return nil, errors.New("invalid hustler ID")
+		}
+	default:
+		hustlerID = userID
+	}
+
+	if !userID.Equals(gig.ClientID()) && !userID.Equals(hustlerID) {
+		return nil, aggregate.ErrNotConversationParty
+	}
+
+	existing, err := h.conversationRepo.FindByGigAndHustler(ctx, gigID, hustlerID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, repository.ErrConversationNotFound) {
+		return nil, err
+	}
+
+	if !canDiscussGig(gig, hustlerID, userID) {
+		return nil, ErrCannotDiscussGig
+	}
+
+	conversation, err := aggregate.NewConversation(
+		valueobject.GenerateConversationID(),
+		subject,
+		subjectID,
+		&gigID,
+		nil,
+		gig.ClientID(),
+		hustlerID,
+		userID,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.conversationRepo.SaveWithEvents(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	return conversation, nil
+}
+
+func (h *ConversationHandler) export(ctx context.Context, conversation *aggregate.Conversation, userID valueobject.UserID) (*command.ExportConversationResult, error) {
+	transcript, err := conversation.Export(userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	reference, err := h.transcripts.StoreTranscript(ctx, transcript)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.conversationRepo.SaveWithEvents(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	return &command.ExportConversationResult{
+		Reference:    reference,
+		MessageCount: len(transcript.Messages),
+		Checksum:     transcript.Checksum,
+		ExportedAt:   transcript.ExportedAt,
+	}, nil
+}
+
+func (h *ConversationHandler) loadConversation(ctx context.Context, conversationIDStr, userIDStr string) (*aggregate.Conversation, valueobject.UserID, error) {
+	conversationID, err := valueobject.NewConversationID(conversationIDStr)
+	if err != nil {
+		return nil, valueobject.UserID{}, errors.New("invalid conversation ID")
+	}
+
+	userID, err := valueobject.NewUserID(userIDStr)
+	if err != nil {
+		return nil, valueobject.UserID{}, errors.New("invalid user ID")
+	}
+
+	conversation, err := h.conversationRepo.FindByID(ctx, conversationID)
+	if err != nil {
+		return nil, valueobject.UserID{}, repository.ErrConversationNotFound
+	}
+
+	return conversation, userID, nil
+}
+
+// canDiscussGig allows talk with hustlers who proposed or were invited, and lets
+// hustlers ask about any gig that is open to everyone
+func canDiscussGig(gig *aggregate.Gig, hustlerID, startedBy valueobject.UserID) bool {
+	if gig.FindProposalByHustler(hustlerID) != nil {
+		return true
+	}
+	for _, invitation := range gig.Invitations() {
+		if invitation.HustlerID().Equals(hustlerID) {
+			return true
+		}
+	}
+	return startedBy.Equals(hustlerID) && gig.Status().IsOpen() && !gig.IsInviteOnly()
+}
+
+// concernsDispute reports whether a conversation is between the disputing parties
+// about the disputed contract or its gig
+func concernsDispute(conversation *aggregate.Conversation, dispute *aggregate.Dispute) bool {
+	if !conversation.ClientID().Equals(dispute.ClientID()) || !conversation.HustlerID().Equals(dispute.HustlerID()) {
+		return false
+	}
+	if contractID := conversation.ContractID(); contractID != nil {
+		return contractID.Equals(dispute.ContractID())
+	}
+	gigID := conversation.GigID()
+	return gigID != nil && !dispute.GigID().IsEmpty() && gigID.Equals(dispute.GigID())
+}
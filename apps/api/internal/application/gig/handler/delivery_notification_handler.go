@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	gigevent "hustlex/internal/domain/gig/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// DeliveryNotice describes a change to a contract's delivered work that the other
+// party should hear about
+type DeliveryNotice struct {
+	Kind        string // delivered, revision_requested, deadline_extended
+	ContractID  string
+	MilestoneID string
+	RecipientID string
+	ActorID     string
+	Version     int    // delivery version, for delivered notices
+	Message     string // the hustler's notes or the client's reason
+	Remaining   int    // revisions left, for revision requests
+	DueDate     *time.Time
+}
+
+// Delivery notice kinds
+const (
+	NoticeDelivered         = "delivered"
+	NoticeRevisionRequested = "revision_requested"
+	NoticeDeadlineExtended  = "deadline_extended"
+)
+
+// DeliveryNotifier tells contract parties about deliveries and revision requests
+// This is a PORT - infrastructure delivers the notification
+type DeliveryNotifier interface {
+	NotifyDelivery(ctx context.Context, notice DeliveryNotice) error
+}
+
+// DeliveryNotificationHandler turns delivery and revision events into notifications
+type DeliveryNotificationHandler struct {
+	notifier DeliveryNotifier
+}
+
+// NewDeliveryNotificationHandler creates a new delivery notification handler
+func NewDeliveryNotificationHandler(notifier DeliveryNotifier) *DeliveryNotificationHandler {
+	return &DeliveryNotificationHandler{notifier: notifier}
+}
+
+// OnMilestoneDelivered tells the client a new version of the work is ready for review
+func (h *DeliveryNotificationHandler) OnMilestoneDelivered(ctx context.Context, e sharedevent.DomainEvent) error {
+	delivered, ok := e.(*gigevent.MilestoneDelivered)
+	if !ok {
+		return nil
+	}
+
+	return h.notifier.NotifyDelivery(ctx, DeliveryNotice{
+		Kind:        NoticeDelivered,
+		ContractID:  delivered.ContractID,
+		MilestoneID: delivered.MilestoneID,
+		RecipientID: delivered.ClientID,
+		ActorID:     delivered.HustlerID,
+		Version:     delivered.DeliveryVersion,
+		Message:     delivered.Notes,
+	})
+}
+
+// OnRevisionRequested tells the hustler what the client wants changed
+func (h *DeliveryNotificationHandler) OnRevisionRequested(ctx context.Context, e sharedevent.DomainEvent) error {
+	requested, ok := e.(*gigevent.MilestoneRevisionRequested)
+	if !ok {
+		return nil
+	}
+
+	return h.notifier.NotifyDelivery(ctx, DeliveryNotice{
+		Kind:        NoticeRevisionRequested,
+		ContractID:  requested.ContractID,
+		MilestoneID: requested.MilestoneID,
+		RecipientID: requested.HustlerID,
+		ActorID:     requested.ClientID,
+		Message:     requested.Notes,
+		Remaining:   requested.RevisionsRemaining,
+	})
+}
+
+// OnDeadlineExtended tells the hustler they have been given more time
+func (h *DeliveryNotificationHandler) OnDeadlineExtended(ctx context.Context, e sharedevent.DomainEvent) error {
+	extended, ok := e.(*gigevent.ContractDeadlineExtended)
+	if !ok {
+		return nil
+	}
+
+	return h.notifier.NotifyDelivery(ctx, DeliveryNotice{
+		Kind:        NoticeDeadlineExtended,
+		ContractID:  extended.ContractID,
+		MilestoneID: extended.MilestoneID,
+		RecipientID: extended.HustlerID,
+		ActorID:     extended.ClientID,
+		DueDate:     &extended.DueDate,
+	})
+}
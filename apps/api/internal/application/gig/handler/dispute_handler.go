@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/application/gig/command"
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// DisputeHandler handles contract disputes from opening to resolution
+type DisputeHandler struct {
+	disputeRepo repository.DisputeRepository
+	disputeSvc  *service.DisputeService
+}
+
+// NewDisputeHandler creates a new dispute handler
+func NewDisputeHandler(
+	disputeRepo repository.DisputeRepository,
+	disputeSvc *service.DisputeService,
+) *DisputeHandler {
+	return &DisputeHandler{
+		disputeRepo: disputeRepo,
+		disputeSvc:  disputeSvc,
+	}
+}
+
+// HandleDisputeContract raises a dispute over everything the contract holds in escrow
+func (h *DisputeHandler) HandleDisputeContract(ctx context.Context, cmd command.DisputeContract) (*command.DisputeResult, error) {
+	return h.openDispute(ctx, cmd.ContractID, "", cmd.UserID, cmd.Reason, cmd.Statement, cmd.Attachments)
+}
+
+// HandleDisputeMilestone raises a dispute on a single milestone
+func (h *DisputeHandler) HandleDisputeMilestone(ctx context.Context, cmd command.DisputeMilestone) (*command.DisputeResult, error) {
+	if cmd.MilestoneID == "" {
+		return nil, aggregate.ErrMilestoneNotFound
+	}
+	return h.openDispute(ctx, cmd.ContractID, cmd.MilestoneID, cmd.UserID, cmd.Reason, cmd.Statement, cmd.Attachments)
+}
+
+func (h *DisputeHandler) openDispute(ctx context.Context, contractIDStr, milestoneID, userIDStr, reason, statement string, attachments []string) (*command.DisputeResult, error) {
+	contractID, err := valueobject.NewContractID(contractIDStr)
+	if err != nil {
+		return nil, errors.New("invalid contract ID")
+	}
+
+	userID, err := valueobject.NewUserID(userIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	dispute, err := h.disputeSvc.OpenDispute(ctx, service.OpenDisputeRequest{
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+		UserID:      userID,
+		Reason:      reason,
+		Statement:   statement,
+		Attachments: attachments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &command.DisputeResult{
+		DisputeID:     dispute.ID(),
+		Status:        string(dispute.Status()),
+		Amount:        dispute.Amount().Amount(),
+		MilestoneIDs:  dispute.MilestoneIDs(),
+		ResponseDueAt: dispute.ResponseDueAt(),
+	}, nil
+}
+
+// HandleSubmitEvidence adds a party's evidence to an open dispute
+func (h *DisputeHandler) HandleSubmitEvidence(ctx context.Context, cmd command.SubmitDisputeEvidence) (string, error) {
+	disputeID, err := valueobject.NewDisputeID(cmd.DisputeID)
+	if err != nil {
+		return "", errors.New("invalid dispute ID")
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return "", errors.New("invalid user ID")
+	}
+
+	dispute, err := h.disputeRepo.FindByID(ctx, disputeID)
+	if err != nil {
+		return "", service.ErrDisputeNotFound
+	}
+
+	evidence, err := dispute.SubmitEvidence(valueobject.GenerateEvidenceID().String(), userID, cmd.Statement, cmd.Attachments)
+	if err != nil {
+		return "", err
+	}
+
+	if err := h.disputeRepo.SaveWithEvents(ctx, dispute); err != nil {
+		return "", err
+	}
+
+	return evidence.ID(), nil
+}
+
+// HandleAssignMediator hands a dispute to a mediator
+func (h *DisputeHandler) HandleAssignMediator(ctx context.Context, cmd command.AssignDisputeMediator) error {
+	disputeID, err := valueobject.NewDisputeID(cmd.DisputeID)
+	if err != nil {
+		return errors.New("invalid dispute ID")
+	}
+
+	mediatorID, err := valueobject.NewUserID(cmd.MediatorID)
+	if err != nil {
+		return errors.New("invalid mediator ID")
+	}
+
+	dispute, err := h.disputeRepo.FindByID(ctx, disputeID)
+	if err != nil {
+		return service.ErrDisputeNotFound
+	}
+
+	if err := dispute.AssignMediator(mediatorID); err != nil {
+		return err
+	}
+
+	return h.disputeRepo.SaveWithEvents(ctx, dispute)
+}
+
+// HandleRequestResponse has the mediator ask a party to respond by a deadline
+func (h *DisputeHandler) HandleRequestResponse(ctx context.Context, cmd command.RequestDisputeResponse) error {
+	disputeID, err := valueobject.NewDisputeID(cmd.DisputeID)
+	if err != nil {
+		return errors.New("invalid dispute ID")
+	}
+
+	mediatorID, err := valueobject.NewUserID(cmd.MediatorID)
+	if err != nil {
+		return errors.New("invalid mediator ID")
+	}
+
+	partyID, err := valueobject.NewUserID(cmd.PartyID)
+	if err != nil {
+		return errors.New("invalid party ID")
+	}
+
+	dispute, err := h.disputeRepo.FindByID(ctx, disputeID)
+	if err != nil {
+		return service.ErrDisputeNotFound
+	}
+
+	window := time.Duration(cmd.WindowHours) * time.Hour
+	if err := dispute.RequestResponse(mediatorID, partyID, window); err != nil {
+		return err
+	}
+
+	return h.disputeRepo.SaveWithEvents(ctx, dispute)
+}
+
+// HandleResolveDispute records the mediator's ruling and settles the escrow
+func (h *DisputeHandler) HandleResolveDispute(ctx context.Context, cmd command.ResolveDispute) (*command.ResolveDisputeResult, error) {
+	disputeID, err := valueobject.NewDisputeID(cmd.DisputeID)
+	if err != nil {
+		return nil, errors.New("invalid dispute ID")
+	}
+
+	mediatorID, err := valueobject.NewUserID(cmd.MediatorID)
+	if err != nil {
+		return nil, errors.New("invalid mediator ID")
+	}
+
+	dispute, err := h.disputeSvc.ResolveDispute(ctx, service.ResolveDisputeRequest{
+		DisputeID:     disputeID,
+		MediatorID:    mediatorID,
+		Outcome:       aggregate.DisputeOutcome(cmd.Outcome),
+		ClientPercent: cmd.ClientPercent,
+		Notes:         cmd.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := dispute.Resolution()
+	return &command.ResolveDisputeResult{
+		DisputeID:     dispute.ID(),
+		Outcome:       string(resolution.Outcome()),
+		RefundAmount:  resolution.Refund().Amount(),
+		ReleaseAmount: resolution.Release().Amount(),
+		PlatformFee:   resolution.ReleaseFee().Amount(),
+		ResolvedAt:    resolution.ResolvedAt(),
+	}, nil
+}
+
+// ResolveOverdueDisputes decides disputes whose response deadline has passed.
+// It is run on a schedule by the background worker.
+func (h *DisputeHandler) ResolveOverdueDisputes(ctx context.Context, asOf time.Time) error {
+	return h.disputeSvc.ResolveOverdueDisputes(ctx, asOf)
+}
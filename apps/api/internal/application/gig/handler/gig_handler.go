@@ -16,16 +16,19 @@ import (
 type GigHandler struct {
 	gigRepo      repository.GigRepository
 	proposalRepo repository.ProposalRepository
+	skills       service.SkillTaxonomy
 }
 
 // NewGigHandler creates a new gig handler
 func NewGigHandler(
 	gigRepo repository.GigRepository,
 	proposalRepo repository.ProposalRepository,
+	skills service.SkillTaxonomy,
 ) *GigHandler {
 	return &GigHandler{
 		gigRepo:      gigRepo,
 		proposalRepo: proposalRepo,
+		skills:       skills,
 	}
 }
 
@@ -51,6 +54,16 @@ func (h *GigHandler) HandleCreateGig(ctx context.Context, cmd command.CreateGig)
 		return nil, errors.New("invalid skill ID")
 	}
 
+	category, err := skillCategory(ctx, h.skills, skillID, cmd.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinates, err := cmd.GetCoordinates()
+	if err != nil {
+		return nil, err
+	}
+
 	// Create gig aggregate
 	gigID := valueobject.GenerateGigID()
 	gig, err := aggregate.NewGig(
@@ -58,7 +71,7 @@ func (h *GigHandler) HandleCreateGig(ctx context.Context, cmd command.CreateGig)
 		clientID,
 		cmd.Title,
 		cmd.Description,
-		cmd.Category,
+		category,
 		budget,
 		cmd.DeliveryDays,
 		cmd.IsRemote,
@@ -71,7 +84,7 @@ func (h *GigHandler) HandleCreateGig(ctx context.Context, cmd command.CreateGig)
 	if err := gig.Update(
 		cmd.Title,
 		cmd.Description,
-		cmd.Category,
+		category,
 		skillID,
 		budget,
 		cmd.DeliveryDays,
@@ -83,6 +96,9 @@ func (h *GigHandler) HandleCreateGig(ctx context.Context, cmd command.CreateGig)
 	); err != nil {
 		return nil, err
 	}
+	if err := gig.PinLocation(coordinates); err != nil {
+		return nil, err
+	}
 
 	// Save gig with events
 	if err := h.gigRepo.SaveWithEvents(ctx, gig); err != nil {
@@ -148,11 +164,21 @@ func (h *GigHandler) HandleUpdateGig(ctx context.Context, cmd command.UpdateGig)
 		}
 	}
 
+	category, err := skillCategory(ctx, h.skills, skillID, cmd.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinates, err := cmd.GetCoordinates()
+	if err != nil {
+		return nil, err
+	}
+
 	// Update gig
 	if err := gig.Update(
 		cmd.Title,
 		cmd.Description,
-		cmd.Category,
+		category,
 		skillID,
 		budget,
 		cmd.DeliveryDays,
@@ -164,6 +190,9 @@ func (h *GigHandler) HandleUpdateGig(ctx context.Context, cmd command.UpdateGig)
 	); err != nil {
 		return nil, err
 	}
+	if err := gig.PinLocation(coordinates); err != nil {
+		return nil, err
+	}
 
 	if err := h.gigRepo.SaveWithEvents(ctx, gig); err != nil {
 		return nil, err
@@ -218,12 +247,7 @@ func (h *GigHandler) HandleSubmitProposal(ctx context.Context, cmd command.Submi
 		return nil, errors.New("invalid gig ID")
 	}
 
-	hustlerID, err := cmd.GetHustlerID()
-	if err != nil {
-		return nil, errors.New("invalid hustler ID")
-	}
-
-	proposedPrice, err := cmd.GetProposedPrice()
+	proposal, err := newProposal(cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -234,17 +258,6 @@ func (h *GigHandler) HandleSubmitProposal(ctx context.Context, cmd command.Submi
 		return nil, service.ErrGigNotFound
 	}
 
-	// Create proposal
-	proposalID := valueobject.GenerateProposalID()
-	proposal := aggregate.NewProposal(
-		proposalID,
-		hustlerID,
-		cmd.CoverLetter,
-		proposedPrice,
-		cmd.DeliveryDays,
-		cmd.Attachments,
-	)
-
 	// Submit proposal to gig
 	if err := gig.SubmitProposal(proposal); err != nil {
 		return nil, err
@@ -255,14 +268,7 @@ func (h *GigHandler) HandleSubmitProposal(ctx context.Context, cmd command.Submi
 		return nil, err
 	}
 
-	return &command.SubmitProposalResult{
-		ProposalID:    proposal.ID().String(),
-		GigID:         gigID.String(),
-		ProposedPrice: proposal.ProposedPrice().Amount(),
-		DeliveryDays:  proposal.DeliveryDays(),
-		Status:        string(proposal.Status()),
-		CreatedAt:     proposal.CreatedAt(),
-	}, nil
+	return submitProposalResult(gigID, proposal), nil
 }
 
 // HandleWithdrawProposal withdraws a proposal
@@ -294,11 +300,52 @@ func (h *GigHandler) HandleWithdrawProposal(ctx context.Context, cmd command.Wit
 	return h.gigRepo.SaveWithEvents(ctx, gig)
 }
 
+// newProposal builds the proposal a submit command describes
+func newProposal(cmd command.SubmitProposal) (*aggregate.Proposal, error) {
+	hustlerID, err := cmd.GetHustlerID()
+	if err != nil {
+		return nil, errors.New("invalid hustler ID")
+	}
+
+	proposedPrice, err := cmd.GetProposedPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := aggregate.NewProposal(
+		valueobject.GenerateProposalID(),
+		hustlerID,
+		cmd.CoverLetter,
+		proposedPrice,
+		cmd.DeliveryDays,
+		cmd.Attachments,
+	)
+	if cmd.Revisions != nil {
+		if err := proposal.SetRevisions(*cmd.Revisions); err != nil {
+			return nil, err
+		}
+	}
+
+	return proposal, nil
+}
+
+func submitProposalResult(gigID valueobject.GigID, proposal *aggregate.Proposal) *command.SubmitProposalResult {
+	return &command.SubmitProposalResult{
+		ProposalID:    proposal.ID().String(),
+		GigID:         gigID.String(),
+		ProposedPrice: proposal.ProposedPrice().Amount(),
+		DeliveryDays:  proposal.DeliveryDays(),
+		Status:        string(proposal.Status()),
+		CreatedAt:     proposal.CreatedAt(),
+	}
+}
+
 // ContractHandler handles contract-related commands
 type ContractHandler struct {
-	contractSvc *service.ContractService
-	reviewSvc   *service.ReviewService
-	contractRepo repository.ContractRepository
+	contractSvc     *service.ContractService
+	reviewSvc       *service.ReviewService
+	contractRepo    repository.ContractRepository
+	reviewScheduler ReviewScheduler
 }
 
 // NewContractHandler creates a new contract handler
@@ -306,11 +353,13 @@ func NewContractHandler(
 	contractSvc *service.ContractService,
 	reviewSvc *service.ReviewService,
 	contractRepo repository.ContractRepository,
+	reviewScheduler ReviewScheduler,
 ) *ContractHandler {
 	return &ContractHandler{
-		contractSvc:  contractSvc,
-		reviewSvc:    reviewSvc,
-		contractRepo: contractRepo,
+		contractSvc:     contractSvc,
+		reviewSvc:       reviewSvc,
+		contractRepo:    contractRepo,
+		reviewScheduler: reviewScheduler,
 	}
 }
 
@@ -331,10 +380,21 @@ func (h *ContractHandler) HandleAcceptProposal(ctx context.Context, cmd command.
 		return nil, errors.New("invalid client ID")
 	}
 
+	milestones := make([]aggregate.MilestoneSpec, len(cmd.Milestones))
+	for i, m := range cmd.Milestones {
+		milestones[i] = aggregate.MilestoneSpec{
+			Title:   m.Title,
+			Amount:  m.Amount,
+			DueDate: m.DueDate,
+		}
+	}
+
 	result, err := h.contractSvc.AcceptProposal(ctx, service.AcceptProposalRequest{
-		GigID:      gigID,
-		ProposalID: proposalID,
-		ClientID:   clientID,
+		GigID:       gigID,
+		ProposalID:  proposalID,
+		ClientID:    clientID,
+		Milestones:  milestones,
+		FundingMode: aggregate.FundingMode(cmd.FundingMode),
 	})
 	if err != nil {
 		return nil, err
@@ -349,6 +409,9 @@ func (h *ContractHandler) HandleAcceptProposal(ctx context.Context, cmd command.
 		DeliveryDays: result.Contract.DeliveryDays(),
 		DeadlineAt:   result.Contract.DeadlineAt(),
 		Status:       result.Contract.Status().String(),
+		FundingMode:  string(result.Contract.FundingMode()),
+		Milestones:   len(result.Contract.Milestones()),
+		EscrowHeld:   result.Contract.EscrowBalance().Amount(),
 	}, nil
 }
 
@@ -369,7 +432,13 @@ func (h *ContractHandler) HandleDeliverWork(ctx context.Context, cmd command.Del
 		return nil, service.ErrContractNotFound
 	}
 
-	if err := contract.Deliver(hustlerID, cmd.Deliverables); err != nil {
+	var milestone *aggregate.Milestone
+	if cmd.MilestoneID != "" {
+		milestone, err = contract.DeliverMilestone(hustlerID, cmd.MilestoneID, cmd.Deliverables, cmd.Notes)
+	} else {
+		milestone, err = contract.Deliver(hustlerID, cmd.Deliverables, cmd.Notes)
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -377,10 +446,28 @@ func (h *ContractHandler) HandleDeliverWork(ctx context.Context, cmd command.Del
 		return nil, err
 	}
 
+	// Start the client's review clock
+	policy := h.contractSvc.ReviewPolicy()
+	dueAt := *policy.ReviewDueAt(milestone)
+	// The delivery stands if scheduling fails; the hourly overdue-review sweep
+	// auto-approves it instead, only without the reminders
+	_ = h.reviewScheduler.ScheduleReviewDeadline(
+		ctx,
+		contract.ID().String(),
+		milestone.ID(),
+		dueAt,
+		policy.ReminderTimes(milestone),
+	)
+
+	delivery := contract.LatestDelivery(milestone.ID())
 	return &command.DeliverWorkResult{
 		ContractID:  contract.ID().String(),
+		MilestoneID: milestone.ID(),
+		DeliveryID:  delivery.ID(),
+		Version:     delivery.Version(),
 		Status:      contract.Status().String(),
-		DeliveredAt: *contract.DeliveredAt(),
+		DeliveredAt: *milestone.DeliveredAt(),
+		ReviewDueAt: dueAt,
 	}, nil
 }
 
@@ -396,33 +483,56 @@ func (h *ContractHandler) HandleApproveDelivery(ctx context.Context, cmd command
 		return nil, errors.New("invalid client ID")
 	}
 
-	contract, err := h.contractSvc.CompleteContract(ctx, service.CompleteContractRequest{
-		ContractID: contractID,
-		ClientID:   clientID,
-		Notes:      cmd.Notes,
+	result, err := h.contractSvc.CompleteContract(ctx, service.CompleteContractRequest{
+		ContractID:  contractID,
+		ClientID:    clientID,
+		MilestoneID: cmd.MilestoneID,
+		Notes:       cmd.Notes,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &command.ApproveDeliveryResult{
-		ContractID:  contract.ID().String(),
-		Status:      contract.Status().String(),
-		CompletedAt: *contract.CompletedAt(),
-		PaidAmount:  contract.NetPayoutAmount().Amount(),
+		ContractID:  result.Contract.ID().String(),
+		MilestoneID: result.Milestone.ID(),
+		Status:      result.Contract.Status().String(),
+		CompletedAt: result.Contract.CompletedAt(),
+		PaidAmount:  result.Milestone.NetPayout().Amount(),
 	}, nil
 }
 
-// HandleDisputeContract raises a dispute
-func (h *ContractHandler) HandleDisputeContract(ctx context.Context, cmd command.DisputeContract) error {
+// HandleFundMilestone holds the client's money for a milestone in escrow
+func (h *ContractHandler) HandleFundMilestone(ctx context.Context, cmd command.FundMilestone) error {
 	contractID, err := valueobject.NewContractID(cmd.ContractID)
 	if err != nil {
 		return errors.New("invalid contract ID")
 	}
 
-	userID, err := valueobject.NewUserID(cmd.UserID)
+	clientID, err := valueobject.NewUserID(cmd.ClientID)
 	if err != nil {
-		return errors.New("invalid user ID")
+		return errors.New("invalid client ID")
+	}
+
+	_, err = h.contractSvc.FundMilestone(ctx, service.FundMilestoneRequest{
+		ContractID:  contractID,
+		ClientID:    clientID,
+		MilestoneID: cmd.MilestoneID,
+	})
+
+	return err
+}
+
+// HandleRequestRevision sends a delivered milestone back to the hustler
+func (h *ContractHandler) HandleRequestRevision(ctx context.Context, cmd command.RequestRevision) error {
+	contractID, err := valueobject.NewContractID(cmd.ContractID)
+	if err != nil {
+		return errors.New("invalid contract ID")
+	}
+
+	clientID, err := valueobject.NewUserID(cmd.ClientID)
+	if err != nil {
+		return errors.New("invalid client ID")
 	}
 
 	contract, err := h.contractRepo.FindByID(ctx, contractID)
@@ -430,7 +540,7 @@ func (h *ContractHandler) HandleDisputeContract(ctx context.Context, cmd command
 		return service.ErrContractNotFound
 	}
 
-	if err := contract.Dispute(userID, cmd.Reason); err != nil {
+	if err := contract.RequestRevision(clientID, cmd.MilestoneID, cmd.Reason, cmd.ExtendDays); err != nil {
 		return err
 	}
 
@@ -487,6 +597,28 @@ func (h *ContractHandler) HandleSubmitReview(ctx context.Context, cmd command.Su
 		ReviewID:   review.ID(),
 		ContractID: contractID.String(),
 		Rating:     review.Rating(),
+		Revealed:   review.IsRevealed(),
+		Held:       review.IsHeld(),
 		CreatedAt:  time.Now().UTC(),
 	}, nil
 }
+
+// HandleRespondToReview posts the reviewee's public response to a review
+func (h *ContractHandler) HandleRespondToReview(ctx context.Context, cmd command.RespondToReview) error {
+	revieweeID, err := valueobject.NewUserID(cmd.RevieweeID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	return h.reviewSvc.RespondToReview(ctx, cmd.ReviewID, revieweeID, cmd.Response)
+}
+
+// HandleFlagReview reports a review to the moderators
+func (h *ContractHandler) HandleFlagReview(ctx context.Context, cmd command.FlagReview) error {
+	reporterID, err := valueobject.NewUserID(cmd.ReporterID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	return h.reviewSvc.FlagReview(ctx, cmd.ReviewID, reporterID, cmd.Note)
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/application/gig/command"
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// ErrEmptyShortlist is returned when a client messages a shortlist with nobody on it
+var ErrEmptyShortlist = errors.New("no shortlisted proposals to message")
+
+// GigMessenger delivers a client's message about a gig to hustlers
+// This is a PORT - ConversationHandler provides the ADAPTER
+type GigMessenger interface {
+	MessageHustlers(ctx context.Context, gigID, clientID string, hustlerIDs []string, body string) error
+}
+
+// InvitationHandler handles gig invitations, invite-only gigs and the client's
+// shortlist of proposals
+type InvitationHandler struct {
+	gigRepo   repository.GigRepository
+	messenger GigMessenger
+}
+
+// NewInvitationHandler creates a new invitation handler
+func NewInvitationHandler(
+	gigRepo repository.GigRepository,
+	messenger GigMessenger,
+) *InvitationHandler {
+	return &InvitationHandler{
+		gigRepo:   gigRepo,
+		messenger: messenger,
+	}
+}
+
+// HandleSetGigVisibility makes a gig public or invite-only
+func (h *InvitationHandler) HandleSetGigVisibility(ctx context.Context, cmd command.SetGigVisibility) error {
+	gig, clientID, err := h.loadGig(ctx, cmd.GigID, cmd.ClientID)
+	if err != nil {
+		return err
+	}
+
+	if err := gig.SetVisibility(clientID, aggregate.GigVisibility(cmd.Visibility)); err != nil {
+		return err
+	}
+
+	return h.gigRepo.SaveWithEvents(ctx, gig)
+}
+
+// HandleInviteHustlers invites hustlers to propose. Hustlers who cannot be invited
+// are reported back rather than failing the whole batch.
+func (h *InvitationHandler) HandleInviteHustlers(ctx context.Context, cmd command.InviteHustlers) (*command.InviteHustlersResult, error) {
+	gig, clientID, err := h.loadGig(ctx, cmd.GigID, cmd.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	result := &command.InviteHustlersResult{}
+	for _, id := range cmd.HustlerIDs {
+		hustlerID, err := valueobject.NewUserID(id)
+		if err != nil {
+			result.Failed = append(result.Failed, command.InvitationFailure{HustlerID: id, Reason: "invalid hustler ID"})
+			continue
+		}
+
+		invitation, err := gig.InviteHustler(clientID, hustlerID, cmd.Message, now)
+		switch {
+		case errors.Is(err, aggregate.ErrNotGigOwner), errors.Is(err, aggregate.ErrGigNotOpen):
+			return nil, err
+		case err != nil:
+			result.Failed = append(result.Failed, command.InvitationFailure{HustlerID: id, Reason: err.Error()})
+			continue
+		}
+
+		result.Invitations = append(result.Invitations, command.InvitationResult{
+			InvitationID: invitation.ID(),
+			HustlerID:    id,
+			ExpiresAt:    invitation.ExpiresAt(),
+		})
+	}
+
+	if len(result.Invitations) > 0 {
+		if err := h.gigRepo.SaveWithEvents(ctx, gig); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// HandleAcceptInvitation submits the invited hustler's proposal
+func (h *InvitationHandler) HandleAcceptInvitation(ctx context.Context, cmd command.AcceptInvitation) (*command.SubmitProposalResult, error) {
+	gigID, err := cmd.GetGigID()
+	if err != nil {
+		return nil, errors.New("invalid gig ID")
+	}
+
+	proposal, err := newProposal(cmd.SubmitProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	gig, err := h.gigRepo.FindByID(ctx, gigID)
+	if err != nil {
+		return nil, service.ErrGigNotFound
+	}
+
+	if gig.PendingInvitation(proposal.HustlerID(), time.Now().UTC()) == nil {
+		return nil, aggregate.ErrInvitationNotFound
+	}
+
+	// Marks the invitation accepted once the proposal passes the budget checks
+	if err := gig.SubmitProposal(proposal); err != nil {
+		return nil, err
+	}
+
+	if err := h.gigRepo.SaveWithEvents(ctx, gig); err != nil {
+		return nil, err
+	}
+
+	return submitProposalResult(gigID, proposal), nil
+}
+
+// HandleDeclineInvitation turns down an invitation
+func (h *InvitationHandler) HandleDeclineInvitation(ctx context.Context, cmd command.DeclineInvitation) error {
+	gigID, err := valueobject.NewGigID(cmd.GigID)
+	if err != nil {
+		return errors.New("invalid gig ID")
+	}
+
+	hustlerID, err := valueobject.NewUserID(cmd.HustlerID)
+	if err != nil {
+		return errors.New("invalid hustler ID")
+	}
+
+	gig, err := h.gigRepo.FindByID(ctx, gigID)
+	if err != nil {
+		return service.ErrGigNotFound
+	}
+
+	if err := gig.DeclineInvitation(hustlerID, cmd.Reason, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.gigRepo.SaveWithEvents(ctx, gig)
+}
+
+// HandleLabelProposal shortlists or archives a proposal
+func (h *InvitationHandler) HandleLabelProposal(ctx context.Context, cmd command.LabelProposal) error {
+	gig, clientID, err := h.loadGig(ctx, cmd.GigID, cmd.ClientID)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := valueobject.NewProposalID(cmd.ProposalID)
+	if err != nil {
+		return errors.New("invalid proposal ID")
+	}
+
+	if err := gig.LabelProposal(clientID, proposalID, aggregate.ProposalLabel(cmd.Label)); err != nil {
+		return err
+	}
+
+	return h.gigRepo.SaveWithEvents(ctx, gig)
+}
+
+// HandleMessageShortlist sends the client's message to every shortlisted hustler
+func (h *InvitationHandler) HandleMessageShortlist(ctx context.Context, cmd command.MessageShortlist) error {
+	body := strings.TrimSpace(cmd.Body)
+	if body == "" {
+		return errors.New("message body is required")
+	}
+
+	gig, clientID, err := h.loadGig(ctx, cmd.GigID, cmd.ClientID)
+	if err != nil {
+		return err
+	}
+
+	if !gig.ClientID().Equals(clientID) {
+		return aggregate.ErrNotGigOwner
+	}
+
+	shortlist := gig.ShortlistedHustlers()
+	if len(shortlist) == 0 {
+		return ErrEmptyShortlist
+	}
+
+	hustlerIDs := make([]string, len(shortlist))
+	for i, id := range shortlist {
+		hustlerIDs[i] = id.String()
+	}
+
+	return h.messenger.MessageHustlers(ctx, gig.ID().String(), clientID.String(), hustlerIDs, body)
+}
+
+func (h *InvitationHandler) loadGig(ctx context.Context, gigIDStr, clientIDStr string) (*aggregate.Gig, valueobject.UserID, error) {
+	gigID, err := valueobject.NewGigID(gigIDStr)
+	if err != nil {
+		return nil, valueobject.UserID{}, errors.New("invalid gig ID")
+	}
+
+	clientID, err := valueobject.NewUserID(clientIDStr)
+	if err != nil {
+		return nil, valueobject.UserID{}, errors.New("invalid client ID")
+	}
+
+	gig, err := h.gigRepo.FindByID(ctx, gigID)
+	if err != nil {
+		return nil, valueobject.UserID{}, service.ErrGigNotFound
+	}
+
+	return gig, clientID, nil
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// ReviewScheduler queues the reminders and the auto-approval for a delivered milestone
+// This is a PORT - infrastructure runs the delayed tasks
+type ReviewScheduler interface {
+	ScheduleReviewDeadline(ctx context.Context, contractID, milestoneID string, dueAt time.Time, reminders []time.Time) error
+}
+
+// ReviewReminder describes delivered work that will be approved automatically
+type ReviewReminder struct {
+	ContractID     string
+	MilestoneID    string
+	MilestoneTitle string
+	ClientID       string
+	HustlerID      string
+	Amount         int64
+	Currency       string
+	DueAt          time.Time
+}
+
+// ReviewNotifier reminds the client and the hustler that a review deadline is coming
+// This is a PORT - infrastructure delivers the notification
+type ReviewNotifier interface {
+	NotifyReviewDue(ctx context.Context, reminder ReviewReminder) error
+}
+
+// ReviewDeadlineHandler runs the delayed tasks scheduled when work is delivered:
+// reminders while the client's review window is open, and auto-approval once it closes
+type ReviewDeadlineHandler struct {
+	contractRepo repository.ContractRepository
+	contractSvc  *service.ContractService
+	notifier     ReviewNotifier
+}
+
+// NewReviewDeadlineHandler creates a new review deadline handler
+func NewReviewDeadlineHandler(
+	contractRepo repository.ContractRepository,
+	contractSvc *service.ContractService,
+	notifier ReviewNotifier,
+) *ReviewDeadlineHandler {
+	return &ReviewDeadlineHandler{
+		contractRepo: contractRepo,
+		contractSvc:  contractSvc,
+		notifier:     notifier,
+	}
+}
+
+// SendReviewReminder reminds both parties that a delivered milestone is about to be
+// approved. Nothing is sent if the client has already acted or the work was delivered
+// again, since that delivery scheduled its own reminders.
+func (h *ReviewDeadlineHandler) SendReviewReminder(ctx context.Context, contractID, milestoneID string, dueAt time.Time) error {
+	id, err := valueobject.NewContractID(contractID)
+	if err != nil {
+		return errors.New("invalid contract ID")
+	}
+
+	contract, err := h.contractRepo.FindByID(ctx, id)
+	if err != nil {
+		return service.ErrContractNotFound
+	}
+
+	milestone := contract.FindMilestone(milestoneID)
+	if milestone == nil {
+		return aggregate.ErrMilestoneNotFound
+	}
+
+	currentDue := h.contractSvc.ReviewPolicy().ReviewDueAt(milestone)
+	if currentDue == nil || !currentDue.Equal(dueAt) {
+		return nil
+	}
+
+	return h.notifier.NotifyReviewDue(ctx, ReviewReminder{
+		ContractID:     contract.ID().String(),
+		MilestoneID:    milestone.ID(),
+		MilestoneTitle: milestone.Title(),
+		ClientID:       contract.ClientID().String(),
+		HustlerID:      contract.HustlerID().String(),
+		Amount:         milestone.Amount().Amount(),
+		Currency:       string(milestone.Amount().Currency()),
+		DueAt:          *currentDue,
+	})
+}
+
+// AutoApproveOverdueMilestones approves every delivered milestone whose review window
+// has closed, catching deliveries whose deadline task was never scheduled
+func (h *ReviewDeadlineHandler) AutoApproveOverdueMilestones(ctx context.Context, asOf time.Time) error {
+	return h.contractSvc.AutoApproveOverdue(ctx, asOf)
+}
+
+// AutoApproveMilestone approves a delivered milestone the client left unreviewed and
+// releases its escrow. It does nothing if the client approved, asked for a revision or
+// disputed in time. An empty milestone ID approves the earliest delivered milestone.
+func (h *ReviewDeadlineHandler) AutoApproveMilestone(ctx context.Context, contractID, milestoneID string) error {
+	id, err := valueobject.NewContractID(contractID)
+	if err != nil {
+		return errors.New("invalid contract ID")
+	}
+
+	_, err = h.contractSvc.CompleteContract(ctx, service.CompleteContractRequest{
+		ContractID:  id,
+		MilestoneID: milestoneID,
+		AutoApprove: true,
+		AsOf:        time.Now().UTC(),
+	})
+	switch {
+	case errors.Is(err, aggregate.ErrMilestoneNotDelivered),
+		errors.Is(err, aggregate.ErrCannotApprove),
+		errors.Is(err, aggregate.ErrReviewWindowOpen):
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/gig/command"
+	"hustlex/internal/domain/gig/aggregate"
+	gigevent "hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/gig/service"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// RatingStatsSink receives a user's adjusted rating whenever it changes
+// This is a PORT - credit bounded context provides the ADAPTER (UpdateCreditStats)
+type RatingStatsSink interface {
+	UpdateRatingStats(ctx context.Context, userID string, adjustedRating float64, totalReviews int) error
+}
+
+// ReviewModerationHandler runs the moderation queue, reveals reviews once their
+// window closes, and keeps adjusted ratings current
+type ReviewModerationHandler struct {
+	reviewSvc *service.ReviewService
+	ratings   RatingStatsSink
+}
+
+// NewReviewModerationHandler creates a new review moderation handler
+func NewReviewModerationHandler(
+	reviewSvc *service.ReviewService,
+	ratings RatingStatsSink,
+) *ReviewModerationHandler {
+	return &ReviewModerationHandler{
+		reviewSvc: reviewSvc,
+		ratings:   ratings,
+	}
+}
+
+// HandleModerateReview keeps or removes a review from the moderation queue
+func (h *ReviewModerationHandler) HandleModerateReview(ctx context.Context, cmd command.ModerateReview) error {
+	moderatorID, err := valueobject.NewUserID(cmd.ModeratorID)
+	if err != nil {
+		return errors.New("invalid moderator ID")
+	}
+
+	return h.reviewSvc.ModerateReview(ctx, cmd.ReviewID, moderatorID, aggregate.ReviewModerationDecision(cmd.Decision), cmd.Note)
+}
+
+// RevealDueReviews reveals reviews whose blind window has closed.
+// It is run on a schedule by the background worker.
+func (h *ReviewModerationHandler) RevealDueReviews(ctx context.Context, asOf time.Time) error {
+	return h.reviewSvc.RevealDueReviews(ctx, asOf)
+}
+
+// OnReviewsChanged recomputes the adjusted rating of everyone whose visible reviews
+// changed and passes it on to credit scoring. Subscribe it to ReviewsRevealed and ReviewModerated.
+func (h *ReviewModerationHandler) OnReviewsChanged(ctx context.Context, e sharedevent.DomainEvent) error {
+	var revieweeIDs []string
+	switch ev := e.(type) {
+	case *gigevent.ReviewsRevealed:
+		revieweeIDs = ev.RevieweeIDs
+	case *gigevent.ReviewModerated:
+		revieweeIDs = []string{ev.RevieweeID}
+	default:
+		return nil
+	}
+
+	var errs []error
+	for _, id := range revieweeIDs {
+		userID, err := valueobject.NewUserID(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reviewee %s: invalid user ID", id))
+			continue
+		}
+
+		summary, err := h.reviewSvc.RefreshRatingSummary(ctx, userID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reviewee %s: %w", id, err))
+			continue
+		}
+
+		if err := h.ratings.UpdateRatingStats(ctx, id, summary.Adjusted, summary.Count); err != nil {
+			errs = append(errs, fmt.Errorf("reviewee %s: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
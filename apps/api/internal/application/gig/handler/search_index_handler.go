@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/domain/gig/aggregate"
+	gigevent "hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/gig/repository"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// SearchIndexEvents are the gig events that change what search shows for a gig
+var SearchIndexEvents = []string{
+	"GigPosted",
+	"GigUpdated",
+	"GigCancelled",
+	"ProposalSubmitted",
+	"ProposalWithdrawn",
+	"ProposalAccepted",
+}
+
+// SearchIndexHandler keeps the gig search index in step with the gigs themselves.
+// Open public gigs are indexed; anything else is removed so it stops showing in search.
+type SearchIndexHandler struct {
+	gigRepo    repository.GigRepository
+	searchRepo repository.GigSearchRepository
+}
+
+// NewSearchIndexHandler creates a new search index handler
+func NewSearchIndexHandler(
+	gigRepo repository.GigRepository,
+	searchRepo repository.GigSearchRepository,
+) *SearchIndexHandler {
+	return &SearchIndexHandler{
+		gigRepo:    gigRepo,
+		searchRepo: searchRepo,
+	}
+}
+
+// OnGigChanged re-indexes the gig an event was raised on. Subscribe it to each of
+// the SearchIndexEvents.
+func (h *SearchIndexHandler) OnGigChanged(ctx context.Context, e sharedevent.DomainEvent) error {
+	if e.AggregateType() != gigevent.AggregateTypeGig {
+		return nil
+	}
+	return h.Reindex(ctx, e.AggregateID())
+}
+
+// Reindex loads a gig and indexes or removes it depending on whether it is still
+// open to everyone
+func (h *SearchIndexHandler) Reindex(ctx context.Context, gigIDStr string) error {
+	gigID, err := valueobject.NewGigID(gigIDStr)
+	if err != nil {
+		return errors.New("invalid gig ID")
+	}
+
+	gig, err := h.gigRepo.FindByID(ctx, gigID)
+	if err != nil {
+		// A deleted gig must not linger in search
+		return h.searchRepo.RemoveGig(ctx, gigID)
+	}
+
+	if gig.Status() != aggregate.GigStatusOpen || gig.IsInviteOnly() {
+		return h.searchRepo.RemoveGig(ctx, gigID)
+	}
+
+	return h.searchRepo.IndexGig(ctx, gig)
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/application/gig/command"
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// ServicePackageHandler handles hustlers' fixed-price offerings and clients' orders for them
+type ServicePackageHandler struct {
+	packageRepo repository.ServicePackageRepository
+	contractSvc *service.ContractService
+	skills      service.SkillTaxonomy
+}
+
+// NewServicePackageHandler creates a new service package handler
+func NewServicePackageHandler(
+	packageRepo repository.ServicePackageRepository,
+	contractSvc *service.ContractService,
+	skills service.SkillTaxonomy,
+) *ServicePackageHandler {
+	return &ServicePackageHandler{
+		packageRepo: packageRepo,
+		contractSvc: contractSvc,
+		skills:      skills,
+	}
+}
+
+// HandleCreateServicePackage publishes a new service package
+func (h *ServicePackageHandler) HandleCreateServicePackage(ctx context.Context, cmd command.CreateServicePackage) (*command.ServicePackageResult, error) {
+	hustlerID, err := cmd.GetHustlerID()
+	if err != nil {
+		return nil, errors.New("invalid hustler ID")
+	}
+
+	skillID, err := optionalSkillID(cmd.SkillID)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := skillCategory(ctx, h.skills, skillID, cmd.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := valueobject.Currency(cmd.Currency)
+	if cmd.Currency == "" {
+		currency = valueobject.NGN
+	}
+
+	pkg, err := aggregate.NewServicePackage(
+		valueobject.GeneratePackageID(),
+		hustlerID,
+		cmd.Title,
+		cmd.Description,
+		category,
+		currency,
+		tierSpecs(cmd.Tiers),
+		addOnSpecs(cmd.AddOns),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set optional fields
+	if err := pkg.UpdateDetails(hustlerID, cmd.Title, cmd.Description, category, skillID, cmd.Tags); err != nil {
+		return nil, err
+	}
+
+	if err := h.packageRepo.SaveWithEvents(ctx, pkg); err != nil {
+		return nil, err
+	}
+
+	return servicePackageResult(pkg), nil
+}
+
+// HandleUpdateServicePackage changes a package's details and pricing
+func (h *ServicePackageHandler) HandleUpdateServicePackage(ctx context.Context, cmd command.UpdateServicePackage) (*command.ServicePackageResult, error) {
+	pkg, hustlerID, err := h.loadOwnPackage(ctx, cmd.PackageID, cmd.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	skillID, err := optionalSkillID(cmd.SkillID)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := skillCategory(ctx, h.skills, skillID, cmd.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pkg.UpdateDetails(hustlerID, cmd.Title, cmd.Description, category, skillID, cmd.Tags); err != nil {
+		return nil, err
+	}
+	if err := pkg.Reprice(hustlerID, tierSpecs(cmd.Tiers), addOnSpecs(cmd.AddOns)); err != nil {
+		return nil, err
+	}
+
+	if err := h.packageRepo.SaveWithEvents(ctx, pkg); err != nil {
+		return nil, err
+	}
+
+	return servicePackageResult(pkg), nil
+}
+
+// HandleSetServicePackageStatus pauses, resumes or archives a package
+func (h *ServicePackageHandler) HandleSetServicePackageStatus(ctx context.Context, cmd command.SetServicePackageStatus) error {
+	pkg, hustlerID, err := h.loadOwnPackage(ctx, cmd.PackageID, cmd.HustlerID)
+	if err != nil {
+		return err
+	}
+
+	switch aggregate.PackageStatus(cmd.Status) {
+	case aggregate.PackageStatusActive:
+		err = pkg.Resume(hustlerID)
+	case aggregate.PackageStatusPaused:
+		err = pkg.Pause(hustlerID)
+	case aggregate.PackageStatusArchived:
+		err = pkg.Archive(hustlerID)
+	default:
+		return errors.New("invalid package status")
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.packageRepo.SaveWithEvents(ctx, pkg)
+}
+
+// HandleOrderServicePackage buys a package tier and creates its contract with escrow held
+func (h *ServicePackageHandler) HandleOrderServicePackage(ctx context.Context, cmd command.OrderServicePackage) (*command.OrderServicePackageResult, error) {
+	packageID, err := cmd.GetPackageID()
+	if err != nil {
+		return nil, errors.New("invalid package ID")
+	}
+
+	clientID, err := cmd.GetClientID()
+	if err != nil {
+		return nil, errors.New("invalid client ID")
+	}
+
+	result, err := h.contractSvc.OrderPackage(ctx, service.OrderPackageRequest{
+		PackageID: packageID,
+		ClientID:  clientID,
+		Tier:      aggregate.PackageTier(cmd.Tier),
+		AddOnIDs:  cmd.AddOnIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contract := result.Contract
+	return &command.OrderServicePackageResult{
+		ContractID:   contract.ID().String(),
+		PackageID:    result.Package.ID().String(),
+		Tier:         contract.PackageTier().String(),
+		HustlerID:    contract.HustlerID().String(),
+		AgreedPrice:  contract.AgreedPrice().Amount(),
+		PlatformFee:  contract.PlatformFee().Amount(),
+		DeliveryDays: contract.DeliveryDays(),
+		DeadlineAt:   contract.DeadlineAt(),
+		Revisions:    contract.MaxRevisions(),
+		Status:       contract.Status().String(),
+		EscrowHeld:   contract.EscrowBalance().Amount(),
+	}, nil
+}
+
+func (h *ServicePackageHandler) loadOwnPackage(ctx context.Context, packageIDStr, hustlerIDStr string) (*aggregate.ServicePackage, valueobject.UserID, error) {
+	packageID, err := valueobject.NewPackageID(packageIDStr)
+	if err != nil {
+		return nil, valueobject.UserID{}, errors.New("invalid package ID")
+	}
+
+	hustlerID, err := valueobject.NewUserID(hustlerIDStr)
+	if err != nil {
+		return nil, valueobject.UserID{}, errors.New("invalid hustler ID")
+	}
+
+	pkg, err := h.packageRepo.FindByID(ctx, packageID)
+	if err != nil {
+		return nil, valueobject.UserID{}, service.ErrPackageNotFound
+	}
+
+	// Verify ownership
+	if !pkg.HustlerID().Equals(hustlerID) {
+		return nil, valueobject.UserID{}, service.ErrUnauthorized
+	}
+
+	return pkg, hustlerID, nil
+}
+
+// skillCategory files a gig or package under its skill's top-level category so that
+// listings and search agree with the taxonomy. Without a skill the given category stands.
+func skillCategory(ctx context.Context, skills service.SkillTaxonomy, skillID *valueobject.SkillID, category string) (string, error) {
+	if skillID == nil {
+		return category, nil
+	}
+	return skills.SkillCategory(ctx, *skillID)
+}
+
+func optionalSkillID(id string) (*valueobject.SkillID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	skillID, err := valueobject.NewSkillID(id)
+	if err != nil {
+		return nil, errors.New("invalid skill ID")
+	}
+	return &skillID, nil
+}
+
+func tierSpecs(tiers []command.PackageTierSpec) []aggregate.TierSpec {
+	specs := make([]aggregate.TierSpec, len(tiers))
+	for i, t := range tiers {
+		specs[i] = aggregate.TierSpec{
+			Tier:         aggregate.PackageTier(t.Tier),
+			Summary:      t.Summary,
+			Price:        t.Price,
+			DeliveryDays: t.DeliveryDays,
+			Revisions:    t.Revisions,
+			Features:     t.Features,
+		}
+	}
+	return specs
+}
+
+func addOnSpecs(addOns []command.PackageAddOnSpec) []aggregate.AddOnSpec {
+	specs := make([]aggregate.AddOnSpec, len(addOns))
+	for i, a := range addOns {
+		specs[i] = aggregate.AddOnSpec{
+			Title:     a.Title,
+			Price:     a.Price,
+			ExtraDays: a.ExtraDays,
+		}
+	}
+	return specs
+}
+
+func servicePackageResult(pkg *aggregate.ServicePackage) *command.ServicePackageResult {
+	return &command.ServicePackageResult{
+		PackageID:     pkg.ID().String(),
+		Title:         pkg.Title(),
+		Category:      pkg.Category(),
+		StartingPrice: pkg.StartingPrice().Amount(),
+		Currency:      string(pkg.Currency()),
+		Tiers:         len(pkg.Tiers()),
+		Status:        pkg.Status().String(),
+		CreatedAt:     pkg.CreatedAt(),
+	}
+}
@@ -0,0 +1,321 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetAvailability retrieves a hustler's published calendar
+type GetAvailability struct {
+	HustlerID string
+}
+
+// GetOpenSlots lists the slots a client could book with a hustler
+type GetOpenSlots struct {
+	HustlerID       string
+	From            time.Time
+	To              time.Time
+	DurationMinutes int
+}
+
+// GetBooking retrieves a booking for one of its parties
+type GetBooking struct {
+	BookingID string
+	ViewerID  string
+}
+
+// GetMyBookings retrieves the bookings a user has made or taken
+type GetMyBookings struct {
+	UserID string
+	Status string // optional
+	Page   int
+	Limit  int
+}
+
+// AvailabilityDTO represents a hustler's calendar for API responses
+type AvailabilityDTO struct {
+	HustlerID           string           `json:"hustler_id"`
+	Timezone            string           `json:"timezone"`
+	WeeklyHours         []WeeklyHoursDTO `json:"weekly_hours"`
+	Blackouts           []BlackoutDTO    `json:"blackouts,omitempty"`
+	ServiceArea         *ServiceAreaDTO  `json:"service_area,omitempty"`
+	TravelBufferMinutes int              `json:"travel_buffer_minutes"`
+	VisitFee            int64            `json:"visit_fee"`
+	Currency            string           `json:"currency"`
+	UpdatedAt           time.Time        `json:"updated_at"`
+}
+
+// WeeklyHoursDTO represents one working-hours window
+type WeeklyHoursDTO struct {
+	Weekday   int    `json:"weekday"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+// BlackoutDTO represents dates a hustler is away
+type BlackoutDTO struct {
+	ID        string `json:"id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// ServiceAreaDTO represents where a hustler travels for on-site work
+type ServiceAreaDTO struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	RadiusKm  float64 `json:"radius_km"`
+}
+
+// TimeSlotDTO represents a bookable slot
+type TimeSlotDTO struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// BookingDTO represents an on-site booking for API responses
+type BookingDTO struct {
+	ID              string     `json:"id"`
+	HustlerID       string     `json:"hustler_id"`
+	ClientID        string     `json:"client_id"`
+	ContractID      string     `json:"contract_id,omitempty"`
+	StartsAt        time.Time  `json:"starts_at"`
+	EndsAt          time.Time  `json:"ends_at"`
+	Latitude        float64    `json:"latitude"`
+	Longitude       float64    `json:"longitude"`
+	Address         string     `json:"address"`
+	Notes           string     `json:"notes,omitempty"`
+	VisitFee        int64      `json:"visit_fee"`
+	Currency        string     `json:"currency"`
+	Status          string     `json:"status"`
+	RescheduleCount int        `json:"reschedule_count"`
+	CancelledBy     string     `json:"cancelled_by,omitempty"`
+	CancellationFee int64      `json:"cancellation_fee,omitempty"`
+	Refund          int64      `json:"refund,omitempty"`
+	CancelledAt     *time.Time `json:"cancelled_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// BookingListResult represents paginated booking results
+type BookingListResult struct {
+	Bookings   []BookingDTO `json:"bookings"`
+	Total      int64        `json:"total"`
+	Page       int          `json:"page"`
+	Limit      int          `json:"limit"`
+	TotalPages int          `json:"total_pages"`
+}
+
+// BookingQueryHandler handles availability and booking queries
+type BookingQueryHandler struct {
+	availabilityRepo repository.AvailabilityRepository
+	bookingRepo      repository.BookingRepository
+	bookingSvc       *service.BookingService
+}
+
+// NewBookingQueryHandler creates a new booking query handler
+func NewBookingQueryHandler(
+	availabilityRepo repository.AvailabilityRepository,
+	bookingRepo repository.BookingRepository,
+	bookingSvc *service.BookingService,
+) *BookingQueryHandler {
+	return &BookingQueryHandler{
+		availabilityRepo: availabilityRepo,
+		bookingRepo:      bookingRepo,
+		bookingSvc:       bookingSvc,
+	}
+}
+
+// HandleGetAvailability retrieves a hustler's calendar
+func (h *BookingQueryHandler) HandleGetAvailability(ctx context.Context, q GetAvailability) (*AvailabilityDTO, error) {
+	hustlerID, err := valueobject.NewUserID(q.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability, err := h.availabilityRepo.FindByHustlerID(ctx, hustlerID)
+	if err != nil {
+		return nil, service.ErrNotTakingBookings
+	}
+
+	return availabilityToDTO(availability), nil
+}
+
+// HandleGetOpenSlots lists bookable slots, a week ahead unless a range is given
+func (h *BookingQueryHandler) HandleGetOpenSlots(ctx context.Context, q GetOpenSlots) ([]TimeSlotDTO, error) {
+	hustlerID, err := valueobject.NewUserID(q.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := q.From
+	if from.IsZero() {
+		from = time.Now().UTC()
+	}
+	to := q.To
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 7)
+	}
+	length := time.Duration(q.DurationMinutes) * time.Minute
+	if length <= 0 {
+		length = time.Hour
+	}
+
+	slots, err := h.bookingSvc.OpenSlots(ctx, hustlerID, from, to, length)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]TimeSlotDTO, len(slots))
+	for i, s := range slots {
+		dtos[i] = TimeSlotDTO{StartsAt: s.Start(), EndsAt: s.End()}
+	}
+	return dtos, nil
+}
+
+// HandleGetBooking retrieves a booking. Only its client and hustler can see it.
+func (h *BookingQueryHandler) HandleGetBooking(ctx context.Context, q GetBooking) (*BookingDTO, error) {
+	bookingID, err := valueobject.NewBookingID(q.BookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewerID, err := valueobject.NewUserID(q.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, service.ErrBookingNotFound
+	}
+
+	if !booking.IsParty(viewerID) {
+		return nil, service.ErrBookingNotFound
+	}
+
+	return bookingToDTO(booking), nil
+}
+
+// HandleGetMyBookings retrieves a user's bookings as client or hustler
+func (h *BookingQueryHandler) HandleGetMyBookings(ctx context.Context, q GetMyBookings) (*BookingListResult, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pageDefaults(q.Page, q.Limit)
+
+	var status *aggregate.BookingStatus
+	if q.Status != "" {
+		s := aggregate.BookingStatus(q.Status)
+		status = &s
+	}
+
+	bookings, total, err := h.bookingRepo.FindByUser(ctx, userID, status, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]BookingDTO, len(bookings))
+	for i, b := range bookings {
+		dtos[i] = *bookingToDTO(b)
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &BookingListResult{
+		Bookings:   dtos,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func availabilityToDTO(a *aggregate.Availability) *AvailabilityDTO {
+	hours := make([]WeeklyHoursDTO, len(a.WeeklyWindows()))
+	for i, w := range a.WeeklyWindows() {
+		hours[i] = WeeklyHoursDTO{
+			Weekday:   int(w.Weekday()),
+			StartTime: formatClock(w.StartMinute()),
+			EndTime:   formatClock(w.EndMinute()),
+		}
+	}
+
+	blackouts := make([]BlackoutDTO, len(a.Blackouts()))
+	for i, b := range a.Blackouts() {
+		blackouts[i] = BlackoutDTO{
+			ID:        b.ID(),
+			StartDate: b.StartDate().Format("2006-01-02"),
+			EndDate:   b.EndDate().Format("2006-01-02"),
+			Reason:    b.Reason(),
+		}
+	}
+
+	dto := &AvailabilityDTO{
+		HustlerID:           a.HustlerID().String(),
+		Timezone:            a.Timezone(),
+		WeeklyHours:         hours,
+		Blackouts:           blackouts,
+		TravelBufferMinutes: int(a.TravelBuffer() / time.Minute),
+		VisitFee:            a.VisitFee().Amount(),
+		Currency:            string(a.VisitFee().Currency()),
+		UpdatedAt:           a.UpdatedAt(),
+	}
+
+	if area := a.ServiceArea(); area != nil {
+		dto.ServiceArea = &ServiceAreaDTO{
+			Latitude:  area.Center().Latitude(),
+			Longitude: area.Center().Longitude(),
+			RadiusKm:  area.RadiusKm(),
+		}
+	}
+
+	return dto
+}
+
+func bookingToDTO(b *aggregate.Booking) *BookingDTO {
+	dto := &BookingDTO{
+		ID:              b.ID().String(),
+		HustlerID:       b.HustlerID().String(),
+		ClientID:        b.ClientID().String(),
+		StartsAt:        b.Slot().Start(),
+		EndsAt:          b.Slot().End(),
+		Latitude:        b.Location().Latitude(),
+		Longitude:       b.Location().Longitude(),
+		Address:         b.Address(),
+		Notes:           b.Notes(),
+		VisitFee:        b.VisitFee().Amount(),
+		Currency:        string(b.VisitFee().Currency()),
+		Status:          b.Status().String(),
+		RescheduleCount: b.RescheduleCount(),
+		CreatedAt:       b.CreatedAt(),
+	}
+
+	if b.ContractID() != nil {
+		dto.ContractID = b.ContractID().String()
+	}
+
+	if c := b.Cancellation(); c != nil {
+		cancelledAt := c.CancelledAt()
+		dto.CancelledBy = c.CancelledBy().String()
+		dto.CancellationFee = c.Fee().Amount()
+		dto.Refund = c.Refund().Amount()
+		dto.CancelledAt = &cancelledAt
+	}
+
+	return dto
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,257 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// DefaultMessagePageSize is how many messages a conversation page holds by default
+const DefaultMessagePageSize = 50
+
+// GetConversations retrieves a user's conversations, most recently active first
+type GetConversations struct {
+	UserID string
+	Page   int
+	Limit  int
+}
+
+// GetConversationMessages retrieves a page of messages, newest last.
+// BeforeSeq pages backwards through history; zero starts from the latest message.
+type GetConversationMessages struct {
+	ConversationID string
+	UserID         string
+	BeforeSeq      int
+	Limit          int
+}
+
+// ConversationDTO represents a conversation for API responses
+type ConversationDTO struct {
+	ID            string      `json:"id"`
+	Subject       string      `json:"subject"`
+	SubjectID     string      `json:"subject_id"`
+	GigID         string      `json:"gig_id,omitempty"`
+	ContractID    string      `json:"contract_id,omitempty"`
+	ClientID      string      `json:"client_id"`
+	HustlerID     string      `json:"hustler_id"`
+	OtherPartyID  string      `json:"other_party_id"`
+	OtherOnline   bool        `json:"other_party_online"`
+	Screened      bool        `json:"screened"` // contact details are hidden until a contract starts
+	UnreadCount   int         `json:"unread_count"`
+	LastMessage   *MessageDTO `json:"last_message,omitempty"`
+	OtherReadUpTo int         `json:"other_party_read_up_to"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// MessageDTO represents a message for API responses
+type MessageDTO struct {
+	ID          string    `json:"id"`
+	Seq         int       `json:"seq"`
+	SenderID    string    `json:"sender_id"`
+	Body        string    `json:"body"`
+	Attachments []string  `json:"attachments,omitempty"`
+	Redacted    []string  `json:"redacted,omitempty"`
+	Read        bool      `json:"read"` // the recipient has read it
+	SentAt      time.Time `json:"sent_at"`
+}
+
+// ConversationListResult represents paginated conversation results
+type ConversationListResult struct {
+	Conversations []ConversationDTO `json:"conversations"`
+	Total         int64             `json:"total"`
+	Page          int               `json:"page"`
+	Limit         int               `json:"limit"`
+	TotalPages    int               `json:"total_pages"`
+}
+
+// MessagePageResult represents a page of a conversation's messages
+type MessagePageResult struct {
+	Conversation ConversationDTO `json:"conversation"`
+	Messages     []MessageDTO    `json:"messages"`
+	HasMore      bool            `json:"has_more"`
+}
+
+// PresenceReader reports who currently has a live connection
+type PresenceReader interface {
+	OnlineUsers(ctx context.Context, userIDs []string) (map[string]bool, error)
+}
+
+// ConversationQueryHandler handles conversation queries
+type ConversationQueryHandler struct {
+	conversationRepo repository.ConversationRepository
+	presence         PresenceReader
+}
+
+// NewConversationQueryHandler creates a new conversation query handler
+func NewConversationQueryHandler(
+	conversationRepo repository.ConversationRepository,
+	presence PresenceReader,
+) *ConversationQueryHandler {
+	return &ConversationQueryHandler{
+		conversationRepo: conversationRepo,
+		presence:         presence,
+	}
+}
+
+// HandleGetConversations returns a user's inbox
+func (h *ConversationQueryHandler) HandleGetConversations(ctx context.Context, q GetConversations) (*ConversationListResult, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pageDefaults(q.Page, q.Limit)
+
+	conversations, total, err := h.conversationRepo.FindByParticipant(ctx, userID, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	others := make([]string, len(conversations))
+	for i, c := range conversations {
+		others[i] = c.OtherParty(userID).String()
+	}
+	online := h.onlineUsers(ctx, others)
+
+	dtos := make([]ConversationDTO, len(conversations))
+	for i, c := range conversations {
+		dtos[i] = conversationToDTO(c, userID, online)
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &ConversationListResult{
+		Conversations: dtos,
+		Total:         total,
+		Page:          page,
+		Limit:         limit,
+		TotalPages:    totalPages,
+	}, nil
+}
+
+// HandleGetConversationMessages returns a page of messages for a participant
+func (h *ConversationQueryHandler) HandleGetConversationMessages(ctx context.Context, q GetConversationMessages) (*MessagePageResult, error) {
+	conversationID, err := valueobject.NewConversationID(q.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := h.conversationRepo.FindByID(ctx, conversationID)
+	if err != nil {
+		return nil, repository.ErrConversationNotFound
+	}
+
+	if !conversation.IsParticipant(userID) {
+		return nil, aggregate.ErrNotConversationParty
+	}
+
+	limit := q.Limit
+	if limit <= 0 || limit > 100 {
+		limit = DefaultMessagePageSize
+	}
+
+	// Messages are in sequence order; take the last page before BeforeSeq
+	messages := conversation.Messages()
+	end := len(messages)
+	if q.BeforeSeq > 0 {
+		for end > 0 && messages[end-1].Seq() >= q.BeforeSeq {
+			end--
+		}
+	}
+	start := end - limit
+	if start < 0 {
+		start = 0
+	}
+
+	recipient := conversation.OtherParty(userID)
+	dtos := make([]MessageDTO, 0, end-start)
+	for _, m := range messages[start:end] {
+		reader := recipient
+		if !m.SenderID().Equals(userID) {
+			reader = userID
+		}
+		dtos = append(dtos, messageToDTO(conversation, m, reader))
+	}
+
+	online := h.onlineUsers(ctx, []string{recipient.String()})
+
+	return &MessagePageResult{
+		Conversation: conversationToDTO(conversation, userID, online),
+		Messages:     dtos,
+		HasMore:      start > 0,
+	}, nil
+}
+
+// onlineUsers asks the presence tracker who is online. Presence is a nicety, so
+// lookup failures just show everyone offline.
+func (h *ConversationQueryHandler) onlineUsers(ctx context.Context, userIDs []string) map[string]bool {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	online, err := h.presence.OnlineUsers(ctx, userIDs)
+	if err != nil {
+		return nil
+	}
+	return online
+}
+
+func conversationToDTO(c *aggregate.Conversation, viewerID valueobject.UserID, online map[string]bool) ConversationDTO {
+	other := c.OtherParty(viewerID)
+	dto := ConversationDTO{
+		ID:            c.ID().String(),
+		Subject:       string(c.Subject()),
+		SubjectID:     c.SubjectID(),
+		ClientID:      c.ClientID().String(),
+		HustlerID:     c.HustlerID().String(),
+		OtherPartyID:  other.String(),
+		OtherOnline:   online[other.String()],
+		Screened:      c.ScreensContactInfo(),
+		UnreadCount:   c.UnreadCount(viewerID),
+		OtherReadUpTo: c.ReadReceipts()[other.String()].UpToSeq,
+		UpdatedAt:     c.UpdatedAt(),
+	}
+	if c.GigID() != nil {
+		dto.GigID = c.GigID().String()
+	}
+	if c.ContractID() != nil {
+		dto.ContractID = c.ContractID().String()
+	}
+	if last := c.LastMessage(); last != nil {
+		reader := other
+		if !last.SenderID().Equals(viewerID) {
+			reader = viewerID
+		}
+		m := messageToDTO(c, last, reader)
+		dto.LastMessage = &m
+	}
+	return dto
+}
+
+// messageToDTO builds a message DTO; reader is the participant the message was sent to
+func messageToDTO(c *aggregate.Conversation, m *aggregate.Message, reader valueobject.UserID) MessageDTO {
+	redacted := make([]string, len(m.Redacted()))
+	for i, kind := range m.Redacted() {
+		redacted[i] = string(kind)
+	}
+	return MessageDTO{
+		ID:          m.ID(),
+		Seq:         m.Seq(),
+		SenderID:    m.SenderID().String(),
+		Body:        m.Body(),
+		Attachments: m.Attachments(),
+		Redacted:    redacted,
+		Read:        c.IsReadBy(m, reader),
+		SentAt:      m.SentAt(),
+	}
+}
@@ -0,0 +1,255 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetDispute retrieves a single dispute
+type GetDispute struct {
+	DisputeID string
+	ViewerID  string
+	IsStaff   bool // admins and mediators can view any dispute
+}
+
+// GetContractDisputes retrieves the disputes raised on a contract
+type GetContractDisputes struct {
+	ContractID string
+	UserID     string // must be party to contract
+}
+
+// GetOpenDisputes retrieves the queue of unresolved disputes
+type GetOpenDisputes struct {
+	MediatorID string // optional; only disputes assigned to this mediator
+	Page       int
+	Limit      int
+}
+
+// DisputeDTO represents a dispute for API responses
+type DisputeDTO struct {
+	ID            string                `json:"id"`
+	ContractID    string                `json:"contract_id"`
+	GigID         string                `json:"gig_id"`
+	MilestoneIDs  []string              `json:"milestone_ids"`
+	ClientID      string                `json:"client_id"`
+	HustlerID     string                `json:"hustler_id"`
+	RaisedBy      string                `json:"raised_by"`
+	Reason        string                `json:"reason"`
+	Amount        int64                 `json:"amount"`
+	Currency      string                `json:"currency"`
+	Status        string                `json:"status"`
+	AwaitingParty string                `json:"awaiting_party,omitempty"`
+	ResponseDueAt *time.Time            `json:"response_due_at,omitempty"`
+	MediatorID    string                `json:"mediator_id,omitempty"`
+	Evidence      []EvidenceDTO         `json:"evidence"`
+	Resolution    *DisputeResolutionDTO `json:"resolution,omitempty"`
+	CreatedAt     time.Time             `json:"created_at"`
+}
+
+// EvidenceDTO represents a piece of dispute evidence
+type EvidenceDTO struct {
+	ID          string    `json:"id"`
+	SubmittedBy string    `json:"submitted_by"`
+	Statement   string    `json:"statement,omitempty"`
+	Attachments []string  `json:"attachments,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// DisputeResolutionDTO represents how a dispute was decided
+type DisputeResolutionDTO struct {
+	Outcome       string    `json:"outcome"`
+	ClientPercent int       `json:"client_percent"`
+	RefundAmount  int64     `json:"refund_amount"`
+	ReleaseAmount int64     `json:"release_amount"`
+	PlatformFee   int64     `json:"platform_fee"`
+	ResolvedBy    string    `json:"resolved_by,omitempty"`
+	ByDefault     bool      `json:"by_default"`
+	Notes         string    `json:"notes,omitempty"`
+	ResolvedAt    time.Time `json:"resolved_at"`
+}
+
+// DisputeListResult represents paginated dispute results
+type DisputeListResult struct {
+	Disputes   []DisputeDTO `json:"disputes"`
+	Total      int64        `json:"total"`
+	Page       int          `json:"page"`
+	Limit      int          `json:"limit"`
+	TotalPages int          `json:"total_pages"`
+}
+
+// DisputeQueryHandler handles dispute queries
+type DisputeQueryHandler struct {
+	disputeRepo  repository.DisputeRepository
+	contractRepo repository.ContractRepository
+}
+
+// NewDisputeQueryHandler creates a new dispute query handler
+func NewDisputeQueryHandler(
+	disputeRepo repository.DisputeRepository,
+	contractRepo repository.ContractRepository,
+) *DisputeQueryHandler {
+	return &DisputeQueryHandler{
+		disputeRepo:  disputeRepo,
+		contractRepo: contractRepo,
+	}
+}
+
+// HandleGetDispute retrieves a dispute for one of its parties or staff
+func (h *DisputeQueryHandler) HandleGetDispute(ctx context.Context, q GetDispute) (*DisputeDTO, error) {
+	disputeID, err := valueobject.NewDisputeID(q.DisputeID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewerID, err := valueobject.NewUserID(q.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	dispute, err := h.disputeRepo.FindByID(ctx, disputeID)
+	if err != nil {
+		return nil, service.ErrDisputeNotFound
+	}
+
+	if !q.IsStaff && !dispute.IsParty(viewerID) {
+		return nil, service.ErrUnauthorized
+	}
+
+	return disputeToDTO(dispute), nil
+}
+
+// HandleGetContractDisputes retrieves the disputes raised on a contract
+func (h *DisputeQueryHandler) HandleGetContractDisputes(ctx context.Context, q GetContractDisputes) ([]DisputeDTO, error) {
+	contractID, err := valueobject.NewContractID(q.ContractID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := h.contractRepo.FindByID(ctx, contractID)
+	if err != nil {
+		return nil, service.ErrContractNotFound
+	}
+
+	if !contract.IsParty(userID) {
+		return nil, service.ErrUnauthorized
+	}
+
+	disputes, err := h.disputeRepo.FindByContractID(ctx, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]DisputeDTO, len(disputes))
+	for i, d := range disputes {
+		dtos[i] = *disputeToDTO(d)
+	}
+
+	return dtos, nil
+}
+
+// HandleGetOpenDisputes retrieves unresolved disputes for the mediation queue
+func (h *DisputeQueryHandler) HandleGetOpenDisputes(ctx context.Context, q GetOpenDisputes) (*DisputeListResult, error) {
+	var mediatorID *valueobject.UserID
+	if q.MediatorID != "" {
+		id, err := valueobject.NewUserID(q.MediatorID)
+		if err != nil {
+			return nil, err
+		}
+		mediatorID = &id
+	}
+
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 || q.Limit > 50 {
+		q.Limit = 20
+	}
+
+	disputes, total, err := h.disputeRepo.FindOpen(ctx, mediatorID, (q.Page-1)*q.Limit, q.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]DisputeDTO, len(disputes))
+	for i, d := range disputes {
+		dtos[i] = *disputeToDTO(d)
+	}
+
+	totalPages := int(total) / q.Limit
+	if int(total)%q.Limit > 0 {
+		totalPages++
+	}
+
+	return &DisputeListResult{
+		Disputes:   dtos,
+		Total:      total,
+		Page:       q.Page,
+		Limit:      q.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func disputeToDTO(dispute *aggregate.Dispute) *DisputeDTO {
+	evidence := make([]EvidenceDTO, len(dispute.Evidence()))
+	for i, e := range dispute.Evidence() {
+		evidence[i] = EvidenceDTO{
+			ID:          e.ID(),
+			SubmittedBy: e.SubmittedBy().String(),
+			Statement:   e.Statement(),
+			Attachments: e.Attachments(),
+			SubmittedAt: e.SubmittedAt(),
+		}
+	}
+
+	dto := &DisputeDTO{
+		ID:            dispute.ID(),
+		ContractID:    dispute.ContractID().String(),
+		GigID:         dispute.GigID().String(),
+		MilestoneIDs:  dispute.MilestoneIDs(),
+		ClientID:      dispute.ClientID().String(),
+		HustlerID:     dispute.HustlerID().String(),
+		RaisedBy:      dispute.RaisedBy().String(),
+		Reason:        dispute.Reason(),
+		Amount:        dispute.Amount().Amount(),
+		Currency:      string(dispute.Amount().Currency()),
+		Status:        string(dispute.Status()),
+		ResponseDueAt: dispute.ResponseDueAt(),
+		Evidence:      evidence,
+		CreatedAt:     dispute.CreatedAt(),
+	}
+
+	if party := dispute.AwaitingParty(); party != nil {
+		dto.AwaitingParty = party.String()
+	}
+	if mediator := dispute.MediatorID(); mediator != nil {
+		dto.MediatorID = mediator.String()
+	}
+
+	if r := dispute.Resolution(); r != nil {
+		dto.Resolution = &DisputeResolutionDTO{
+			Outcome:       string(r.Outcome()),
+			ClientPercent: r.ClientPercent(),
+			RefundAmount:  r.Refund().Amount(),
+			ReleaseAmount: r.Release().Amount(),
+			PlatformFee:   r.ReleaseFee().Amount(),
+			ByDefault:     r.ByDefault(),
+			Notes:         r.Notes(),
+			ResolvedAt:    r.ResolvedAt(),
+		}
+		if by := r.ResolvedBy(); by != nil {
+			dto.Resolution.ResolvedBy = by.String()
+		}
+	}
+
+	return dto
+}
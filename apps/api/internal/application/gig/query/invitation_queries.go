@@ -0,0 +1,172 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetInvitationInbox retrieves a hustler's pending gig invitations
+type GetInvitationInbox struct {
+	HustlerID string
+	Page      int
+	Limit     int
+}
+
+// GetGigInvitations retrieves everyone a client has invited to a gig
+type GetGigInvitations struct {
+	GigID    string
+	ClientID string // must be gig owner
+}
+
+// InvitationDTO represents an invitation for API responses
+type InvitationDTO struct {
+	ID          string     `json:"id"`
+	GigID       string     `json:"gig_id"`
+	HustlerID   string     `json:"hustler_id"`
+	Message     string     `json:"message,omitempty"`
+	Status      string     `json:"status"`
+	ProposalID  *string    `json:"proposal_id,omitempty"`
+	InvitedAt   time.Time  `json:"invited_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+}
+
+// InvitationInboxItemDTO pairs a pending invitation with the gig it is for
+type InvitationInboxItemDTO struct {
+	Invitation InvitationDTO `json:"invitation"`
+	Gig        GigDTO        `json:"gig"`
+}
+
+// InvitationInboxResult represents a page of a hustler's invitation inbox
+type InvitationInboxResult struct {
+	Invitations []InvitationInboxItemDTO `json:"invitations"`
+	Total       int64                    `json:"total"`
+	Page        int                      `json:"page"`
+	Limit       int                      `json:"limit"`
+	TotalPages  int                      `json:"total_pages"`
+}
+
+// InvitationQueryHandler handles invitation queries
+type InvitationQueryHandler struct {
+	gigRepo repository.GigRepository
+}
+
+// NewInvitationQueryHandler creates a new invitation query handler
+func NewInvitationQueryHandler(gigRepo repository.GigRepository) *InvitationQueryHandler {
+	return &InvitationQueryHandler{gigRepo: gigRepo}
+}
+
+// HandleGetInvitationInbox returns the invitations a hustler can still respond to,
+// most recent first
+func (h *InvitationQueryHandler) HandleGetInvitationInbox(ctx context.Context, q GetInvitationInbox) (*InvitationInboxResult, error) {
+	hustlerID, err := valueobject.NewUserID(q.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Page, q.Limit = pageDefaults(q.Page, q.Limit)
+	now := time.Now().UTC()
+
+	gigs, total, err := h.gigRepo.FindByInvitedHustler(ctx, hustlerID, now, (q.Page-1)*q.Limit, q.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]InvitationInboxItemDTO, 0, len(gigs))
+	for _, gig := range gigs {
+		invitation := gig.PendingInvitation(hustlerID, now)
+		if invitation == nil {
+			continue
+		}
+		items = append(items, InvitationInboxItemDTO{
+			Invitation: invitationToDTO(gig.ID().String(), invitation, now),
+			Gig:        *gigToDTO(gig),
+		})
+	}
+
+	totalPages := int(total) / q.Limit
+	if int(total)%q.Limit > 0 {
+		totalPages++
+	}
+
+	return &InvitationInboxResult{
+		Invitations: items,
+		Total:       total,
+		Page:        q.Page,
+		Limit:       q.Limit,
+		TotalPages:  totalPages,
+	}, nil
+}
+
+// HandleGetGigInvitations returns a gig's invitations for its client
+func (h *InvitationQueryHandler) HandleGetGigInvitations(ctx context.Context, q GetGigInvitations) ([]InvitationDTO, error) {
+	gigID, err := valueobject.NewGigID(q.GigID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, err := valueobject.NewUserID(q.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	gig, err := h.gigRepo.FindByID(ctx, gigID)
+	if err != nil {
+		return nil, service.ErrGigNotFound
+	}
+
+	if !gig.ClientID().Equals(clientID) {
+		return nil, aggregate.ErrNotGigOwner
+	}
+
+	now := time.Now().UTC()
+	invitations := gig.Invitations()
+	dtos := make([]InvitationDTO, len(invitations))
+	for i, invitation := range invitations {
+		dtos[i] = invitationToDTO(gig.ID().String(), invitation, now)
+	}
+
+	return dtos, nil
+}
+
+// canSeeInviteOnlyGig reports whether the viewer is the gig's client, was invited
+// to it or has proposed on it
+func canSeeInviteOnlyGig(gig *aggregate.Gig, viewerID string) bool {
+	viewer, err := valueobject.NewUserID(viewerID)
+	if err != nil {
+		return false
+	}
+
+	if gig.ClientID().Equals(viewer) || gig.FindProposalByHustler(viewer) != nil {
+		return true
+	}
+	for _, invitation := range gig.Invitations() {
+		if invitation.HustlerID().Equals(viewer) {
+			return true
+		}
+	}
+	return false
+}
+
+func invitationToDTO(gigID string, invitation *aggregate.Invitation, now time.Time) InvitationDTO {
+	dto := InvitationDTO{
+		ID:          invitation.ID(),
+		GigID:       gigID,
+		HustlerID:   invitation.HustlerID().String(),
+		Message:     invitation.Message(),
+		Status:      string(invitation.StatusAt(now)),
+		InvitedAt:   invitation.InvitedAt(),
+		ExpiresAt:   invitation.ExpiresAt(),
+		RespondedAt: invitation.RespondedAt(),
+	}
+	if invitation.ProposalID() != nil {
+		proposalID := invitation.ProposalID().String()
+		dto.ProposalID = &proposalID
+	}
+	return dto
+}
@@ -0,0 +1,255 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetServicePackage retrieves a single service package
+type GetServicePackage struct {
+	PackageID string
+	ViewerID  string // optional; the owner can see paused and archived packages
+}
+
+// GetServicePackages browses packages clients can order
+type GetServicePackages struct {
+	Category      string
+	SkillID       string
+	MaxPrice      int64
+	SearchQuery   string
+	ExcludeUserID string
+	SortBy        string // newest, price_low, price_high, popular
+	Page          int
+	Limit         int
+}
+
+// GetHustlerPackages retrieves the packages a hustler offers
+type GetHustlerPackages struct {
+	HustlerID string
+	ViewerID  string // the hustler themselves also sees paused and archived packages
+	Page      int
+	Limit     int
+}
+
+// ServicePackageDTO represents a service package for API responses
+type ServicePackageDTO struct {
+	ID            string            `json:"id"`
+	HustlerID     string            `json:"hustler_id"`
+	Title         string            `json:"title"`
+	Description   string            `json:"description"`
+	Category      string            `json:"category"`
+	SkillID       string            `json:"skill_id,omitempty"`
+	Tags          []string          `json:"tags,omitempty"`
+	Currency      string            `json:"currency"`
+	StartingPrice int64             `json:"starting_price"`
+	Tiers         []PackageTierDTO  `json:"tiers"`
+	AddOns        []PackageAddOnDTO `json:"add_ons,omitempty"`
+	Status        string            `json:"status"`
+	OrderCount    int               `json:"order_count"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// PackageTierDTO represents one tier of a service package
+type PackageTierDTO struct {
+	Tier         string   `json:"tier"`
+	Summary      string   `json:"summary,omitempty"`
+	Price        int64    `json:"price"`
+	DeliveryDays int      `json:"delivery_days"`
+	Revisions    int      `json:"revisions"`
+	Features     []string `json:"features,omitempty"`
+}
+
+// PackageAddOnDTO represents an optional extra on a service package
+type PackageAddOnDTO struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Price     int64  `json:"price"`
+	ExtraDays int    `json:"extra_days"`
+}
+
+// ServicePackageListResult represents paginated package results
+type ServicePackageListResult struct {
+	Packages   []ServicePackageDTO `json:"packages"`
+	Total      int64               `json:"total"`
+	Page       int                 `json:"page"`
+	Limit      int                 `json:"limit"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+// PackageQueryHandler handles service package queries
+type PackageQueryHandler struct {
+	packageRepo repository.ServicePackageRepository
+	skills      service.SkillTaxonomy
+}
+
+// NewPackageQueryHandler creates a new service package query handler
+func NewPackageQueryHandler(
+	packageRepo repository.ServicePackageRepository,
+	skills service.SkillTaxonomy,
+) *PackageQueryHandler {
+	return &PackageQueryHandler{
+		packageRepo: packageRepo,
+		skills:      skills,
+	}
+}
+
+// HandleGetServicePackage retrieves a package. Only the owner sees it once it is not active.
+func (h *PackageQueryHandler) HandleGetServicePackage(ctx context.Context, q GetServicePackage) (*ServicePackageDTO, error) {
+	packageID, err := valueobject.NewPackageID(q.PackageID)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := h.packageRepo.FindByID(ctx, packageID)
+	if err != nil {
+		return nil, service.ErrPackageNotFound
+	}
+
+	if !pkg.IsActive() && pkg.HustlerID().String() != q.ViewerID {
+		return nil, service.ErrPackageNotFound
+	}
+
+	return servicePackageToDTO(pkg), nil
+}
+
+// HandleGetServicePackages browses active packages
+func (h *PackageQueryHandler) HandleGetServicePackages(ctx context.Context, q GetServicePackages) (*ServicePackageListResult, error) {
+	page, limit := pageDefaults(q.Page, q.Limit)
+
+	active := aggregate.PackageStatusActive
+	filter := repository.PackageFilter{
+		Category:    q.Category,
+		MaxPrice:    q.MaxPrice,
+		Status:      &active,
+		SearchQuery: q.SearchQuery,
+		SortBy:      q.SortBy,
+		Offset:      (page - 1) * limit,
+		Limit:       limit,
+	}
+
+	skillIDs, err := expandSkill(ctx, h.skills, q.SkillID)
+	if err != nil {
+		return nil, err
+	}
+	filter.SkillIDs = skillIDs
+
+	if q.ExcludeUserID != "" {
+		userID, err := valueobject.NewUserID(q.ExcludeUserID)
+		if err == nil {
+			filter.ExcludeUserID = &userID
+		}
+	}
+
+	pkgs, total, err := h.packageRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return packageListResult(pkgs, total, page, limit), nil
+}
+
+// HandleGetHustlerPackages retrieves a hustler's packages for their profile
+func (h *PackageQueryHandler) HandleGetHustlerPackages(ctx context.Context, q GetHustlerPackages) (*ServicePackageListResult, error) {
+	hustlerID, err := valueobject.NewUserID(q.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pageDefaults(q.Page, q.Limit)
+
+	var status *aggregate.PackageStatus
+	if q.ViewerID != q.HustlerID {
+		active := aggregate.PackageStatusActive
+		status = &active
+	}
+
+	pkgs, total, err := h.packageRepo.FindByHustlerID(ctx, hustlerID, status, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return packageListResult(pkgs, total, page, limit), nil
+}
+
+func pageDefaults(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func packageListResult(pkgs []*aggregate.ServicePackage, total int64, page, limit int) *ServicePackageListResult {
+	dtos := make([]ServicePackageDTO, len(pkgs))
+	for i, pkg := range pkgs {
+		dtos[i] = *servicePackageToDTO(pkg)
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &ServicePackageListResult{
+		Packages:   dtos,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+}
+
+func servicePackageToDTO(pkg *aggregate.ServicePackage) *ServicePackageDTO {
+	tiers := make([]PackageTierDTO, len(pkg.Tiers()))
+	for i, t := range pkg.Tiers() {
+		tiers[i] = PackageTierDTO{
+			Tier:         t.Tier().String(),
+			Summary:      t.Summary(),
+			Price:        t.Price().Amount(),
+			DeliveryDays: t.DeliveryDays(),
+			Revisions:    t.Revisions(),
+			Features:     t.Features(),
+		}
+	}
+
+	addOns := make([]PackageAddOnDTO, len(pkg.AddOns()))
+	for i, a := range pkg.AddOns() {
+		addOns[i] = PackageAddOnDTO{
+			ID:        a.ID(),
+			Title:     a.Title(),
+			Price:     a.Price().Amount(),
+			ExtraDays: a.ExtraDays(),
+		}
+	}
+
+	dto := &ServicePackageDTO{
+		ID:            pkg.ID().String(),
+		HustlerID:     pkg.HustlerID().String(),
+		Title:         pkg.Title(),
+		Description:   pkg.Description(),
+		Category:      pkg.Category(),
+		Tags:          pkg.Tags(),
+		Currency:      string(pkg.Currency()),
+		StartingPrice: pkg.StartingPrice().Amount(),
+		Tiers:         tiers,
+		AddOns:        addOns,
+		Status:        pkg.Status().String(),
+		OrderCount:    pkg.OrderCount(),
+		CreatedAt:     pkg.CreatedAt(),
+		UpdatedAt:     pkg.UpdatedAt(),
+	}
+
+	if pkg.SkillID() != nil {
+		dto.SkillID = pkg.SkillID().String()
+	}
+
+	return dto
+}
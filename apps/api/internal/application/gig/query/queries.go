@@ -12,7 +12,8 @@ import (
 
 // GetGig retrieves a single gig
 type GetGig struct {
-	GigID string
+	GigID    string
+	ViewerID string // optional; invite-only gigs are shown to the client, invitees and proposers
 }
 
 // GetGigs retrieves gigs with filters
@@ -56,7 +57,10 @@ type GigDTO struct {
 	Deadline      *time.Time   `json:"deadline,omitempty"`
 	IsRemote      bool         `json:"is_remote"`
 	Location      string       `json:"location,omitempty"`
+	Latitude      *float64     `json:"latitude,omitempty"`
+	Longitude     *float64     `json:"longitude,omitempty"`
 	Status        string       `json:"status"`
+	Visibility    string       `json:"visibility"`
 	ViewCount     int          `json:"view_count"`
 	ProposalCount int          `json:"proposal_count"`
 	IsFeatured    bool         `json:"is_featured"`
@@ -75,10 +79,66 @@ type GigListResult struct {
 	TotalPages int      `json:"total_pages"`
 }
 
+// SearchGigs runs a full-text search over open gigs
+type SearchGigs struct {
+	Query         string
+	Category      string
+	SkillID       string
+	MinBudget     int64
+	MaxBudget     int64
+	BudgetBand    string
+	IsRemote      *bool
+	Location      string
+	Latitude      *float64
+	Longitude     *float64
+	RadiusKm      float64
+	ExcludeUserID string
+	SortBy        string // relevance, newest, budget_high, budget_low, deadline, popular, distance
+	Page          int
+	Limit         int
+}
+
+// GigSearchHitDTO represents a gig search result for API responses
+type GigSearchHitDTO struct {
+	ID           string    `json:"id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	Category     string    `json:"category"`
+	Tags         []string  `json:"tags,omitempty"`
+	BudgetMin    int64     `json:"budget_min"`
+	BudgetMax    int64     `json:"budget_max"`
+	Currency     string    `json:"currency"`
+	DeliveryDays int       `json:"delivery_days"`
+	IsRemote     bool      `json:"is_remote"`
+	Location     string    `json:"location,omitempty"`
+	DistanceKm   *float64  `json:"distance_km,omitempty"`
+	ClientName   string    `json:"client_name,omitempty"`
+	Score        float64   `json:"score"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// FacetCountDTO is the number of matching gigs for one facet value
+type FacetCountDTO struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// GigSearchResult represents paginated gig search results with facet counts
+type GigSearchResult struct {
+	Gigs        []GigSearchHitDTO `json:"gigs"`
+	Categories  []FacetCountDTO   `json:"categories"`
+	BudgetBands []FacetCountDTO   `json:"budget_bands"`
+	Total       int64             `json:"total"`
+	Page        int               `json:"page"`
+	Limit       int               `json:"limit"`
+	TotalPages  int               `json:"total_pages"`
+}
+
 // GetGigProposals retrieves proposals for a gig
 type GetGigProposals struct {
 	GigID    string
 	ClientID string // must be gig owner
+	Label    string // optional; shortlisted or archived
 }
 
 // ProposalDTO represents a proposal for API responses
@@ -93,7 +153,9 @@ type ProposalDTO struct {
 	ProposedPrice   int64     `json:"proposed_price"`
 	Currency        string    `json:"currency"`
 	DeliveryDays    int       `json:"delivery_days"`
+	Revisions       int       `json:"revisions"`
 	Status          string    `json:"status"`
+	Label           string    `json:"label,omitempty"`
 	Attachments     []string  `json:"attachments,omitempty"`
 	CreatedAt       time.Time `json:"created_at"`
 }
@@ -124,8 +186,10 @@ type GetMyContracts struct {
 // ContractDTO represents a contract for API responses
 type ContractDTO struct {
 	ID           string     `json:"id"`
-	GigID        string     `json:"gig_id"`
-	GigTitle     string     `json:"gig_title"`
+	GigID        string     `json:"gig_id,omitempty"`
+	GigTitle     string     `json:"gig_title,omitempty"`
+	PackageID    string     `json:"package_id,omitempty"`
+	PackageTier  string     `json:"package_tier,omitempty"`
 	ClientID     string     `json:"client_id"`
 	ClientName   string     `json:"client_name,omitempty"`
 	HustlerID    string     `json:"hustler_id"`
@@ -143,6 +207,43 @@ type ContractDTO struct {
 	Deliverables []string   `json:"deliverables,omitempty"`
 	HasReviewed  bool       `json:"has_reviewed"`
 	IsOverdue    bool       `json:"is_overdue"`
+	FundingMode  string     `json:"funding_mode"`
+	EscrowHeld   int64      `json:"escrow_held"`
+	Milestones   []MilestoneDTO `json:"milestones"`
+
+	MaxRevisions       int `json:"max_revisions"`
+	RevisionsRemaining int `json:"revisions_remaining"`
+}
+
+// MilestoneDTO represents a stage of a contract and its escrow
+type MilestoneDTO struct {
+	ID            string     `json:"id"`
+	Sequence      int        `json:"sequence"`
+	Title         string     `json:"title"`
+	Amount        int64      `json:"amount"`
+	NetPayout     int64      `json:"net_payout"`
+	DueDate       time.Time  `json:"due_date"`
+	Status        string     `json:"status"`
+	Deliverables  []string   `json:"deliverables,omitempty"`
+	Revisions     int        `json:"revisions"`
+	RevisionNotes string     `json:"revision_notes,omitempty"`
+	IsOverdue     bool       `json:"is_overdue"`
+	FundedAt      *time.Time `json:"funded_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	ReleasedAt    *time.Time `json:"released_at,omitempty"`
+	Versions      []DeliveryDTO `json:"versions"`
+}
+
+// DeliveryDTO represents one numbered version of a milestone's delivered work
+type DeliveryDTO struct {
+	ID             string     `json:"id"`
+	Version        int        `json:"version"`
+	Attachments    []string   `json:"attachments,omitempty"`
+	Notes          string     `json:"notes,omitempty"`
+	Status         string     `json:"status"`
+	RevisionReason string     `json:"revision_reason,omitempty"`
+	DeliveredAt    time.Time  `json:"delivered_at"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
 }
 
 // ContractListResult represents paginated contract results
@@ -163,25 +264,27 @@ type GetReviews struct {
 
 // ReviewDTO represents a review for API responses
 type ReviewDTO struct {
-	ID                  string    `json:"id"`
-	ContractID          string    `json:"contract_id"`
-	GigTitle            string    `json:"gig_title,omitempty"`
-	ReviewerID          string    `json:"reviewer_id"`
-	ReviewerName        string    `json:"reviewer_name,omitempty"`
-	ReviewerImage       string    `json:"reviewer_image,omitempty"`
-	Rating              int       `json:"rating"`
-	ReviewText          string    `json:"review_text,omitempty"`
-	CommunicationRating int       `json:"communication_rating,omitempty"`
-	QualityRating       int       `json:"quality_rating,omitempty"`
-	TimelinessRating    int       `json:"timeliness_rating,omitempty"`
-	CreatedAt           time.Time `json:"created_at"`
+	ID                  string     `json:"id"`
+	ContractID          string     `json:"contract_id"`
+	GigTitle            string     `json:"gig_title,omitempty"`
+	ReviewerID          string     `json:"reviewer_id"`
+	ReviewerName        string     `json:"reviewer_name,omitempty"`
+	ReviewerImage       string     `json:"reviewer_image,omitempty"`
+	Rating              int        `json:"rating"`
+	ReviewText          string     `json:"review_text,omitempty"`
+	CommunicationRating int        `json:"communication_rating,omitempty"`
+	QualityRating       int        `json:"quality_rating,omitempty"`
+	TimelinessRating    int        `json:"timeliness_rating,omitempty"`
+	Response            string     `json:"response,omitempty"`
+	RespondedAt         *time.Time `json:"responded_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
 }
 
 // ReviewListResult represents paginated review results
 type ReviewListResult struct {
 	Reviews       []ReviewDTO `json:"reviews"`
 	Total         int64       `json:"total"`
-	AverageRating float64     `json:"average_rating"`
+	AverageRating float64     `json:"average_rating"` // adjusted for reviewer trust and review count
 	Page          int         `json:"page"`
 	Limit         int         `json:"limit"`
 	TotalPages    int         `json:"total_pages"`
@@ -193,6 +296,8 @@ type GigQueryHandler struct {
 	proposalRepo repository.ProposalRepository
 	contractRepo repository.ContractRepository
 	reviewRepo   repository.ReviewRepository
+	searchRepo   repository.GigSearchRepository
+	skills       service.SkillTaxonomy
 }
 
 // NewGigQueryHandler creates a new query handler
@@ -201,12 +306,16 @@ func NewGigQueryHandler(
 	proposalRepo repository.ProposalRepository,
 	contractRepo repository.ContractRepository,
 	reviewRepo repository.ReviewRepository,
+	searchRepo repository.GigSearchRepository,
+	skills service.SkillTaxonomy,
 ) *GigQueryHandler {
 	return &GigQueryHandler{
 		gigRepo:      gigRepo,
 		proposalRepo: proposalRepo,
 		contractRepo: contractRepo,
 		reviewRepo:   reviewRepo,
+		searchRepo:   searchRepo,
+		skills:       skills,
 	}
 }
 
@@ -222,6 +331,10 @@ func (h *GigQueryHandler) HandleGetGig(ctx context.Context, q GetGig) (*GigDTO,
 		return nil, service.ErrGigNotFound
 	}
 
+	if gig.IsInviteOnly() && !canSeeInviteOnlyGig(gig, q.ViewerID) {
+		return nil, service.ErrGigNotFound
+	}
+
 	// Increment view count
 	gig.IncrementViewCount()
 	_ = h.gigRepo.Save(ctx, gig)
@@ -251,12 +364,11 @@ func (h *GigQueryHandler) HandleGetGigs(ctx context.Context, q GetGigs) (*GigLis
 		Limit:       q.Limit,
 	}
 
-	if q.SkillID != "" {
-		skillID, err := valueobject.NewSkillID(q.SkillID)
-		if err == nil {
-			filter.SkillID = &skillID
-		}
+	skillIDs, err := expandSkill(ctx, h.skills, q.SkillID)
+	if err != nil {
+		return nil, err
 	}
+	filter.SkillIDs = skillIDs
 
 	if q.Status != "" {
 		status := aggregate.GigStatus(q.Status)
@@ -294,6 +406,101 @@ func (h *GigQueryHandler) HandleGetGigs(ctx context.Context, q GetGigs) (*GigLis
 	}, nil
 }
 
+// HandleSearchGigs searches open gigs by relevance and counts the matches per
+// category and budget band
+func (h *GigQueryHandler) HandleSearchGigs(ctx context.Context, q SearchGigs) (*GigSearchResult, error) {
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 || q.Limit > 50 {
+		q.Limit = 20
+	}
+
+	filter := repository.GigFilter{
+		Category:   q.Category,
+		MinBudget:  q.MinBudget,
+		MaxBudget:  q.MaxBudget,
+		BudgetBand: q.BudgetBand,
+		IsRemote:   q.IsRemote,
+		Location:   q.Location,
+		RadiusKm:   q.RadiusKm,
+		SortBy:     q.SortBy,
+		Offset:     (q.Page - 1) * q.Limit,
+		Limit:      q.Limit,
+	}
+
+	skillIDs, err := expandSkill(ctx, h.skills, q.SkillID)
+	if err != nil {
+		return nil, err
+	}
+	filter.SkillIDs = skillIDs
+
+	if q.Latitude != nil && q.Longitude != nil {
+		near, err := valueobject.NewGeoPoint(*q.Latitude, *q.Longitude)
+		if err != nil {
+			return nil, err
+		}
+		filter.Near = &near
+	}
+
+	if q.ExcludeUserID != "" {
+		userID, err := valueobject.NewUserID(q.ExcludeUserID)
+		if err == nil {
+			filter.ExcludeUserID = &userID
+		}
+	}
+
+	hits, total, err := h.searchRepo.Search(ctx, q.Query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := h.searchRepo.Facets(ctx, q.Query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]GigSearchHitDTO, len(hits))
+	for i, hit := range hits {
+		dtos[i] = searchHitToDTO(hit)
+	}
+
+	totalPages := int(total) / q.Limit
+	if int(total)%q.Limit > 0 {
+		totalPages++
+	}
+
+	return &GigSearchResult{
+		Gigs:        dtos,
+		Categories:  facetCountsToDTO(facets.Categories),
+		BudgetBands: facetCountsToDTO(facets.BudgetBands),
+		Total:       total,
+		Page:        q.Page,
+		Limit:       q.Limit,
+		TotalPages:  totalPages,
+	}, nil
+}
+
+func searchHitToDTO(hit *repository.GigSearchResult) GigSearchHitDTO {
+	return GigSearchHitDTO{
+		ID:           hit.GigID,
+		Title:        hit.Title,
+		Description:  hit.Description,
+		Category:     hit.Category,
+		Tags:         hit.Tags,
+		BudgetMin:    hit.BudgetMin,
+		BudgetMax:    hit.BudgetMax,
+		Currency:     hit.Currency,
+		DeliveryDays: hit.DeliveryDays,
+		IsRemote:     hit.IsRemote,
+		Location:     hit.Location,
+		DistanceKm:   hit.DistanceKm,
+		ClientName:   hit.ClientName,
+		Score:        hit.Score,
+		CreatedAt:    hit.CreatedAt,
+	}
+}
+
 // HandleGetMyGigs retrieves gigs posted by a user
 func (h *GigQueryHandler) HandleGetMyGigs(ctx context.Context, q GetMyGigs) (*GigListResult, error) {
 	clientID, err := valueobject.NewUserID(q.ClientID)
@@ -309,8 +516,9 @@ func (h *GigQueryHandler) HandleGetMyGigs(ctx context.Context, q GetMyGigs) (*Gi
 	}
 
 	filter := repository.GigFilter{
-		Offset: (q.Page - 1) * q.Limit,
-		Limit:  q.Limit,
+		IncludeInviteOnly: true,
+		Offset:            (q.Page - 1) * q.Limit,
+		Limit:             q.Limit,
 	}
 
 	if q.Status != "" {
@@ -364,10 +572,13 @@ func (h *GigQueryHandler) HandleGetGigProposals(ctx context.Context, q GetGigPro
 		return nil, service.ErrUnauthorized
 	}
 
-	proposals := gig.Proposals()
-	dtos := make([]ProposalDTO, len(proposals))
-	for i, p := range proposals {
-		dtos[i] = ProposalDTO{
+	label := aggregate.ProposalLabel(q.Label)
+	dtos := make([]ProposalDTO, 0, len(gig.Proposals()))
+	for _, p := range gig.Proposals() {
+		if label != aggregate.ProposalLabelNone && p.Label() != label {
+			continue
+		}
+		dtos = append(dtos, ProposalDTO{
 			ID:            p.ID().String(),
 			GigID:         gigID.String(),
 			HustlerID:     p.HustlerID().String(),
@@ -375,10 +586,12 @@ func (h *GigQueryHandler) HandleGetGigProposals(ctx context.Context, q GetGigPro
 			ProposedPrice: p.ProposedPrice().Amount(),
 			Currency:      string(p.ProposedPrice().Currency()),
 			DeliveryDays:  p.DeliveryDays(),
+			Revisions:     p.Revisions(),
 			Status:        string(p.Status()),
+			Label:         string(p.Label()),
 			Attachments:   p.Attachments(),
 			CreatedAt:     p.CreatedAt(),
-		}
+		})
 	}
 
 	return dtos, nil
@@ -498,9 +711,9 @@ func (h *GigQueryHandler) HandleGetReviews(ctx context.Context, q GetReviews) (*
 		return nil, err
 	}
 
-	avgRating, _, err := h.reviewRepo.GetAverageRating(ctx, userID)
-	if err != nil {
-		avgRating = 0
+	var avgRating float64
+	if summary, err := h.reviewRepo.FindRatingSummary(ctx, userID); err == nil {
+		avgRating = summary.Adjusted
 	}
 
 	dtos := make([]ReviewDTO, len(reviews))
@@ -517,6 +730,8 @@ func (h *GigQueryHandler) HandleGetReviews(ctx context.Context, q GetReviews) (*
 			CommunicationRating: r.CommunicationRating,
 			QualityRating:       r.QualityRating,
 			TimelinessRating:    r.TimelinessRating,
+			Response:            r.Response,
+			RespondedAt:         r.RespondedAt,
 			CreatedAt:           r.CreatedAt,
 		}
 	}
@@ -553,6 +768,7 @@ func gigToDTO(gig *aggregate.Gig) *GigDTO {
 		IsRemote:      gig.IsRemote(),
 		Location:      gig.Location(),
 		Status:        gig.Status().String(),
+		Visibility:    string(gig.Visibility()),
 		ViewCount:     gig.ViewCount(),
 		ProposalCount: gig.ProposalCount(),
 		IsFeatured:    gig.IsFeatured(),
@@ -565,12 +781,62 @@ func gigToDTO(gig *aggregate.Gig) *GigDTO {
 	if gig.SkillID() != nil {
 		dto.SkillID = gig.SkillID().String()
 	}
+	if point := gig.Coordinates(); point != nil {
+		lat, lng := point.Latitude(), point.Longitude()
+		dto.Latitude = &lat
+		dto.Longitude = &lng
+	}
 
 	return dto
 }
 
+func facetCountsToDTO(counts []repository.FacetCount) []FacetCountDTO {
+	dtos := make([]FacetCountDTO, len(counts))
+	for i, c := range counts {
+		dtos[i] = FacetCountDTO{Value: c.Value, Count: c.Count}
+	}
+	return dtos
+}
+
 func contractToDTO(contract *aggregate.Contract, viewerID valueobject.UserID) *ContractDTO {
-	return &ContractDTO{
+	now := time.Now().UTC()
+	milestones := make([]MilestoneDTO, len(contract.Milestones()))
+	for i, m := range contract.Milestones() {
+		deliveries := contract.DeliveriesFor(m.ID())
+		versions := make([]DeliveryDTO, len(deliveries))
+		for j, d := range deliveries {
+			versions[j] = DeliveryDTO{
+				ID:             d.ID(),
+				Version:        d.Version(),
+				Attachments:    d.Attachments(),
+				Notes:          d.Notes(),
+				Status:         string(d.Status()),
+				RevisionReason: d.RevisionReason(),
+				DeliveredAt:    d.DeliveredAt(),
+				ReviewedAt:     d.ReviewedAt(),
+			}
+		}
+
+		milestones[i] = MilestoneDTO{
+			ID:            m.ID(),
+			Sequence:      m.Sequence(),
+			Title:         m.Title(),
+			Amount:        m.Amount().Amount(),
+			NetPayout:     m.NetPayout().Amount(),
+			DueDate:       m.DueDate(),
+			Status:        string(m.Status()),
+			Deliverables:  m.Deliverables(),
+			Revisions:     m.Revisions(),
+			RevisionNotes: m.RevisionNotes(),
+			IsOverdue:     m.IsOverdue(now),
+			FundedAt:      m.FundedAt(),
+			DeliveredAt:   m.DeliveredAt(),
+			ReleasedAt:    m.ReleasedAt(),
+			Versions:      versions,
+		}
+	}
+
+	dto := &ContractDTO{
 		ID:           contract.ID().String(),
 		GigID:        contract.GigID().String(),
 		ClientID:     contract.ClientID().String(),
@@ -588,5 +854,18 @@ func contractToDTO(contract *aggregate.Contract, viewerID valueobject.UserID) *C
 		Deliverables: contract.Deliverables(),
 		HasReviewed:  contract.HasReviewFrom(viewerID),
 		IsOverdue:    contract.IsOverdue(),
+		FundingMode:  string(contract.FundingMode()),
+		EscrowHeld:   contract.EscrowBalance().Amount(),
+		Milestones:   milestones,
+
+		MaxRevisions:       contract.MaxRevisions(),
+		RevisionsRemaining: contract.RevisionsRemaining(),
+	}
+
+	if contract.IsPackageOrder() {
+		dto.PackageID = contract.PackageID().String()
+		dto.PackageTier = contract.PackageTier().String()
 	}
+
+	return dto
 }
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/gig/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetRecommendedGigs retrieves open gigs that match a hustler's skills
+type GetRecommendedGigs struct {
+	HustlerID string
+	Page      int
+	Limit     int
+}
+
+// RecommendedGigsResult represents a page of recommended gigs
+type RecommendedGigsResult struct {
+	Gigs       []GigSearchHitDTO `json:"gigs"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	Limit      int               `json:"limit"`
+	TotalPages int               `json:"total_pages"`
+}
+
+// HustlerSkillReader lists the skills on a hustler's profile
+// This is a PORT - identity bounded context provides the ADAPTER
+type HustlerSkillReader interface {
+	HustlerSkillIDs(ctx context.Context, hustlerID valueobject.UserID) ([]valueobject.SkillID, error)
+}
+
+// RecommendationQueryHandler matches hustlers to open gigs through the skill taxonomy
+type RecommendationQueryHandler struct {
+	searchRepo    repository.GigSearchRepository
+	skills        service.SkillTaxonomy
+	hustlerSkills HustlerSkillReader
+}
+
+// NewRecommendationQueryHandler creates a new recommendation query handler
+func NewRecommendationQueryHandler(
+	searchRepo repository.GigSearchRepository,
+	skills service.SkillTaxonomy,
+	hustlerSkills HustlerSkillReader,
+) *RecommendationQueryHandler {
+	return &RecommendationQueryHandler{
+		searchRepo:    searchRepo,
+		skills:        skills,
+		hustlerSkills: hustlerSkills,
+	}
+}
+
+// HandleGetRecommendedGigs returns the newest open gigs filed under any of the
+// hustler's skills or the skills beneath them
+func (h *RecommendationQueryHandler) HandleGetRecommendedGigs(ctx context.Context, q GetRecommendedGigs) (*RecommendedGigsResult, error) {
+	hustlerID, err := valueobject.NewUserID(q.HustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pageDefaults(q.Page, q.Limit)
+	result := &RecommendedGigsResult{
+		Gigs:  []GigSearchHitDTO{},
+		Page:  page,
+		Limit: limit,
+	}
+
+	declared, err := h.hustlerSkills.HustlerSkillIDs(ctx, hustlerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skills retired from the catalog since the hustler added them are skipped
+	seen := make(map[string]bool)
+	var skillIDs []valueobject.SkillID
+	for _, skillID := range declared {
+		expanded, err := h.skills.ExpandSkill(ctx, skillID)
+		if err != nil {
+			continue
+		}
+		for _, id := range expanded {
+			if !seen[id.String()] {
+				seen[id.String()] = true
+				skillIDs = append(skillIDs, id)
+			}
+		}
+	}
+	if len(skillIDs) == 0 {
+		return result, nil
+	}
+
+	hits, total, err := h.searchRepo.Search(ctx, "", repository.GigFilter{
+		SkillIDs:      skillIDs,
+		ExcludeUserID: &hustlerID,
+		SortBy:        "newest",
+		Offset:        (page - 1) * limit,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hit := range hits {
+		result.Gigs = append(result.Gigs, searchHitToDTO(hit))
+	}
+	result.Total = total
+	result.TotalPages = int(total) / limit
+	if int(total)%limit > 0 {
+		result.TotalPages++
+	}
+
+	return result, nil
+}
+
+// expandSkill turns a skill filter into the skill and every skill beneath it.
+// An empty ID means no skill filter.
+func expandSkill(ctx context.Context, skills service.SkillTaxonomy, id string) ([]valueobject.SkillID, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	skillID, err := valueobject.NewSkillID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return skills.ExpandSkill(ctx, skillID)
+}
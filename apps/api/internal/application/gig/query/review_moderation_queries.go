@@ -0,0 +1,116 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+)
+
+// GetReviewModerationQueue retrieves reviews awaiting a moderator, oldest flag first.
+// Pages are counted in contracts, so a page can hold both reviews of one contract.
+type GetReviewModerationQueue struct {
+	Page  int
+	Limit int
+}
+
+// QueuedReviewDTO represents a review awaiting moderation
+type QueuedReviewDTO struct {
+	ReviewID   string          `json:"review_id"`
+	ContractID string          `json:"contract_id"`
+	ReviewerID string          `json:"reviewer_id"`
+	RevieweeID string          `json:"reviewee_id"`
+	Rating     int             `json:"rating"`
+	ReviewText string          `json:"review_text,omitempty"`
+	Moderation string          `json:"moderation"` // pending (still shown), held (hidden)
+	Weight     float64         `json:"weight"`
+	Flags      []ReviewFlagDTO `json:"flags"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// ReviewFlagDTO represents one reason a review was sent to moderation
+type ReviewFlagDTO struct {
+	Reason    string    `json:"reason"`
+	FlaggedBy string    `json:"flagged_by,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	FlaggedAt time.Time `json:"flagged_at"`
+}
+
+// ReviewModerationQueueResult represents a page of the moderation queue
+type ReviewModerationQueueResult struct {
+	Reviews    []QueuedReviewDTO `json:"reviews"`
+	Total      int64             `json:"total"` // contracts with queued reviews
+	Page       int               `json:"page"`
+	Limit      int               `json:"limit"`
+	TotalPages int               `json:"total_pages"`
+}
+
+// ReviewModerationQueryHandler handles moderation queue queries
+type ReviewModerationQueryHandler struct {
+	contractRepo repository.ContractRepository
+}
+
+// NewReviewModerationQueryHandler creates a new review moderation query handler
+func NewReviewModerationQueryHandler(contractRepo repository.ContractRepository) *ReviewModerationQueryHandler {
+	return &ReviewModerationQueryHandler{contractRepo: contractRepo}
+}
+
+// HandleGetReviewModerationQueue returns the reviews moderators still have to decide on
+func (h *ReviewModerationQueryHandler) HandleGetReviewModerationQueue(ctx context.Context, q GetReviewModerationQueue) (*ReviewModerationQueueResult, error) {
+	page, limit := pageDefaults(q.Page, q.Limit)
+
+	contracts, total, err := h.contractRepo.FindWithQueuedReviews(ctx, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]QueuedReviewDTO, 0, len(contracts))
+	for _, c := range contracts {
+		for _, r := range c.Reviews() {
+			if r.Moderation().IsQueued() {
+				dtos = append(dtos, queuedReviewToDTO(c, r))
+			}
+		}
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &ReviewModerationQueueResult{
+		Reviews:    dtos,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func queuedReviewToDTO(c *aggregate.Contract, r *aggregate.Review) QueuedReviewDTO {
+	flags := make([]ReviewFlagDTO, len(r.Flags()))
+	for i, f := range r.Flags() {
+		flags[i] = ReviewFlagDTO{
+			Reason:    string(f.Reason),
+			Note:      f.Note,
+			FlaggedAt: f.FlaggedAt,
+		}
+		if f.FlaggedBy != nil {
+			flags[i].FlaggedBy = f.FlaggedBy.String()
+		}
+	}
+
+	return QueuedReviewDTO{
+		ReviewID:   r.ID(),
+		ContractID: c.ID().String(),
+		ReviewerID: r.ReviewerID().String(),
+		RevieweeID: r.RevieweeID().String(),
+		Rating:     r.Rating(),
+		ReviewText: r.ReviewText(),
+		Moderation: string(r.Moderation()),
+		Weight:     r.Weight(),
+		Flags:      flags,
+		CreatedAt:  r.CreatedAt(),
+	}
+}
@@ -112,6 +112,132 @@ type RemoveUserSkill struct {
 	SkillID string
 }
 
+// AddPortfolioItem adds a piece of work to a hustler's portfolio
+type AddPortfolioItem struct {
+	UserID      string
+	Title       string
+	Description string
+	MediaRefs   []string
+	SkillID     string   // optional
+	ContractIDs []string // completed contracts the work came from
+}
+
+// UpdatePortfolioItem replaces the details of a portfolio item
+type UpdatePortfolioItem struct {
+	UserID      string
+	ItemID      string
+	Title       string
+	Description string
+	MediaRefs   []string
+	SkillID     string
+	ContractIDs []string
+}
+
+// PortfolioItemResult is the result of adding a portfolio item
+type PortfolioItemResult struct {
+	UserID string `json:"user_id"`
+	ItemID string `json:"item_id"`
+	Title  string `json:"title"`
+}
+
+// RemovePortfolioItem takes a piece of work off a hustler's portfolio
+type RemovePortfolioItem struct {
+	UserID string
+	ItemID string
+}
+
+// EndorseSkill records a past client vouching for a hustler's skill
+type EndorseSkill struct {
+	HustlerID  string
+	SkillID    string
+	EndorserID string
+	ContractID string // the completed contract the endorser hired the hustler on
+	Note       string
+}
+
+// SubmitSkillCertificate submits a certificate for an admin to review
+type SubmitSkillCertificate struct {
+	UserID      string
+	SkillID     string
+	Title       string
+	Issuer      string
+	DocumentRef string
+}
+
+// SkillCertificateResult is the result of submitting a certificate
+type SkillCertificateResult struct {
+	CertificateID string `json:"certificate_id"`
+	SkillID       string `json:"skill_id"`
+	Status        string `json:"status"`
+}
+
+// ReviewSkillCertificate records an admin's decision on a certificate
+type ReviewSkillCertificate struct {
+	UserID        string
+	CertificateID string
+	ReviewerID    string
+	Approve       bool
+	Note          string
+}
+
+// SkillBadgeResult is a skill's badge after a change to the evidence behind it
+type SkillBadgeResult struct {
+	SkillID string `json:"skill_id"`
+	Badge   string `json:"badge,omitempty"`
+}
+
+// CreateSkill adds a skill to the catalog tree
+type CreateSkill struct {
+	Name        string
+	ParentID    string // empty for a top-level category
+	Description string
+	Icon        string
+}
+
+// CreateSkillResult is the result of creating a skill
+type CreateSkillResult struct {
+	SkillID  string   `json:"skill_id"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	ParentID string   `json:"parent_id,omitempty"`
+	Path     []string `json:"path"`
+}
+
+// VerifyIdentityNumber checks a BVN or NIN against the user's profile (KYC Tier 2)
+type VerifyIdentityNumber struct {
+	UserID string
+	Type   string // bvn, nin
+	Number string
+}
+
+// VerifyIDDocument checks a government ID document and selfie (part of KYC Tier 3)
+type VerifyIDDocument struct {
+	UserID           string
+	DocumentType     string // passport, drivers_license, voters_card, national_id
+	DocumentNumber   string
+	DocumentImageRef string
+	SelfieImageRef   string
+}
+
+// VerifyAddress checks the user's residential address (part of KYC Tier 3)
+type VerifyAddress struct {
+	UserID string
+	Street string
+	City   string
+	LGA    string
+	State  string
+}
+
+// KYCCheckResult is the outcome of a KYC check
+type KYCCheckResult struct {
+	CheckID   string `json:"check_id"`
+	CheckType string `json:"check_type"`
+	Status    string `json:"status"`
+	Provider  string `json:"provider"`
+	Level     int    `json:"kyc_level"`
+	LevelName string `json:"kyc_level_name"`
+}
+
 // DeactivateUser deactivates a user account
 type DeactivateUser struct {
 	UserID      string
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/application/identity/command"
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/event"
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/identity/service"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// VerificationScoreSink receives the verifications behind a user's credit score
+// This is a PORT - credit bounded context provides the ADAPTER (CreditScoreHandler.UpdateVerificationScore)
+type VerificationScoreSink interface {
+	UpdateVerificationScore(ctx context.Context, userID string, hasPhone, hasEmail, hasBVN, hasNIN bool) error
+}
+
+// KYCHandler handles identity verification commands
+type KYCHandler struct {
+	userRepo   repository.UserRepository
+	kycRepo    repository.KYCProfileRepository
+	kycService *service.KYCService
+	scores     VerificationScoreSink
+}
+
+// NewKYCHandler creates a new KYC handler
+func NewKYCHandler(
+	userRepo repository.UserRepository,
+	kycRepo repository.KYCProfileRepository,
+	kycService *service.KYCService,
+	scores VerificationScoreSink,
+) *KYCHandler {
+	return &KYCHandler{
+		userRepo:   userRepo,
+		kycRepo:    kycRepo,
+		kycService: kycService,
+		scores:     scores,
+	}
+}
+
+// HandleVerifyIdentityNumber checks a BVN or NIN against the user's name and date of birth
+func (h *KYCHandler) HandleVerifyIdentityNumber(ctx context.Context, cmd command.VerifyIdentityNumber) (*command.KYCCheckResult, error) {
+	checkType := aggregate.KYCCheckType(cmd.Type)
+	if checkType != aggregate.KYCCheckBVN && checkType != aggregate.KYCCheckNIN {
+		return nil, aggregate.ErrKYCCheckNotSupported
+	}
+
+	return h.verify(ctx, cmd.UserID, service.VerificationRequest{
+		CheckType: checkType,
+		IDNumber:  cmd.Number,
+	})
+}
+
+// HandleVerifyIDDocument checks a government ID document and a selfie against it
+func (h *KYCHandler) HandleVerifyIDDocument(ctx context.Context, cmd command.VerifyIDDocument) (*command.KYCCheckResult, error) {
+	return h.verify(ctx, cmd.UserID, service.VerificationRequest{
+		CheckType:        aggregate.KYCCheckIDDocument,
+		IDNumber:         cmd.DocumentNumber,
+		DocumentType:     cmd.DocumentType,
+		DocumentImageRef: cmd.DocumentImageRef,
+		SelfieImageRef:   cmd.SelfieImageRef,
+	})
+}
+
+// HandleVerifyAddress checks the user's residential address
+func (h *KYCHandler) HandleVerifyAddress(ctx context.Context, cmd command.VerifyAddress) (*command.KYCCheckResult, error) {
+	return h.verify(ctx, cmd.UserID, service.VerificationRequest{
+		CheckType: aggregate.KYCCheckAddress,
+		Address: service.AddressDetails{
+			Street: cmd.Street,
+			City:   cmd.City,
+			LGA:    cmd.LGA,
+			State:  cmd.State,
+		},
+	})
+}
+
+// OnKYCChanged passes a user's verifications on to credit scoring.
+// Subscribe it to KYCCheckCompleted and KYCLevelChanged.
+func (h *KYCHandler) OnKYCChanged(ctx context.Context, e sharedevent.DomainEvent) error {
+	var rawUserID string
+	switch ev := e.(type) {
+	case *event.KYCCheckCompleted:
+		if ev.Status != string(aggregate.KYCCheckVerified) {
+			return nil
+		}
+		rawUserID = ev.UserID
+	case *event.KYCLevelChanged:
+		rawUserID = ev.UserID
+	default:
+		return nil
+	}
+
+	userID, err := valueobject.NewUserID(rawUserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return service.ErrUserNotFound
+	}
+
+	profile, err := h.loadProfile(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	return h.scores.UpdateVerificationScore(
+		ctx,
+		userID.String(),
+		profile.PhoneVerified(),
+		!user.Email().IsEmpty(),
+		profile.IsVerified(aggregate.KYCCheckBVN),
+		profile.IsVerified(aggregate.KYCCheckNIN),
+	)
+}
+
+func (h *KYCHandler) verify(ctx context.Context, rawUserID string, req service.VerificationRequest) (*command.KYCCheckResult, error) {
+	userID, err := valueobject.NewUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, service.ErrUserNotFound
+	}
+
+	profile, err := h.loadProfile(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Reference = valueobject.GenerateTransactionID().String()
+	check, err := h.kycService.Verify(ctx, user, profile, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Failed checks are kept too; they count towards the daily attempt limit
+	if err := h.kycRepo.SaveWithEvents(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	if check.Status == aggregate.KYCCheckVerified && (check.Type == aggregate.KYCCheckBVN || check.Type == aggregate.KYCCheckNIN) {
+		user.MarkVerified(string(check.Type))
+		if err := h.userRepo.SaveWithEvents(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	return &command.KYCCheckResult{
+		CheckID:   check.ID,
+		CheckType: string(check.Type),
+		Status:    string(check.Status),
+		Provider:  check.Provider,
+		Level:     int(profile.Level()),
+		LevelName: profile.Level().String(),
+	}, nil
+}
+
+// loadProfile returns the user's KYC profile, starting one at Tier 1 if they
+// have none yet, since every account is opened by phone OTP
+func (h *KYCHandler) loadProfile(ctx context.Context, user *aggregate.User) (*aggregate.KYCProfile, error) {
+	profile, err := h.kycRepo.FindByUserID(ctx, user.ID())
+	if errors.Is(err, repository.ErrKYCProfileNotFound) {
+		return aggregate.NewKYCProfile(user.ID(), true, user.CreatedAt()), nil
+	}
+	return profile, err
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+
+	"hustlex/internal/application/identity/command"
+	"hustlex/internal/domain/identity/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// SkillCatalogHandler handles admin commands on the skill tree
+type SkillCatalogHandler struct {
+	taxonomy *service.SkillTaxonomyService
+}
+
+// NewSkillCatalogHandler creates a new skill catalog handler
+func NewSkillCatalogHandler(taxonomy *service.SkillTaxonomyService) *SkillCatalogHandler {
+	return &SkillCatalogHandler{taxonomy: taxonomy}
+}
+
+// HandleCreateSkill adds a skill to the tree, as a top-level category or beneath an existing skill
+func (h *SkillCatalogHandler) HandleCreateSkill(ctx context.Context, cmd command.CreateSkill) (*command.CreateSkillResult, error) {
+	var parentID *valueobject.SkillID
+	if cmd.ParentID != "" {
+		id, err := valueobject.NewSkillID(cmd.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &id
+	}
+
+	skill, err := h.taxonomy.CreateSkill(ctx, cmd.Name, parentID, cmd.Description, cmd.Icon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &command.CreateSkillResult{
+		SkillID:  skill.ID,
+		Name:     skill.Name,
+		Category: skill.Category,
+		ParentID: skill.ParentID,
+		Path:     skill.Path,
+	}, nil
+}
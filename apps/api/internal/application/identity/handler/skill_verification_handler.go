@@ -0,0 +1,455 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/identity/command"
+	gigevent "hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/identity/service"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Skill verification errors
+var (
+	ErrContractNotYours = errors.New("portfolio can only link contracts you completed as the hustler")
+	ErrNotPastClient    = errors.New("only clients who completed a contract with this hustler can endorse them")
+)
+
+// ContractRecord is what identity needs to know about a gig contract
+type ContractRecord struct {
+	ContractID string
+	ClientID   string
+	HustlerID  string
+	SkillID    string // the gig's skill, empty if it had none
+	Completed  bool
+}
+
+// ContractRecordReader looks up the contracts behind portfolio links and endorsements
+// This is a PORT - gig bounded context provides the ADAPTER
+type ContractRecordReader interface {
+	FindContractRecord(ctx context.Context, contractID valueobject.ContractID) (*ContractRecord, error)
+}
+
+// GigSkillRecord is a hustler's completed work under one gig skill
+type GigSkillRecord struct {
+	SkillID            string
+	CompletedContracts int
+	ReviewCount        int
+	AverageRating      float64
+}
+
+// SkillTrackRecordReader reports a hustler's completed work per gig skill
+// This is a PORT - gig bounded context provides the ADAPTER
+type SkillTrackRecordReader interface {
+	SkillTrackRecords(ctx context.Context, hustlerID valueobject.UserID) ([]GigSkillRecord, error)
+}
+
+// SkillVerificationHandler handles portfolio, endorsement and certificate commands
+// and keeps each skill's badge in step with the evidence behind it
+type SkillVerificationHandler struct {
+	userRepo     repository.UserRepository
+	profileRepo  repository.HustlerProfileRepository
+	skillRepo    repository.SkillRepository
+	contracts    ContractRecordReader
+	trackRecords SkillTrackRecordReader
+}
+
+// NewSkillVerificationHandler creates a new skill verification handler
+func NewSkillVerificationHandler(
+	userRepo repository.UserRepository,
+	profileRepo repository.HustlerProfileRepository,
+	skillRepo repository.SkillRepository,
+	contracts ContractRecordReader,
+	trackRecords SkillTrackRecordReader,
+) *SkillVerificationHandler {
+	return &SkillVerificationHandler{
+		userRepo:     userRepo,
+		profileRepo:  profileRepo,
+		skillRepo:    skillRepo,
+		contracts:    contracts,
+		trackRecords: trackRecords,
+	}
+}
+
+// HandleAddPortfolioItem adds a piece of work to a hustler's portfolio
+func (h *SkillVerificationHandler) HandleAddPortfolioItem(ctx context.Context, cmd command.AddPortfolioItem) (*command.PortfolioItemResult, error) {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, service.ErrUserNotFound
+	}
+
+	skillID, contractIDs, err := h.portfolioLinks(ctx, user, cmd.SkillID, cmd.ContractIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.loadProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := profile.AddPortfolioItem(cmd.Title, cmd.Description, cmd.MediaRefs, skillID, contractIDs, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.profileRepo.SaveWithEvents(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return &command.PortfolioItemResult{
+		UserID: userID.String(),
+		ItemID: item.ID,
+		Title:  item.Title,
+	}, nil
+}
+
+// HandleUpdatePortfolioItem replaces the details of a portfolio item
+func (h *SkillVerificationHandler) HandleUpdatePortfolioItem(ctx context.Context, cmd command.UpdatePortfolioItem) error {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return service.ErrUserNotFound
+	}
+
+	skillID, contractIDs, err := h.portfolioLinks(ctx, user, cmd.SkillID, cmd.ContractIDs)
+	if err != nil {
+		return err
+	}
+
+	profile, err := h.profileRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return aggregate.ErrPortfolioItemNotFound
+	}
+
+	if err := profile.UpdatePortfolioItem(cmd.ItemID, cmd.Title, cmd.Description, cmd.MediaRefs, skillID, contractIDs, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.profileRepo.SaveWithEvents(ctx, profile)
+}
+
+// HandleRemovePortfolioItem takes a piece of work off a hustler's portfolio
+func (h *SkillVerificationHandler) HandleRemovePortfolioItem(ctx context.Context, cmd command.RemovePortfolioItem) error {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	profile, err := h.profileRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return aggregate.ErrPortfolioItemNotFound
+	}
+
+	if err := profile.RemovePortfolioItem(cmd.ItemID, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.profileRepo.SaveWithEvents(ctx, profile)
+}
+
+// HandleEndorseSkill records a past client's endorsement of a hustler's skill
+func (h *SkillVerificationHandler) HandleEndorseSkill(ctx context.Context, cmd command.EndorseSkill) error {
+	hustlerID, err := valueobject.NewUserID(cmd.HustlerID)
+	if err != nil {
+		return err
+	}
+
+	endorserID, err := valueobject.NewUserID(cmd.EndorserID)
+	if err != nil {
+		return err
+	}
+
+	skillID, err := valueobject.NewSkillID(cmd.SkillID)
+	if err != nil {
+		return err
+	}
+
+	contractID, err := valueobject.NewContractID(cmd.ContractID)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, hustlerID)
+	if err != nil {
+		return service.ErrUserNotFound
+	}
+	if !user.HasSkill(skillID) {
+		return aggregate.ErrSkillNotFound
+	}
+
+	// Only the client on a completed contract with this hustler can vouch for them
+	record, err := h.contracts.FindContractRecord(ctx, contractID)
+	if err != nil || !record.Completed || record.ClientID != endorserID.String() || record.HustlerID != hustlerID.String() {
+		return ErrNotPastClient
+	}
+
+	profile, err := h.loadProfile(ctx, hustlerID)
+	if err != nil {
+		return err
+	}
+
+	if err := profile.Endorse(skillID, endorserID, contractID, cmd.Note, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.profileRepo.SaveWithEvents(ctx, profile)
+}
+
+// HandleSubmitSkillCertificate submits a certificate for an admin to review
+func (h *SkillVerificationHandler) HandleSubmitSkillCertificate(ctx context.Context, cmd command.SubmitSkillCertificate) (*command.SkillCertificateResult, error) {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	skillID, err := valueobject.NewSkillID(cmd.SkillID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, service.ErrUserNotFound
+	}
+	if !user.HasSkill(skillID) {
+		return nil, aggregate.ErrSkillNotFound
+	}
+
+	profile, err := h.loadProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := profile.SubmitCertificate(skillID, cmd.Title, cmd.Issuer, cmd.DocumentRef, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.profileRepo.SaveWithEvents(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return &command.SkillCertificateResult{
+		CertificateID: cert.ID,
+		SkillID:       skillID.String(),
+		Status:        string(cert.Status),
+	}, nil
+}
+
+// HandleReviewSkillCertificate records an admin's decision on a certificate and
+// updates the skill's badge on the user's profile
+func (h *SkillVerificationHandler) HandleReviewSkillCertificate(ctx context.Context, cmd command.ReviewSkillCertificate) (*command.SkillBadgeResult, error) {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewerID, err := valueobject.NewUserID(cmd.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.profileRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, aggregate.ErrCertificateNotFound
+	}
+
+	if err := profile.ReviewCertificate(cmd.CertificateID, reviewerID, cmd.Approve, cmd.Note, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	var result *command.SkillBadgeResult
+	for _, c := range profile.Credentials() {
+		for _, cert := range c.Certificates {
+			if cert.ID == cmd.CertificateID {
+				result = &command.SkillBadgeResult{SkillID: c.SkillID.String(), Badge: string(c.Badge)}
+			}
+		}
+	}
+
+	if err := h.saveWithUser(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RefreshSkillBadges recomputes the track record behind each of a hustler's skills
+// from their completed gig work and updates the badges they earn. Work on a skill
+// further down the tree counts towards the skill above it.
+func (h *SkillVerificationHandler) RefreshSkillBadges(ctx context.Context, userID valueobject.UserID) error {
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return service.ErrUserNotFound
+	}
+	if len(user.Skills()) == 0 {
+		return nil
+	}
+
+	records, err := h.trackRecords.SkillTrackRecords(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	profile, err := h.loadProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, skill := range user.Skills() {
+		covered, err := h.skillRepo.FindDescendantIDs(ctx, skill.SkillID)
+		if err != nil {
+			return err
+		}
+		profile.ApplyTrackRecord(skill.SkillID, combineTrackRecords(records, covered), now)
+	}
+
+	return h.saveWithUser(ctx, profile)
+}
+
+// OnReviewsChanged refreshes the skill badges of everyone whose visible reviews
+// changed. Subscribe it to ReviewsRevealed and ReviewModerated.
+func (h *SkillVerificationHandler) OnReviewsChanged(ctx context.Context, e sharedevent.DomainEvent) error {
+	var revieweeIDs []string
+	switch ev := e.(type) {
+	case *gigevent.ReviewsRevealed:
+		revieweeIDs = ev.RevieweeIDs
+	case *gigevent.ReviewModerated:
+		revieweeIDs = []string{ev.RevieweeID}
+	default:
+		return nil
+	}
+
+	var errs []error
+	for _, id := range revieweeIDs {
+		userID, err := valueobject.NewUserID(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reviewee %s: invalid user ID", id))
+			continue
+		}
+
+		if err := h.RefreshSkillBadges(ctx, userID); err != nil {
+			errs = append(errs, fmt.Errorf("reviewee %s: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// portfolioLinks validates the skill and contracts a portfolio item points at
+func (h *SkillVerificationHandler) portfolioLinks(
+	ctx context.Context,
+	user *aggregate.User,
+	rawSkillID string,
+	rawContractIDs []string,
+) (*valueobject.SkillID, []valueobject.ContractID, error) {
+	var skillID *valueobject.SkillID
+	if rawSkillID != "" {
+		id, err := valueobject.NewSkillID(rawSkillID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !user.HasSkill(id) {
+			return nil, nil, aggregate.ErrSkillNotFound
+		}
+		skillID = &id
+	}
+
+	contractIDs := make([]valueobject.ContractID, 0, len(rawContractIDs))
+	for _, raw := range rawContractIDs {
+		id, err := valueobject.NewContractID(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		record, err := h.contracts.FindContractRecord(ctx, id)
+		if err != nil || !record.Completed || record.HustlerID != user.ID().String() {
+			return nil, nil, ErrContractNotYours
+		}
+		contractIDs = append(contractIDs, id)
+	}
+
+	return skillID, contractIDs, nil
+}
+
+// loadProfile returns the user's hustler profile, starting one if they have none yet
+func (h *SkillVerificationHandler) loadProfile(ctx context.Context, userID valueobject.UserID) (*aggregate.HustlerProfile, error) {
+	profile, err := h.profileRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, repository.ErrHustlerProfileNotFound) {
+		return aggregate.NewHustlerProfile(userID, time.Now().UTC()), nil
+	}
+	return profile, err
+}
+
+// saveWithUser saves the profile and mirrors its badges onto the verified flag
+// of the user's skills, which is what the rest of the platform reads
+func (h *SkillVerificationHandler) saveWithUser(ctx context.Context, profile *aggregate.HustlerProfile) error {
+	if err := h.profileRepo.SaveWithEvents(ctx, profile); err != nil {
+		return err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, profile.UserID())
+	if err != nil {
+		return service.ErrUserNotFound
+	}
+
+	changed := false
+	for _, skill := range user.Skills() {
+		verified := profile.BadgeFor(skill.SkillID) != aggregate.SkillBadgeNone
+		if skill.IsVerified == verified {
+			continue
+		}
+		if err := user.SetSkillVerified(skill.SkillID, verified); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	return h.userRepo.SaveWithEvents(ctx, user)
+}
+
+// combineTrackRecords totals the gig work filed under any of the covered skills.
+// Ratings are weighted by how many reviews stand behind them.
+func combineTrackRecords(records []GigSkillRecord, covered []valueobject.SkillID) aggregate.SkillTrackRecord {
+	inTree := make(map[string]bool, len(covered))
+	for _, id := range covered {
+		inTree[id.String()] = true
+	}
+
+	var combined aggregate.SkillTrackRecord
+	var ratingSum float64
+	var reviews int
+	for _, r := range records {
+		if !inTree[r.SkillID] {
+			continue
+		}
+		combined.CompletedContracts += r.CompletedContracts
+		ratingSum += r.AverageRating * float64(r.ReviewCount)
+		reviews += r.ReviewCount
+	}
+	if reviews > 0 {
+		combined.AverageRating = ratingSum / float64(reviews)
+	}
+
+	return combined
+}
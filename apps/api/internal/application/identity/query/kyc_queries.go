@@ -0,0 +1,123 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/identity/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetKYCStatus retrieves a user's KYC level and checks
+type GetKYCStatus struct {
+	UserID string
+}
+
+// KYCCheckDTO represents a KYC check for API responses. Provider responses are never returned.
+type KYCCheckDTO struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	Status       string    `json:"status"`
+	Provider     string    `json:"provider"`
+	MaskedNumber string    `json:"masked_number,omitempty"`
+	CheckedAt    time.Time `json:"checked_at"`
+}
+
+// KYCStatusDTO represents a user's KYC progress
+type KYCStatusDTO struct {
+	UserID     string        `json:"user_id"`
+	Level      int           `json:"level"`
+	LevelName  string        `json:"level_name"`
+	LevelSince time.Time     `json:"level_since"`
+	NextChecks []string      `json:"next_checks"` // checks that would move the user up a level
+	Checks     []KYCCheckDTO `json:"checks"`
+}
+
+// KYCQueryHandler handles KYC queries
+type KYCQueryHandler struct {
+	userRepo repository.UserRepository
+	kycRepo  repository.KYCProfileRepository
+}
+
+// NewKYCQueryHandler creates a new KYC query handler
+func NewKYCQueryHandler(
+	userRepo repository.UserRepository,
+	kycRepo repository.KYCProfileRepository,
+) *KYCQueryHandler {
+	return &KYCQueryHandler{
+		userRepo: userRepo,
+		kycRepo:  kycRepo,
+	}
+}
+
+// HandleGetKYCStatus retrieves a user's KYC level, what they can verify next and their past checks
+func (h *KYCQueryHandler) HandleGetKYCStatus(ctx context.Context, q GetKYCStatus) (*KYCStatusDTO, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.loadProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &KYCStatusDTO{
+		UserID:     userID.String(),
+		Level:      int(profile.Level()),
+		LevelName:  profile.Level().String(),
+		LevelSince: profile.LevelSince(),
+		NextChecks: []string{},
+		Checks:     make([]KYCCheckDTO, 0, len(profile.Checks())),
+	}
+
+	switch profile.Level() {
+	case aggregate.KYCLevelPhone:
+		result.NextChecks = []string{string(aggregate.KYCCheckBVN), string(aggregate.KYCCheckNIN)}
+	case aggregate.KYCLevelIdentity:
+		for _, t := range []aggregate.KYCCheckType{aggregate.KYCCheckIDDocument, aggregate.KYCCheckAddress} {
+			if !profile.IsVerified(t) {
+				result.NextChecks = append(result.NextChecks, string(t))
+			}
+		}
+	}
+
+	for _, c := range profile.Checks() {
+		result.Checks = append(result.Checks, KYCCheckDTO{
+			ID:           c.ID,
+			Type:         string(c.Type),
+			Status:       string(c.Status),
+			Provider:     c.Provider,
+			MaskedNumber: c.MaskedNumber,
+			CheckedAt:    c.CheckedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// KYCLevel returns a user's KYC level. It is the ADAPTER for the wallet limit
+// and loan eligibility KYC ports.
+func (h *KYCQueryHandler) KYCLevel(ctx context.Context, userID valueobject.UserID) (int, error) {
+	profile, err := h.loadProfile(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return int(profile.Level()), nil
+}
+
+// loadProfile returns the user's KYC profile, or the Tier 1 profile every account starts with
+func (h *KYCQueryHandler) loadProfile(ctx context.Context, userID valueobject.UserID) (*aggregate.KYCProfile, error) {
+	profile, err := h.kycRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, repository.ErrKYCProfileNotFound) {
+		user, err := h.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, service.ErrUserNotFound
+		}
+		return aggregate.NewKYCProfile(userID, true, user.CreatedAt()), nil
+	}
+	return profile, err
+}
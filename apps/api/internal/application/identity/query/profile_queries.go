@@ -0,0 +1,240 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/identity/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetHustlerProfile retrieves a hustler's portfolio and skill credentials
+type GetHustlerProfile struct {
+	UserID   string
+	ViewerID string // the owner also sees their pending and rejected certificates
+}
+
+// GetPendingCertificates retrieves certificates awaiting admin review
+type GetPendingCertificates struct {
+	Page  int
+	Limit int
+}
+
+// PortfolioItemDTO represents a portfolio item for API responses
+type PortfolioItemDTO struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	MediaRefs   []string  `json:"media_refs,omitempty"`
+	SkillID     string    `json:"skill_id,omitempty"`
+	ContractIDs []string  `json:"contract_ids,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// EndorsementDTO represents a skill endorsement for API responses
+type EndorsementDTO struct {
+	EndorserID string    `json:"endorser_id"`
+	ContractID string    `json:"contract_id"`
+	Note       string    `json:"note,omitempty"`
+	EndorsedAt time.Time `json:"endorsed_at"`
+}
+
+// CertificateDTO represents a skill certificate for API responses
+type CertificateDTO struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id,omitempty"`
+	SkillID     string     `json:"skill_id,omitempty"`
+	Title       string     `json:"title"`
+	Issuer      string     `json:"issuer,omitempty"`
+	DocumentRef string     `json:"document_ref,omitempty"` // owner and admins only
+	Status      string     `json:"status"`
+	ReviewNote  string     `json:"review_note,omitempty"`
+	SubmittedAt time.Time  `json:"submitted_at"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// SkillCredentialDTO represents the evidence behind one of a hustler's skills
+type SkillCredentialDTO struct {
+	SkillID            string           `json:"skill_id"`
+	SkillName          string           `json:"skill_name"`
+	Badge              string           `json:"badge,omitempty"`
+	BadgeSince         *time.Time       `json:"badge_since,omitempty"`
+	CompletedContracts int              `json:"completed_contracts"`
+	AverageRating      float64          `json:"average_rating"`
+	Endorsements       []EndorsementDTO `json:"endorsements"`
+	Certificates       []CertificateDTO `json:"certificates"`
+}
+
+// HustlerProfileDTO represents a hustler's portfolio and skill credentials
+type HustlerProfileDTO struct {
+	UserID    string               `json:"user_id"`
+	Portfolio []PortfolioItemDTO   `json:"portfolio"`
+	Skills    []SkillCredentialDTO `json:"skills"`
+}
+
+// PendingCertificatesResult represents a page of the certificate review queue
+type PendingCertificatesResult struct {
+	Certificates []CertificateDTO `json:"certificates"`
+	Total        int64            `json:"total"`
+	Page         int              `json:"page"`
+	Limit        int              `json:"limit"`
+}
+
+// HustlerProfileQueryHandler handles portfolio and skill credential queries
+type HustlerProfileQueryHandler struct {
+	userRepo    repository.UserRepository
+	profileRepo repository.HustlerProfileRepository
+}
+
+// NewHustlerProfileQueryHandler creates a new hustler profile query handler
+func NewHustlerProfileQueryHandler(
+	userRepo repository.UserRepository,
+	profileRepo repository.HustlerProfileRepository,
+) *HustlerProfileQueryHandler {
+	return &HustlerProfileQueryHandler{
+		userRepo:    userRepo,
+		profileRepo: profileRepo,
+	}
+}
+
+// HandleGetHustlerProfile retrieves a hustler's portfolio and, for every skill on
+// their profile, the badge, track record, endorsements and certificates behind it
+func (h *HustlerProfileQueryHandler) HandleGetHustlerProfile(ctx context.Context, q GetHustlerProfile) (*HustlerProfileDTO, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, service.ErrUserNotFound
+	}
+
+	profile, err := h.profileRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, repository.ErrHustlerProfileNotFound) {
+		profile = aggregate.NewHustlerProfile(userID, user.CreatedAt())
+	} else if err != nil {
+		return nil, err
+	}
+
+	isOwner := q.ViewerID == userID.String()
+	result := &HustlerProfileDTO{
+		UserID:    userID.String(),
+		Portfolio: make([]PortfolioItemDTO, 0, len(profile.Portfolio())),
+		Skills:    make([]SkillCredentialDTO, 0, len(user.Skills())),
+	}
+
+	for _, item := range profile.Portfolio() {
+		dto := PortfolioItemDTO{
+			ID:          item.ID,
+			Title:       item.Title,
+			Description: item.Description,
+			MediaRefs:   item.MediaRefs,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+		if item.SkillID != nil {
+			dto.SkillID = item.SkillID.String()
+		}
+		for _, id := range item.ContractIDs {
+			dto.ContractIDs = append(dto.ContractIDs, id.String())
+		}
+		result.Portfolio = append(result.Portfolio, dto)
+	}
+
+	// Credentials for skills the hustler has since removed are not shown
+	for _, skill := range user.Skills() {
+		dto := SkillCredentialDTO{
+			SkillID:      skill.SkillID.String(),
+			SkillName:    skill.SkillName,
+			Endorsements: []EndorsementDTO{},
+			Certificates: []CertificateDTO{},
+		}
+
+		if c := profile.Credential(skill.SkillID); c != nil {
+			dto.Badge = string(c.Badge)
+			dto.BadgeSince = c.BadgeSince
+			dto.CompletedContracts = c.TrackRecord.CompletedContracts
+			dto.AverageRating = c.TrackRecord.AverageRating
+
+			for _, e := range c.Endorsements {
+				dto.Endorsements = append(dto.Endorsements, EndorsementDTO{
+					EndorserID: e.EndorserID.String(),
+					ContractID: e.ContractID.String(),
+					Note:       e.Note,
+					EndorsedAt: e.EndorsedAt,
+				})
+			}
+			for _, cert := range c.Certificates {
+				if !isOwner && cert.Status != aggregate.CertificateApproved {
+					continue
+				}
+				certDTO := certificateToDTO(cert)
+				if !isOwner {
+					certDTO.DocumentRef = ""
+				}
+				dto.Certificates = append(dto.Certificates, certDTO)
+			}
+		}
+
+		result.Skills = append(result.Skills, dto)
+	}
+
+	return result, nil
+}
+
+// HandleGetPendingCertificates retrieves certificates awaiting review, oldest first
+func (h *HustlerProfileQueryHandler) HandleGetPendingCertificates(ctx context.Context, q GetPendingCertificates) (*PendingCertificatesResult, error) {
+	page := q.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := q.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	profiles, total, err := h.profileRepo.FindPendingCertificates(ctx, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PendingCertificatesResult{
+		Certificates: []CertificateDTO{},
+		Total:        total,
+		Page:         page,
+		Limit:        limit,
+	}
+	for _, profile := range profiles {
+		for _, c := range profile.Credentials() {
+			for _, cert := range c.Certificates {
+				if cert.Status != aggregate.CertificatePending {
+					continue
+				}
+				dto := certificateToDTO(cert)
+				dto.UserID = profile.UserID().String()
+				dto.SkillID = c.SkillID.String()
+				result.Certificates = append(result.Certificates, dto)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func certificateToDTO(cert aggregate.SkillCertificate) CertificateDTO {
+	return CertificateDTO{
+		ID:          cert.ID,
+		Title:       cert.Title,
+		Issuer:      cert.Issuer,
+		DocumentRef: cert.DocumentRef,
+		Status:      string(cert.Status),
+		ReviewNote:  cert.ReviewNote,
+		SubmittedAt: cert.SubmittedAt,
+		ReviewedAt:  cert.ReviewedAt,
+	}
+}
@@ -74,11 +74,18 @@ type SearchSkills struct {
 
 // SkillCatalogDTO represents a catalog skill
 type SkillCatalogDTO struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Category    string `json:"category"`
-	Description string `json:"description,omitempty"`
-	Icon        string `json:"icon,omitempty"`
+	ID          string   `json:"id"`
+	ParentID    string   `json:"parent_id,omitempty"`
+	Path        []string `json:"path,omitempty"` // IDs from the top-level skill down
+	Name        string   `json:"name"`
+	Category    string   `json:"category"`
+	Description string   `json:"description,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+}
+
+// GetSkillTree retrieves one level of the skill tree
+type GetSkillTree struct {
+	ParentID string // empty for the top-level categories
 }
 
 // GetReferralStats retrieves referral statistics
@@ -237,6 +244,8 @@ func (h *UserQueryHandler) HandleGetSkillCatalog(ctx context.Context, q GetSkill
 	for i, s := range skills {
 		dtos[i] = SkillCatalogDTO{
 			ID:          s.ID,
+			ParentID:    s.ParentID,
+			Path:        s.Path,
 			Name:        s.Name,
 			Category:    s.Category,
 			Description: s.Description,
@@ -247,6 +256,54 @@ func (h *UserQueryHandler) HandleGetSkillCatalog(ctx context.Context, q GetSkill
 	return dtos, nil
 }
 
+// HandleGetSkillTree retrieves the skills directly beneath a skill, or the top-level categories
+func (h *UserQueryHandler) HandleGetSkillTree(ctx context.Context, q GetSkillTree) ([]SkillCatalogDTO, error) {
+	var parentID *valueobject.SkillID
+	if q.ParentID != "" {
+		id, err := valueobject.NewSkillID(q.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &id
+	}
+
+	skills, err := h.skillRepo.FindChildren(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]SkillCatalogDTO, len(skills))
+	for i, s := range skills {
+		dtos[i] = SkillCatalogDTO{
+			ID:          s.ID,
+			ParentID:    s.ParentID,
+			Path:        s.Path,
+			Name:        s.Name,
+			Category:    s.Category,
+			Description: s.Description,
+			Icon:        s.Icon,
+		}
+	}
+
+	return dtos, nil
+}
+
+// HustlerSkillIDs lists the skills on a user's profile.
+// It is the ADAPTER for gig recommendations' HustlerSkillReader port.
+func (h *UserQueryHandler) HustlerSkillIDs(ctx context.Context, hustlerID valueobject.UserID) ([]valueobject.SkillID, error) {
+	user, err := h.userRepo.FindByID(ctx, hustlerID)
+	if err != nil {
+		return nil, service.ErrUserNotFound
+	}
+
+	ids := make([]valueobject.SkillID, 0, len(user.Skills()))
+	for _, skill := range user.Skills() {
+		ids = append(ids, skill.SkillID)
+	}
+
+	return ids, nil
+}
+
 // HandleSearchSkills searches skills by name
 func (h *UserQueryHandler) HandleSearchSkills(ctx context.Context, q SearchSkills) ([]SkillCatalogDTO, error) {
 	limit := q.Limit
@@ -263,6 +320,8 @@ func (h *UserQueryHandler) HandleSearchSkills(ctx context.Context, q SearchSkill
 	for i, s := range skills {
 		dtos[i] = SkillCatalogDTO{
 			ID:          s.ID,
+			ParentID:    s.ParentID,
+			Path:        s.Path,
 			Name:        s.Name,
 			Category:    s.Category,
 			Description: s.Description,
@@ -20,6 +20,14 @@ type CreateCircle struct {
 	StartDate       *time.Time
 	IsPrivate       bool
 	Rules           []string
+	PayoutOrder     string     // fixed, random_draw, auction (rotational circles only)
+	TargetAmount    int64      // Fixed-target circles only
+	TargetDate      *time.Time // Fixed-target circles only
+	TargetPayout    string     // pro_rata (default), per_contribution
+	MissedPolicy    string     // short_payout (default), cover_from_reserve, auto_debit
+	GracePeriodDays *int       // Days after the due date before a contribution is missed
+	Location        string     // Where members are, for discovery
+	RequireApproval bool       // Admins approve join requests instead of auto-join
 }
 
 // CreateCircleResult is the result of creating a circle
@@ -32,6 +40,7 @@ type CreateCircleResult struct {
 	Frequency       string    `json:"frequency"`
 	MaxMembers      int       `json:"max_members"`
 	InviteCode      string    `json:"invite_code"`
+	PayoutOrder     string    `json:"payout_order"`
 	Status          string    `json:"status"`
 	CreatedAt       time.Time `json:"created_at"`
 }
@@ -91,6 +100,291 @@ type TriggerPayout struct {
 	CircleID string
 }
 
+// PlaceLien pledges a member's upcoming payout as loan collateral
+type PlaceLien struct {
+	CircleID string
+	UserID   string
+	LoanID   string
+}
+
+// ReleaseLien lifts a loan's lien from a member's payout
+type ReleaseLien struct {
+	CircleID string
+	UserID   string
+	LoanID   string
+}
+
+// SetPayoutOrder changes how a rotational circle orders payouts (admin only, before start)
+type SetPayoutOrder struct {
+	CircleID string
+	AdminID  string
+	Order    string // fixed, random_draw, auction
+}
+
+// PlacePayoutBid bids a discount for the current round's payout in an auction circle
+type PlacePayoutBid struct {
+	CircleID string
+	UserID   string
+	Discount int64
+}
+
+// PlacePayoutBidResult is the result of placing a bid
+type PlacePayoutBidResult struct {
+	CircleID string    `json:"circle_id"`
+	Round    int       `json:"round"`
+	Discount int64     `json:"discount"`
+	Payout   int64     `json:"payout"` // What the member receives if the bid wins
+	PlacedAt time.Time `json:"placed_at"`
+}
+
+// RequestPositionSwap asks another member to trade payout positions
+type RequestPositionSwap struct {
+	CircleID           string
+	UserID             string
+	CounterpartyUserID string
+}
+
+// RequestPositionSwapResult is the result of requesting a swap
+type RequestPositionSwapResult struct {
+	SwapID string `json:"swap_id"`
+	Status string `json:"status"`
+}
+
+// RespondToPositionSwap records the counterparty's answer to a swap request
+type RespondToPositionSwap struct {
+	CircleID string
+	SwapID   string
+	UserID   string
+	Accept   bool
+}
+
+// ReviewPositionSwap approves or rejects an agreed swap (admin only)
+type ReviewPositionSwap struct {
+	CircleID string
+	SwapID   string
+	AdminID  string
+	Approve  bool
+	Reason   string
+}
+
+// MatureCircle distributes a fixed-target circle's pool at its target date
+type MatureCircle struct {
+	CircleID string
+}
+
+// AppointCircleAdmin makes a member an admin (admin only)
+type AppointCircleAdmin struct {
+	CircleID string
+	AdminID  string
+	UserID   string
+}
+
+// RequestEmergencyWithdrawal asks the admins to release money from an emergency fund
+type RequestEmergencyWithdrawal struct {
+	CircleID string
+	UserID   string
+	Amount   int64
+	Reason   string
+}
+
+// RequestEmergencyWithdrawalResult is the result of requesting a withdrawal
+type RequestEmergencyWithdrawalResult struct {
+	WithdrawalID string `json:"withdrawal_id"`
+	Amount       int64  `json:"amount"`
+	Quorum       int    `json:"quorum"`
+	Status       string `json:"status"`
+}
+
+// VoteOnWithdrawal records an admin's vote on an emergency withdrawal
+type VoteOnWithdrawal struct {
+	CircleID     string
+	WithdrawalID string
+	AdminID      string
+	Approve      bool
+}
+
+// RepayEmergencyWithdrawal pays a withdrawal back into the fund
+type RepayEmergencyWithdrawal struct {
+	CircleID      string
+	WithdrawalID  string
+	UserID        string
+	Amount        int64
+	TransactionID string // from wallet
+}
+
+// SetMissedContributionPolicy chooses how missed contributions are handled (admin only)
+type SetMissedContributionPolicy struct {
+	CircleID        string
+	AdminID         string
+	Policy          string // short_payout, cover_from_reserve, auto_debit
+	GracePeriodDays int
+}
+
+// FundCircleReserve adds money to the reserve that covers missed contributions
+type FundCircleReserve struct {
+	CircleID      string
+	UserID        string
+	Amount        int64
+	TransactionID string // from wallet
+}
+
+// SettleArrears pays off a member's missed contributions
+type SettleArrears struct {
+	CircleID      string
+	UserID        string
+	Amount        int64
+	TransactionID string // from wallet
+}
+
+// DisbursePayout pays a triggered circle payout into the recipient's wallet.
+// LienLoanID is set when the payout is pledged to a loan, which is repaid first.
+// Kind and SourceID are set for payouts other than a member's share of the pool.
+type DisbursePayout struct {
+	CircleID   string
+	UserID     string
+	Round      int
+	Amount     int64
+	LienLoanID string
+	Kind       string
+	SourceID   string
+}
+
+// SetAutoDebit opts a member in to or out of automatic contributions from their wallet
+type SetAutoDebit struct {
+	CircleID string
+	UserID   string
+	Enabled  bool
+}
+
+// CreateSavingsGoal opens a personal savings goal
+type CreateSavingsGoal struct {
+	UserID     string
+	Name       string
+	Target     int64
+	Currency   string
+	TargetDate time.Time
+	Mode       string // flexible, locked
+}
+
+// CreateSavingsGoalResult is the result of opening a savings goal
+type CreateSavingsGoalResult struct {
+	GoalID string
+}
+
+// DepositToGoal moves money from the wallet into a savings goal
+type DepositToGoal struct {
+	GoalID string
+	UserID string
+	Amount int64
+}
+
+// WithdrawFromGoal moves money from a savings goal back to the wallet
+type WithdrawFromGoal struct {
+	GoalID string
+	UserID string
+	Amount int64
+}
+
+// CloseGoal empties a savings goal into the wallet. Locked goals must be broken
+// before their target date, which forfeits the early break penalty.
+type CloseGoal struct {
+	GoalID string
+	UserID string
+	Break  bool
+}
+
+// SetGoalAutoSave sets or clears a savings goal's auto-save rule
+type SetGoalAutoSave struct {
+	GoalID    string
+	UserID    string
+	Kind      string // fixed, round_up, or empty to clear
+	Amount    int64  // fixed only
+	Frequency string // fixed only: daily, weekly, monthly
+	StartAt   time.Time
+	RoundUpTo int64 // round_up only
+}
+
+// ProposeCircleChange puts a decision to the circle's vote
+type ProposeCircleChange struct {
+	CircleID          string
+	UserID            string
+	Action            string // remove_member, change_contribution, cancel_circle, change_rules
+	TargetUserID      string // remove_member only
+	Amount            int64  // change_contribution only
+	MaxMissedPayments int    // change_rules only
+	LateFeeBps        int    // change_rules only
+}
+
+// ProposeCircleChangeResult is the result of opening a proposal
+type ProposeCircleChangeResult struct {
+	ProposalID string    `json:"proposal_id"`
+	Status     string    `json:"status"`
+	Electorate int       `json:"electorate"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// VoteOnProposal records a member's vote on a circle proposal
+type VoteOnProposal struct {
+	CircleID   string
+	ProposalID string
+	UserID     string
+	Approve    bool
+}
+
+// SetCircleGovernance sets a recruiting circle's enforced rules and voting policies (admin only)
+type SetCircleGovernance struct {
+	CircleID          string
+	AdminID           string
+	MaxMissedPayments int
+	LateFeeBps        int
+	VotingPolicies    map[string]VotingPolicy // keyed by proposal action
+}
+
+// VotingPolicy is the quorum and threshold an action is decided under
+type VotingPolicy struct {
+	QuorumPercent    int `json:"quorum_percent"`
+	ThresholdPercent int `json:"threshold_percent"`
+}
+
+// SetCircleListing sets how a recruiting circle appears in discovery (admin only)
+type SetCircleListing struct {
+	CircleID         string
+	AdminID          string
+	Location         string
+	PlannedStartDate *time.Time
+	RequireApproval  bool
+}
+
+// RequestToJoinCircle asks the admins of a circle that approves its members to let a user join
+type RequestToJoinCircle struct {
+	CircleID string
+	UserID   string
+	Message  string
+}
+
+// RequestToJoinCircleResult is the result of requesting to join a circle
+type RequestToJoinCircleResult struct {
+	RequestID   string    `json:"request_id"`
+	Status      string    `json:"status"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// ReviewJoinRequest approves or declines a pending join request (admin only)
+type ReviewJoinRequest struct {
+	CircleID  string
+	RequestID string
+	AdminID   string
+	Approve   bool
+	Reason    string
+}
+
+// WithdrawJoinRequest takes back the user's own pending join request
+type WithdrawJoinRequest struct {
+	CircleID  string
+	RequestID string
+	UserID    string
+}
+
 // Helper methods
 
 func (c CreateCircle) GetCreatorID() (valueobject.UserID, error) {
@@ -132,3 +426,151 @@ func (c MakeContribution) GetUserID() (valueobject.UserID, error) {
 func (c MakeContribution) GetTransactionID() (valueobject.TransactionID, error) {
 	return valueobject.NewTransactionID(c.TransactionID)
 }
+
+func (c PlaceLien) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c PlaceLien) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c ReleaseLien) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c ReleaseLien) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c PlacePayoutBid) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c PlacePayoutBid) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c RequestPositionSwap) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c RequestPositionSwap) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c RequestPositionSwap) GetCounterpartyUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.CounterpartyUserID)
+}
+
+func (c RequestEmergencyWithdrawal) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c RequestEmergencyWithdrawal) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c RepayEmergencyWithdrawal) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c RepayEmergencyWithdrawal) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c RepayEmergencyWithdrawal) GetTransactionID() (valueobject.TransactionID, error) {
+	return valueobject.NewTransactionID(c.TransactionID)
+}
+
+func (c FundCircleReserve) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c FundCircleReserve) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c FundCircleReserve) GetTransactionID() (valueobject.TransactionID, error) {
+	return valueobject.NewTransactionID(c.TransactionID)
+}
+
+func (c SettleArrears) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c SettleArrears) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c SettleArrears) GetTransactionID() (valueobject.TransactionID, error) {
+	return valueobject.NewTransactionID(c.TransactionID)
+}
+
+func (c DisbursePayout) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c DisbursePayout) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c SetAutoDebit) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c SetAutoDebit) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c CreateSavingsGoal) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c CreateSavingsGoal) GetTarget() (valueobject.Money, error) {
+	currency := valueobject.Currency(c.Currency)
+	if c.Currency == "" {
+		currency = valueobject.NGN
+	}
+	return valueobject.NewMoney(c.Target, currency)
+}
+
+func (c DepositToGoal) GetGoalID() (valueobject.GoalID, error) {
+	return valueobject.NewGoalID(c.GoalID)
+}
+
+func (c WithdrawFromGoal) GetGoalID() (valueobject.GoalID, error) {
+	return valueobject.NewGoalID(c.GoalID)
+}
+
+func (c CloseGoal) GetGoalID() (valueobject.GoalID, error) {
+	return valueobject.NewGoalID(c.GoalID)
+}
+
+func (c SetGoalAutoSave) GetGoalID() (valueobject.GoalID, error) {
+	return valueobject.NewGoalID(c.GoalID)
+}
+
+func (c ProposeCircleChange) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c ProposeCircleChange) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c VoteOnProposal) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c VoteOnProposal) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
+
+func (c RequestToJoinCircle) GetCircleID() (valueobject.CircleID, error) {
+	return valueobject.NewCircleID(c.CircleID)
+}
+
+func (c RequestToJoinCircle) GetUserID() (valueobject.UserID, error) {
+	return valueobject.NewUserID(c.UserID)
+}
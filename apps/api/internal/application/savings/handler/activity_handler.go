@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/savings/repository"
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// ActivityHandler keeps each circle's activity log from its domain events
+type ActivityHandler struct {
+	activityRepo repository.CircleActivityRepository
+}
+
+// NewActivityHandler creates a new activity handler
+func NewActivityHandler(activityRepo repository.CircleActivityRepository) *ActivityHandler {
+	return &ActivityHandler{activityRepo: activityRepo}
+}
+
+// OnCircleEvent records membership, money and governance events in the circle's activity log.
+// Entries are keyed by event ID, so redelivered events are recorded once.
+func (h *ActivityHandler) OnCircleEvent(ctx context.Context, e sharedevent.DomainEvent) error {
+	if e.AggregateType() != event.AggregateTypeCircle {
+		return nil
+	}
+
+	activity := &repository.CircleActivity{
+		ID:         e.EventID(),
+		CircleID:   e.AggregateID(),
+		Type:       e.EventType(),
+		OccurredAt: e.OccurredAt(),
+	}
+
+	switch ev := e.(type) {
+	case *event.CircleStarted:
+		activity.Summary = "Circle started"
+	case *event.MemberJoined:
+		activity.UserID = ev.UserID
+		activity.Summary = fmt.Sprintf("Member joined at position %d", ev.Position)
+	case *event.MemberLeft:
+		activity.UserID = ev.UserID
+		activity.Summary = "Member left"
+	case *event.MemberRemoved:
+		activity.UserID = ev.UserID
+		activity.Amount = ev.RefundDue
+		activity.Summary = "Member removed: " + ev.Reason
+	case *event.CircleAdminAppointed:
+		activity.UserID = ev.UserID
+		activity.Summary = "Admin appointed"
+	case *event.CircleAdminTransferred:
+		activity.UserID = ev.UserID
+		activity.Summary = "Admin role passed on after the last admin left"
+	case *event.PayoutTriggered:
+		activity.UserID = ev.UserID
+		activity.Amount = ev.Amount
+		activity.Summary = fmt.Sprintf("Round %d %s", ev.Round, payoutLabel(ev.Kind))
+	case *event.ContributionMissed:
+		activity.UserID = ev.UserID
+		activity.Amount = ev.Amount
+		activity.Summary = fmt.Sprintf("Round %d contribution missed", ev.Round)
+	case *event.MemberDefaulted:
+		activity.UserID = ev.UserID
+		activity.Amount = ev.Arrears
+		activity.Summary = "Member defaulted"
+	case *event.ProposalOpened:
+		activity.UserID = ev.ProposedBy
+		activity.Amount = ev.Amount
+		activity.Summary = "Proposal opened: " + ev.Action
+	case *event.ProposalDecided:
+		activity.Summary = fmt.Sprintf("Proposal %s: %s", ev.Status, ev.Action)
+	case *event.CircleRulesChanged:
+		activity.Summary = fmt.Sprintf("Rules changed: removal after %d missed payments, %d bps late fee", ev.MaxMissedPayments, ev.LateFeeBps)
+	case *event.VotingPolicySet:
+		activity.Summary = fmt.Sprintf("Voting policy for %s: %d%% quorum, %d%% threshold", ev.Action, ev.Quorum, ev.Threshold)
+	case *event.ContributionAmountChanged:
+		activity.Amount = ev.NewAmount
+		activity.Summary = fmt.Sprintf("Contribution amount changed from round %d", ev.EffectiveRound)
+	case *event.CircleCancelled:
+		activity.Amount = ev.Refunded
+		activity.Summary = "Circle cancelled"
+	case *event.CircleCompleted:
+		activity.Amount = ev.TotalSaved
+		activity.Summary = "Circle completed"
+	default:
+		return nil
+	}
+
+	return h.activityRepo.Record(ctx, activity)
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// AutoDebitFailure describes a mandated contribution that could not be taken from a wallet
+type AutoDebitFailure struct {
+	CircleID     string
+	CircleName   string
+	UserID       string
+	AdminIDs     []string
+	Round        int
+	Amount       int64
+	Currency     string
+	Reason       string
+	GraceEndsAt  time.Time
+	FinalAttempt bool
+}
+
+// AutoDebitNotifier tells the member and the circle's admins about failed auto-debits
+// This is a PORT - infrastructure delivers the notification
+type AutoDebitNotifier interface {
+	NotifyAutoDebitFailed(ctx context.Context, failure AutoDebitFailure) error
+}
+
+// AutoDebitHandler takes mandated contributions from members' wallets on each due date
+type AutoDebitHandler struct {
+	circleRepo    repository.CircleRepository
+	walletDebitor WalletDebitor
+	notifier      AutoDebitNotifier
+}
+
+// NewAutoDebitHandler creates a new auto-debit handler
+func NewAutoDebitHandler(circleRepo repository.CircleRepository, walletDebitor WalletDebitor, notifier AutoDebitNotifier) *AutoDebitHandler {
+	return &AutoDebitHandler{
+		circleRepo:    circleRepo,
+		walletDebitor: walletDebitor,
+		notifier:      notifier,
+	}
+}
+
+// HandleSetAutoDebit opts a member in to or out of automatic contributions
+func (h *AutoDebitHandler) HandleSetAutoDebit(ctx context.Context, cmd command.SetAutoDebit) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.SetAutoDebit(userID, cmd.Enabled); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// ProcessAutoDebits collects every mandated contribution that is due. Failed debits are
+// retried on later runs until the grace period ends. A failure on one circle does not stop the others.
+func (h *AutoDebitHandler) ProcessAutoDebits(ctx context.Context, asOf time.Time) error {
+	circles, err := h.circleRepo.FindWithDueAutoDebits(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, circle := range circles {
+		if err := h.collect(ctx, circle, circle.DueAutoDebits(asOf), asOf); err != nil {
+			errs = append(errs, fmt.Errorf("circle %s: %w", circle.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ProcessContribution collects one member's mandated contribution if it is due
+func (h *AutoDebitHandler) ProcessContribution(ctx context.Context, circleID, userID string) error {
+	id, err := valueobject.NewCircleID(circleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	uid, err := valueobject.NewUserID(userID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, id)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	member := circle.FindMemberByUserID(uid)
+	if member == nil {
+		return aggregate.ErrNotMember
+	}
+
+	asOf := time.Now().UTC()
+	due := make([]*aggregate.Contribution, 0, 1)
+	for _, cont := range circle.DueAutoDebits(asOf) {
+		if cont.MemberID().Equals(member.ID()) {
+			due = append(due, cont)
+		}
+	}
+
+	return h.collect(ctx, circle, due, asOf)
+}
+
+// collect debits each contribution and records it on the circle.
+// Failed debits are recorded on the circle and reported once it is saved.
+func (h *AutoDebitHandler) collect(ctx context.Context, circle *aggregate.Circle, due []*aggregate.Contribution, asOf time.Time) error {
+	if len(due) == 0 {
+		return nil
+	}
+
+	failures := make([]AutoDebitFailure, 0)
+	for _, cont := range due {
+		member := circle.FindMemberByID(cont.MemberID())
+		if member == nil {
+			continue
+		}
+
+		amount := circle.AmountDue(cont)
+		transactionID, err := h.walletDebitor.DebitContribution(ctx, member.UserID(), amount, circle.ID(), cont.ID())
+		if err != nil {
+			if err := circle.RecordAutoDebitFailure(cont.ID(), err.Error(), asOf); err != nil {
+				return err
+			}
+
+			graceEndsAt := cont.DueDate().Add(circle.GracePeriod())
+			failures = append(failures, AutoDebitFailure{
+				CircleID:     circle.ID().String(),
+				CircleName:   circle.Name(),
+				UserID:       member.UserID().String(),
+				AdminIDs:     circle.AdminUserIDs(),
+				Round:        cont.Round(),
+				Amount:       amount.Amount(),
+				Currency:     string(amount.Currency()),
+				Reason:       err.Error(),
+				GraceEndsAt:  graceEndsAt,
+				FinalAttempt: asOf.Add(aggregate.AutoDebitRetryInterval).After(graceEndsAt),
+			})
+			continue
+		}
+
+		if _, err := circle.RecordContribution(member.ID(), transactionID); err != nil {
+			return err
+		}
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return err
+	}
+
+	// Notifications are best effort; the failure is already recorded on the circle
+	if h.notifier != nil {
+		for _, failure := range failures {
+			_ = h.notifier.NotifyAutoDebitFailed(ctx, failure)
+		}
+	}
+
+	return nil
+}
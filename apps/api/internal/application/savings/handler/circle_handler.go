@@ -35,6 +35,10 @@ func (h *CircleHandler) HandleCreateCircle(ctx context.Context, cmd command.Crea
 		return nil, errors.New("invalid creator ID")
 	}
 
+	if err := checkNotSuspended(ctx, h.circleRepo, creatorID); err != nil {
+		return nil, err
+	}
+
 	contributionAmt, err := cmd.GetContributionAmount()
 	if err != nil {
 		return nil, err
@@ -75,6 +79,30 @@ func (h *CircleHandler) HandleCreateCircle(ctx context.Context, cmd command.Crea
 		circle.SetRules(cmd.Rules)
 	}
 
+	if circleType == aggregate.CircleTypeFixedTarget {
+		if err := applySavingsTarget(circle, cmd); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.PayoutOrder != "" {
+		if err := applyPayoutOrder(circle, cmd.PayoutOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.MissedPolicy != "" || cmd.GracePeriodDays != nil {
+		if err := applyMissedContributionPolicy(circle, cmd); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Location != "" || cmd.StartDate != nil || cmd.RequireApproval {
+		if err := circle.SetListing(cmd.Location, cmd.StartDate, cmd.RequireApproval); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
 		return nil, err
 	}
@@ -88,12 +116,13 @@ func (h *CircleHandler) HandleCreateCircle(ctx context.Context, cmd command.Crea
 		Frequency:       string(circle.Frequency()),
 		MaxMembers:      circle.MaxMembers(),
 		InviteCode:      circle.InviteCode(),
+		PayoutOrder:     circle.PayoutOrder().String(),
 		Status:          circle.Status().String(),
 		CreatedAt:       circle.CreatedAt(),
 	}, nil
 }
 
-// HandleJoinCircle adds a member to a circle
+// HandleJoinCircle adds a member to a circle that does not approve its members
 func (h *CircleHandler) HandleJoinCircle(ctx context.Context, cmd command.JoinCircle) (*command.JoinCircleResult, error) {
 	circleID, err := cmd.GetCircleID()
 	if err != nil {
@@ -105,12 +134,16 @@ func (h *CircleHandler) HandleJoinCircle(ctx context.Context, cmd command.JoinCi
 		return nil, errors.New("invalid user ID")
 	}
 
+	if err := checkNotSuspended(ctx, h.circleRepo, userID); err != nil {
+		return nil, err
+	}
+
 	circle, err := h.circleRepo.FindByID(ctx, circleID)
 	if err != nil {
 		return nil, ErrCircleNotFound
 	}
 
-	member, err := circle.AddMember(userID)
+	member, err := circle.Join(userID)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +167,10 @@ func (h *CircleHandler) HandleJoinCircleByCode(ctx context.Context, cmd command.
 		return nil, errors.New("invalid user ID")
 	}
 
+	if err := checkNotSuspended(ctx, h.circleRepo, userID); err != nil {
+		return nil, err
+	}
+
 	circle, err := h.circleRepo.FindByInviteCode(ctx, cmd.InviteCode)
 	if err != nil {
 		return nil, errors.New("invalid invite code")
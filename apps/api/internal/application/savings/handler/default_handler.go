@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+	walletevent "hustlex/internal/domain/wallet/event"
+)
+
+// ErrSuspendedForDefault is returned when a user in default tries to join or start a circle
+var ErrSuspendedForDefault = errors.New("user is suspended from circles until their arrears are settled")
+
+// lienReasonCircleDefault matches the reason the wallet context records on a default lien
+const lienReasonCircleDefault = "circle_default"
+
+// WalletDebitor collects contributions directly from members' wallets
+// This is a PORT - infrastructure moves the money through the wallet context
+type WalletDebitor interface {
+	// DebitContribution moves a contribution from the member's wallet into the circle.
+	// Debiting the same contribution twice returns the first transaction.
+	DebitContribution(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, circleID valueobject.CircleID, contributionID valueobject.ContributionID) (valueobject.TransactionID, error)
+}
+
+// DefaultHandler handles missed contributions, circle reserves and recovery of arrears
+type DefaultHandler struct {
+	circleRepo    repository.CircleRepository
+	walletDebitor WalletDebitor
+}
+
+// NewDefaultHandler creates a new default handler
+func NewDefaultHandler(circleRepo repository.CircleRepository, walletDebitor WalletDebitor) *DefaultHandler {
+	return &DefaultHandler{
+		circleRepo:    circleRepo,
+		walletDebitor: walletDebitor,
+	}
+}
+
+// HandleSetMissedContributionPolicy chooses how a circle handles missed contributions
+func (h *DefaultHandler) HandleSetMissedContributionPolicy(ctx context.Context, cmd command.SetMissedContributionPolicy) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if !circle.IsAdmin(adminID) {
+		return ErrUnauthorized
+	}
+
+	if err := circle.SetMissedContributionPolicy(aggregate.MissedContributionPolicy(cmd.Policy), cmd.GracePeriodDays); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleFundReserve adds a member's money to the circle reserve
+func (h *DefaultHandler) HandleFundReserve(ctx context.Context, cmd command.FundCircleReserve) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	transactionID, err := cmd.GetTransactionID()
+	if err != nil {
+		return errors.New("invalid transaction ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.FundReserve(userID, cmd.Amount, transactionID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleSettleArrears pays off a member's missed contributions
+func (h *DefaultHandler) HandleSettleArrears(ctx context.Context, cmd command.SettleArrears) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	transactionID, err := cmd.GetTransactionID()
+	if err != nil {
+		return errors.New("invalid transaction ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.SettleArrears(userID, cmd.Amount, transactionID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// ProcessMissedContributions applies each circle's policy to contributions still unpaid
+// after the grace period. Auto-debit circles try the member's wallet first.
+// A failure on one circle does not stop the others.
+func (h *DefaultHandler) ProcessMissedContributions(ctx context.Context, asOf time.Time) error {
+	circles, err := h.circleRepo.FindWithOverdueContributions(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, circle := range circles {
+		if err := h.processCircle(ctx, circle, asOf); err != nil {
+			errs = append(errs, fmt.Errorf("circle %s: %w", circle.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *DefaultHandler) processCircle(ctx context.Context, circle *aggregate.Circle, asOf time.Time) error {
+	if circle.MissedPolicy() == aggregate.MissedPolicyAutoDebit && h.walletDebitor != nil {
+		for _, cont := range circle.OverdueContributions(asOf) {
+			member := circle.FindMemberByID(cont.MemberID())
+			if member == nil {
+				continue
+			}
+
+			// A failed debit leaves the contribution to be marked missed below
+			transactionID, err := h.walletDebitor.DebitContribution(ctx, member.UserID(), circle.AmountDue(cont), circle.ID(), cont.ID())
+			if err != nil {
+				continue
+			}
+
+			if _, err := circle.RecordContribution(member.ID(), transactionID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := circle.MarkMissedContributions(asOf); err != nil && !errors.Is(err, aggregate.ErrCircleNotActive) {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// OnDefaultLienHeld applies funds held on a defaulted member's wallet to their arrears
+func (h *DefaultHandler) OnDefaultLienHeld(ctx context.Context, e sharedevent.DomainEvent) error {
+	held, ok := e.(walletevent.FundsHeldInEscrow)
+	if !ok || held.Reason != lienReasonCircleDefault {
+		return nil
+	}
+
+	circleID, err := valueobject.NewCircleID(held.Reference)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := valueobject.NewUserID(held.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	transactionID, err := valueobject.NewTransactionID(held.TransactionID)
+	if err != nil {
+		return errors.New("invalid transaction ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.ApplyDefaultLien(userID, held.Amount, transactionID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// checkNotSuspended stops users in default on any circle from joining or creating another
+func checkNotSuspended(ctx context.Context, circleRepo repository.CircleRepository, userID valueobject.UserID) error {
+	defaulted, err := circleRepo.HasDefaulted(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if defaulted {
+		return ErrSuspendedForDefault
+	}
+	return nil
+}
+
+// applyMissedContributionPolicy sets a new circle's missed contribution policy from the create command
+func applyMissedContributionPolicy(circle *aggregate.Circle, cmd command.CreateCircle) error {
+	policy := circle.MissedPolicy()
+	if cmd.MissedPolicy != "" {
+		policy = aggregate.MissedContributionPolicy(cmd.MissedPolicy)
+	}
+
+	gracePeriodDays := circle.GracePeriodDays()
+	if cmd.GracePeriodDays != nil {
+		gracePeriodDays = *cmd.GracePeriodDays
+	}
+
+	return circle.SetMissedContributionPolicy(policy, gracePeriodDays)
+}
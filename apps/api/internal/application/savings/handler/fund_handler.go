@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// FundHandler handles fixed-target maturity and emergency fund withdrawals
+type FundHandler struct {
+	circleRepo repository.CircleRepository
+}
+
+// NewFundHandler creates a new fund handler
+func NewFundHandler(circleRepo repository.CircleRepository) *FundHandler {
+	return &FundHandler{circleRepo: circleRepo}
+}
+
+// HandleMatureCircle distributes a fixed-target circle's pool once its target date arrives
+func (h *FundHandler) HandleMatureCircle(ctx context.Context, cmd command.MatureCircle) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.Mature(time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// MatureDueCircles matures every fixed-target circle whose target date has passed.
+// A failure on one circle does not stop the others.
+func (h *FundHandler) MatureDueCircles(ctx context.Context, asOf time.Time) error {
+	circles, err := h.circleRepo.FindDueForMaturity(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, circle := range circles {
+		if err := circle.Mature(asOf); err != nil {
+			errs = append(errs, fmt.Errorf("circle %s: %w", circle.ID(), err))
+			continue
+		}
+		if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+			errs = append(errs, fmt.Errorf("circle %s: %w", circle.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// HandleAppointAdmin makes a member an admin
+func (h *FundHandler) HandleAppointAdmin(ctx context.Context, cmd command.AppointCircleAdmin) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if !circle.IsAdmin(adminID) {
+		return ErrUnauthorized
+	}
+
+	if err := circle.AppointAdmin(userID, adminID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleRequestEmergencyWithdrawal asks the admins to release money from an emergency fund
+func (h *FundHandler) HandleRequestEmergencyWithdrawal(ctx context.Context, cmd command.RequestEmergencyWithdrawal) (*command.RequestEmergencyWithdrawalResult, error) {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	withdrawal, err := circle.RequestEmergencyWithdrawal(
+		valueobject.GenerateEmergencyWithdrawalID().String(),
+		userID,
+		cmd.Amount,
+		cmd.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	return &command.RequestEmergencyWithdrawalResult{
+		WithdrawalID: withdrawal.ID(),
+		Amount:       withdrawal.Amount(),
+		Quorum:       withdrawal.Quorum(),
+		Status:       string(withdrawal.Status()),
+	}, nil
+}
+
+// HandleVoteOnWithdrawal records an admin's vote on an emergency withdrawal
+func (h *FundHandler) HandleVoteOnWithdrawal(ctx context.Context, cmd command.VoteOnWithdrawal) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.VoteOnWithdrawal(cmd.WithdrawalID, adminID, cmd.Approve); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleRepayEmergencyWithdrawal pays a withdrawal back into the fund
+func (h *FundHandler) HandleRepayEmergencyWithdrawal(ctx context.Context, cmd command.RepayEmergencyWithdrawal) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	transactionID, err := cmd.GetTransactionID()
+	if err != nil {
+		return errors.New("invalid transaction ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.RepayWithdrawal(cmd.WithdrawalID, userID, cmd.Amount, transactionID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// applySavingsTarget sets a new fixed-target circle's goal from the create command
+func applySavingsTarget(circle *aggregate.Circle, cmd command.CreateCircle) error {
+	if cmd.TargetDate == nil {
+		return aggregate.ErrTargetNotSet
+	}
+
+	target, err := valueobject.NewMoney(cmd.TargetAmount, circle.ContributionAmount().Currency())
+	if err != nil {
+		return aggregate.ErrInvalidTarget
+	}
+
+	mode := aggregate.TargetPayoutProRata
+	if cmd.TargetPayout != "" {
+		mode = aggregate.TargetPayoutMode(cmd.TargetPayout)
+	}
+
+	return circle.SetSavingsTarget(target, *cmd.TargetDate, mode)
+}
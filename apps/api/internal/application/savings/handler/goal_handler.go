@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+	walletaggregate "hustlex/internal/domain/wallet/aggregate"
+	walletevent "hustlex/internal/domain/wallet/event"
+)
+
+// ErrGoalNotFound is returned when a savings goal does not exist or belongs to someone else
+var ErrGoalNotFound = errors.New("savings goal not found")
+
+// SavingsMover keeps the wallet's savings balance in step with savings goals
+// This is a PORT - infrastructure moves the money through the wallet context
+type SavingsMover interface {
+	// MoveToSavings moves money from the user's available balance into a goal
+	MoveToSavings(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, goalID valueobject.GoalID) error
+
+	// WithdrawFromSavings returns money from a goal to the user's available balance.
+	// Any penalty leaves savings with it and is charged as a fee.
+	WithdrawFromSavings(ctx context.Context, userID valueobject.UserID, amount, penalty valueobject.Money, goalID valueobject.GoalID) error
+}
+
+// GoalHandler handles personal savings goals
+type GoalHandler struct {
+	goalRepo repository.SavingsGoalRepository
+	mover    SavingsMover
+}
+
+// NewGoalHandler creates a new savings goal handler
+func NewGoalHandler(goalRepo repository.SavingsGoalRepository, mover SavingsMover) *GoalHandler {
+	return &GoalHandler{
+		goalRepo: goalRepo,
+		mover:    mover,
+	}
+}
+
+// HandleCreateGoal opens a savings goal
+func (h *GoalHandler) HandleCreateGoal(ctx context.Context, cmd command.CreateSavingsGoal) (*command.CreateSavingsGoalResult, error) {
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	target, err := cmd.GetTarget()
+	if err != nil {
+		return nil, err
+	}
+
+	goal, err := aggregate.NewSavingsGoal(
+		valueobject.GenerateGoalID(),
+		userID,
+		cmd.Name,
+		target,
+		cmd.TargetDate,
+		aggregate.GoalMode(cmd.Mode),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.goalRepo.SaveWithEvents(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	return &command.CreateSavingsGoalResult{GoalID: goal.ID().String()}, nil
+}
+
+// HandleDeposit moves money from the wallet into a goal
+func (h *GoalHandler) HandleDeposit(ctx context.Context, cmd command.DepositToGoal) error {
+	goal, err := h.findOwned(ctx, cmd.GoalID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	amount, err := valueobject.NewMoney(cmd.Amount, goal.Balance().Currency())
+	if err != nil {
+		return err
+	}
+
+	if err := goal.Deposit(amount, aggregate.GoalSourceManual); err != nil {
+		return err
+	}
+
+	if err := h.mover.MoveToSavings(ctx, goal.UserID(), amount, goal.ID()); err != nil {
+		return err
+	}
+
+	return h.goalRepo.SaveWithEvents(ctx, goal)
+}
+
+// HandleWithdraw moves money from a goal back to the wallet
+func (h *GoalHandler) HandleWithdraw(ctx context.Context, cmd command.WithdrawFromGoal) error {
+	goal, err := h.findOwned(ctx, cmd.GoalID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	amount, err := valueobject.NewMoney(cmd.Amount, goal.Balance().Currency())
+	if err != nil {
+		return err
+	}
+
+	if err := goal.Withdraw(amount, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	zero := valueobject.Zero(amount.Currency())
+	if err := h.mover.WithdrawFromSavings(ctx, goal.UserID(), amount, zero, goal.ID()); err != nil {
+		return err
+	}
+
+	return h.goalRepo.SaveWithEvents(ctx, goal)
+}
+
+// HandleCloseGoal empties a goal into the wallet, breaking a locked goal early if asked
+func (h *GoalHandler) HandleCloseGoal(ctx context.Context, cmd command.CloseGoal) error {
+	goal, err := h.findOwned(ctx, cmd.GoalID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	penalty := valueobject.Zero(goal.Balance().Currency())
+
+	var payout valueobject.Money
+	if cmd.Break {
+		payout, penalty, err = goal.Break(now)
+	} else {
+		payout, err = goal.Close(now)
+	}
+	if err != nil {
+		return err
+	}
+
+	if payout.IsPositive() || penalty.IsPositive() {
+		if err := h.mover.WithdrawFromSavings(ctx, goal.UserID(), payout, penalty, goal.ID()); err != nil {
+			return err
+		}
+	}
+
+	return h.goalRepo.SaveWithEvents(ctx, goal)
+}
+
+// HandleSetAutoSave sets or clears a goal's auto-save rule
+func (h *GoalHandler) HandleSetAutoSave(ctx context.Context, cmd command.SetGoalAutoSave) error {
+	goal, err := h.findOwned(ctx, cmd.GoalID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	switch aggregate.AutoSaveKind(cmd.Kind) {
+	case aggregate.AutoSaveFixed:
+		amount, err := valueobject.NewMoney(cmd.Amount, goal.Balance().Currency())
+		if err != nil {
+			return err
+		}
+		startAt := cmd.StartAt
+		if startAt.IsZero() {
+			startAt = time.Now().UTC()
+		}
+		err = goal.SetFixedAutoSave(amount, aggregate.ContributionFrequency(cmd.Frequency), startAt)
+		if err != nil {
+			return err
+		}
+	case aggregate.AutoSaveRoundUp:
+		if err := goal.SetRoundUpAutoSave(cmd.RoundUpTo); err != nil {
+			return err
+		}
+	case "":
+		goal.ClearAutoSave()
+	default:
+		return aggregate.ErrInvalidAutoSaveRule
+	}
+
+	return h.goalRepo.SaveWithEvents(ctx, goal)
+}
+
+// ProcessAutoSaves runs every scheduled auto-save that is due. A run the wallet cannot
+// fund is skipped until the next one. A failure on one goal does not stop the others.
+func (h *GoalHandler) ProcessAutoSaves(ctx context.Context, asOf time.Time) error {
+	goals, err := h.goalRepo.FindDueAutoSaves(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, goal := range goals {
+		if !goal.IsAutoSaveDue(asOf) {
+			continue
+		}
+
+		if err := h.save(ctx, goal, goal.AutoSave().Amount(), aggregate.GoalSourceScheduled); err != nil {
+			errs = append(errs, fmt.Errorf("goal %s: %w", goal.ID(), err))
+			continue
+		}
+
+		goal.AdvanceAutoSave(asOf)
+		if err := h.goalRepo.SaveWithEvents(ctx, goal); err != nil {
+			errs = append(errs, fmt.Errorf("goal %s: %w", goal.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// OnWalletDebited saves the change from rounding a wallet debit up into the user's round-up goals
+func (h *GoalHandler) OnWalletDebited(ctx context.Context, e sharedevent.DomainEvent) error {
+	debited, ok := e.(walletevent.WalletDebited)
+	if !ok {
+		return nil
+	}
+
+	userID, err := valueobject.NewUserID(debited.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	debit, err := valueobject.NewMoney(debited.Amount, valueobject.Currency(debited.Currency))
+	if err != nil {
+		return err
+	}
+
+	goals, err := h.goalRepo.FindRoundUpGoals(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, goal := range goals {
+		change := goal.RoundUp(debit)
+		if !change.IsPositive() {
+			continue
+		}
+
+		if err := h.save(ctx, goal, change, aggregate.GoalSourceRoundUp); err != nil {
+			return err
+		}
+		if err := h.goalRepo.SaveWithEvents(ctx, goal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// save moves an automatic saving into a goal. An empty or inactive wallet skips it.
+func (h *GoalHandler) save(ctx context.Context, goal *aggregate.SavingsGoal, amount valueobject.Money, source string) error {
+	err := h.mover.MoveToSavings(ctx, goal.UserID(), amount, goal.ID())
+	switch {
+	case errors.Is(err, walletaggregate.ErrInsufficientFunds),
+		errors.Is(err, walletaggregate.ErrWalletLocked),
+		errors.Is(err, walletaggregate.ErrWalletSuspended):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	return goal.Deposit(amount, source)
+}
+
+func (h *GoalHandler) findOwned(ctx context.Context, goalIDStr, userIDStr string) (*aggregate.SavingsGoal, error) {
+	goalID, err := valueobject.NewGoalID(goalIDStr)
+	if err != nil {
+		return nil, errors.New("invalid goal ID")
+	}
+
+	userID, err := valueobject.NewUserID(userIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	goal, err := h.goalRepo.FindByID(ctx, goalID)
+	if err != nil || !goal.UserID().Equals(userID) {
+		return nil, ErrGoalNotFound
+	}
+
+	return goal, nil
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GovernanceHandler handles circle proposals, votes and rules
+type GovernanceHandler struct {
+	circleRepo repository.CircleRepository
+}
+
+// NewGovernanceHandler creates a new governance handler
+func NewGovernanceHandler(circleRepo repository.CircleRepository) *GovernanceHandler {
+	return &GovernanceHandler{circleRepo: circleRepo}
+}
+
+// HandlePropose puts a decision to the circle's vote
+func (h *GovernanceHandler) HandlePropose(ctx context.Context, cmd command.ProposeCircleChange) (*command.ProposeCircleChangeResult, error) {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	terms := aggregate.ProposalTerms{
+		Amount: cmd.Amount,
+		Rules: aggregate.CircleRules{
+			MaxMissedPayments: cmd.MaxMissedPayments,
+			LateFeeBps:        cmd.LateFeeBps,
+		},
+	}
+	if cmd.TargetUserID != "" {
+		targetID, err := valueobject.NewUserID(cmd.TargetUserID)
+		if err != nil {
+			return nil, errors.New("invalid target user ID")
+		}
+		terms.TargetUserID = &targetID
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	proposal, err := circle.Propose(
+		valueobject.GenerateGovernanceProposalID().String(),
+		userID,
+		aggregate.ProposalAction(cmd.Action),
+		terms,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	return &command.ProposeCircleChangeResult{
+		ProposalID: proposal.ID(),
+		Status:     string(proposal.Status()),
+		Electorate: len(proposal.Electorate()),
+		ExpiresAt:  proposal.ExpiresAt(),
+	}, nil
+}
+
+// HandleVote records a member's vote on a proposal
+func (h *GovernanceHandler) HandleVote(ctx context.Context, cmd command.VoteOnProposal) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.VoteOnProposal(cmd.ProposalID, userID, cmd.Approve); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleSetGovernance sets a recruiting circle's enforced rules and voting policies
+func (h *GovernanceHandler) HandleSetGovernance(ctx context.Context, cmd command.SetCircleGovernance) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if !circle.IsAdmin(adminID) {
+		return ErrUnauthorized
+	}
+
+	rules := aggregate.CircleRules{
+		MaxMissedPayments: cmd.MaxMissedPayments,
+		LateFeeBps:        cmd.LateFeeBps,
+	}
+	if rules != circle.CircleRules() {
+		if err := circle.SetCircleRules(rules); err != nil {
+			return err
+		}
+	}
+
+	for action, policy := range cmd.VotingPolicies {
+		err := circle.SetVotingPolicy(aggregate.ProposalAction(action), aggregate.VotingPolicy{
+			QuorumPercent:    policy.QuorumPercent,
+			ThresholdPercent: policy.ThresholdPercent,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// CloseExpiredProposals decides every proposal whose voting period has ended.
+// A failure on one circle does not stop the others.
+func (h *GovernanceHandler) CloseExpiredProposals(ctx context.Context, asOf time.Time) error {
+	circles, err := h.circleRepo.FindWithExpiredProposals(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, circle := range circles {
+		if !circle.HasExpiredProposals(asOf) {
+			continue
+		}
+
+		circle.CloseExpiredProposals(asOf)
+		if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+			errs = append(errs, fmt.Errorf("circle %s: %w", circle.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/savings/service"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// InterestCrediter posts capitalized interest to the wallet's savings balance
+// This is a PORT - infrastructure credits the wallet through the wallet context
+type InterestCrediter interface {
+	// CreditInterest credits net interest on a goal. Crediting a reference twice must be a no-op.
+	CreditInterest(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, goalID valueobject.GoalID, reference string) error
+}
+
+// InterestHandler accrues and capitalizes interest on savings goals
+type InterestHandler struct {
+	goalRepo    repository.SavingsGoalRepository
+	postingRepo repository.InterestPostingRepository
+	crediter    InterestCrediter
+	engine      *service.InterestEngine
+}
+
+// NewInterestHandler creates a new interest handler
+func NewInterestHandler(
+	goalRepo repository.SavingsGoalRepository,
+	postingRepo repository.InterestPostingRepository,
+	crediter InterestCrediter,
+	engine *service.InterestEngine,
+) *InterestHandler {
+	return &InterestHandler{
+		goalRepo:    goalRepo,
+		postingRepo: postingRepo,
+		crediter:    crediter,
+		engine:      engine,
+	}
+}
+
+// AccrueDaily accrues a day's interest on every accruing goal and capitalizes any that are due.
+// Re-running a day is safe. A failure on one goal does not stop the others.
+func (h *InterestHandler) AccrueDaily(ctx context.Context, asOf time.Time) error {
+	goals, err := h.goalRepo.FindAccruing(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, goal := range goals {
+		if err := h.accrue(ctx, goal, asOf); err != nil {
+			errs = append(errs, fmt.Errorf("goal %s: %w", goal.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *InterestHandler) accrue(ctx context.Context, goal *aggregate.SavingsGoal, day time.Time) error {
+	err := h.engine.Accrue(goal, day)
+	switch {
+	case errors.Is(err, aggregate.ErrInterestAlreadyAccrued):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if h.engine.IsCapitalizationDue(goal, day) {
+		if err := h.capitalize(ctx, goal, day); err != nil {
+			return err
+		}
+	}
+
+	return h.goalRepo.SaveWithEvents(ctx, goal)
+}
+
+// capitalize adds accrued interest to the goal, credits it to the wallet and records the posting
+func (h *InterestHandler) capitalize(ctx context.Context, goal *aggregate.SavingsGoal, day time.Time) error {
+	apr, err := h.engine.APRFor(goal)
+	if err != nil {
+		return err
+	}
+
+	periodStart := goal.InterestPeriodStart()
+	gross, tax, err := h.engine.Capitalize(goal, day)
+	if err != nil {
+		return err
+	}
+
+	net := gross.MustSubtract(tax)
+	reference := interestReference(goal.ID(), day)
+	if net.IsPositive() {
+		if err := h.crediter.CreditInterest(ctx, goal.UserID(), net, goal.ID(), reference); err != nil {
+			return err
+		}
+	}
+
+	return h.postingRepo.Record(ctx, &repository.InterestPosting{
+		ID:          reference,
+		GoalID:      goal.ID().String(),
+		GoalName:    goal.Name(),
+		UserID:      goal.UserID().String(),
+		PeriodStart: periodStart,
+		PeriodEnd:   day.UTC(),
+		APR:         apr.Value(),
+		DayCount:    string(h.engine.Convention()),
+		Gross:       gross.Amount(),
+		Tax:         tax.Amount(),
+		Net:         net.Amount(),
+		Currency:    string(gross.Currency()),
+		PostedAt:    time.Now().UTC(),
+	})
+}
+
+// interestReference identifies a goal's interest posting for a day; it doubles as the posting ID
+func interestReference(goalID valueobject.GoalID, day time.Time) string {
+	return fmt.Sprintf("INT-%s-%s", goalID, day.UTC().Format("20060102"))
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/application/savings/command"
+	creditevent "hustlex/internal/domain/credit/event"
+	"hustlex/internal/domain/savings/repository"
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// collateralCirclePayout matches the credit context's circle payout collateral type
+const collateralCirclePayout = "circle_payout"
+
+// LienHandler places and lifts liens on member payouts pledged as loan collateral
+type LienHandler struct {
+	circleRepo repository.CircleRepository
+}
+
+// NewLienHandler creates a new lien handler
+func NewLienHandler(circleRepo repository.CircleRepository) *LienHandler {
+	return &LienHandler{circleRepo: circleRepo}
+}
+
+// HandlePlaceLien pledges a member's upcoming payout to a loan
+func (h *LienHandler) HandlePlaceLien(ctx context.Context, cmd command.PlaceLien) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.PlaceLien(userID, cmd.LoanID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleReleaseLien lifts a loan's lien from a member's payout
+func (h *LienHandler) HandleReleaseLien(ctx context.Context, cmd command.ReleaseLien) error {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.ReleaseLien(userID, cmd.LoanID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// OnCollateralPledged places a lien when a loan pledges a circle payout
+func (h *LienHandler) OnCollateralPledged(ctx context.Context, e sharedevent.DomainEvent) error {
+	pledged, ok := e.(creditevent.CollateralPledged)
+	if !ok || pledged.CollateralType != collateralCirclePayout {
+		return nil
+	}
+
+	return h.HandlePlaceLien(ctx, command.PlaceLien{
+		CircleID: pledged.SourceID,
+		UserID:   pledged.UserID,
+		LoanID:   pledged.LoanID,
+	})
+}
+
+// OnCollateralReleased lifts the lien once the loan no longer needs it
+func (h *LienHandler) OnCollateralReleased(ctx context.Context, e sharedevent.DomainEvent) error {
+	released, ok := e.(creditevent.CollateralReleased)
+	if !ok || released.CollateralType != collateralCirclePayout {
+		return nil
+	}
+
+	return h.HandleReleaseLien(ctx, command.ReleaseLien{
+		CircleID: released.SourceID,
+		UserID:   released.UserID,
+		LoanID:   released.LoanID,
+	})
+}
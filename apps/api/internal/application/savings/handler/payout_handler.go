@@ -0,0 +1,330 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	savingsevent "hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/savings/service"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+	walletaggregate "hustlex/internal/domain/wallet/aggregate"
+)
+
+// PayoutDisburser moves payouts from the circle's pooled custody account into members' wallets
+// This is a PORT - infrastructure credits the wallet through the wallet context
+type PayoutDisburser interface {
+	// DisbursePayout credits the recipient's wallet. The reference is unique per payout,
+	// so retrying a payout that already landed must not credit it twice.
+	DisbursePayout(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, reference, description string) error
+
+	// SettleLien pays the lien's share of a payout from the custody account to the lender
+	// as one loan repayment transaction. The member's balance does not change, so it works
+	// on locked wallets too. Retrying a reference returns the transaction already posted.
+	SettleLien(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, loanID, reference string) (valueobject.TransactionID, error)
+}
+
+// LienBalanceReader reads how much of a loan a payout lien still secures
+// This is a PORT - credit bounded context provides the ADAPTER (CollateralHandler.OutstandingLien)
+type LienBalanceReader interface {
+	OutstandingLien(ctx context.Context, loanID string) (valueobject.Money, error)
+}
+
+// PayoutNotifier tells members about their payouts
+// This is a PORT - infrastructure delivers the notification
+type PayoutNotifier interface {
+	NotifyPayout(ctx context.Context, payout *repository.Payout) error
+}
+
+// PayoutHandler disburses triggered circle payouts into recipients' wallets
+type PayoutHandler struct {
+	circleRepo repository.CircleRepository
+	payoutRepo repository.PayoutRepository
+	disburser  PayoutDisburser
+	liens      LienBalanceReader
+	notifier   PayoutNotifier
+	fees       *service.FeeSchedule
+}
+
+// NewPayoutHandler creates a new payout handler
+func NewPayoutHandler(
+	circleRepo repository.CircleRepository,
+	payoutRepo repository.PayoutRepository,
+	disburser PayoutDisburser,
+	liens LienBalanceReader,
+	notifier PayoutNotifier,
+	fees *service.FeeSchedule,
+) *PayoutHandler {
+	if fees == nil {
+		fees = service.DefaultFeeSchedule()
+	}
+	return &PayoutHandler{
+		circleRepo: circleRepo,
+		payoutRepo: payoutRepo,
+		disburser:  disburser,
+		liens:      liens,
+		notifier:   notifier,
+		fees:       fees,
+	}
+}
+
+// HandleDisbursePayout pays a member's payout for a round, less the platform fee.
+// A loan holding a lien on the payout is repaid first and the member receives the rest.
+// Dividends, refunds and other kinds of payout are paid in full without a fee or lien.
+// Each payout is made at most once; repeating the command returns the recorded payout.
+// If the recipient's wallet is locked or suspended the payout is recorded as held.
+func (h *PayoutHandler) HandleDisbursePayout(ctx context.Context, cmd command.DisbursePayout) (*repository.Payout, error) {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	member := circle.FindPayee(userID)
+	if member == nil {
+		return nil, aggregate.ErrNotMember
+	}
+
+	reference := payoutReference(circleID.String(), cmd.Round, member.ID().String(), cmd.Kind, cmd.SourceID)
+	existing, err := h.payoutRepo.FindByCircleAndRound(ctx, circleID, cmd.Round)
+	if err != nil {
+		return nil, err
+	}
+	for _, payout := range existing {
+		if payout.ID == reference {
+			return payout, nil
+		}
+	}
+
+	amount, err := valueobject.NewMoney(cmd.Amount, circle.ContributionAmount().Currency())
+	if err != nil {
+		return nil, err
+	}
+	fee := valueobject.Zero(amount.Currency())
+	if cmd.Kind == "" {
+		fee = h.fees.PayoutFee(circle.Type(), amount)
+	}
+
+	payout := &repository.Payout{
+		ID:            reference,
+		CircleID:      circleID.String(),
+		CircleName:    circle.Name(),
+		MemberID:      member.ID().String(),
+		UserID:        userID.String(),
+		Round:         cmd.Round,
+		Kind:          cmd.Kind,
+		Amount:        amount.Amount(),
+		Fee:           fee.Amount(),
+		Currency:      string(amount.Currency()),
+		TransactionID: reference,
+	}
+
+	lienLoanID := cmd.LienLoanID
+	if lienLoanID == "" && cmd.Kind == "" {
+		lienLoanID = member.LienLoanID()
+	}
+	if lienLoanID != "" {
+		if err := h.settleLien(ctx, circle, payout, lienLoanID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.disburse(ctx, payout); err != nil {
+		return nil, err
+	}
+
+	if err := h.payoutRepo.RecordPayout(ctx, payout); err != nil {
+		return nil, err
+	}
+
+	h.notify(ctx, payout)
+	return payout, nil
+}
+
+// ReleaseHeldPayouts retries payouts held on locked wallets.
+// Payouts whose wallet is still locked stay held; other failures are reported together.
+func (h *PayoutHandler) ReleaseHeldPayouts(ctx context.Context) error {
+	held, err := h.payoutRepo.FindHeld(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, payout := range held {
+		if err := h.disburse(ctx, payout); err != nil {
+			errs = append(errs, fmt.Errorf("payout %s: %w", payout.ID, err))
+			continue
+		}
+		if payout.Status == repository.PayoutStatusHeld {
+			continue
+		}
+
+		if err := h.payoutRepo.Update(ctx, payout); err != nil {
+			errs = append(errs, fmt.Errorf("payout %s: %w", payout.ID, err))
+			continue
+		}
+
+		h.notify(ctx, payout)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ProcessPayout disburses a payout scheduled by the background worker
+func (h *PayoutHandler) ProcessPayout(ctx context.Context, circleID, userID string, round int, amount int64) error {
+	_, err := h.HandleDisbursePayout(ctx, command.DisbursePayout{
+		CircleID: circleID,
+		UserID:   userID,
+		Round:    round,
+		Amount:   amount,
+	})
+	return err
+}
+
+// OnPayoutTriggered disburses a payout as soon as the circle triggers it
+func (h *PayoutHandler) OnPayoutTriggered(ctx context.Context, e sharedevent.DomainEvent) error {
+	triggered, ok := e.(*savingsevent.PayoutTriggered)
+	if !ok {
+		return nil
+	}
+
+	_, err := h.HandleDisbursePayout(ctx, command.DisbursePayout{
+		CircleID:   triggered.CircleID,
+		UserID:     triggered.UserID,
+		Round:      triggered.Round,
+		Amount:     triggered.Amount,
+		LienLoanID: triggered.LienLoanID,
+		Kind:       triggered.Kind,
+		SourceID:   triggered.SourceID,
+	})
+	return err
+}
+
+// settleLien repays the loan secured by the payout, up to what it still owes and what the
+// payout has left after fees. The settlement is recorded on the circle before the member is
+// credited, and the credit context applies it to the loan from that event.
+func (h *PayoutHandler) settleLien(ctx context.Context, circle *aggregate.Circle, payout *repository.Payout, loanID string) error {
+	outstanding, err := h.liens.OutstandingLien(ctx, loanID)
+	if err != nil {
+		return err
+	}
+
+	available := payout.Amount - payout.Fee
+	lien := outstanding.Amount()
+	if lien > available {
+		lien = available
+	}
+	if lien <= 0 {
+		return nil
+	}
+
+	userID, err := valueobject.NewUserID(payout.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	amount, err := valueobject.NewMoney(lien, valueobject.Currency(payout.Currency))
+	if err != nil {
+		return err
+	}
+
+	reference := payout.TransactionID + "-LIEN"
+	transactionID, err := h.disburser.SettleLien(ctx, userID, amount, loanID, reference)
+	if err != nil {
+		return err
+	}
+
+	if err := circle.SettlePayoutLien(userID, loanID, payout.Round, amount, reference, transactionID); err != nil {
+		return err
+	}
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return err
+	}
+
+	payout.LienLoanID = loanID
+	payout.LienAmount = lien
+	payout.LienTransactionID = transactionID.String()
+	return nil
+}
+
+// disburse credits the payout net of fees and any lien, and sets its status.
+// A locked or suspended wallet holds the payout rather than failing it.
+func (h *PayoutHandler) disburse(ctx context.Context, payout *repository.Payout) error {
+	userID, err := valueobject.NewUserID(payout.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	net, err := valueobject.NewMoney(payout.NetAmount(), valueobject.Currency(payout.Currency))
+	if err != nil {
+		return err
+	}
+
+	if net.IsPositive() {
+		description := fmt.Sprintf("%s %s (Round %d)", payout.CircleName, payoutLabel(payout.Kind), payout.Round)
+		err := h.disburser.DisbursePayout(ctx, userID, net, payout.TransactionID, description)
+		if errors.Is(err, walletaggregate.ErrWalletLocked) || errors.Is(err, walletaggregate.ErrWalletSuspended) {
+			payout.Status = repository.PayoutStatusHeld
+			payout.HeldReason = err.Error()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	payout.Status = repository.PayoutStatusPaid
+	payout.HeldReason = ""
+	payout.PaidAt = time.Now().UTC()
+	return nil
+}
+
+// notify is best effort; a failed notification never undoes a payout
+func (h *PayoutHandler) notify(ctx context.Context, payout *repository.Payout) {
+	if h.notifier == nil {
+		return
+	}
+	_ = h.notifier.NotifyPayout(ctx, payout)
+}
+
+// payoutReference identifies a payout to a member for a round across retries
+func payoutReference(circleID string, round int, memberID, kind, sourceID string) string {
+	reference := fmt.Sprintf("PAYOUT-%s-%d-%s", circleID, round, memberID)
+	if kind != "" {
+		reference += "-" + kind
+	}
+	if sourceID != "" {
+		reference += "-" + sourceID
+	}
+	return reference
+}
+
+// payoutLabel names a kind of payout for members
+func payoutLabel(kind string) string {
+	switch kind {
+	case savingsevent.PayoutKindAuctionDividend:
+		return "auction dividend"
+	case savingsevent.PayoutKindEmergencyWithdrawal:
+		return "emergency withdrawal"
+	case savingsevent.PayoutKindShortfallRepayment:
+		return "shortfall repayment"
+	case savingsevent.PayoutKindReserveRelease:
+		return "reserve release"
+	default:
+		return "payout"
+	}
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// PayoutOrderHandler handles payout ordering, auction bids and position swaps
+type PayoutOrderHandler struct {
+	circleRepo repository.CircleRepository
+}
+
+// NewPayoutOrderHandler creates a new payout order handler
+func NewPayoutOrderHandler(circleRepo repository.CircleRepository) *PayoutOrderHandler {
+	return &PayoutOrderHandler{circleRepo: circleRepo}
+}
+
+// HandleSetPayoutOrder changes how a rotational circle orders its payouts
+func (h *PayoutOrderHandler) HandleSetPayoutOrder(ctx context.Context, cmd command.SetPayoutOrder) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if !circle.IsAdmin(adminID) {
+		return ErrUnauthorized
+	}
+
+	if err := applyPayoutOrder(circle, cmd.Order); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandlePlacePayoutBid bids a discount for the current round's payout
+func (h *PayoutOrderHandler) HandlePlacePayoutBid(ctx context.Context, cmd command.PlacePayoutBid) (*command.PlacePayoutBidResult, error) {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	bid, err := circle.PlaceBid(userID, cmd.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	return &command.PlacePayoutBidResult{
+		CircleID: circle.ID().String(),
+		Round:    bid.Round(),
+		Discount: bid.Discount(),
+		Payout:   circle.ExpectedPayout().Amount() - bid.Discount(),
+		PlacedAt: bid.PlacedAt(),
+	}, nil
+}
+
+// HandleRequestPositionSwap asks another member to trade payout positions
+func (h *PayoutOrderHandler) HandleRequestPositionSwap(ctx context.Context, cmd command.RequestPositionSwap) (*command.RequestPositionSwapResult, error) {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	counterpartyID, err := cmd.GetCounterpartyUserID()
+	if err != nil {
+		return nil, errors.New("invalid counterparty ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	swap, err := circle.RequestPositionSwap(valueobject.GenerateSwapRequestID().String(), userID, counterpartyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	return &command.RequestPositionSwapResult{
+		SwapID: swap.ID(),
+		Status: string(swap.Status()),
+	}, nil
+}
+
+// HandleRespondToPositionSwap records the counterparty agreeing to or declining a swap
+func (h *PayoutOrderHandler) HandleRespondToPositionSwap(ctx context.Context, cmd command.RespondToPositionSwap) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.RespondToPositionSwap(cmd.SwapID, userID, cmd.Accept); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleReviewPositionSwap lets the circle admin approve or reject a swap
+func (h *PayoutOrderHandler) HandleReviewPositionSwap(ctx context.Context, cmd command.ReviewPositionSwap) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if !circle.IsAdmin(adminID) {
+		return ErrUnauthorized
+	}
+
+	if cmd.Approve {
+		err = circle.ApprovePositionSwap(cmd.SwapID, adminID)
+	} else {
+		err = circle.RejectPositionSwap(cmd.SwapID, adminID, cmd.Reason)
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// applyPayoutOrder sets the circle's payout order, generating a fresh draw seed for random draws
+func applyPayoutOrder(circle *aggregate.Circle, order string) error {
+	payoutOrder := aggregate.PayoutOrder(order)
+	if !payoutOrder.IsValid() {
+		return aggregate.ErrInvalidPayoutOrder
+	}
+
+	seed := ""
+	if payoutOrder == aggregate.PayoutOrderRandomDraw {
+		var err error
+		seed, err = generateDrawSeed()
+		if err != nil {
+			return errors.New("failed to generate draw seed")
+		}
+	}
+
+	return circle.SetPayoutOrder(payoutOrder, seed)
+}
+
+func generateDrawSeed() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
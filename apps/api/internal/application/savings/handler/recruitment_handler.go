@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/application/savings/command"
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// RecruitmentHandler handles circle listings and join requests
+type RecruitmentHandler struct {
+	circleRepo repository.CircleRepository
+}
+
+// NewRecruitmentHandler creates a new recruitment handler
+func NewRecruitmentHandler(circleRepo repository.CircleRepository) *RecruitmentHandler {
+	return &RecruitmentHandler{circleRepo: circleRepo}
+}
+
+// HandleSetListing sets how a recruiting circle appears in discovery
+func (h *RecruitmentHandler) HandleSetListing(ctx context.Context, cmd command.SetCircleListing) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if !circle.IsAdmin(adminID) {
+		return ErrUnauthorized
+	}
+
+	if err := circle.SetListing(cmd.Location, cmd.PlannedStartDate, cmd.RequireApproval); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
+
+// HandleRequestToJoin asks a circle's admins to let the user join
+func (h *RecruitmentHandler) HandleRequestToJoin(ctx context.Context, cmd command.RequestToJoinCircle) (*command.RequestToJoinCircleResult, error) {
+	circleID, err := cmd.GetCircleID()
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	userID, err := cmd.GetUserID()
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	if err := checkNotSuspended(ctx, h.circleRepo, userID); err != nil {
+		return nil, err
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	request, err := circle.RequestToJoin(valueobject.GenerateJoinRequestID().String(), userID, cmd.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	return &command.RequestToJoinCircleResult{
+		RequestID:   request.ID(),
+		Status:      string(request.Status()),
+		RequestedAt: request.RequestedAt(),
+	}, nil
+}
+
+// HandleReviewJoinRequest approves or declines a pending join request.
+// The new member is returned when the request is approved.
+func (h *RecruitmentHandler) HandleReviewJoinRequest(ctx context.Context, cmd command.ReviewJoinRequest) (*command.JoinCircleResult, error) {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return nil, errors.New("invalid circle ID")
+	}
+
+	adminID, err := valueobject.NewUserID(cmd.AdminID)
+	if err != nil {
+		return nil, errors.New("invalid admin ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, ErrCircleNotFound
+	}
+
+	// A requester suspended since asking cannot be let in
+	if cmd.Approve {
+		request := circle.FindJoinRequest(cmd.RequestID)
+		if request == nil {
+			return nil, aggregate.ErrJoinRequestNotFound
+		}
+		if err := checkNotSuspended(ctx, h.circleRepo, request.UserID()); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := circle.ReviewJoinRequest(cmd.RequestID, adminID, cmd.Approve, cmd.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.circleRepo.SaveWithEvents(ctx, circle); err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return nil, nil
+	}
+
+	return &command.JoinCircleResult{
+		MemberID: member.ID().String(),
+		CircleID: circle.ID().String(),
+		Position: member.Position(),
+		Status:   string(member.Status()),
+	}, nil
+}
+
+// HandleWithdrawJoinRequest takes back the user's own pending join request
+func (h *RecruitmentHandler) HandleWithdrawJoinRequest(ctx context.Context, cmd command.WithdrawJoinRequest) error {
+	circleID, err := valueobject.NewCircleID(cmd.CircleID)
+	if err != nil {
+		return errors.New("invalid circle ID")
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return ErrCircleNotFound
+	}
+
+	if err := circle.WithdrawJoinRequest(cmd.RequestID, userID); err != nil {
+		return err
+	}
+
+	return h.circleRepo.SaveWithEvents(ctx, circle)
+}
@@ -0,0 +1,301 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// CreditTierLookup reports the credit tier of prospective circle-mates.
+// This is a PORT - the credit application's CreditQueryHandler satisfies it.
+type CreditTierLookup interface {
+	// CreditTiers returns each user's credit tier keyed by user ID; users without a score are omitted
+	CreditTiers(ctx context.Context, userIDs []string) (map[string]string, error)
+}
+
+// DiscoverCircles searches public circles that are still recruiting
+type DiscoverCircles struct {
+	Location   string
+	MinAmount  int64
+	MaxAmount  int64
+	Frequency  string
+	StartAfter *time.Time
+	StartBy    *time.Time
+	Page       int
+	Limit      int
+}
+
+// CircleListingDTO represents a recruiting circle in discovery results
+type CircleListingDTO struct {
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Description      string          `json:"description,omitempty"`
+	Type             string          `json:"type"`
+	ContributionAmt  int64           `json:"contribution_amount"`
+	Currency         string          `json:"currency"`
+	Frequency        string          `json:"frequency"`
+	MaxMembers       int             `json:"max_members"`
+	CurrentMembers   int             `json:"current_members"`
+	OpenSpots        int             `json:"open_spots"`
+	TotalRounds      int             `json:"total_rounds"`
+	Location         string          `json:"location,omitempty"`
+	PlannedStartDate *time.Time      `json:"planned_start_date,omitempty"`
+	RequiresApproval bool            `json:"requires_approval"`
+	CreatorID        string          `json:"creator_id"`
+	CreatorName      string          `json:"creator_name,omitempty"`
+	TrustSignals     TrustSignalsDTO `json:"trust_signals"`
+	CreatedAt        time.Time       `json:"created_at"`
+}
+
+// TrustSignalsDTO summarises the track record of a circle's existing members
+type TrustSignalsDTO struct {
+	Members            int            `json:"members"`
+	CreditTiers        map[string]int `json:"credit_tiers"`         // Members per credit tier
+	Unscored           int            `json:"unscored"`             // Members without a credit score
+	MembersWithHistory int            `json:"members_with_history"` // Members who have owed contributions before
+	OnTimeRate         float64        `json:"on_time_rate"`         // Average across members with history
+	CompletedCircles   int            `json:"completed_circles"`    // Total across members
+}
+
+// CircleListingResult represents a page of discovery results
+type CircleListingResult struct {
+	Circles    []CircleListingDTO `json:"circles"`
+	Total      int64              `json:"total"`
+	Page       int                `json:"page"`
+	Limit      int                `json:"limit"`
+	TotalPages int                `json:"total_pages"`
+}
+
+// GetJoinRequests retrieves a circle's join requests
+type GetJoinRequests struct {
+	CircleID string
+	UserID   string // Admins see every request, anyone else only their own
+}
+
+// JoinRequestDTO represents a request to join a circle
+type JoinRequestDTO struct {
+	ID          string           `json:"id"`
+	UserID      string           `json:"user_id"`
+	Message     string           `json:"message,omitempty"`
+	Status      string           `json:"status"`
+	ReviewedBy  string           `json:"reviewed_by,omitempty"`
+	Reason      string           `json:"reason,omitempty"`
+	RequestedAt time.Time        `json:"requested_at"`
+	DecidedAt   *time.Time       `json:"decided_at,omitempty"`
+	Requester   *TrustSignalsDTO `json:"requester,omitempty"` // Admins only, for pending requests
+}
+
+// DiscoveryQueryHandler handles circle discovery queries
+type DiscoveryQueryHandler struct {
+	circleRepo repository.CircleRepository
+	statsRepo  repository.SavingsStatisticsRepository
+	tiers      CreditTierLookup
+}
+
+// NewDiscoveryQueryHandler creates a new discovery query handler
+func NewDiscoveryQueryHandler(
+	circleRepo repository.CircleRepository,
+	statsRepo repository.SavingsStatisticsRepository,
+	tiers CreditTierLookup,
+) *DiscoveryQueryHandler {
+	return &DiscoveryQueryHandler{
+		circleRepo: circleRepo,
+		statsRepo:  statsRepo,
+		tiers:      tiers,
+	}
+}
+
+// HandleDiscoverCircles searches recruiting public circles and summarises who is already in them
+func (h *DiscoveryQueryHandler) HandleDiscoverCircles(ctx context.Context, q DiscoverCircles) (*CircleListingResult, error) {
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 || q.Limit > 50 {
+		q.Limit = 20
+	}
+
+	status := aggregate.CircleStatusRecruiting
+	filter := repository.CircleFilter{
+		Status:     &status,
+		MinAmount:  q.MinAmount,
+		MaxAmount:  q.MaxAmount,
+		IsPublic:   true,
+		Location:   q.Location,
+		StartAfter: q.StartAfter,
+		StartBy:    q.StartBy,
+		Offset:     (q.Page - 1) * q.Limit,
+		Limit:      q.Limit,
+	}
+
+	if q.Frequency != "" {
+		f := aggregate.ContributionFrequency(q.Frequency)
+		filter.Frequency = &f
+	}
+
+	circles, total, err := h.circleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look every member up once for the whole page
+	userIDs := make([]string, 0)
+	for _, c := range circles {
+		userIDs = append(userIDs, c.MemberUserIDs...)
+	}
+	stats, tiers, err := h.trackRecords(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]CircleListingDTO, len(circles))
+	for i, c := range circles {
+		dtos[i] = CircleListingDTO{
+			ID:               c.ID,
+			Name:             c.Name,
+			Description:      c.Description,
+			Type:             c.Type,
+			ContributionAmt:  c.ContributionAmt,
+			Currency:         c.Currency,
+			Frequency:        c.Frequency,
+			MaxMembers:       c.MaxMembers,
+			CurrentMembers:   c.CurrentMembers,
+			OpenSpots:        c.MaxMembers - c.CurrentMembers,
+			TotalRounds:      c.TotalRounds,
+			Location:         c.Location,
+			PlannedStartDate: c.PlannedStart,
+			RequiresApproval: c.JoinApproval,
+			CreatorID:        c.CreatorID,
+			CreatorName:      c.CreatorName,
+			TrustSignals:     trustSignals(c.MemberUserIDs, stats, tiers),
+			CreatedAt:        c.CreatedAt,
+		}
+	}
+
+	totalPages := int(total) / q.Limit
+	if int(total)%q.Limit > 0 {
+		totalPages++
+	}
+
+	return &CircleListingResult{
+		Circles:    dtos,
+		Total:      total,
+		Page:       q.Page,
+		Limit:      q.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// HandleGetJoinRequests retrieves a circle's join requests. Admins also see each
+// pending requester's track record to help them decide.
+func (h *DiscoveryQueryHandler) HandleGetJoinRequests(ctx context.Context, q GetJoinRequests) ([]JoinRequestDTO, error) {
+	circleID, err := valueobject.NewCircleID(q.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	isAdmin := circle.IsAdmin(userID)
+	requests := make([]*aggregate.JoinRequest, 0)
+	for _, r := range circle.JoinRequests() {
+		if isAdmin || r.UserID().Equals(userID) {
+			requests = append(requests, r)
+		}
+	}
+
+	var stats map[string]*repository.UserSavingsStats
+	var tiers map[string]string
+	if isAdmin {
+		pending := make([]string, 0)
+		for _, r := range requests {
+			if r.IsPending() {
+				pending = append(pending, r.UserID().String())
+			}
+		}
+		if stats, tiers, err = h.trackRecords(ctx, pending); err != nil {
+			return nil, err
+		}
+	}
+
+	dtos := make([]JoinRequestDTO, len(requests))
+	for i, r := range requests {
+		dtos[i] = JoinRequestDTO{
+			ID:          r.ID(),
+			UserID:      r.UserID().String(),
+			Message:     r.Message(),
+			Status:      string(r.Status()),
+			ReviewedBy:  r.ReviewedBy(),
+			Reason:      r.Reason(),
+			RequestedAt: r.RequestedAt(),
+			DecidedAt:   r.DecidedAt(),
+		}
+		if isAdmin && r.IsPending() {
+			signals := trustSignals([]string{r.UserID().String()}, stats, tiers)
+			dtos[i].Requester = &signals
+		}
+	}
+
+	return dtos, nil
+}
+
+// trackRecords loads the savings history and credit tier of each user
+func (h *DiscoveryQueryHandler) trackRecords(ctx context.Context, userIDs []string) (map[string]*repository.UserSavingsStats, map[string]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	stats, err := h.statsRepo.GetUsersStats(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tiers, err := h.tiers.CreditTiers(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stats, tiers, nil
+}
+
+// trustSignals aggregates members' track records so no individual's history is exposed
+func trustSignals(userIDs []string, stats map[string]*repository.UserSavingsStats, tiers map[string]string) TrustSignalsDTO {
+	signals := TrustSignalsDTO{
+		Members:     len(userIDs),
+		CreditTiers: make(map[string]int),
+	}
+
+	var onTimeTotal float64
+	for _, userID := range userIDs {
+		if tier, ok := tiers[userID]; ok {
+			signals.CreditTiers[tier]++
+		} else {
+			signals.Unscored++
+		}
+
+		s, ok := stats[userID]
+		// Joining a circle is not a track record; owing a contribution is
+		if !ok || (s.TotalContributed == 0 && s.MissedPayments == 0) {
+			continue
+		}
+		signals.MembersWithHistory++
+		signals.CompletedCircles += s.CompletedCircles
+		onTimeTotal += s.OnTimeRate
+	}
+
+	if signals.MembersWithHistory > 0 {
+		signals.OnTimeRate = onTimeTotal / float64(signals.MembersWithHistory)
+	}
+
+	return signals
+}
@@ -0,0 +1,213 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetMyGoals retrieves a user's savings goals
+type GetMyGoals struct {
+	UserID string
+	Status string
+}
+
+// GoalDTO represents a savings goal
+type GoalDTO struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	Target     int64        `json:"target"`
+	Balance    int64        `json:"balance"`
+	Currency   string       `json:"currency"`
+	Progress   int          `json:"progress"`
+	TargetDate time.Time    `json:"target_date"`
+	Mode       string       `json:"mode"`
+	Status     string       `json:"status"`
+	IsAchieved bool         `json:"is_achieved"`
+	Interest   int64        `json:"interest_earned"`
+	AutoSave   *AutoSaveDTO `json:"auto_save,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// AutoSaveDTO represents a savings goal's auto-save rule
+type AutoSaveDTO struct {
+	Kind      string     `json:"kind"`
+	Amount    int64      `json:"amount,omitempty"`
+	Frequency string     `json:"frequency,omitempty"`
+	RoundUpTo int64      `json:"round_up_to,omitempty"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+}
+
+// GetInterestStatement retrieves a user's interest postings for a period
+type GetInterestStatement struct {
+	UserID string
+	From   time.Time
+	To     time.Time
+}
+
+// InterestStatementDTO represents a user's interest statement
+type InterestStatementDTO struct {
+	From       time.Time             `json:"from"`
+	To         time.Time             `json:"to"`
+	Postings   []InterestPostingDTO  `json:"postings"`
+	TotalGross int64                 `json:"total_gross"`
+	TotalTax   int64                 `json:"total_tax"`
+	TotalNet   int64                 `json:"total_net"`
+	Accruing   []AccruingInterestDTO `json:"accruing"`
+}
+
+// InterestPostingDTO represents interest capitalized into a goal
+type InterestPostingDTO struct {
+	GoalID      string    `json:"goal_id"`
+	GoalName    string    `json:"goal_name"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	APR         int       `json:"apr_bps"`
+	DayCount    string    `json:"day_count"`
+	Gross       int64     `json:"gross"`
+	Tax         int64     `json:"tax"`
+	Net         int64     `json:"net"`
+	Currency    string    `json:"currency"`
+	PostedAt    time.Time `json:"posted_at"`
+}
+
+// AccruingInterestDTO represents interest accrued on a goal but not yet capitalized
+type AccruingInterestDTO struct {
+	GoalID   string `json:"goal_id"`
+	GoalName string `json:"goal_name"`
+	Accrued  int64  `json:"accrued"`
+}
+
+// GoalQueryHandler handles savings goal queries
+type GoalQueryHandler struct {
+	goalRepo    repository.SavingsGoalRepository
+	postingRepo repository.InterestPostingRepository
+}
+
+// NewGoalQueryHandler creates a new goal query handler
+func NewGoalQueryHandler(goalRepo repository.SavingsGoalRepository, postingRepo repository.InterestPostingRepository) *GoalQueryHandler {
+	return &GoalQueryHandler{
+		goalRepo:    goalRepo,
+		postingRepo: postingRepo,
+	}
+}
+
+// HandleGetMyGoals retrieves a user's savings goals
+func (h *GoalQueryHandler) HandleGetMyGoals(ctx context.Context, q GetMyGoals) ([]GoalDTO, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	var status *aggregate.GoalStatus
+	if q.Status != "" {
+		s := aggregate.GoalStatus(q.Status)
+		status = &s
+	}
+
+	goals, err := h.goalRepo.FindByUserID(ctx, userID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]GoalDTO, len(goals))
+	for i, goal := range goals {
+		dtos[i] = goalToDTO(goal)
+	}
+	return dtos, nil
+}
+
+// HandleGetInterestStatement retrieves a user's interest postings for a period,
+// with the interest each active goal has accrued since its last posting
+func (h *GoalQueryHandler) HandleGetInterestStatement(ctx context.Context, q GetInterestStatement) (*InterestStatementDTO, error) {
+	userID, err := valueobject.NewUserID(q.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if q.To.Before(q.From) {
+		return nil, errors.New("statement period ends before it starts")
+	}
+
+	postings, err := h.postingRepo.FindByUser(ctx, userID, q.From, q.To)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &InterestStatementDTO{
+		From:     q.From,
+		To:       q.To,
+		Postings: make([]InterestPostingDTO, len(postings)),
+		Accruing: []AccruingInterestDTO{},
+	}
+	for i, p := range postings {
+		statement.Postings[i] = InterestPostingDTO{
+			GoalID:      p.GoalID,
+			GoalName:    p.GoalName,
+			PeriodStart: p.PeriodStart,
+			PeriodEnd:   p.PeriodEnd,
+			APR:         p.APR,
+			DayCount:    p.DayCount,
+			Gross:       p.Gross,
+			Tax:         p.Tax,
+			Net:         p.Net,
+			Currency:    p.Currency,
+			PostedAt:    p.PostedAt,
+		}
+		statement.TotalGross += p.Gross
+		statement.TotalTax += p.Tax
+		statement.TotalNet += p.Net
+	}
+
+	active := aggregate.GoalStatusActive
+	goals, err := h.goalRepo.FindByUserID(ctx, userID, &active)
+	if err != nil {
+		return nil, err
+	}
+	for _, goal := range goals {
+		if accrued := goal.AccruedInterest() / aggregate.InterestAccrualScale; accrued > 0 {
+			statement.Accruing = append(statement.Accruing, AccruingInterestDTO{
+				GoalID:   goal.ID().String(),
+				GoalName: goal.Name(),
+				Accrued:  accrued,
+			})
+		}
+	}
+
+	return statement, nil
+}
+
+func goalToDTO(goal *aggregate.SavingsGoal) GoalDTO {
+	dto := GoalDTO{
+		ID:         goal.ID().String(),
+		Name:       goal.Name(),
+		Target:     goal.Target().Amount(),
+		Balance:    goal.Balance().Amount(),
+		Currency:   string(goal.Target().Currency()),
+		Progress:   goal.Progress(),
+		TargetDate: goal.TargetDate(),
+		Mode:       string(goal.Mode()),
+		Status:     string(goal.Status()),
+		IsAchieved: goal.IsAchieved(),
+		Interest:   goal.InterestEarned(),
+		CreatedAt:  goal.CreatedAt(),
+	}
+
+	if rule := goal.AutoSave(); rule != nil {
+		dto.AutoSave = &AutoSaveDTO{
+			Kind:      string(rule.Kind()),
+			Frequency: string(rule.Frequency()),
+			RoundUpTo: rule.RoundUpTo(),
+		}
+		if rule.Kind() == aggregate.AutoSaveFixed {
+			nextRunAt := rule.NextRunAt()
+			dto.AutoSave.Amount = rule.Amount().Amount()
+			dto.AutoSave.NextRunAt = &nextRunAt
+		}
+	}
+
+	return dto
+}
@@ -0,0 +1,232 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/savings/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// GetCircleGovernance retrieves a circle's rules, voting policies and proposals
+type GetCircleGovernance struct {
+	CircleID string
+	UserID   string
+}
+
+// GovernanceDTO represents how a circle is governed
+type GovernanceDTO struct {
+	CircleID          string                     `json:"circle_id"`
+	Admins            []string                   `json:"admins"`
+	MaxMissedPayments int                        `json:"max_missed_payments"`
+	LateFeeBps        int                        `json:"late_fee_bps"`
+	VotingPolicies    map[string]VotingPolicyDTO `json:"voting_policies"`
+	Proposals         []ProposalDTO              `json:"proposals"`
+}
+
+// VotingPolicyDTO represents the quorum and threshold an action is decided under
+type VotingPolicyDTO struct {
+	QuorumPercent    int `json:"quorum_percent"`
+	ThresholdPercent int `json:"threshold_percent"`
+}
+
+// ProposalDTO represents a decision put to a circle's vote
+type ProposalDTO struct {
+	ID                string     `json:"id"`
+	Action            string     `json:"action"`
+	ProposedBy        string     `json:"proposed_by"`
+	TargetMemberID    string     `json:"target_member_id,omitempty"`
+	Amount            int64      `json:"amount,omitempty"`
+	MaxMissedPayments *int       `json:"max_missed_payments,omitempty"`
+	LateFeeBps        *int       `json:"late_fee_bps,omitempty"`
+	Status            string     `json:"status"`
+	Reason            string     `json:"reason,omitempty"`
+	Electorate        int        `json:"electorate"`
+	Approvals         int        `json:"approvals"`
+	Rejections        int        `json:"rejections"`
+	QuorumPercent     int        `json:"quorum_percent"`
+	ThresholdPercent  int        `json:"threshold_percent"`
+	CreatedAt         time.Time  `json:"created_at"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+}
+
+// GetCircleActivity retrieves a circle's activity log
+type GetCircleActivity struct {
+	CircleID string
+	UserID   string
+	Page     int
+	Limit    int
+}
+
+// ActivityDTO represents an entry in a circle's activity log
+type ActivityDTO struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	UserID     string    `json:"user_id,omitempty"`
+	Summary    string    `json:"summary"`
+	Amount     int64     `json:"amount,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// ActivityListResult represents a page of a circle's activity log
+type ActivityListResult struct {
+	Activity   []ActivityDTO `json:"activity"`
+	Total      int64         `json:"total"`
+	Page       int           `json:"page"`
+	Limit      int           `json:"limit"`
+	TotalPages int           `json:"total_pages"`
+}
+
+// GovernanceQueryHandler handles circle governance queries
+type GovernanceQueryHandler struct {
+	circleRepo   repository.CircleRepository
+	activityRepo repository.CircleActivityRepository
+}
+
+// NewGovernanceQueryHandler creates a new governance query handler
+func NewGovernanceQueryHandler(circleRepo repository.CircleRepository, activityRepo repository.CircleActivityRepository) *GovernanceQueryHandler {
+	return &GovernanceQueryHandler{
+		circleRepo:   circleRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// HandleGetCircleGovernance retrieves a circle's rules, voting policies and proposals
+func (h *GovernanceQueryHandler) HandleGetCircleGovernance(ctx context.Context, q GetCircleGovernance) (*GovernanceDTO, error) {
+	circle, err := h.findForMember(ctx, q.CircleID, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := circle.CircleRules()
+	dto := &GovernanceDTO{
+		CircleID:          circle.ID().String(),
+		Admins:            circle.AdminUserIDs(),
+		MaxMissedPayments: rules.MaxMissedPayments,
+		LateFeeBps:        rules.LateFeeBps,
+		VotingPolicies:    make(map[string]VotingPolicyDTO),
+		Proposals:         make([]ProposalDTO, 0, len(circle.Proposals())),
+	}
+
+	for _, action := range []aggregate.ProposalAction{
+		aggregate.ProposalRemoveMember,
+		aggregate.ProposalChangeContribution,
+		aggregate.ProposalCancelCircle,
+		aggregate.ProposalChangeRules,
+	} {
+		policy := circle.VotingPolicy(action)
+		dto.VotingPolicies[string(action)] = VotingPolicyDTO{
+			QuorumPercent:    policy.QuorumPercent,
+			ThresholdPercent: policy.ThresholdPercent,
+		}
+	}
+
+	for _, p := range circle.Proposals() {
+		dto.Proposals = append(dto.Proposals, proposalToDTO(p))
+	}
+
+	return dto, nil
+}
+
+// HandleGetCircleActivity retrieves a page of a circle's activity log, newest first
+func (h *GovernanceQueryHandler) HandleGetCircleActivity(ctx context.Context, q GetCircleActivity) (*ActivityListResult, error) {
+	circle, err := h.findForMember(ctx, q.CircleID, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 || q.Limit > 50 {
+		q.Limit = 20
+	}
+
+	entries, total, err := h.activityRepo.FindByCircle(ctx, circle.ID(), (q.Page-1)*q.Limit, q.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]ActivityDTO, len(entries))
+	for i, a := range entries {
+		dtos[i] = ActivityDTO{
+			ID:         a.ID,
+			Type:       a.Type,
+			UserID:     a.UserID,
+			Summary:    a.Summary,
+			Amount:     a.Amount,
+			OccurredAt: a.OccurredAt,
+		}
+	}
+
+	totalPages := int(total) / q.Limit
+	if int(total)%q.Limit > 0 {
+		totalPages++
+	}
+
+	return &ActivityListResult{
+		Activity:   dtos,
+		Total:      total,
+		Page:       q.Page,
+		Limit:      q.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// findForMember loads a circle the user belongs to
+func (h *GovernanceQueryHandler) findForMember(ctx context.Context, circleIDStr, userIDStr string) (*aggregate.Circle, error) {
+	circleID, err := valueobject.NewCircleID(circleIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobject.NewUserID(userIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !circle.IsMember(userID) {
+		return nil, aggregate.ErrNotMember
+	}
+
+	return circle, nil
+}
+
+func proposalToDTO(p *aggregate.Proposal) ProposalDTO {
+	approvals, rejections := p.Tally()
+	dto := ProposalDTO{
+		ID:               p.ID(),
+		Action:           string(p.Action()),
+		ProposedBy:       p.ProposedBy().String(),
+		Status:           string(p.Status()),
+		Reason:           p.Reason(),
+		Electorate:       len(p.Electorate()),
+		Approvals:        approvals,
+		Rejections:       rejections,
+		QuorumPercent:    p.Policy().QuorumPercent,
+		ThresholdPercent: p.Policy().ThresholdPercent,
+		CreatedAt:        p.CreatedAt(),
+		ExpiresAt:        p.ExpiresAt(),
+		DecidedAt:        p.DecidedAt(),
+	}
+
+	switch p.Action() {
+	case aggregate.ProposalRemoveMember:
+		dto.TargetMemberID = p.Target().String()
+	case aggregate.ProposalChangeContribution:
+		dto.Amount = p.Amount()
+	case aggregate.ProposalChangeRules:
+		rules := p.Rules()
+		dto.MaxMissedPayments = &rules.MaxMissedPayments
+		dto.LateFeeBps = &rules.LateFeeBps
+	}
+
+	return dto
+}
@@ -51,7 +51,11 @@ type CircleDTO struct {
 	CurrentMembers  int          `json:"current_members"`
 	TotalRounds     int          `json:"total_rounds"`
 	CurrentRound    int          `json:"current_round"`
+	PayoutOrder     string       `json:"payout_order"`
+	MissedPolicy    string       `json:"missed_policy"`
+	GracePeriodDays int          `json:"grace_period_days"`
 	PoolBalance     int64        `json:"pool_balance"`
+	ReserveBalance  int64        `json:"reserve_balance"`
 	TotalSaved      int64        `json:"total_saved"`
 	Status          string       `json:"status"`
 	IsPrivate       bool         `json:"is_private"`
@@ -59,9 +63,14 @@ type CircleDTO struct {
 	Rules           []string     `json:"rules,omitempty"`
 	StartDate       *time.Time   `json:"start_date,omitempty"`
 	NextPayoutDate  *time.Time   `json:"next_payout_date,omitempty"`
+	Location        string       `json:"location,omitempty"`
+	PlannedStart    *time.Time   `json:"planned_start_date,omitempty"`
+	JoinApproval    bool         `json:"requires_approval"`
 	CreatorID       string       `json:"creator_id"`
 	CreatorName     string       `json:"creator_name,omitempty"`
 	Members         []MemberDTO  `json:"members,omitempty"`
+	Bids            []PayoutBidDTO    `json:"bids,omitempty"`
+	PositionSwaps   []PositionSwapDTO `json:"position_swaps,omitempty"`
 	CreatedAt       time.Time    `json:"created_at"`
 }
 
@@ -77,9 +86,32 @@ type MemberDTO struct {
 	TotalContrib   int64     `json:"total_contributed"`
 	MissedPayments int       `json:"missed_payments"`
 	HasReceived    bool      `json:"has_received"`
+	Arrears        int64     `json:"arrears"`
+	IsDefaulted    bool      `json:"is_defaulted"`
+	HasAutoDebit   bool      `json:"has_auto_debit"`
 	JoinedAt       time.Time `json:"joined_at"`
 }
 
+// PayoutBidDTO represents a bid for the current round's payout
+type PayoutBidDTO struct {
+	MemberID string    `json:"member_id"`
+	Round    int       `json:"round"`
+	Discount int64     `json:"discount"`
+	PlacedAt time.Time `json:"placed_at"`
+}
+
+// PositionSwapDTO represents a payout position swap request
+type PositionSwapDTO struct {
+	ID             string     `json:"id"`
+	RequesterID    string     `json:"requester_id"`
+	CounterpartyID string     `json:"counterparty_id"`
+	Status         string     `json:"status"`
+	ReviewedBy     string     `json:"reviewed_by,omitempty"`
+	Reason         string     `json:"reason,omitempty"`
+	RequestedAt    time.Time  `json:"requested_at"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+}
+
 // CircleListResult represents paginated circle results
 type CircleListResult struct {
 	Circles    []CircleDTO `json:"circles"`
@@ -138,6 +170,63 @@ type UserSavingsStatsDTO struct {
 	OnTimeRate       float64 `json:"on_time_rate"`
 }
 
+// GetTargetProgress retrieves a fixed-target circle's progress towards its goal
+type GetTargetProgress struct {
+	CircleID string
+	UserID   string
+}
+
+// TargetProgressDTO represents a fixed-target circle's progress and projected payouts
+type TargetProgressDTO struct {
+	CircleID      string         `json:"circle_id"`
+	TargetAmount  int64          `json:"target_amount"`
+	TargetDate    *time.Time     `json:"target_date,omitempty"`
+	PayoutMode    string         `json:"payout_mode"`
+	PoolBalance   int64          `json:"pool_balance"`
+	PercentSaved  float64        `json:"percent_saved"`
+	TargetReached bool           `json:"target_reached"`
+	DaysRemaining int            `json:"days_remaining"`
+	Shares        []FundShareDTO `json:"projected_shares"`
+}
+
+// GetEmergencyFund retrieves an emergency circle's fund and withdrawals
+type GetEmergencyFund struct {
+	CircleID string
+	UserID   string
+}
+
+// EmergencyFundDTO represents an emergency circle's pooled fund
+type EmergencyFundDTO struct {
+	CircleID    string          `json:"circle_id"`
+	FundBalance int64           `json:"fund_balance"`
+	Outstanding int64           `json:"outstanding"` // Withdrawn and not yet repaid
+	Withdrawals []WithdrawalDTO `json:"withdrawals"`
+	Shares      []FundShareDTO  `json:"projected_shares"`
+}
+
+// WithdrawalDTO represents an emergency withdrawal
+type WithdrawalDTO struct {
+	ID          string     `json:"id"`
+	MemberID    string     `json:"member_id"`
+	Amount      int64      `json:"amount"`
+	Reason      string     `json:"reason"`
+	Status      string     `json:"status"`
+	Quorum      int        `json:"quorum"`
+	Approvals   int        `json:"approvals"`
+	Rejections  int        `json:"rejections"`
+	Repaid      int64      `json:"repaid"`
+	Outstanding int64      `json:"outstanding"`
+	RequestedAt time.Time  `json:"requested_at"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+}
+
+// FundShareDTO represents a member's share of a pooled fund if it were distributed now
+type FundShareDTO struct {
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	Amount   int64  `json:"amount"`
+}
+
 // CircleQueryHandler handles circle queries
 type CircleQueryHandler struct {
 	circleRepo       repository.CircleRepository
@@ -353,6 +442,123 @@ func (h *CircleQueryHandler) HandleGetUserSavingsStats(ctx context.Context, q Ge
 	}, nil
 }
 
+// HandleGetTargetProgress retrieves a fixed-target circle's progress for a member
+func (h *CircleQueryHandler) HandleGetTargetProgress(ctx context.Context, q GetTargetProgress) (*TargetProgressDTO, error) {
+	circle, err := h.findForMember(ctx, q.CircleID, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if circle.Type() != aggregate.CircleTypeFixedTarget {
+		return nil, aggregate.ErrNotTargetCircle
+	}
+
+	dto := &TargetProgressDTO{
+		CircleID:      circle.ID().String(),
+		TargetAmount:  circle.TargetAmount(),
+		TargetDate:    circle.TargetDate(),
+		PayoutMode:    string(circle.TargetPayout()),
+		PoolBalance:   circle.PoolBalance(),
+		TargetReached: circle.TargetReached(),
+		Shares:        fundSharesToDTO(circle.FundShares()),
+	}
+
+	if circle.TargetAmount() > 0 {
+		dto.PercentSaved = float64(circle.PoolBalance()) / float64(circle.TargetAmount()) * 100
+	}
+	if circle.TargetDate() != nil && circle.Status().IsActive() {
+		if remaining := time.Until(*circle.TargetDate()); remaining > 0 {
+			dto.DaysRemaining = int(remaining.Hours()/24) + 1
+		}
+	}
+
+	return dto, nil
+}
+
+// HandleGetEmergencyFund retrieves an emergency circle's fund and withdrawals for a member
+func (h *CircleQueryHandler) HandleGetEmergencyFund(ctx context.Context, q GetEmergencyFund) (*EmergencyFundDTO, error) {
+	circle, err := h.findForMember(ctx, q.CircleID, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if circle.Type() != aggregate.CircleTypeEmergency {
+		return nil, aggregate.ErrNotEmergencyCircle
+	}
+
+	dto := &EmergencyFundDTO{
+		CircleID:    circle.ID().String(),
+		FundBalance: circle.PoolBalance(),
+		Withdrawals: make([]WithdrawalDTO, 0, len(circle.Withdrawals())),
+		Shares:      fundSharesToDTO(circle.FundShares()),
+	}
+
+	for _, w := range circle.Withdrawals() {
+		var approvals, rejections int
+		for _, v := range w.Votes() {
+			if v.Approve {
+				approvals++
+			} else {
+				rejections++
+			}
+		}
+
+		dto.Outstanding += w.Outstanding()
+		dto.Withdrawals = append(dto.Withdrawals, WithdrawalDTO{
+			ID:          w.ID(),
+			MemberID:    w.MemberID().String(),
+			Amount:      w.Amount(),
+			Reason:      w.Reason(),
+			Status:      string(w.Status()),
+			Quorum:      w.Quorum(),
+			Approvals:   approvals,
+			Rejections:  rejections,
+			Repaid:      w.Repaid(),
+			Outstanding: w.Outstanding(),
+			RequestedAt: w.RequestedAt(),
+			DecidedAt:   w.DecidedAt(),
+		})
+	}
+
+	return dto, nil
+}
+
+// findForMember loads a circle the user belongs to
+func (h *CircleQueryHandler) findForMember(ctx context.Context, circleIDStr, userIDStr string) (*aggregate.Circle, error) {
+	circleID, err := valueobject.NewCircleID(circleIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobject.NewUserID(userIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := h.circleRepo.FindByID(ctx, circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !circle.IsMember(userID) {
+		return nil, aggregate.ErrNotMember
+	}
+
+	return circle, nil
+}
+
+func fundSharesToDTO(shares []aggregate.FundShare) []FundShareDTO {
+	dtos := make([]FundShareDTO, len(shares))
+	for i, s := range shares {
+		dtos[i] = FundShareDTO{
+			MemberID: s.MemberID.String(),
+			UserID:   s.UserID.String(),
+			Amount:   s.Amount,
+		}
+	}
+	return dtos
+}
+
 func circleToDTO(circle *aggregate.Circle, includeSensitive bool) *CircleDTO {
 	dto := &CircleDTO{
 		ID:              circle.ID().String(),
@@ -366,13 +572,20 @@ func circleToDTO(circle *aggregate.Circle, includeSensitive bool) *CircleDTO {
 		CurrentMembers:  circle.CurrentMembers(),
 		TotalRounds:     circle.TotalRounds(),
 		CurrentRound:    circle.CurrentRound(),
+		PayoutOrder:     circle.PayoutOrder().String(),
+		MissedPolicy:    string(circle.MissedPolicy()),
+		GracePeriodDays: circle.GracePeriodDays(),
 		PoolBalance:     circle.PoolBalance(),
+		ReserveBalance:  circle.ReserveBalance(),
 		TotalSaved:      circle.TotalSaved(),
 		Status:          circle.Status().String(),
 		IsPrivate:       circle.IsPrivate(),
 		Rules:           circle.Rules(),
 		StartDate:       circle.StartDate(),
 		NextPayoutDate:  circle.NextPayoutDate(),
+		Location:        circle.Location(),
+		PlannedStart:    circle.PlannedStartDate(),
+		JoinApproval:    circle.RequiresApproval(),
 		CreatorID:       circle.CreatedBy().String(),
 		CreatedAt:       circle.CreatedAt(),
 	}
@@ -392,11 +605,36 @@ func circleToDTO(circle *aggregate.Circle, includeSensitive bool) *CircleDTO {
 					TotalContrib:   m.TotalContrib(),
 					MissedPayments: m.MissedPayments(),
 					HasReceived:    m.HasReceived(),
+					Arrears:        m.Arrears(),
+					IsDefaulted:    m.IsDefaulted(),
+					HasAutoDebit:   m.HasAutoDebit(),
 					JoinedAt:       m.JoinedAt(),
 				})
 			}
 		}
 		dto.Members = members
+
+		for _, b := range circle.Bids() {
+			dto.Bids = append(dto.Bids, PayoutBidDTO{
+				MemberID: b.MemberID().String(),
+				Round:    b.Round(),
+				Discount: b.Discount(),
+				PlacedAt: b.PlacedAt(),
+			})
+		}
+
+		for _, s := range circle.PositionSwaps() {
+			dto.PositionSwaps = append(dto.PositionSwaps, PositionSwapDTO{
+				ID:             s.ID(),
+				RequesterID:    s.RequesterID().String(),
+				CounterpartyID: s.CounterpartyID().String(),
+				Status:         string(s.Status()),
+				ReviewedBy:     s.ReviewedBy(),
+				Reason:         s.Reason(),
+				RequestedAt:    s.RequestedAt(),
+				ResolvedAt:     s.ResolvedAt(),
+			})
+		}
 	}
 
 	return dto
@@ -85,6 +85,41 @@ type RefundEscrow struct {
 	RequestedBy string
 }
 
+// PledgeSavings locks a goal's savings as collateral for a loan
+type PledgeSavings struct {
+	UserID   string
+	GoalID   string
+	Amount   int64
+	Currency string
+	LoanID   string
+}
+
+// ReleasePledgedSavings returns pledged savings once a loan no longer needs them
+type ReleasePledgedSavings struct {
+	UserID   string
+	Amount   int64
+	Currency string
+	LoanID   string
+}
+
+// PlaceDefaultLien holds up to a defaulted circle member's arrears on their wallet
+type PlaceDefaultLien struct {
+	UserID   string
+	CircleID string
+	Arrears  int64
+	Currency string
+}
+
+// ReleaseDefaultLien pays the applied part of a default lien to the circle and
+// returns the rest to the member
+type ReleaseDefaultLien struct {
+	UserID   string
+	CircleID string
+	Applied  int64
+	Held     int64
+	Currency string
+}
+
 // SetTransactionPIN sets or updates the wallet PIN
 type SetTransactionPIN struct {
 	UserID     string
@@ -185,3 +220,15 @@ func (w Withdraw) GetMoney() (valueobject.Money, error) {
 func (t Transfer) GetMoney() (valueobject.Money, error) {
 	return valueobject.NewMoney(t.Amount, valueobject.Currency(t.Currency))
 }
+
+func (p PledgeSavings) GetMoney() (valueobject.Money, error) {
+	return valueobject.NewMoney(p.Amount, valueobject.Currency(p.Currency))
+}
+
+func (r ReleasePledgedSavings) GetMoney() (valueobject.Money, error) {
+	return valueobject.NewMoney(r.Amount, valueobject.Currency(r.Currency))
+}
+
+func (p PlaceDefaultLien) GetMoney() (valueobject.Money, error) {
+	return valueobject.NewMoney(p.Arrears, valueobject.Currency(p.Currency))
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/repository"
+)
+
+// CircleContributionHandler moves savings circle contributions from members' wallets
+// into the circle's pooled custody account
+type CircleContributionHandler struct {
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+}
+
+// NewCircleContributionHandler creates a new circle contribution handler
+func NewCircleContributionHandler(walletRepo repository.WalletRepository, transactionRepo repository.TransactionRepository) *CircleContributionHandler {
+	return &CircleContributionHandler{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// DebitContribution takes a contribution from the member's wallet and records the transaction
+// in the same save.
+// The contribution ID is the transaction reference, so a contribution already debited
+// returns the same transaction instead of being taken twice.
+func (h *CircleContributionHandler) DebitContribution(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, circleID valueobject.CircleID, contributionID valueobject.ContributionID) (valueobject.TransactionID, error) {
+	reference := contributionID.String()
+	if existing, err := h.transactionRepo.FindByReference(ctx, reference); err == nil && existing != nil {
+		return valueobject.NewTransactionID(existing.ID)
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return valueobject.TransactionID{}, err
+	}
+
+	err = wallet.Debit(
+		amount,
+		string(repository.TransactionTypeContribution),
+		reference,
+		"Savings circle contribution "+circleID.String(),
+		valueobject.Zero(amount.Currency()),
+	)
+	if err != nil {
+		return valueobject.TransactionID{}, err
+	}
+
+	transactionID := valueobject.GenerateTransactionID()
+	tx := &repository.Transaction{
+		ID:           transactionID.String(),
+		WalletID:     wallet.ID().String(),
+		Type:         repository.TransactionTypeContribution,
+		Amount:       amount.Amount(),
+		Currency:     string(amount.Currency()),
+		BalanceAfter: wallet.AvailableBalance().Amount(),
+		Status:       repository.TransactionStatusCompleted,
+		Reference:    reference,
+		Description:  "Savings circle contribution " + circleID.String(),
+	}
+	err = h.walletRepo.SaveWithTransaction(ctx, wallet, tx)
+	if errors.Is(err, repository.ErrDuplicateReference) {
+		existing, err := h.transactionRepo.FindByReference(ctx, reference)
+		if err != nil {
+			return valueobject.TransactionID{}, err
+		}
+		return valueobject.NewTransactionID(existing.ID)
+	}
+	if err != nil {
+		return valueobject.TransactionID{}, err
+	}
+
+	return transactionID, nil
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"hustlex/internal/application/wallet/command"
+	savingsevent "hustlex/internal/domain/savings/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/repository"
+)
+
+// lienReasonCircleDefault marks escrow held against a savings circle default.
+// The savings context listens for holds with this reason to settle the arrears.
+const lienReasonCircleDefault = "circle_default"
+
+// CircleDefaultHandler places liens on the wallets of members who default on a savings circle
+type CircleDefaultHandler struct {
+	walletRepo repository.WalletRepository
+}
+
+// NewCircleDefaultHandler creates a new circle default handler
+func NewCircleDefaultHandler(walletRepo repository.WalletRepository) *CircleDefaultHandler {
+	return &CircleDefaultHandler{
+		walletRepo: walletRepo,
+	}
+}
+
+// HandlePlaceDefaultLien holds as much of the member's arrears as their available balance covers.
+// The hold is posted as an escrow transaction whose ID the circle records against the arrears.
+func (h *CircleDefaultHandler) HandlePlaceDefaultLien(ctx context.Context, cmd command.PlaceDefaultLien) error {
+	arrears, err := cmd.GetMoney()
+	if err != nil {
+		return err
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	amount := arrears
+	if wallet.AvailableBalance().LessThan(amount) {
+		amount = wallet.AvailableBalance()
+	}
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	transactionID := valueobject.GenerateTransactionID()
+	if err := wallet.HoldLien(amount, cmd.CircleID, lienReasonCircleDefault, transactionID); err != nil {
+		return err
+	}
+
+	tx := &repository.Transaction{
+		ID:           transactionID.String(),
+		WalletID:     wallet.ID().String(),
+		Type:         repository.TransactionTypeEscrowHold,
+		Amount:       amount.Amount(),
+		Currency:     string(amount.Currency()),
+		BalanceAfter: wallet.AvailableBalance().Amount(),
+		Status:       repository.TransactionStatusCompleted,
+		Reference:    transactionID.String(),
+		Description:  fmt.Sprintf("Lien for savings circle arrears (circle %s)", cmd.CircleID),
+	}
+	return h.walletRepo.SaveWithTransaction(ctx, wallet, tx)
+}
+
+// HandleReleaseDefaultLien releases a lien once the circle has applied it to the arrears
+func (h *CircleDefaultHandler) HandleReleaseDefaultLien(ctx context.Context, cmd command.ReleaseDefaultLien) error {
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	currency := valueobject.Currency(cmd.Currency)
+
+	// The applied part leaves the wallet for the circle
+	if cmd.Applied > 0 {
+		applied, err := valueobject.NewMoney(cmd.Applied, currency)
+		if err != nil {
+			return err
+		}
+		if err := wallet.ReleaseFromEscrow(applied, cmd.CircleID, false, cmd.CircleID); err != nil {
+			return err
+		}
+	}
+
+	// Anything held beyond the arrears goes back to the member
+	if excess := cmd.Held - cmd.Applied; excess > 0 {
+		returned, err := valueobject.NewMoney(excess, currency)
+		if err != nil {
+			return err
+		}
+		if err := wallet.ReleaseFromEscrow(returned, cmd.CircleID, true, cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
+
+// OnMemberDefaulted places a lien when a member defaults after receiving their payout
+func (h *CircleDefaultHandler) OnMemberDefaulted(ctx context.Context, e sharedevent.DomainEvent) error {
+	defaulted, ok := e.(*savingsevent.MemberDefaulted)
+	if !ok {
+		return nil
+	}
+
+	return h.HandlePlaceDefaultLien(ctx, command.PlaceDefaultLien{
+		UserID:   defaulted.UserID,
+		CircleID: defaulted.CircleID,
+		Arrears:  defaulted.Arrears,
+		Currency: defaulted.Currency,
+	})
+}
+
+// OnArrearsSettled releases a lien once the circle has applied it
+func (h *CircleDefaultHandler) OnArrearsSettled(ctx context.Context, e sharedevent.DomainEvent) error {
+	settled, ok := e.(*savingsevent.ArrearsSettled)
+	if !ok || settled.LienHeld == 0 {
+		return nil
+	}
+
+	return h.HandleReleaseDefaultLien(ctx, command.ReleaseDefaultLien{
+		UserID:   settled.UserID,
+		CircleID: settled.CircleID,
+		Applied:  settled.Amount,
+		Held:     settled.LienHeld,
+		Currency: settled.Currency,
+	})
+}
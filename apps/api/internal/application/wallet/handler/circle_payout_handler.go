@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/repository"
+)
+
+// CirclePayoutHandler credits savings circle payouts from the circle's pooled custody account
+type CirclePayoutHandler struct {
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+}
+
+// NewCirclePayoutHandler creates a new circle payout handler
+func NewCirclePayoutHandler(walletRepo repository.WalletRepository, transactionRepo repository.TransactionRepository) *CirclePayoutHandler {
+	return &CirclePayoutHandler{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// DisbursePayout credits a payout to the recipient's wallet and records the transaction
+// in the same save. A payout whose reference has already been recorded is skipped,
+// so retries are safe.
+func (h *CirclePayoutHandler) DisbursePayout(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, reference, description string) error {
+	if existing, err := h.transactionRepo.FindByReference(ctx, reference); err == nil && existing != nil {
+		return nil
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.Credit(amount, string(repository.TransactionTypePayout), reference, description); err != nil {
+		return err
+	}
+
+	err = h.walletRepo.SaveWithTransaction(ctx, wallet, &repository.Transaction{
+		ID:           valueobject.GenerateTransactionID().String(),
+		WalletID:     wallet.ID().String(),
+		Type:         repository.TransactionTypePayout,
+		Amount:       amount.Amount(),
+		Currency:     string(amount.Currency()),
+		BalanceAfter: wallet.AvailableBalance().Amount(),
+		Status:       repository.TransactionStatusCompleted,
+		Reference:    reference,
+		Description:  description,
+	})
+	if errors.Is(err, repository.ErrDuplicateReference) {
+		return nil
+	}
+	return err
+}
+
+// SettleLien posts the share of a payout that repays a loan holding a lien on it.
+// The money goes from the custody account to the lender without touching the member's
+// balances, so it is one loan repayment transaction. Retries return the posted transaction.
+func (h *CirclePayoutHandler) SettleLien(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, loanID, reference string) (valueobject.TransactionID, error) {
+	if existing, err := h.transactionRepo.FindByReference(ctx, reference); err == nil && existing != nil {
+		return valueobject.NewTransactionID(existing.ID)
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return valueobject.TransactionID{}, err
+	}
+
+	transactionID := valueobject.GenerateTransactionID()
+	tx := &repository.Transaction{
+		ID:           transactionID.String(),
+		WalletID:     wallet.ID().String(),
+		Type:         repository.TransactionTypeLoanRepayment,
+		Amount:       amount.Amount(),
+		Currency:     string(amount.Currency()),
+		BalanceAfter: wallet.AvailableBalance().Amount(),
+		Status:       repository.TransactionStatusCompleted,
+		Reference:    reference,
+		Description:  fmt.Sprintf("Loan repayment from circle payout (loan %s)", loanID),
+		Metadata:     map[string]interface{}{"loan_id": loanID},
+	}
+	if err := h.transactionRepo.Save(ctx, tx); err != nil {
+		return valueobject.TransactionID{}, err
+	}
+
+	return transactionID, nil
+}
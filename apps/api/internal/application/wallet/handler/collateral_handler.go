@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/application/wallet/command"
+	creditevent "hustlex/internal/domain/credit/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/aggregate"
+	"hustlex/internal/domain/wallet/repository"
+)
+
+// collateralLockedSavings matches the credit context's locked savings collateral type
+const collateralLockedSavings = "locked_savings"
+
+// CollateralHandler keeps wallet balances in step with secured loans
+type CollateralHandler struct {
+	walletRepo repository.WalletRepository
+}
+
+// NewCollateralHandler creates a new collateral handler
+func NewCollateralHandler(walletRepo repository.WalletRepository) *CollateralHandler {
+	return &CollateralHandler{walletRepo: walletRepo}
+}
+
+// HandlePledgeSavings locks a goal's savings as loan collateral. A loan already
+// holding a pledge is left as it is, so redelivered events are harmless.
+func (h *CollateralHandler) HandlePledgeSavings(ctx context.Context, cmd command.PledgeSavings) error {
+	amount, err := cmd.GetMoney()
+	if err != nil {
+		return err
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = wallet.PledgeSavings(amount, cmd.GoalID, cmd.LoanID)
+	if errors.Is(err, aggregate.ErrAlreadyPledged) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
+
+// HandleReleasePledgedSavings returns pledged savings to the goal they came from.
+// A loan with nothing left pledged has already been released.
+func (h *CollateralHandler) HandleReleasePledgedSavings(ctx context.Context, cmd command.ReleasePledgedSavings) error {
+	amount, err := cmd.GetMoney()
+	if err != nil {
+		return err
+	}
+
+	userID, err := valueobject.NewUserID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = wallet.ReleasePledgedSavings(amount, cmd.LoanID)
+	if errors.Is(err, aggregate.ErrPledgeNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
+
+// OnCollateralPledged locks savings when a savings-backed loan is applied for
+func (h *CollateralHandler) OnCollateralPledged(ctx context.Context, e sharedevent.DomainEvent) error {
+	pledged, ok := e.(creditevent.CollateralPledged)
+	if !ok || pledged.CollateralType != collateralLockedSavings {
+		return nil
+	}
+
+	return h.HandlePledgeSavings(ctx, command.PledgeSavings{
+		UserID:   pledged.UserID,
+		GoalID:   pledged.SourceID,
+		Amount:   pledged.Amount,
+		Currency: pledged.Currency,
+		LoanID:   pledged.LoanID,
+	})
+}
+
+// OnCollateralReleased unlocks savings once the loan completes or is rejected
+func (h *CollateralHandler) OnCollateralReleased(ctx context.Context, e sharedevent.DomainEvent) error {
+	released, ok := e.(creditevent.CollateralReleased)
+	if !ok || released.CollateralType != collateralLockedSavings {
+		return nil
+	}
+
+	return h.HandleReleasePledgedSavings(ctx, command.ReleasePledgedSavings{
+		UserID:   released.UserID,
+		Amount:   released.Amount,
+		Currency: released.Currency,
+		LoanID:   released.LoanID,
+	})
+}
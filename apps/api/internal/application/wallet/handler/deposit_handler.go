@@ -10,6 +10,7 @@ import (
 	"hustlex/internal/domain/shared/valueobject"
 	"hustlex/internal/domain/wallet/aggregate"
 	"hustlex/internal/domain/wallet/repository"
+	"hustlex/internal/domain/wallet/service"
 )
 
 // PaymentGateway defines the interface for payment processing
@@ -52,16 +53,20 @@ type PaymentVerification struct {
 type DepositHandler struct {
 	walletRepo     repository.WalletRepository
 	paymentGateway PaymentGateway
+	limits         kycLimits
 }
 
 // NewDepositHandler creates a new deposit handler
 func NewDepositHandler(
 	walletRepo repository.WalletRepository,
 	paymentGateway PaymentGateway,
+	kyc KYCLevelReader,
+	limitPolicy *service.KYCLimitPolicy,
 ) *DepositHandler {
 	return &DepositHandler{
 		walletRepo:     walletRepo,
 		paymentGateway: paymentGateway,
+		limits:         kycLimits{kyc: kyc, policy: limitPolicy},
 	}
 }
 
@@ -98,6 +103,12 @@ func (h *DepositHandler) HandleInitiateDeposit(ctx context.Context, cmd command.
 		return nil, aggregate.ErrWalletLocked
 	}
 
+	// Refuse deposits that would take the wallet past its KYC balance cap
+	// before the user pays, since a completed payment cannot be turned away
+	if err := h.limits.checkInflow(ctx, wallet, amount.Amount()); err != nil {
+		return nil, err
+	}
+
 	// Initiate payment via gateway
 	gatewayResp, err := h.paymentGateway.InitiateDeposit(ctx, InitiateDepositRequest{
 		UserID:    cmd.RequestedBy,
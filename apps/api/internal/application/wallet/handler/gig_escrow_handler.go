@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/repository"
+	"hustlex/internal/domain/wallet/service"
+)
+
+// GigEscrowHandler moves gig contract and booking money in and out of the client's escrow balance.
+// It is the wallet side of the gig context's EscrowService port.
+type GigEscrowHandler struct {
+	walletRepo repository.WalletRepository
+	escrowSvc  *service.EscrowService
+}
+
+// NewGigEscrowHandler creates a new gig escrow handler
+func NewGigEscrowHandler(walletRepo repository.WalletRepository, escrowSvc *service.EscrowService) *GigEscrowHandler {
+	return &GigEscrowHandler{
+		walletRepo: walletRepo,
+		escrowSvc:  escrowSvc,
+	}
+}
+
+// HoldFunds moves the client's money for a contract into escrow
+func (h *GigEscrowHandler) HoldFunds(ctx context.Context, userID valueobject.UserID, contractID valueobject.ContractID, amount valueobject.Money, description string) error {
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.HoldInEscrow(amount, contractID.String(), description); err != nil {
+		return err
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
+
+// ReleaseFunds pays escrowed contract money to the hustler, less the platform fee
+func (h *GigEscrowHandler) ReleaseFunds(ctx context.Context, payerID, recipientID valueobject.UserID, contractID valueobject.ContractID, amount, platformFee valueobject.Money) error {
+	return h.escrowSvc.ReleaseEscrowToRecipient(ctx, service.EscrowReleaseRequest{
+		PayerUserID:     payerID,
+		RecipientUserID: recipientID,
+		Amount:          amount,
+		PlatformFee:     platformFee,
+		Reference:       contractID.String(),
+		Description:     "Gig contract payment",
+	})
+}
+
+// RefundFunds returns escrowed contract money to the client
+func (h *GigEscrowHandler) RefundFunds(ctx context.Context, userID valueobject.UserID, contractID valueobject.ContractID, amount valueobject.Money, reason string) error {
+	return h.escrowSvc.RefundEscrow(ctx, userID, amount, contractID.String(), reason)
+}
+
+// HoldVisitFee moves the client's visit fee for an on-site booking into escrow
+func (h *GigEscrowHandler) HoldVisitFee(ctx context.Context, clientID valueobject.UserID, bookingID valueobject.BookingID, amount valueobject.Money, description string) error {
+	wallet, err := h.walletRepo.FindByUserID(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.HoldInEscrow(amount, bookingID.String(), description); err != nil {
+		return err
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
+
+// ReleaseVisitFee pays an escrowed visit fee to the hustler. Visit fees carry no platform fee.
+func (h *GigEscrowHandler) ReleaseVisitFee(ctx context.Context, clientID, hustlerID valueobject.UserID, bookingID valueobject.BookingID, amount valueobject.Money) error {
+	return h.escrowSvc.ReleaseEscrowToRecipient(ctx, service.EscrowReleaseRequest{
+		PayerUserID:     clientID,
+		RecipientUserID: hustlerID,
+		Amount:          amount,
+		PlatformFee:     valueobject.Zero(amount.Currency()),
+		Reference:       bookingID.String(),
+		Description:     "On-site visit payment",
+	})
+}
+
+// RefundVisitFee returns an escrowed visit fee to the client
+func (h *GigEscrowHandler) RefundVisitFee(ctx context.Context, clientID valueobject.UserID, bookingID valueobject.BookingID, amount valueobject.Money, reason string) error {
+	return h.escrowSvc.RefundEscrow(ctx, clientID, amount, bookingID.String(), reason)
+}
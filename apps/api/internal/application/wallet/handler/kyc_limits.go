@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/aggregate"
+	"hustlex/internal/domain/wallet/repository"
+	"hustlex/internal/domain/wallet/service"
+)
+
+// KYCLevelReader reports how far a user has got through identity verification
+// This is a PORT - identity bounded context provides the ADAPTER (KYCQueryHandler.KYCLevel)
+type KYCLevelReader interface {
+	KYCLevel(ctx context.Context, userID valueobject.UserID) (int, error)
+}
+
+// kycLimits applies the KYC limit policy to a wallet's owner
+type kycLimits struct {
+	kyc    KYCLevelReader
+	policy *service.KYCLimitPolicy
+}
+
+// checkOutflow checks money leaving a wallet against its owner's single and daily caps
+func (l kycLimits) checkOutflow(
+	ctx context.Context,
+	transactionRepo repository.TransactionRepository,
+	wallet *aggregate.Wallet,
+	amount int64,
+) error {
+	level, err := l.kyc.KYCLevel(ctx, wallet.UserID())
+	if err != nil {
+		return err
+	}
+
+	sentToday, err := transactionRepo.SumOutflowsSince(ctx, wallet.ID(), time.Now().UTC().Add(-24*time.Hour))
+	if err != nil {
+		return err
+	}
+
+	return l.policy.CheckOutflow(level, amount, sentToday)
+}
+
+// checkInflow checks money coming into a wallet against its owner's balance cap
+func (l kycLimits) checkInflow(ctx context.Context, wallet *aggregate.Wallet, amount int64) error {
+	level, err := l.kyc.KYCLevel(ctx, wallet.UserID())
+	if err != nil {
+		return err
+	}
+
+	return l.policy.CheckInflow(level, amount, wallet.TotalBalance().Amount())
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/repository"
+)
+
+// SavingsGoalHandler moves money between available balance and savings goals.
+// Every savings movement names its goal, so the savings balance is the sum of the owner's goals.
+type SavingsGoalHandler struct {
+	walletRepo repository.WalletRepository
+}
+
+// NewSavingsGoalHandler creates a new savings goal handler
+func NewSavingsGoalHandler(walletRepo repository.WalletRepository) *SavingsGoalHandler {
+	return &SavingsGoalHandler{walletRepo: walletRepo}
+}
+
+// MoveToSavings moves money from the user's available balance into a goal
+func (h *SavingsGoalHandler) MoveToSavings(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, goalID valueobject.GoalID) error {
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.MoveToSavings(amount, goalID.String()); err != nil {
+		return err
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
+
+// WithdrawFromSavings returns money from a goal to the user's available balance
+// and charges any early break penalty as a fee
+func (h *SavingsGoalHandler) WithdrawFromSavings(ctx context.Context, userID valueobject.UserID, amount, penalty valueobject.Money, goalID valueobject.GoalID) error {
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	total, err := amount.Add(penalty)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.WithdrawFromSavings(total, goalID.String()); err != nil {
+		return err
+	}
+
+	if penalty.IsPositive() {
+		err := wallet.Debit(
+			penalty,
+			string(repository.TransactionTypeFee),
+			goalID.String(),
+			"Savings goal early break penalty",
+			valueobject.Zero(penalty.Currency()),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return h.walletRepo.SaveWithEvents(ctx, wallet)
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"hustlex/internal/domain/shared/valueobject"
+	"hustlex/internal/domain/wallet/repository"
+)
+
+// SavingsInterestHandler posts interest capitalized into savings goals to the owner's savings balance
+type SavingsInterestHandler struct {
+	walletRepo      repository.WalletRepository
+	transactionRepo repository.TransactionRepository
+}
+
+// NewSavingsInterestHandler creates a new savings interest handler
+func NewSavingsInterestHandler(walletRepo repository.WalletRepository, transactionRepo repository.TransactionRepository) *SavingsInterestHandler {
+	return &SavingsInterestHandler{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// CreditInterest credits net interest to the user's savings balance and records the transaction
+// in the same save.
+// Interest whose reference has already been recorded is skipped, so retries are safe.
+func (h *SavingsInterestHandler) CreditInterest(ctx context.Context, userID valueobject.UserID, amount valueobject.Money, goalID valueobject.GoalID, reference string) error {
+	if existing, err := h.transactionRepo.FindByReference(ctx, reference); err == nil && existing != nil {
+		return nil
+	}
+
+	wallet, err := h.walletRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := wallet.CreditSavingsInterest(amount, goalID.String(), reference); err != nil {
+		return err
+	}
+
+	err = h.walletRepo.SaveWithTransaction(ctx, wallet, &repository.Transaction{
+		ID:           valueobject.GenerateTransactionID().String(),
+		WalletID:     wallet.ID().String(),
+		Type:         repository.TransactionTypeInterest,
+		Amount:       amount.Amount(),
+		Currency:     string(amount.Currency()),
+		BalanceAfter: wallet.SavingsBalance().Amount(),
+		Status:       repository.TransactionStatusCompleted,
+		Reference:    reference,
+		Description:  "Savings interest",
+		Metadata:     map[string]interface{}{"goal_id": goalID.String()},
+	})
+	if errors.Is(err, repository.ErrDuplicateReference) {
+		return nil
+	}
+	return err
+}
@@ -41,6 +41,7 @@ type TransferHandler struct {
 	transactionRepo repository.TransactionRepository
 	transferService *service.TransferService
 	userLookup      UserLookup
+	limits          kycLimits
 }
 
 // NewTransferHandler creates a new transfer handler
@@ -49,12 +50,15 @@ func NewTransferHandler(
 	transactionRepo repository.TransactionRepository,
 	transferService *service.TransferService,
 	userLookup UserLookup,
+	kyc KYCLevelReader,
+	limitPolicy *service.KYCLimitPolicy,
 ) *TransferHandler {
 	return &TransferHandler{
 		walletRepo:      walletRepo,
 		transactionRepo: transactionRepo,
 		transferService: transferService,
 		userLookup:      userLookup,
+		limits:          kycLimits{kyc: kyc, policy: limitPolicy},
 	}
 }
 
@@ -119,6 +123,19 @@ func (h *TransferHandler) Handle(ctx context.Context, cmd command.Transfer) (*co
 		return nil, err
 	}
 
+	// Both ends of the transfer must stay within their KYC limits
+	if err := h.limits.checkOutflow(ctx, h.transactionRepo, senderWallet, amount.Amount()); err != nil {
+		return nil, err
+	}
+
+	recipientWallet, err := h.walletRepo.FindByUserID(ctx, recipientUserID)
+	if err != nil {
+		return nil, service.ErrRecipientNotFound
+	}
+	if err := h.limits.checkInflow(ctx, recipientWallet, amount.Amount()); err != nil {
+		return nil, err
+	}
+
 	// Generate reference
 	reference := cmd.Reference
 	if reference == "" {
@@ -13,6 +13,7 @@ import (
 	"hustlex/internal/domain/shared/valueobject"
 	"hustlex/internal/domain/wallet/aggregate"
 	"hustlex/internal/domain/wallet/repository"
+	"hustlex/internal/domain/wallet/service"
 )
 
 const (
@@ -69,6 +70,7 @@ type WithdrawHandler struct {
 	walletRepo       repository.WalletRepository
 	transactionRepo  repository.TransactionRepository
 	transferProvider TransferProvider
+	limits           kycLimits
 }
 
 // NewWithdrawHandler creates a new withdrawal handler
@@ -76,11 +78,14 @@ func NewWithdrawHandler(
 	walletRepo repository.WalletRepository,
 	transactionRepo repository.TransactionRepository,
 	transferProvider TransferProvider,
+	kyc KYCLevelReader,
+	limitPolicy *service.KYCLimitPolicy,
 ) *WithdrawHandler {
 	return &WithdrawHandler{
 		walletRepo:       walletRepo,
 		transactionRepo:  transactionRepo,
 		transferProvider: transferProvider,
+		limits:           kycLimits{kyc: kyc, policy: limitPolicy},
 	}
 }
 
@@ -133,6 +138,11 @@ func (h *WithdrawHandler) Handle(ctx context.Context, cmd command.Withdraw) (*co
 	// Reset PIN attempts on success
 	wallet.ResetPINAttempts()
 
+	// Check KYC limits
+	if err := h.limits.checkOutflow(ctx, h.transactionRepo, wallet, amount.Amount()); err != nil {
+		return nil, err
+	}
+
 	// Calculate fee
 	fee := valueobject.MustNewMoney(WithdrawalFee, amount.Currency())
 
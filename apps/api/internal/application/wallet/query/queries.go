@@ -20,6 +20,7 @@ type GetWalletResult struct {
 	AvailableBalance int64     `json:"available_balance"`
 	EscrowBalance    int64     `json:"escrow_balance"`
 	SavingsBalance   int64     `json:"savings_balance"`
+	PledgedBalance   int64     `json:"pledged_balance"`
 	TotalBalance     int64     `json:"total_balance"`
 	Currency         string    `json:"currency"`
 	Status           string    `json:"status"`
@@ -147,6 +148,7 @@ func (h *WalletQueryHandler) HandleGetWallet(ctx context.Context, q GetWallet) (
 		AvailableBalance: wallet.AvailableBalance().Amount(),
 		EscrowBalance:    wallet.EscrowBalance().Amount(),
 		SavingsBalance:   wallet.SavingsBalance().Amount(),
+		PledgedBalance:   wallet.PledgedBalance().Amount(),
 		TotalBalance:     wallet.TotalBalance().Amount(),
 		Currency:         string(wallet.Currency()),
 		Status:           string(wallet.Status()),
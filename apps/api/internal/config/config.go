@@ -19,6 +19,8 @@ type Config struct {
 	SMS      SMSConfig
 	Payment  PaymentConfig
 	Storage  StorageConfig
+	Gig      GigConfig
+	KYC      KYCConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -87,6 +89,41 @@ type StorageConfig struct {
 	Region          string
 }
 
+// GigConfig holds gig marketplace configuration
+type GigConfig struct {
+	ReviewWindow    time.Duration   // how long a client has to review delivered work before it is approved
+	ReviewReminders []time.Duration // how long before the review deadline both parties are reminded
+
+	BookingFreeCancelWindow  time.Duration   // clients cancelling at least this far before a visit pay no fee
+	BookingLateCancelPercent int             // share of the visit fee a later client cancellation costs
+	BookingRescheduleNotice  time.Duration   // how far before a visit it can still be moved
+	BookingMaxReschedules    int             // how many times one booking can be moved
+	BookingReminders         []time.Duration // how long before a visit both parties are reminded
+
+	ReviewBlindWindow time.Duration // reviews stay hidden until both parties review or this passes after completion
+	RatingPriorMean   float64       // rating every user starts from
+	RatingPriorWeight float64       // how many full-weight reviews the starting rating is worth
+	ReviewStaleAfter  time.Duration // older reviews count for half
+}
+
+// KYCConfig holds identity verification provider configuration
+type KYCConfig struct {
+	Providers           []string // tried in order: smileid, dojah, verifyme
+	ResultEncryptionKey string   // encrypts stored provider responses
+	NameMatchThreshold  float64  // how closely a provider's name must match the profile (0-1)
+
+	SmileIDBaseURL   string
+	SmileIDPartnerID string
+	SmileIDAPIKey    string
+
+	DojahBaseURL   string
+	DojahAppID     string
+	DojahSecretKey string
+
+	VerifyMeBaseURL   string
+	VerifyMeSecretKey string
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if exists (for development)
@@ -104,6 +141,14 @@ func Load() (*Config, error) {
 		jwtSecret = "dev-only-secret-do-not-use-in-production"
 	}
 
+	kycEncryptionKey := os.Getenv("KYC_RESULT_ENCRYPTION_KEY")
+	if isProduction && kycEncryptionKey == "" {
+		return nil, fmt.Errorf("KYC_RESULT_ENCRYPTION_KEY environment variable is required in production")
+	}
+	if kycEncryptionKey == "" {
+		kycEncryptionKey = "dev-only-kyc-key-do-not-use-in-production"
+	}
+
 	// Validate CORS origins - never allow wildcard in production
 	corsOrigins := getEnv("CORS_ORIGINS", "http://localhost:3000,http://localhost:8080")
 	if isProduction && (corsOrigins == "*" || strings.Contains(corsOrigins, "*")) {
@@ -179,6 +224,37 @@ func Load() (*Config, error) {
 			SecretAccessKey: getEnv("STORAGE_SECRET_KEY", ""),
 			Region:          getEnv("STORAGE_REGION", "us-east-1"),
 		},
+		Gig: GigConfig{
+			ReviewWindow:    getEnvDuration("GIG_REVIEW_WINDOW", 72*time.Hour),
+			ReviewReminders: getEnvDurations("GIG_REVIEW_REMINDERS", []time.Duration{24 * time.Hour, 2 * time.Hour}),
+
+			BookingFreeCancelWindow:  getEnvDuration("GIG_BOOKING_FREE_CANCEL_WINDOW", 24*time.Hour),
+			BookingLateCancelPercent: getEnvInt("GIG_BOOKING_LATE_CANCEL_PERCENT", 50),
+			BookingRescheduleNotice:  getEnvDuration("GIG_BOOKING_RESCHEDULE_NOTICE", 12*time.Hour),
+			BookingMaxReschedules:    getEnvInt("GIG_BOOKING_MAX_RESCHEDULES", 2),
+			BookingReminders:         getEnvDurations("GIG_BOOKING_REMINDERS", []time.Duration{24 * time.Hour, time.Hour}),
+
+			ReviewBlindWindow: getEnvDuration("GIG_REVIEW_BLIND_WINDOW", 14*24*time.Hour),
+			RatingPriorMean:   getEnvFloat("GIG_RATING_PRIOR_MEAN", 4.0),
+			RatingPriorWeight: getEnvFloat("GIG_RATING_PRIOR_WEIGHT", 5),
+			ReviewStaleAfter:  getEnvDuration("GIG_REVIEW_STALE_AFTER", 365*24*time.Hour),
+		},
+		KYC: KYCConfig{
+			Providers:           getEnvList("KYC_PROVIDERS", []string{"smileid", "dojah", "verifyme"}),
+			ResultEncryptionKey: kycEncryptionKey,
+			NameMatchThreshold:  getEnvFloat("KYC_NAME_MATCH_THRESHOLD", 0.85),
+
+			SmileIDBaseURL:   getEnv("SMILE_ID_BASE_URL", "https://testapi.smileidentity.com"),
+			SmileIDPartnerID: getEnv("SMILE_ID_PARTNER_ID", ""),
+			SmileIDAPIKey:    getEnv("SMILE_ID_API_KEY", ""),
+
+			DojahBaseURL:   getEnv("DOJAH_BASE_URL", "https://sandbox.dojah.io"),
+			DojahAppID:     getEnv("DOJAH_APP_ID", ""),
+			DojahSecretKey: getEnv("DOJAH_SECRET_KEY", ""),
+
+			VerifyMeBaseURL:   getEnv("VERIFYME_BASE_URL", "https://vapi.verifyme.ng"),
+			VerifyMeSecretKey: getEnv("VERIFYME_SECRET_KEY", ""),
+		},
 	}
 
 	return cfg, nil
@@ -212,6 +288,15 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -221,6 +306,38 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getEnvDurations(key string, defaultValue []time.Duration) []time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	durations := make([]time.Duration, 0)
+	for _, part := range strings.Split(value, ",") {
+		duration, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			return defaultValue
+		}
+		durations = append(durations, duration)
+	}
+	return durations
+}
+
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	list := make([]string, 0)
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
+
 func getEnvBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if boolVal, err := strconv.ParseBool(value); err == nil {
@@ -0,0 +1,237 @@
+package aggregate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// Bureau submission errors
+var (
+	ErrUnknownBureau            = errors.New("unknown credit bureau")
+	ErrInvalidReportingPeriod   = errors.New("invalid reporting period")
+	ErrEmptySubmission          = errors.New("bureau submission has no records")
+	ErrSubmissionNotGenerated   = errors.New("bureau submission has already been sent")
+	ErrSubmissionNotSent        = errors.New("bureau submission has not been sent")
+	ErrSubmissionNotCorrectable = errors.New("only rejected or accepted submissions can be corrected")
+)
+
+// CreditBureau identifies a licensed Nigerian credit bureau
+type CreditBureau string
+
+const (
+	BureauCRC            CreditBureau = "crc"
+	BureauFirstCentral   CreditBureau = "first_central"
+	BureauCreditRegistry CreditBureau = "credit_registry"
+)
+
+func (b CreditBureau) String() string {
+	return string(b)
+}
+
+func (b CreditBureau) IsValid() bool {
+	switch b {
+	case BureauCRC, BureauFirstCentral, BureauCreditRegistry:
+		return true
+	}
+	return false
+}
+
+// AllCreditBureaus returns every bureau the lender reports to
+func AllCreditBureaus() []CreditBureau {
+	return []CreditBureau{BureauCRC, BureauFirstCentral, BureauCreditRegistry}
+}
+
+// SubmissionStatus tracks a bureau file through submission
+type SubmissionStatus string
+
+const (
+	SubmissionGenerated  SubmissionStatus = "generated"
+	SubmissionSubmitted  SubmissionStatus = "submitted"
+	SubmissionAccepted   SubmissionStatus = "accepted"
+	SubmissionRejected   SubmissionStatus = "rejected"
+	SubmissionSuperseded SubmissionStatus = "superseded" // Replaced by a correction
+)
+
+func (s SubmissionStatus) String() string {
+	return string(s)
+}
+
+// BureauSubmission is the aggregate root for a monthly credit bureau file.
+// The generated file is kept verbatim so we can show exactly what was reported.
+type BureauSubmission struct {
+	sharedevent.AggregateRoot
+
+	id           string
+	bureau       CreditBureau
+	period       time.Time // First day of the reporting month, UTC
+	fileName     string
+	content      []byte
+	checksum     string
+	recordCount  int
+	correctionOf string // ID of the submission this one corrects
+	status       SubmissionStatus
+	reference    string // Bureau acknowledgement reference
+	errors       []string
+	submittedAt  *time.Time
+	respondedAt  *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+	version      int64
+}
+
+// NewBureauSubmission records a generated bureau file
+func NewBureauSubmission(id string, bureau CreditBureau, period time.Time, fileName string, content []byte, recordCount int, correctionOf string) (*BureauSubmission, error) {
+	if !bureau.IsValid() {
+		return nil, ErrUnknownBureau
+	}
+	if period.IsZero() {
+		return nil, ErrInvalidReportingPeriod
+	}
+	if recordCount <= 0 || len(content) == 0 {
+		return nil, ErrEmptySubmission
+	}
+
+	sum := sha256.Sum256(content)
+	now := time.Now().UTC()
+
+	return &BureauSubmission{
+		id:           id,
+		bureau:       bureau,
+		period:       ReportingPeriod(period),
+		fileName:     fileName,
+		content:      content,
+		checksum:     hex.EncodeToString(sum[:]),
+		recordCount:  recordCount,
+		correctionOf: correctionOf,
+		status:       SubmissionGenerated,
+		errors:       make([]string, 0),
+		createdAt:    now,
+		updatedAt:    now,
+		version:      1,
+	}, nil
+}
+
+// ReconstructBureauSubmission reconstructs from persistence
+func ReconstructBureauSubmission(
+	id string,
+	bureau CreditBureau,
+	period time.Time,
+	fileName string,
+	content []byte,
+	checksum string,
+	recordCount int,
+	correctionOf string,
+	status SubmissionStatus,
+	reference string,
+	errs []string,
+	submittedAt *time.Time,
+	respondedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int64,
+) *BureauSubmission {
+	return &BureauSubmission{
+		id:           id,
+		bureau:       bureau,
+		period:       period,
+		fileName:     fileName,
+		content:      content,
+		checksum:     checksum,
+		recordCount:  recordCount,
+		correctionOf: correctionOf,
+		status:       status,
+		reference:    reference,
+		errors:       errs,
+		submittedAt:  submittedAt,
+		respondedAt:  respondedAt,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		version:      version,
+	}
+}
+
+// ReportingPeriod normalises a date to the first day of its month
+func ReportingPeriod(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Getters
+func (s *BureauSubmission) ID() string               { return s.id }
+func (s *BureauSubmission) Bureau() CreditBureau     { return s.bureau }
+func (s *BureauSubmission) Period() time.Time        { return s.period }
+func (s *BureauSubmission) FileName() string         { return s.fileName }
+func (s *BureauSubmission) Content() []byte          { return s.content }
+func (s *BureauSubmission) Checksum() string         { return s.checksum }
+func (s *BureauSubmission) RecordCount() int         { return s.recordCount }
+func (s *BureauSubmission) CorrectionOf() string     { return s.correctionOf }
+func (s *BureauSubmission) IsCorrection() bool       { return s.correctionOf != "" }
+func (s *BureauSubmission) Status() SubmissionStatus { return s.status }
+func (s *BureauSubmission) Reference() string        { return s.reference }
+func (s *BureauSubmission) Errors() []string         { return s.errors }
+func (s *BureauSubmission) SubmittedAt() *time.Time  { return s.submittedAt }
+func (s *BureauSubmission) RespondedAt() *time.Time  { return s.respondedAt }
+func (s *BureauSubmission) CreatedAt() time.Time     { return s.createdAt }
+func (s *BureauSubmission) UpdatedAt() time.Time     { return s.updatedAt }
+func (s *BureauSubmission) Version() int64           { return s.version }
+
+// MarkSubmitted records that the file was delivered to the bureau
+func (s *BureauSubmission) MarkSubmitted(reference string) error {
+	if s.status != SubmissionGenerated {
+		return ErrSubmissionNotGenerated
+	}
+
+	now := time.Now().UTC()
+	s.status = SubmissionSubmitted
+	s.reference = reference
+	s.submittedAt = &now
+	s.updatedAt = now
+
+	return nil
+}
+
+// Accept records the bureau accepting the file
+func (s *BureauSubmission) Accept() error {
+	if s.status != SubmissionSubmitted {
+		return ErrSubmissionNotSent
+	}
+
+	now := time.Now().UTC()
+	s.status = SubmissionAccepted
+	s.respondedAt = &now
+	s.updatedAt = now
+
+	return nil
+}
+
+// Reject records the bureau rejecting the file with its validation errors
+func (s *BureauSubmission) Reject(errs []string) error {
+	if s.status != SubmissionSubmitted {
+		return ErrSubmissionNotSent
+	}
+
+	now := time.Now().UTC()
+	s.status = SubmissionRejected
+	s.errors = errs
+	s.respondedAt = &now
+	s.updatedAt = now
+
+	return nil
+}
+
+// Supersede marks the submission as replaced by a correction.
+// Rejected files are resubmitted in full; accepted files are amended by a correction file.
+func (s *BureauSubmission) Supersede() error {
+	if s.status != SubmissionRejected && s.status != SubmissionAccepted {
+		return ErrSubmissionNotCorrectable
+	}
+
+	s.status = SubmissionSuperseded
+	s.updatedAt = time.Now().UTC()
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBureauSubmission_Lifecycle(t *testing.T) {
+	period := time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC)
+
+	submission, err := NewBureauSubmission("sub-1", BureauCRC, period, "file.txt", []byte("H|X\r\n"), 1, "")
+	if err != nil {
+		t.Fatalf("NewBureauSubmission() error = %v", err)
+	}
+	if !submission.Period().Equal(time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Period() = %v, want first of month", submission.Period())
+	}
+	if submission.Checksum() == "" {
+		t.Error("Checksum() is empty")
+	}
+
+	if err := submission.Accept(); err != ErrSubmissionNotSent {
+		t.Errorf("Accept() before submit = %v, want %v", err, ErrSubmissionNotSent)
+	}
+	if err := submission.Supersede(); err != ErrSubmissionNotCorrectable {
+		t.Errorf("Supersede() before response = %v, want %v", err, ErrSubmissionNotCorrectable)
+	}
+
+	submission.MarkSubmitted("CRC-REF-1")
+	if err := submission.Reject([]string{"line 2: invalid BVN"}); err != nil {
+		t.Fatalf("Reject() error = %v", err)
+	}
+	if err := submission.Supersede(); err != nil {
+		t.Fatalf("Supersede() error = %v", err)
+	}
+	if submission.Status() != SubmissionSuperseded {
+		t.Errorf("Status() = %s, want superseded", submission.Status())
+	}
+}
+
+func TestNewBureauSubmission_Validation(t *testing.T) {
+	period := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := NewBureauSubmission("sub-1", CreditBureau("equifax"), period, "f", []byte("x"), 1, ""); err != ErrUnknownBureau {
+		t.Errorf("unknown bureau error = %v, want %v", err, ErrUnknownBureau)
+	}
+	if _, err := NewBureauSubmission("sub-1", BureauCRC, period, "f", nil, 0, ""); err != ErrEmptySubmission {
+		t.Errorf("empty file error = %v, want %v", err, ErrEmptySubmission)
+	}
+}
@@ -0,0 +1,55 @@
+package aggregate
+
+import (
+	"errors"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Collateral errors
+var (
+	ErrCollateralRequired     = errors.New("loan product requires collateral")
+	ErrCollateralNotAccepted  = errors.New("collateral type is not accepted for this loan product")
+	ErrInsufficientCollateral = errors.New("collateral value does not cover the requested amount")
+	ErrInvalidCollateral      = errors.New("invalid collateral")
+	ErrLoanNotSecured         = errors.New("loan has no pledged collateral")
+)
+
+// CollateralType identifies what secures a loan
+type CollateralType string
+
+const (
+	CollateralNone          CollateralType = "none"
+	CollateralCirclePayout  CollateralType = "circle_payout"
+	CollateralLockedSavings CollateralType = "locked_savings"
+)
+
+func (t CollateralType) String() string {
+	return string(t)
+}
+
+// Collateral is a pledge against a member's circle payout or locked savings
+type Collateral struct {
+	collateralType CollateralType
+	sourceID       string // Circle ID for payouts, savings goal ID for savings
+	value          valueobject.Money
+}
+
+// NewCollateral creates a collateral pledge
+func NewCollateral(collateralType CollateralType, sourceID string, value valueobject.Money) (*Collateral, error) {
+	if collateralType != CollateralCirclePayout && collateralType != CollateralLockedSavings {
+		return nil, ErrInvalidCollateral
+	}
+	if sourceID == "" || !value.IsPositive() {
+		return nil, ErrInvalidCollateral
+	}
+	return &Collateral{
+		collateralType: collateralType,
+		sourceID:       sourceID,
+		value:          value,
+	}, nil
+}
+
+func (c *Collateral) Type() CollateralType     { return c.collateralType }
+func (c *Collateral) SourceID() string         { return c.sourceID }
+func (c *Collateral) Value() valueobject.Money { return c.value }
@@ -2,6 +2,7 @@ package aggregate
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sharedevent "hustlex/internal/domain/shared/event"
@@ -12,8 +13,16 @@ import (
 var (
 	ErrInvalidScoreComponent = errors.New("score component must be between 0 and 100")
 	ErrScoreOutOfRange       = errors.New("credit score must be between 0 and 850")
+	ErrDisputeAlreadyRecorded = errors.New("dispute already recorded against this score")
+	ErrDefaultAlreadyRecorded = errors.New("circle default already recorded against this score")
 )
 
+// CircleDefaultPenalty is deducted from the score for each savings circle default
+const CircleDefaultPenalty = 50
+
+// DisputeLossPenalty is deducted from the score for each gig dispute decided wholly against the user
+const DisputeLossPenalty = 25
+
 // UserTier represents the credit tier
 type UserTier string
 
@@ -98,6 +107,8 @@ type CreditScore struct {
 	totalReviews         int
 	onTimeContributions  int
 	totalContributions   int
+	circleDefaultKeys    []string
+	lostDisputeIDs       []string
 
 	lastCalculatedAt     time.Time
 	createdAt            time.Time
@@ -138,6 +149,8 @@ func ReconstructCreditScore(
 	totalReviews int,
 	onTimeContributions int,
 	totalContributions int,
+	circleDefaultKeys []string,
+	lostDisputeIDs []string,
 	lastCalculatedAt time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
@@ -160,6 +173,8 @@ func ReconstructCreditScore(
 		totalReviews:        totalReviews,
 		onTimeContributions: onTimeContributions,
 		totalContributions:  totalContributions,
+		circleDefaultKeys:   circleDefaultKeys,
+		lostDisputeIDs:      lostDisputeIDs,
 		lastCalculatedAt:    lastCalculatedAt,
 		createdAt:           createdAt,
 		updatedAt:           updatedAt,
@@ -184,6 +199,10 @@ func (cs *CreditScore) AverageRating() float64     { return cs.averageRating }
 func (cs *CreditScore) TotalReviews() int          { return cs.totalReviews }
 func (cs *CreditScore) OnTimeContributions() int   { return cs.onTimeContributions }
 func (cs *CreditScore) TotalContributions() int    { return cs.totalContributions }
+func (cs *CreditScore) CircleDefaults() int        { return len(cs.circleDefaultKeys) }
+func (cs *CreditScore) CircleDefaultKeys() []string { return cs.circleDefaultKeys }
+func (cs *CreditScore) DisputesLost() int          { return len(cs.lostDisputeIDs) }
+func (cs *CreditScore) LostDisputeIDs() []string   { return cs.lostDisputeIDs }
 func (cs *CreditScore) LastCalculatedAt() time.Time { return cs.lastCalculatedAt }
 func (cs *CreditScore) CreatedAt() time.Time       { return cs.createdAt }
 func (cs *CreditScore) UpdatedAt() time.Time       { return cs.updatedAt }
@@ -218,7 +237,8 @@ func (cs *CreditScore) UpdateGigStats(completed, accepted int) {
 	cs.updatedAt = time.Now().UTC()
 }
 
-// UpdateRatingStats updates rating-related statistics
+// UpdateRatingStats updates rating-related statistics. The gig context sends the
+// Bayesian-adjusted rating, so a few reviews cannot swing the score.
 func (cs *CreditScore) UpdateRatingStats(averageRating float64, totalReviews int) {
 	cs.averageRating = averageRating
 	cs.totalReviews = totalReviews
@@ -246,6 +266,36 @@ func (cs *CreditScore) UpdateSavingsStats(onTime, total int) {
 	cs.updatedAt = time.Now().UTC()
 }
 
+// RecordCircleDefault records a savings circle default, penalised on the next recalculation.
+// A default is keyed by circle, round and member and counts once, so a redelivered
+// default returns ErrDefaultAlreadyRecorded.
+func (cs *CreditScore) RecordCircleDefault(circleID string, round int, memberID string) error {
+	key := fmt.Sprintf("%s:%d:%s", circleID, round, memberID)
+	for _, k := range cs.circleDefaultKeys {
+		if k == key {
+			return ErrDefaultAlreadyRecorded
+		}
+	}
+
+	cs.circleDefaultKeys = append(cs.circleDefaultKeys, key)
+	cs.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// RecordDisputeLost records a gig dispute decided wholly against the user, penalised on the next recalculation.
+// Each dispute counts once, so a redelivered resolution returns ErrDisputeAlreadyRecorded.
+func (cs *CreditScore) RecordDisputeLost(disputeID string) error {
+	for _, id := range cs.lostDisputeIDs {
+		if id == disputeID {
+			return ErrDisputeAlreadyRecorded
+		}
+	}
+
+	cs.lostDisputeIDs = append(cs.lostDisputeIDs, disputeID)
+	cs.updatedAt = time.Now().UTC()
+	return nil
+}
+
 // UpdateAccountAgeScore updates score based on account age
 func (cs *CreditScore) UpdateAccountAgeScore(accountAge time.Duration) {
 	months := int(accountAge.Hours() / 24 / 30)
@@ -300,8 +350,8 @@ func (cs *CreditScore) Recalculate() {
 		float64(cs.accountAgeScore)*0.10 +
 		float64(cs.communityScore)*0.05
 
-	// Scale to 0-850
-	cs.score = int(weightedScore * 8.5)
+	// Scale to 0-850, less any circle default and lost dispute penalties
+	cs.score = int(weightedScore*8.5) - len(cs.circleDefaultKeys)*CircleDefaultPenalty - len(cs.lostDisputeIDs)*DisputeLossPenalty
 	if cs.score > 850 {
 		cs.score = 850
 	}
@@ -310,6 +310,85 @@ func TestCreditScore_Recalculate_ZeroScores(t *testing.T) {
 	}
 }
 
+func TestCreditScore_Recalculate_CircleDefaultPenalty(t *testing.T) {
+	cs := NewCreditScore(valueobject.GenerateUserID())
+
+	cs.UpdateGigStats(10, 10)      // 100
+	cs.UpdateRatingStats(5.0, 10)  // 100
+	cs.UpdateSavingsStats(10, 10)  // 100
+	cs.UpdateAccountAgeScore(24 * 30 * 24 * time.Hour) // 100
+	cs.UpdateVerificationScore(true, true, true, true) // 100
+	cs.UpdateCommunityScore(10, 10) // 100
+
+	if err := cs.RecordCircleDefault("circle-1", 3, "member-1"); err != nil {
+		t.Fatalf("RecordCircleDefault() error = %v", err)
+	}
+	if err := cs.RecordCircleDefault("circle-2", 1, "member-2"); err != nil {
+		t.Fatalf("RecordCircleDefault() error = %v", err)
+	}
+	cs.Recalculate()
+
+	// 850 less two defaults of 50
+	if cs.Score() != 750 {
+		t.Errorf("Recalculate() with two circle defaults = %d, want 750", cs.Score())
+	}
+	if cs.CircleDefaults() != 2 {
+		t.Errorf("CircleDefaults() = %d, want 2", cs.CircleDefaults())
+	}
+}
+
+func TestCreditScore_RecordCircleDefault_Redelivered(t *testing.T) {
+	cs := NewCreditScore(valueobject.GenerateUserID())
+
+	if err := cs.RecordCircleDefault("circle-1", 3, "member-1"); err != nil {
+		t.Fatalf("RecordCircleDefault() error = %v", err)
+	}
+	if err := cs.RecordCircleDefault("circle-1", 3, "member-1"); err != ErrDefaultAlreadyRecorded {
+		t.Errorf("RecordCircleDefault() again error = %v, want %v", err, ErrDefaultAlreadyRecorded)
+	}
+	if cs.CircleDefaults() != 1 {
+		t.Errorf("CircleDefaults() = %d, want 1", cs.CircleDefaults())
+	}
+}
+
+func TestCreditScore_Recalculate_DisputeLossPenalty(t *testing.T) {
+	cs := NewCreditScore(valueobject.GenerateUserID())
+
+	cs.UpdateGigStats(10, 10)      // 100
+	cs.UpdateRatingStats(5.0, 10)  // 100
+	cs.UpdateSavingsStats(10, 10)  // 100
+	cs.UpdateAccountAgeScore(24 * 30 * 24 * time.Hour) // 100
+	cs.UpdateVerificationScore(true, true, true, true) // 100
+	cs.UpdateCommunityScore(10, 10) // 100
+
+	if err := cs.RecordDisputeLost("dispute-1"); err != nil {
+		t.Fatalf("RecordDisputeLost() error = %v", err)
+	}
+	if err := cs.RecordCircleDefault("circle-1", 3, "member-1"); err != nil {
+		t.Fatalf("RecordCircleDefault() error = %v", err)
+	}
+	cs.Recalculate()
+
+	// 850 less one circle default and one lost dispute
+	if cs.Score() != 775 {
+		t.Errorf("Recalculate() with a lost dispute = %d, want 775", cs.Score())
+	}
+}
+
+func TestCreditScore_RecordDisputeLost_Redelivered(t *testing.T) {
+	cs := NewCreditScore(valueobject.GenerateUserID())
+
+	if err := cs.RecordDisputeLost("dispute-1"); err != nil {
+		t.Fatalf("RecordDisputeLost() error = %v", err)
+	}
+	if err := cs.RecordDisputeLost("dispute-1"); err != ErrDisputeAlreadyRecorded {
+		t.Errorf("RecordDisputeLost() again error = %v, want %v", err, ErrDisputeAlreadyRecorded)
+	}
+	if cs.DisputesLost() != 1 {
+		t.Errorf("DisputesLost() = %d, want 1", cs.DisputesLost())
+	}
+}
+
 func TestCreditScore_MaxLoanAmount(t *testing.T) {
 	cs := NewCreditScore(valueobject.GenerateUserID())
 
@@ -351,7 +430,7 @@ func TestReconstructCreditScore(t *testing.T) {
 		650,
 		TierGold,
 		80, 90, 85, 50, 75, 40, // component scores
-		15, 18, 4.5, 25, 20, 22, // stats
+		15, 18, 4.5, 25, 20, 22, []string{"circle-1:3:member-1"}, []string{"dispute-1", "dispute-2"}, // stats
 		now, now, now,
 		5,
 	)
@@ -380,6 +459,12 @@ func TestReconstructCreditScore(t *testing.T) {
 	if cs.AverageRating() != 4.5 {
 		t.Errorf("AverageRating = %f, want 4.5", cs.AverageRating())
 	}
+	if cs.CircleDefaults() != 1 {
+		t.Errorf("CircleDefaults = %d, want 1", cs.CircleDefaults())
+	}
+	if cs.DisputesLost() != 2 {
+		t.Errorf("DisputesLost = %d, want 2", cs.DisputesLost())
+	}
 	if cs.Version() != 5 {
 		t.Errorf("Version = %d, want 5", cs.Version())
 	}
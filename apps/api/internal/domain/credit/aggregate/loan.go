@@ -2,8 +2,10 @@ package aggregate
 
 import (
 	"errors"
+	"strings"
 	"time"
 
+	"hustlex/internal/domain/credit/event"
 	sharedevent "hustlex/internal/domain/shared/event"
 	"hustlex/internal/domain/shared/valueobject"
 )
@@ -12,7 +14,7 @@ import (
 var (
 	ErrLoanExceedsLimit    = errors.New("loan amount exceeds maximum allowed for your tier")
 	ErrInvalidLoanAmount   = errors.New("invalid loan amount")
-	ErrInvalidTenure       = errors.New("tenure must be between 1 and 12 months")
+	ErrInvalidTenure       = errors.New("tenure is outside the range allowed for this loan product")
 	ErrLoanNotApproved     = errors.New("loan is not approved")
 	ErrLoanNotDisbursed    = errors.New("loan has not been disbursed")
 	ErrLoanAlreadyPaid     = errors.New("loan has already been fully repaid")
@@ -24,15 +26,28 @@ var (
 type LoanStatus string
 
 const (
-	LoanStatusPending   LoanStatus = "pending"
-	LoanStatusApproved  LoanStatus = "approved"
-	LoanStatusDisbursed LoanStatus = "disbursed"
-	LoanStatusRepaying  LoanStatus = "repaying"
-	LoanStatusCompleted LoanStatus = "completed"
-	LoanStatusDefaulted LoanStatus = "defaulted"
-	LoanStatusRejected  LoanStatus = "rejected"
+	LoanStatusPending         LoanStatus = "pending"
+	LoanStatusReferred        LoanStatus = "referred"         // Waiting in the manual underwriting queue
+	LoanStatusCounterOffered  LoanStatus = "counter_offered"  // Waiting for the borrower to respond
+	LoanStatusAwaitingChecker LoanStatus = "awaiting_checker" // Maker has approved, checker sign-off needed
+	LoanStatusApproved        LoanStatus = "approved"
+	LoanStatusDisbursed       LoanStatus = "disbursed"
+	LoanStatusRepaying        LoanStatus = "repaying"
+	LoanStatusCompleted       LoanStatus = "completed"
+	LoanStatusDefaulted       LoanStatus = "defaulted"
+	LoanStatusRejected        LoanStatus = "rejected"
+	LoanStatusOfferDeclined   LoanStatus = "offer_declined"
 )
 
+// IsUnderReview reports whether the application is still being underwritten
+func (s LoanStatus) IsUnderReview() bool {
+	switch s {
+	case LoanStatusPending, LoanStatusReferred, LoanStatusCounterOffered, LoanStatusAwaitingChecker:
+		return true
+	}
+	return false
+}
+
 func (s LoanStatus) String() string {
 	return string(s)
 }
@@ -65,12 +80,16 @@ type Loan struct {
 
 	id             valueobject.LoanID
 	userID         valueobject.UserID
+	productID      string
 	principal      valueobject.Money
 	interestRate   float64 // Monthly rate
 	interestAmount valueobject.Money
 	totalAmount    valueobject.Money
+	originationFee valueobject.Money
 	amountRepaid   valueobject.Money
 	tenureMonths   int
+	gracePeriodDays int
+	collateral     *Collateral
 	status         LoanStatus
 	purpose        string
 	approvedAt     *time.Time
@@ -78,53 +97,75 @@ type Loan struct {
 	dueDate        *time.Time
 	completedAt    *time.Time
 	repayments     []*Repayment
+	decisions      []*LoanDecision
+	makerID        string // Underwriter awaiting checker sign-off or who made the open counter-offer
 	createdAt      time.Time
 	updatedAt      time.Time
 	version        int64
 }
 
-// NewLoan creates a new loan application
+// NewLoan creates a new loan application priced by the given product
 func NewLoan(
 	id valueobject.LoanID,
 	userID valueobject.UserID,
+	product *LoanProduct,
+	tier UserTier,
 	principal valueobject.Money,
-	interestRate float64,
 	tenureMonths int,
 	purpose string,
-	maxAllowed int64,
+	collateral *Collateral,
 ) (*Loan, error) {
 	if principal.Amount() <= 0 {
 		return nil, ErrInvalidLoanAmount
 	}
 
-	if principal.Amount() > maxAllowed {
-		return nil, ErrLoanExceedsLimit
+	if err := product.ValidateTerms(principal, tenureMonths, tier); err != nil {
+		return nil, err
 	}
 
-	if tenureMonths < 1 || tenureMonths > 12 {
-		return nil, ErrInvalidTenure
+	if err := product.ValidateCollateral(principal, collateral); err != nil {
+		return nil, err
 	}
 
+	pricing, _ := product.PricingFor(tier)
+	interestRate := pricing.MonthlyRate
+
 	// Calculate interest
 	interestAmount := int64(float64(principal.Amount()) * interestRate * float64(tenureMonths))
 	interest, _ := valueobject.NewMoney(interestAmount, principal.Currency())
 	totalAmount := principal.MustAdd(interest)
 
 	loan := &Loan{
-		id:             id,
-		userID:         userID,
-		principal:      principal,
-		interestRate:   interestRate,
-		interestAmount: interest,
-		totalAmount:    totalAmount,
-		amountRepaid:   valueobject.MustNewMoney(0, principal.Currency()),
-		tenureMonths:   tenureMonths,
-		status:         LoanStatusPending,
-		purpose:        purpose,
-		repayments:     make([]*Repayment, 0),
-		createdAt:      time.Now().UTC(),
-		updatedAt:      time.Now().UTC(),
-		version:        1,
+		id:              id,
+		userID:          userID,
+		productID:       product.ID(),
+		principal:       principal,
+		interestRate:    interestRate,
+		interestAmount:  interest,
+		totalAmount:     totalAmount,
+		originationFee:  product.OriginationFee(principal),
+		amountRepaid:    valueobject.MustNewMoney(0, principal.Currency()),
+		tenureMonths:    tenureMonths,
+		gracePeriodDays: product.GracePeriodDays(),
+		collateral:      collateral,
+		status:          LoanStatusPending,
+		purpose:         purpose,
+		repayments:      make([]*Repayment, 0),
+		decisions:       make([]*LoanDecision, 0),
+		createdAt:       time.Now().UTC(),
+		updatedAt:       time.Now().UTC(),
+		version:         1,
+	}
+
+	if collateral != nil {
+		loan.RecordEvent(event.NewCollateralPledged(
+			id.String(),
+			userID.String(),
+			collateral.Type().String(),
+			collateral.SourceID(),
+			collateral.Value().Amount(),
+			string(collateral.Value().Currency()),
+		))
 	}
 
 	return loan, nil
@@ -134,12 +175,16 @@ func NewLoan(
 func ReconstructLoan(
 	id valueobject.LoanID,
 	userID valueobject.UserID,
+	productID string,
 	principal valueobject.Money,
 	interestRate float64,
 	interestAmount valueobject.Money,
 	totalAmount valueobject.Money,
+	originationFee valueobject.Money,
 	amountRepaid valueobject.Money,
 	tenureMonths int,
+	gracePeriodDays int,
+	collateral *Collateral,
 	status LoanStatus,
 	purpose string,
 	approvedAt *time.Time,
@@ -147,6 +192,8 @@ func ReconstructLoan(
 	dueDate *time.Time,
 	completedAt *time.Time,
 	repayments []*Repayment,
+	decisions []*LoanDecision,
+	makerID string,
 	createdAt time.Time,
 	updatedAt time.Time,
 	version int64,
@@ -154,12 +201,16 @@ func ReconstructLoan(
 	return &Loan{
 		id:             id,
 		userID:         userID,
+		productID:      productID,
 		principal:      principal,
 		interestRate:   interestRate,
 		interestAmount: interestAmount,
 		totalAmount:    totalAmount,
+		originationFee: originationFee,
 		amountRepaid:   amountRepaid,
 		tenureMonths:   tenureMonths,
+		gracePeriodDays: gracePeriodDays,
+		collateral:     collateral,
 		status:         status,
 		purpose:        purpose,
 		approvedAt:     approvedAt,
@@ -167,6 +218,8 @@ func ReconstructLoan(
 		dueDate:        dueDate,
 		completedAt:    completedAt,
 		repayments:     repayments,
+		decisions:      decisions,
+		makerID:        makerID,
 		createdAt:      createdAt,
 		updatedAt:      updatedAt,
 		version:        version,
@@ -176,12 +229,17 @@ func ReconstructLoan(
 // Getters
 func (l *Loan) ID() valueobject.LoanID       { return l.id }
 func (l *Loan) UserID() valueobject.UserID   { return l.userID }
+func (l *Loan) ProductID() string            { return l.productID }
 func (l *Loan) Principal() valueobject.Money { return l.principal }
 func (l *Loan) InterestRate() float64        { return l.interestRate }
 func (l *Loan) InterestAmount() valueobject.Money { return l.interestAmount }
 func (l *Loan) TotalAmount() valueobject.Money { return l.totalAmount }
+func (l *Loan) OriginationFee() valueobject.Money { return l.originationFee }
 func (l *Loan) AmountRepaid() valueobject.Money { return l.amountRepaid }
 func (l *Loan) TenureMonths() int            { return l.tenureMonths }
+func (l *Loan) GracePeriodDays() int         { return l.gracePeriodDays }
+func (l *Loan) Collateral() *Collateral      { return l.collateral }
+func (l *Loan) IsSecured() bool              { return l.collateral != nil }
 func (l *Loan) Status() LoanStatus           { return l.status }
 func (l *Loan) Purpose() string              { return l.purpose }
 func (l *Loan) ApprovedAt() *time.Time       { return l.approvedAt }
@@ -189,6 +247,8 @@ func (l *Loan) DisbursedAt() *time.Time      { return l.disbursedAt }
 func (l *Loan) DueDate() *time.Time          { return l.dueDate }
 func (l *Loan) CompletedAt() *time.Time      { return l.completedAt }
 func (l *Loan) Repayments() []*Repayment     { return l.repayments }
+func (l *Loan) Decisions() []*LoanDecision   { return l.decisions }
+func (l *Loan) MakerID() string              { return l.makerID }
 func (l *Loan) CreatedAt() time.Time         { return l.createdAt }
 func (l *Loan) UpdatedAt() time.Time         { return l.updatedAt }
 func (l *Loan) Version() int64               { return l.version }
@@ -198,6 +258,14 @@ func (l *Loan) RemainingBalance() valueobject.Money {
 	return l.totalAmount.MustSubtract(l.amountRepaid)
 }
 
+// DisbursementAmount returns the principal net of the origination fee
+func (l *Loan) DisbursementAmount() valueobject.Money {
+	if l.originationFee.Amount() >= l.principal.Amount() {
+		return valueobject.Zero(l.principal.Currency())
+	}
+	return l.principal.MustSubtract(l.originationFee)
+}
+
 // IsFullyRepaid checks if the loan is fully repaid
 func (l *Loan) IsFullyRepaid() bool {
 	return l.amountRepaid.Amount() >= l.totalAmount.Amount()
@@ -213,32 +281,193 @@ func (l *Loan) IsOverdue() bool {
 
 // Business Methods
 
-// Approve approves the loan application
-func (l *Loan) Approve() error {
+// ApplyAutomatedDecision records the decision engine's outcome on a new application.
+// Auto-approved loans move straight to approved, declined loans are rejected and
+// referred loans join the manual underwriting queue.
+func (l *Loan) ApplyAutomatedDecision(outcome DecisionOutcome, reasons []string) error {
 	if l.status != LoanStatusPending {
-		return errors.New("can only approve pending loans")
+		return errors.New("automated decisions only apply to pending loans")
+	}
+	if len(reasons) == 0 {
+		return ErrDecisionReasonNeeded
+	}
+
+	switch outcome {
+	case DecisionAutoApprove:
+		l.recordDecision(outcome, SystemDecider, reasons)
+		l.approve()
+	case DecisionAutoDecline:
+		l.recordDecision(outcome, SystemDecider, reasons)
+		l.reject(reasons)
+	case DecisionRefer:
+		l.recordDecision(outcome, SystemDecider, reasons)
+		l.status = LoanStatusReferred
+		l.updatedAt = time.Now().UTC()
+	default:
+		return ErrInvalidDecision
+	}
+
+	return nil
+}
+
+// Approve approves the loan application on behalf of an underwriter.
+// When dualControl is set the first approval only records the maker's recommendation;
+// a second, different underwriter must approve before the loan can be disbursed.
+func (l *Loan) Approve(decidedBy string, reasons []string, dualControl bool) error {
+	if len(reasons) == 0 {
+		return ErrDecisionReasonNeeded
 	}
 
+	switch l.status {
+	case LoanStatusAwaitingChecker:
+		if decidedBy == l.makerID {
+			return ErrSameApprover
+		}
+	case LoanStatusPending, LoanStatusReferred:
+		if dualControl {
+			l.recordDecision(DecisionRecommendApprove, decidedBy, reasons)
+			l.makerID = decidedBy
+			l.status = LoanStatusAwaitingChecker
+			l.updatedAt = time.Now().UTC()
+			return nil
+		}
+	default:
+		return errors.New("can only approve loans under review")
+	}
+
+	l.recordDecision(DecisionApprove, decidedBy, reasons)
+	l.approve()
+
+	return nil
+}
+
+func (l *Loan) approve() {
 	now := time.Now().UTC()
 	l.status = LoanStatusApproved
 	l.approvedAt = &now
 	l.updatedAt = now
 
-	return nil
+	l.RecordEvent(event.NewLoanApproved(
+		l.id.String(),
+		l.userID.String(),
+		l.principal.Amount(),
+		string(l.principal.Currency()),
+		now,
+	))
 }
 
 // Reject rejects the loan application
-func (l *Loan) Reject(reason string) error {
-	if l.status != LoanStatusPending {
-		return errors.New("can only reject pending loans")
+func (l *Loan) Reject(decidedBy string, reasons []string) error {
+	if !l.status.IsUnderReview() {
+		return errors.New("can only reject loans under review")
+	}
+	if len(reasons) == 0 {
+		return ErrDecisionReasonNeeded
 	}
 
+	l.recordDecision(DecisionReject, decidedBy, reasons)
+	l.reject(reasons)
+
+	return nil
+}
+
+func (l *Loan) reject(reasons []string) {
 	l.status = LoanStatusRejected
+	l.makerID = ""
 	l.updatedAt = time.Now().UTC()
 
+	l.RecordEvent(event.NewLoanRejected(l.id.String(), l.userID.String(), strings.Join(reasons, "; ")))
+
+	l.releaseCollateral()
+}
+
+// CounterOffer proposes revised terms with a lower amount and/or shorter tenure.
+// Interest is repriced at the loan's rate and the origination fee scales with the principal.
+func (l *Loan) CounterOffer(decidedBy string, principal valueobject.Money, tenureMonths int, reasons []string) error {
+	if l.status != LoanStatusPending && l.status != LoanStatusReferred {
+		return errors.New("can only counter-offer loans awaiting a decision")
+	}
+	if len(reasons) == 0 {
+		return ErrDecisionReasonNeeded
+	}
+	if !principal.IsPositive() || principal.Currency() != l.principal.Currency() || tenureMonths < 1 {
+		return ErrInvalidCounterOffer
+	}
+	if principal.GreaterThan(l.principal) || tenureMonths > l.tenureMonths {
+		return ErrInvalidCounterOffer
+	}
+	if principal.Equals(l.principal) && tenureMonths == l.tenureMonths {
+		return ErrInvalidCounterOffer
+	}
+
+	feeAmount := l.originationFee.Amount() * principal.Amount() / l.principal.Amount()
+	interestAmount := int64(float64(principal.Amount()) * l.interestRate * float64(tenureMonths))
+	interest := valueobject.MustNewMoney(interestAmount, principal.Currency())
+
+	l.principal = principal
+	l.tenureMonths = tenureMonths
+	l.interestAmount = interest
+	l.totalAmount = principal.MustAdd(interest)
+	l.originationFee = valueobject.MustNewMoney(feeAmount, principal.Currency())
+	l.makerID = decidedBy
+	l.status = LoanStatusCounterOffered
+	l.updatedAt = time.Now().UTC()
+
+	l.recordDecision(DecisionCounterOffer, decidedBy, reasons)
+
 	return nil
 }
 
+// AcceptCounterOffer records the borrower's acceptance of the revised terms.
+// Offers above the dual-control threshold still need a checker's approval.
+func (l *Loan) AcceptCounterOffer(dualControl bool) error {
+	if l.status != LoanStatusCounterOffered {
+		return ErrNoCounterOffer
+	}
+
+	l.recordDecision(DecisionOfferAccepted, l.userID.String(), []string{"borrower accepted revised terms"})
+
+	if dualControl {
+		l.status = LoanStatusAwaitingChecker
+		l.updatedAt = time.Now().UTC()
+		return nil
+	}
+
+	l.makerID = ""
+	l.approve()
+
+	return nil
+}
+
+// DeclineCounterOffer records the borrower turning down the revised terms
+func (l *Loan) DeclineCounterOffer() error {
+	if l.status != LoanStatusCounterOffered {
+		return ErrNoCounterOffer
+	}
+
+	l.recordDecision(DecisionOfferDeclined, l.userID.String(), []string{"borrower declined revised terms"})
+
+	l.status = LoanStatusOfferDeclined
+	l.makerID = ""
+	l.updatedAt = time.Now().UTC()
+
+	l.releaseCollateral()
+
+	return nil
+}
+
+// LatestDecision returns the most recent underwriting decision, if any
+func (l *Loan) LatestDecision() *LoanDecision {
+	if len(l.decisions) == 0 {
+		return nil
+	}
+	return l.decisions[len(l.decisions)-1]
+}
+
+func (l *Loan) recordDecision(outcome DecisionOutcome, decidedBy string, reasons []string) {
+	l.decisions = append(l.decisions, NewLoanDecision(outcome, decidedBy, reasons, l.principal, l.tenureMonths))
+}
+
 // Disburse marks the loan as disbursed
 func (l *Loan) Disburse() error {
 	if l.status != LoanStatusApproved {
@@ -246,7 +475,7 @@ func (l *Loan) Disburse() error {
 	}
 
 	now := time.Now().UTC()
-	dueDate := now.AddDate(0, l.tenureMonths, 0)
+	dueDate := now.AddDate(0, l.tenureMonths, l.gracePeriodDays)
 
 	l.status = LoanStatusDisbursed
 	l.disbursedAt = &now
@@ -266,6 +495,18 @@ func (l *Loan) StartRepayment() {
 
 // RecordRepayment records a loan repayment
 func (l *Loan) RecordRepayment(repaymentID string, amount valueobject.Money, transactionID valueobject.TransactionID) error {
+	if err := l.applyRepayment(repaymentID, amount, transactionID); err != nil {
+		return err
+	}
+
+	if l.status == LoanStatusCompleted {
+		l.releaseCollateral()
+	}
+
+	return nil
+}
+
+func (l *Loan) applyRepayment(repaymentID string, amount valueobject.Money, transactionID valueobject.TransactionID) error {
 	if l.status != LoanStatusDisbursed && l.status != LoanStatusRepaying {
 		return ErrLoanNotDisbursed
 	}
@@ -299,6 +540,68 @@ func (l *Loan) RecordRepayment(repaymentID string, amount valueobject.Money, tra
 	return nil
 }
 
+// HasRepayment reports whether a repayment with this ID has already been applied
+func (l *Loan) HasRepayment(repaymentID string) bool {
+	for _, r := range l.repayments {
+		if r.id == repaymentID {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyCollateralProceeds applies collateral proceeds, such as a circle payout,
+// against the outstanding balance and returns the amount deducted
+func (l *Loan) ApplyCollateralProceeds(repaymentID string, proceeds valueobject.Money, transactionID valueobject.TransactionID) (valueobject.Money, error) {
+	if l.collateral == nil {
+		return valueobject.Money{}, ErrLoanNotSecured
+	}
+
+	if !proceeds.IsPositive() {
+		return valueobject.Money{}, ErrInvalidLoanAmount
+	}
+
+	deduction := l.RemainingBalance()
+	if proceeds.LessThan(deduction) {
+		deduction = proceeds
+	}
+
+	if err := l.applyRepayment(repaymentID, deduction, transactionID); err != nil {
+		return valueobject.Money{}, err
+	}
+
+	l.RecordEvent(event.NewCollateralApplied(
+		l.id.String(),
+		l.userID.String(),
+		l.collateral.Type().String(),
+		l.collateral.SourceID(),
+		deduction.Amount(),
+		string(deduction.Currency()),
+		l.RemainingBalance().Amount(),
+	))
+
+	if l.status == LoanStatusCompleted {
+		l.releaseCollateral()
+	}
+
+	return deduction, nil
+}
+
+func (l *Loan) releaseCollateral() {
+	if l.collateral == nil {
+		return
+	}
+
+	l.RecordEvent(event.NewCollateralReleased(
+		l.id.String(),
+		l.userID.String(),
+		l.collateral.Type().String(),
+		l.collateral.SourceID(),
+		l.collateral.Value().Amount(),
+		string(l.collateral.Value().Currency()),
+	))
+}
+
 // MarkDefaulted marks the loan as defaulted
 func (l *Loan) MarkDefaulted() error {
 	if l.status != LoanStatusRepaying {
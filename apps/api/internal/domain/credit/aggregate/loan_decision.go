@@ -0,0 +1,81 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Underwriting errors
+var (
+	ErrInvalidDecision      = errors.New("invalid underwriting decision")
+	ErrDecisionReasonNeeded = errors.New("underwriting decision requires at least one reason")
+	ErrSameApprover         = errors.New("checker must be a different person from the maker")
+	ErrInvalidCounterOffer  = errors.New("counter-offer must lower the amount or shorten the tenure")
+	ErrNoCounterOffer       = errors.New("loan has no open counter-offer")
+)
+
+// SystemDecider identifies decisions taken by the automated decision engine
+const SystemDecider = "system"
+
+// DecisionOutcome describes the result of an underwriting step
+type DecisionOutcome string
+
+const (
+	DecisionAutoApprove      DecisionOutcome = "auto_approve"
+	DecisionAutoDecline      DecisionOutcome = "auto_decline"
+	DecisionRefer            DecisionOutcome = "refer"
+	DecisionRecommendApprove DecisionOutcome = "recommend_approve" // Maker step under dual control
+	DecisionApprove          DecisionOutcome = "approve"
+	DecisionReject           DecisionOutcome = "reject"
+	DecisionCounterOffer     DecisionOutcome = "counter_offer"
+	DecisionOfferAccepted    DecisionOutcome = "offer_accepted"
+	DecisionOfferDeclined    DecisionOutcome = "offer_declined"
+)
+
+func (o DecisionOutcome) String() string {
+	return string(o)
+}
+
+// LoanDecision is an immutable record of an underwriting decision.
+// Decisions are kept on the loan so the full audit trail can be produced for compliance.
+type LoanDecision struct {
+	outcome      DecisionOutcome
+	decidedBy    string
+	reasons      []string
+	principal    valueobject.Money // Terms in force when the decision was taken
+	tenureMonths int
+	decidedAt    time.Time
+}
+
+// NewLoanDecision creates a decision record
+func NewLoanDecision(outcome DecisionOutcome, decidedBy string, reasons []string, principal valueobject.Money, tenureMonths int) *LoanDecision {
+	return &LoanDecision{
+		outcome:      outcome,
+		decidedBy:    decidedBy,
+		reasons:      reasons,
+		principal:    principal,
+		tenureMonths: tenureMonths,
+		decidedAt:    time.Now().UTC(),
+	}
+}
+
+// ReconstructLoanDecision reconstructs a decision from persistence
+func ReconstructLoanDecision(outcome DecisionOutcome, decidedBy string, reasons []string, principal valueobject.Money, tenureMonths int, decidedAt time.Time) *LoanDecision {
+	return &LoanDecision{
+		outcome:      outcome,
+		decidedBy:    decidedBy,
+		reasons:      reasons,
+		principal:    principal,
+		tenureMonths: tenureMonths,
+		decidedAt:    decidedAt,
+	}
+}
+
+func (d *LoanDecision) Outcome() DecisionOutcome     { return d.outcome }
+func (d *LoanDecision) DecidedBy() string            { return d.decidedBy }
+func (d *LoanDecision) Reasons() []string            { return d.reasons }
+func (d *LoanDecision) Principal() valueobject.Money { return d.principal }
+func (d *LoanDecision) TenureMonths() int            { return d.tenureMonths }
+func (d *LoanDecision) DecidedAt() time.Time         { return d.decidedAt }
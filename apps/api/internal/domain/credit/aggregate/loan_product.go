@@ -0,0 +1,430 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Loan product errors
+var (
+	ErrInvalidLoanProduct  = errors.New("invalid loan product definition")
+	ErrLoanProductInactive = errors.New("loan product is not currently offered")
+	ErrTierNotEligible     = errors.New("loan product is not offered to your tier")
+	ErrScoreTooLow         = errors.New("credit score is below the product minimum")
+	ErrKYCLevelTooLow      = errors.New("verification level is below the product minimum")
+	ErrAccountTooNew       = errors.New("account is too new for this loan product")
+	ErrCurrencyMismatch    = errors.New("loan currency does not match product currency")
+	ErrBelowMinimumAmount  = errors.New("loan amount is below the product minimum")
+)
+
+// LoanProductCategory groups loan products by purpose
+type LoanProductCategory string
+
+const (
+	LoanCategoryPersonal      LoanProductCategory = "personal"
+	LoanCategorySalaryAdvance LoanProductCategory = "salary_advance"
+	LoanCategorySavingsBacked LoanProductCategory = "savings_backed"
+	LoanCategoryEquipment     LoanProductCategory = "equipment"
+	LoanCategoryCircleBacked  LoanProductCategory = "circle_backed"
+)
+
+func (c LoanProductCategory) String() string {
+	return string(c)
+}
+
+// KYCLevel represents how far a user has progressed through identity verification.
+// The values mirror the identity context's KYC tiers.
+type KYCLevel int
+
+const (
+	KYCLevelNone  KYCLevel = 0 // Not verified
+	KYCLevelBasic KYCLevel = 1 // Tier 1: phone verified
+	KYCLevelBVN   KYCLevel = 2 // Tier 2: BVN or NIN matched to the profile
+	KYCLevelFull  KYCLevel = 3 // Tier 3: address and ID document verified
+)
+
+// TierPricing is the limit and monthly rate a product offers to a tier
+type TierPricing struct {
+	MaxAmount   int64
+	MonthlyRate float64
+}
+
+// EligibilityRules are the minimum requirements to apply for a product
+type EligibilityRules struct {
+	MinScore      int
+	MinKYCLevel   KYCLevel
+	MinAccountAge time.Duration
+}
+
+// LoanApplicant is the borrower snapshot a product is evaluated against
+type LoanApplicant struct {
+	Score      int
+	Tier       UserTier
+	KYCLevel   KYCLevel
+	AccountAge time.Duration
+}
+
+// LoanProduct is the aggregate root for a lending product in the catalog
+type LoanProduct struct {
+	sharedevent.AggregateRoot
+
+	id                 string
+	code               string
+	name               string
+	description        string
+	category           LoanProductCategory
+	currency           valueobject.Currency
+	minAmount          int64
+	maxAmount          int64
+	minTenureMonths    int
+	maxTenureMonths    int
+	pricing            map[UserTier]TierPricing
+	originationFeeRate float64 // Fraction of principal
+	gracePeriodDays    int
+	eligibility        EligibilityRules
+	collateralType     CollateralType
+	loanToValue        float64 // Max principal as a fraction of collateral value
+	isActive           bool
+	createdAt          time.Time
+	updatedAt          time.Time
+	version            int64
+}
+
+// NewLoanProduct creates a new loan product
+func NewLoanProduct(
+	id string,
+	code string,
+	name string,
+	category LoanProductCategory,
+	currency valueobject.Currency,
+	minAmount int64,
+	maxAmount int64,
+	minTenureMonths int,
+	maxTenureMonths int,
+	originationFeeRate float64,
+	gracePeriodDays int,
+	eligibility EligibilityRules,
+) (*LoanProduct, error) {
+	if code == "" || name == "" {
+		return nil, ErrInvalidLoanProduct
+	}
+	if !currency.IsValid() || minAmount <= 0 || maxAmount < minAmount {
+		return nil, ErrInvalidLoanProduct
+	}
+	if minTenureMonths < 1 || maxTenureMonths < minTenureMonths {
+		return nil, ErrInvalidLoanProduct
+	}
+	if originationFeeRate < 0 || originationFeeRate >= 1 || gracePeriodDays < 0 {
+		return nil, ErrInvalidLoanProduct
+	}
+
+	now := time.Now().UTC()
+	return &LoanProduct{
+		id:                 id,
+		code:               code,
+		name:               name,
+		category:           category,
+		currency:           currency,
+		minAmount:          minAmount,
+		maxAmount:          maxAmount,
+		minTenureMonths:    minTenureMonths,
+		maxTenureMonths:    maxTenureMonths,
+		pricing:            make(map[UserTier]TierPricing),
+		originationFeeRate: originationFeeRate,
+		gracePeriodDays:    gracePeriodDays,
+		eligibility:        eligibility,
+		collateralType:     CollateralNone,
+		isActive:           true,
+		createdAt:          now,
+		updatedAt:          now,
+		version:            1,
+	}, nil
+}
+
+// ReconstructLoanProduct reconstructs from persistence
+func ReconstructLoanProduct(
+	id string,
+	code string,
+	name string,
+	description string,
+	category LoanProductCategory,
+	currency valueobject.Currency,
+	minAmount int64,
+	maxAmount int64,
+	minTenureMonths int,
+	maxTenureMonths int,
+	pricing map[UserTier]TierPricing,
+	originationFeeRate float64,
+	gracePeriodDays int,
+	eligibility EligibilityRules,
+	collateralType CollateralType,
+	loanToValue float64,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int64,
+) *LoanProduct {
+	if pricing == nil {
+		pricing = make(map[UserTier]TierPricing)
+	}
+	return &LoanProduct{
+		id:                 id,
+		code:               code,
+		name:               name,
+		description:        description,
+		category:           category,
+		currency:           currency,
+		minAmount:          minAmount,
+		maxAmount:          maxAmount,
+		minTenureMonths:    minTenureMonths,
+		maxTenureMonths:    maxTenureMonths,
+		pricing:            pricing,
+		originationFeeRate: originationFeeRate,
+		gracePeriodDays:    gracePeriodDays,
+		eligibility:        eligibility,
+		collateralType:     collateralType,
+		loanToValue:        loanToValue,
+		isActive:           isActive,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+		version:            version,
+	}
+}
+
+// Getters
+func (p *LoanProduct) ID() string                     { return p.id }
+func (p *LoanProduct) Code() string                   { return p.code }
+func (p *LoanProduct) Name() string                   { return p.name }
+func (p *LoanProduct) Description() string            { return p.description }
+func (p *LoanProduct) Category() LoanProductCategory  { return p.category }
+func (p *LoanProduct) Currency() valueobject.Currency { return p.currency }
+func (p *LoanProduct) MinAmount() int64               { return p.minAmount }
+func (p *LoanProduct) MaxAmount() int64               { return p.maxAmount }
+func (p *LoanProduct) MinTenureMonths() int           { return p.minTenureMonths }
+func (p *LoanProduct) MaxTenureMonths() int           { return p.maxTenureMonths }
+func (p *LoanProduct) OriginationFeeRate() float64    { return p.originationFeeRate }
+func (p *LoanProduct) GracePeriodDays() int           { return p.gracePeriodDays }
+func (p *LoanProduct) Eligibility() EligibilityRules  { return p.eligibility }
+func (p *LoanProduct) CollateralType() CollateralType { return p.collateralType }
+func (p *LoanProduct) LoanToValue() float64           { return p.loanToValue }
+func (p *LoanProduct) IsSecured() bool                { return p.collateralType != CollateralNone }
+func (p *LoanProduct) IsActive() bool                 { return p.isActive }
+func (p *LoanProduct) CreatedAt() time.Time           { return p.createdAt }
+func (p *LoanProduct) UpdatedAt() time.Time           { return p.updatedAt }
+func (p *LoanProduct) Version() int64                 { return p.version }
+
+// Pricing returns a copy of the per-tier pricing table
+func (p *LoanProduct) Pricing() map[UserTier]TierPricing {
+	pricing := make(map[UserTier]TierPricing, len(p.pricing))
+	for tier, tp := range p.pricing {
+		pricing[tier] = tp
+	}
+	return pricing
+}
+
+// Business Methods
+
+// SetDescription updates the product description
+func (p *LoanProduct) SetDescription(description string) {
+	p.description = description
+	p.updatedAt = time.Now().UTC()
+}
+
+// SetTierPricing sets the limit and rate offered to a tier
+func (p *LoanProduct) SetTierPricing(tier UserTier, pricing TierPricing) error {
+	if pricing.MaxAmount <= 0 || pricing.MonthlyRate < 0 {
+		return ErrInvalidLoanProduct
+	}
+	p.pricing[tier] = pricing
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// RequireCollateral makes the product a secured loan against the given collateral type
+func (p *LoanProduct) RequireCollateral(collateralType CollateralType, loanToValue float64) error {
+	if collateralType == CollateralNone || loanToValue <= 0 || loanToValue > 1 {
+		return ErrInvalidLoanProduct
+	}
+	p.collateralType = collateralType
+	p.loanToValue = loanToValue
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// Activate makes the product available for new applications
+func (p *LoanProduct) Activate() {
+	p.isActive = true
+	p.updatedAt = time.Now().UTC()
+}
+
+// Deactivate withdraws the product from new applications
+func (p *LoanProduct) Deactivate() {
+	p.isActive = false
+	p.updatedAt = time.Now().UTC()
+}
+
+// PricingFor returns the pricing for a tier, if the tier is offered
+func (p *LoanProduct) PricingFor(tier UserTier) (TierPricing, bool) {
+	pricing, ok := p.pricing[tier]
+	return pricing, ok
+}
+
+// MaxAmountFor returns the largest principal a tier can borrow on this product
+func (p *LoanProduct) MaxAmountFor(tier UserTier) int64 {
+	pricing, ok := p.pricing[tier]
+	if !ok {
+		return 0
+	}
+	if pricing.MaxAmount < p.maxAmount {
+		return pricing.MaxAmount
+	}
+	return p.maxAmount
+}
+
+// CheckEligibility verifies the applicant meets the product's rules
+func (p *LoanProduct) CheckEligibility(applicant LoanApplicant) error {
+	if !p.isActive {
+		return ErrLoanProductInactive
+	}
+	if _, ok := p.pricing[applicant.Tier]; !ok {
+		return ErrTierNotEligible
+	}
+	if applicant.Score < p.eligibility.MinScore {
+		return ErrScoreTooLow
+	}
+	if applicant.KYCLevel < p.eligibility.MinKYCLevel {
+		return ErrKYCLevelTooLow
+	}
+	if applicant.AccountAge < p.eligibility.MinAccountAge {
+		return ErrAccountTooNew
+	}
+	return nil
+}
+
+// ValidateTerms checks a requested principal and tenure against the product
+func (p *LoanProduct) ValidateTerms(principal valueobject.Money, tenureMonths int, tier UserTier) error {
+	if principal.Currency() != p.currency {
+		return ErrCurrencyMismatch
+	}
+	if principal.Amount() <= 0 {
+		return ErrInvalidLoanAmount
+	}
+	if principal.Amount() < p.minAmount {
+		return ErrBelowMinimumAmount
+	}
+	if principal.Amount() > p.MaxAmountFor(tier) {
+		return ErrLoanExceedsLimit
+	}
+	if tenureMonths < p.minTenureMonths || tenureMonths > p.maxTenureMonths {
+		return ErrInvalidTenure
+	}
+	return nil
+}
+
+// ValidateCollateral checks a pledge covers the principal under the product's loan-to-value
+func (p *LoanProduct) ValidateCollateral(principal valueobject.Money, collateral *Collateral) error {
+	if !p.IsSecured() {
+		if collateral != nil {
+			return ErrCollateralNotAccepted
+		}
+		return nil
+	}
+	if collateral == nil {
+		return ErrCollateralRequired
+	}
+	if collateral.Type() != p.collateralType {
+		return ErrCollateralNotAccepted
+	}
+	if collateral.Value().Currency() != principal.Currency() {
+		return ErrCurrencyMismatch
+	}
+	maxSecured := int64(float64(collateral.Value().Amount()) * p.loanToValue)
+	if principal.Amount() > maxSecured {
+		return ErrInsufficientCollateral
+	}
+	return nil
+}
+
+// OriginationFee calculates the upfront fee for a principal
+func (p *LoanProduct) OriginationFee(principal valueobject.Money) valueobject.Money {
+	fee := int64(float64(principal.Amount()) * p.originationFeeRate)
+	return valueobject.MustNewMoney(fee, principal.Currency())
+}
+
+// DefaultLoanProducts returns the standard catalog seeded for new deployments.
+// Each product's ID is its code, so every deployment and every reseed agrees on it.
+func DefaultLoanProducts() []*LoanProduct {
+	tiers := []UserTier{TierBronze, TierSilver, TierGold, TierPlatinum}
+	products := make([]*LoanProduct, 0, 5)
+
+	// General purpose microloan, priced on the historical tier table
+	personal, _ := NewLoanProduct(
+		"personal", "personal", "Hustle Microloan",
+		LoanCategoryPersonal, valueobject.NGN,
+		1000*100, 500000*100, 1, 12, 0, 0,
+		EligibilityRules{MinKYCLevel: KYCLevelBasic},
+	)
+	personal.SetDescription("General purpose microloan for working capital")
+	for _, tier := range tiers {
+		personal.SetTierPricing(tier, TierPricing{MaxAmount: tier.MaxLoanAmount(), MonthlyRate: tier.InterestRate()})
+	}
+	products = append(products, personal)
+
+	// Short salary advance against upcoming gig earnings
+	salary, _ := NewLoanProduct(
+		"salary_advance", "salary_advance", "Earnings Advance",
+		LoanCategorySalaryAdvance, valueobject.NGN,
+		1000*100, 100000*100, 1, 1, 0.01, 0,
+		EligibilityRules{MinScore: 400, MinKYCLevel: KYCLevelBVN, MinAccountAge: 90 * 24 * time.Hour},
+	)
+	salary.SetDescription("One-month advance on expected gig earnings")
+	salary.SetTierPricing(TierSilver, TierPricing{MaxAmount: 30000 * 100, MonthlyRate: 0.04})
+	salary.SetTierPricing(TierGold, TierPricing{MaxAmount: 60000 * 100, MonthlyRate: 0.03})
+	salary.SetTierPricing(TierPlatinum, TierPricing{MaxAmount: 100000 * 100, MonthlyRate: 0.025})
+	products = append(products, salary)
+
+	// Loan secured against the borrower's own savings
+	savings, _ := NewLoanProduct(
+		"savings_backed", "savings_backed", "Savings-Backed Loan",
+		LoanCategorySavingsBacked, valueobject.NGN,
+		5000*100, 1000000*100, 1, 12, 0.005, 7,
+		EligibilityRules{MinKYCLevel: KYCLevelBasic},
+	)
+	savings.SetDescription("Borrow against your savings at a reduced rate")
+	savings.RequireCollateral(CollateralLockedSavings, 0.9)
+	for _, tier := range tiers {
+		savings.SetTierPricing(tier, TierPricing{MaxAmount: 1000000 * 100, MonthlyRate: 0.015})
+	}
+	products = append(products, savings)
+
+	// Advance against a member's upcoming savings circle payout
+	circle, _ := NewLoanProduct(
+		"circle_backed", "circle_backed", "Circle Payout Advance",
+		LoanCategoryCircleBacked, valueobject.NGN,
+		5000*100, 2000000*100, 1, 12, 0.005, 0,
+		EligibilityRules{MinKYCLevel: KYCLevelBasic},
+	)
+	circle.SetDescription("Borrow now against your expected savings circle payout")
+	circle.RequireCollateral(CollateralCirclePayout, 0.8)
+	for _, tier := range tiers {
+		circle.SetTierPricing(tier, TierPricing{MaxAmount: 2000000 * 100, MonthlyRate: 0.02})
+	}
+	products = append(products, circle)
+
+	// Tools and equipment financing for artisans
+	equipment, _ := NewLoanProduct(
+		"artisan_equipment", "artisan_equipment", "Artisan Equipment Loan",
+		LoanCategoryEquipment, valueobject.NGN,
+		20000*100, 1500000*100, 3, 18, 0.02, 30,
+		EligibilityRules{MinScore: 600, MinKYCLevel: KYCLevelFull, MinAccountAge: 180 * 24 * time.Hour},
+	)
+	equipment.SetDescription("Finance tools and equipment for your trade")
+	equipment.SetTierPricing(TierGold, TierPricing{MaxAmount: 750000 * 100, MonthlyRate: 0.025})
+	equipment.SetTierPricing(TierPlatinum, TierPricing{MaxAmount: 1500000 * 100, MonthlyRate: 0.02})
+	products = append(products, equipment)
+
+	return products
+}
@@ -0,0 +1,280 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func newTestProduct(t *testing.T) *LoanProduct {
+	t.Helper()
+	product, err := NewLoanProduct(
+		"product-1", "test", "Test Loan", LoanCategoryPersonal, valueobject.NGN,
+		1000*100, 100000*100, 1, 6, 0.01, 7,
+		EligibilityRules{MinScore: 300, MinKYCLevel: KYCLevelBasic, MinAccountAge: 30 * 24 * time.Hour},
+	)
+	if err != nil {
+		t.Fatalf("NewLoanProduct() error = %v", err)
+	}
+	product.SetTierPricing(TierSilver, TierPricing{MaxAmount: 50000 * 100, MonthlyRate: 0.04})
+	product.SetTierPricing(TierGold, TierPricing{MaxAmount: 200000 * 100, MonthlyRate: 0.03})
+	return product
+}
+
+func TestNewLoanProduct_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		minAmount int64
+		maxAmount int64
+		minTenure int
+		maxTenure int
+		feeRate   float64
+	}{
+		{"zero min amount", 0, 1000, 1, 12, 0},
+		{"max below min", 5000, 1000, 1, 12, 0},
+		{"zero min tenure", 1000, 5000, 0, 12, 0},
+		{"max tenure below min", 1000, 5000, 6, 3, 0},
+		{"fee rate too high", 1000, 5000, 1, 12, 1.5},
+	}
+
+	for _, tt := range tests {
+		_, err := NewLoanProduct("id", "code", "name", LoanCategoryPersonal, valueobject.NGN,
+			tt.minAmount, tt.maxAmount, tt.minTenure, tt.maxTenure, tt.feeRate, 0, EligibilityRules{})
+		if err != ErrInvalidLoanProduct {
+			t.Errorf("%s: NewLoanProduct() error = %v, want %v", tt.name, err, ErrInvalidLoanProduct)
+		}
+	}
+}
+
+func TestLoanProduct_MaxAmountFor(t *testing.T) {
+	product := newTestProduct(t)
+
+	tests := []struct {
+		tier UserTier
+		want int64
+	}{
+		{TierBronze, 0},
+		{TierSilver, 50000 * 100},
+		{TierGold, 100000 * 100}, // capped by product maximum
+	}
+
+	for _, tt := range tests {
+		got := product.MaxAmountFor(tt.tier)
+		if got != tt.want {
+			t.Errorf("MaxAmountFor(%s) = %d, want %d", tt.tier, got, tt.want)
+		}
+	}
+}
+
+func TestLoanProduct_CheckEligibility(t *testing.T) {
+	product := newTestProduct(t)
+	eligible := LoanApplicant{Score: 450, Tier: TierSilver, KYCLevel: KYCLevelBVN, AccountAge: 60 * 24 * time.Hour}
+
+	tests := []struct {
+		name   string
+		modify func(a *LoanApplicant)
+		want   error
+	}{
+		{"eligible", func(a *LoanApplicant) {}, nil},
+		{"tier not offered", func(a *LoanApplicant) { a.Tier = TierBronze }, ErrTierNotEligible},
+		{"score too low", func(a *LoanApplicant) { a.Score = 200 }, ErrScoreTooLow},
+		{"kyc too low", func(a *LoanApplicant) { a.KYCLevel = KYCLevelNone }, ErrKYCLevelTooLow},
+		{"account too new", func(a *LoanApplicant) { a.AccountAge = 24 * time.Hour }, ErrAccountTooNew},
+	}
+
+	for _, tt := range tests {
+		applicant := eligible
+		tt.modify(&applicant)
+		if err := product.CheckEligibility(applicant); err != tt.want {
+			t.Errorf("%s: CheckEligibility() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	product.Deactivate()
+	if err := product.CheckEligibility(eligible); err != ErrLoanProductInactive {
+		t.Errorf("CheckEligibility() on inactive product = %v, want %v", err, ErrLoanProductInactive)
+	}
+}
+
+func TestLoanProduct_ValidateTerms(t *testing.T) {
+	product := newTestProduct(t)
+
+	tests := []struct {
+		name   string
+		amount valueobject.Money
+		tenure int
+		want   error
+	}{
+		{"valid", valueobject.MustNewMoney(20000*100, valueobject.NGN), 3, nil},
+		{"wrong currency", valueobject.MustNewMoney(20000*100, valueobject.USD), 3, ErrCurrencyMismatch},
+		{"below minimum", valueobject.MustNewMoney(500*100, valueobject.NGN), 3, ErrBelowMinimumAmount},
+		{"above tier limit", valueobject.MustNewMoney(60000*100, valueobject.NGN), 3, ErrLoanExceedsLimit},
+		{"tenure too long", valueobject.MustNewMoney(20000*100, valueobject.NGN), 12, ErrInvalidTenure},
+	}
+
+	for _, tt := range tests {
+		if err := product.ValidateTerms(tt.amount, tt.tenure, TierSilver); err != tt.want {
+			t.Errorf("%s: ValidateTerms() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestNewLoan_UsesProductPricing(t *testing.T) {
+	product := newTestProduct(t)
+	principal := valueobject.MustNewMoney(10000*100, valueobject.NGN)
+
+	loan, err := NewLoan(valueobject.GenerateLoanID(), valueobject.GenerateUserID(), product, TierGold, principal, 2, "stock", nil)
+	if err != nil {
+		t.Fatalf("NewLoan() error = %v", err)
+	}
+
+	if loan.InterestRate() != 0.03 {
+		t.Errorf("InterestRate() = %f, want 0.03", loan.InterestRate())
+	}
+	if loan.InterestAmount().Amount() != 60000 {
+		t.Errorf("InterestAmount() = %d, want 60000", loan.InterestAmount().Amount())
+	}
+	if loan.OriginationFee().Amount() != 10000 {
+		t.Errorf("OriginationFee() = %d, want 10000", loan.OriginationFee().Amount())
+	}
+	if loan.DisbursementAmount().Amount() != 990000 {
+		t.Errorf("DisbursementAmount() = %d, want 990000", loan.DisbursementAmount().Amount())
+	}
+	if loan.ProductID() != product.ID() {
+		t.Errorf("ProductID() = %s, want %s", loan.ProductID(), product.ID())
+	}
+}
+
+func TestDefaultLoanProducts(t *testing.T) {
+	products := DefaultLoanProducts()
+	if len(products) != 5 {
+		t.Fatalf("DefaultLoanProducts() returned %d products, want 5", len(products))
+	}
+
+	for _, p := range products {
+		if len(p.Pricing()) == 0 {
+			t.Errorf("product %s has no tier pricing", p.Code())
+		}
+		if p.ID() != p.Code() {
+			t.Errorf("product %s ID = %s, want its code", p.Code(), p.ID())
+		}
+	}
+
+	// The general microloan preserves the historical tier limits
+	personal := products[0]
+	for _, tier := range []UserTier{TierBronze, TierSilver, TierGold, TierPlatinum} {
+		if personal.MaxAmountFor(tier) != tier.MaxLoanAmount() {
+			t.Errorf("personal.MaxAmountFor(%s) = %d, want %d", tier, personal.MaxAmountFor(tier), tier.MaxLoanAmount())
+		}
+	}
+}
+
+func newSecuredProduct(t *testing.T) *LoanProduct {
+	t.Helper()
+	product := newTestProduct(t)
+	if err := product.RequireCollateral(CollateralCirclePayout, 0.8); err != nil {
+		t.Fatalf("RequireCollateral() error = %v", err)
+	}
+	return product
+}
+
+func TestLoanProduct_ValidateCollateral(t *testing.T) {
+	product := newSecuredProduct(t)
+	principal := valueobject.MustNewMoney(40000*100, valueobject.NGN)
+
+	covering, _ := NewCollateral(CollateralCirclePayout, "circle-1", valueobject.MustNewMoney(50000*100, valueobject.NGN))
+	short, _ := NewCollateral(CollateralCirclePayout, "circle-1", valueobject.MustNewMoney(45000*100, valueobject.NGN))
+	savings, _ := NewCollateral(CollateralLockedSavings, "goal-1", valueobject.MustNewMoney(50000*100, valueobject.NGN))
+
+	tests := []struct {
+		name       string
+		collateral *Collateral
+		want       error
+	}{
+		{"covered at 80% LTV", covering, nil},
+		{"missing collateral", nil, ErrCollateralRequired},
+		{"below LTV", short, ErrInsufficientCollateral},
+		{"wrong type", savings, ErrCollateralNotAccepted},
+	}
+
+	for _, tt := range tests {
+		if err := product.ValidateCollateral(principal, tt.collateral); err != tt.want {
+			t.Errorf("%s: ValidateCollateral() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	unsecured := newTestProduct(t)
+	if err := unsecured.ValidateCollateral(principal, covering); err != ErrCollateralNotAccepted {
+		t.Errorf("unsecured ValidateCollateral() = %v, want %v", err, ErrCollateralNotAccepted)
+	}
+}
+
+func newSecuredLoan(t *testing.T) *Loan {
+	t.Helper()
+	product := newSecuredProduct(t)
+	collateral, _ := NewCollateral(CollateralCirclePayout, "circle-1", valueobject.MustNewMoney(50000*100, valueobject.NGN))
+	principal := valueobject.MustNewMoney(10000*100, valueobject.NGN)
+
+	loan, err := NewLoan(valueobject.GenerateLoanID(), valueobject.GenerateUserID(), product, TierSilver, principal, 1, "stock", collateral)
+	if err != nil {
+		t.Fatalf("NewLoan() error = %v", err)
+	}
+	return loan
+}
+
+func TestNewLoan_SecuredRecordsPledge(t *testing.T) {
+	loan := newSecuredLoan(t)
+
+	if !loan.IsSecured() {
+		t.Fatal("IsSecured() = false, want true")
+	}
+
+	events := loan.DomainEvents()
+	if len(events) != 1 || events[0].EventType() != "credit.collateral.pledged" {
+		t.Errorf("NewLoan() events = %v, want one credit.collateral.pledged", events)
+	}
+}
+
+func TestLoan_ApplyCollateralProceeds(t *testing.T) {
+	loan := newSecuredLoan(t)
+	loan.Approve("underwriter-1", []string{"meets policy"}, false)
+	loan.Disburse()
+	loan.ClearEvents()
+
+	// Payout larger than the balance only deducts what is owed
+	payout := valueobject.MustNewMoney(50000*100, valueobject.NGN)
+	applied, err := loan.ApplyCollateralProceeds("rep-1", payout, valueobject.GenerateTransactionID())
+	if err != nil {
+		t.Fatalf("ApplyCollateralProceeds() error = %v", err)
+	}
+
+	if !applied.Equals(loan.TotalAmount()) {
+		t.Errorf("ApplyCollateralProceeds() applied = %d, want %d", applied.Amount(), loan.TotalAmount().Amount())
+	}
+	if loan.Status() != LoanStatusCompleted {
+		t.Errorf("Status() = %s, want completed", loan.Status())
+	}
+	if !loan.HasRepayment("rep-1") || loan.HasRepayment("rep-2") {
+		t.Error("HasRepayment() should only report applied repayment IDs")
+	}
+
+	events := loan.DomainEvents()
+	if len(events) != 2 {
+		t.Fatalf("ApplyCollateralProceeds() recorded %d events, want 2", len(events))
+	}
+	if events[0].EventType() != "credit.collateral.applied" || events[1].EventType() != "credit.collateral.released" {
+		t.Errorf("ApplyCollateralProceeds() events = %s, %s", events[0].EventType(), events[1].EventType())
+	}
+}
+
+func TestLoan_ApplyCollateralProceeds_Unsecured(t *testing.T) {
+	product := newTestProduct(t)
+	principal := valueobject.MustNewMoney(10000*100, valueobject.NGN)
+	loan, _ := NewLoan(valueobject.GenerateLoanID(), valueobject.GenerateUserID(), product, TierSilver, principal, 1, "stock", nil)
+
+	_, err := loan.ApplyCollateralProceeds("rep-1", principal, valueobject.GenerateTransactionID())
+	if err != ErrLoanNotSecured {
+		t.Errorf("ApplyCollateralProceeds() error = %v, want %v", err, ErrLoanNotSecured)
+	}
+}
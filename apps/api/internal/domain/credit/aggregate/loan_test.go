@@ -0,0 +1,123 @@
+package aggregate
+
+import (
+	"testing"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func newPendingLoan(t *testing.T) *Loan {
+	t.Helper()
+	product := newTestProduct(t)
+	principal := valueobject.MustNewMoney(40000*100, valueobject.NGN)
+
+	loan, err := NewLoan(valueobject.GenerateLoanID(), valueobject.GenerateUserID(), product, TierSilver, principal, 4, "stock", nil)
+	if err != nil {
+		t.Fatalf("NewLoan() error = %v", err)
+	}
+	return loan
+}
+
+func TestLoan_ApplyAutomatedDecision(t *testing.T) {
+	tests := []struct {
+		outcome DecisionOutcome
+		want    LoanStatus
+	}{
+		{DecisionAutoApprove, LoanStatusApproved},
+		{DecisionAutoDecline, LoanStatusRejected},
+		{DecisionRefer, LoanStatusReferred},
+	}
+
+	for _, tt := range tests {
+		loan := newPendingLoan(t)
+		if err := loan.ApplyAutomatedDecision(tt.outcome, []string{"rule matched"}); err != nil {
+			t.Fatalf("ApplyAutomatedDecision(%s) error = %v", tt.outcome, err)
+		}
+		if loan.Status() != tt.want {
+			t.Errorf("ApplyAutomatedDecision(%s) status = %s, want %s", tt.outcome, loan.Status(), tt.want)
+		}
+		if d := loan.LatestDecision(); d == nil || d.DecidedBy() != SystemDecider {
+			t.Errorf("ApplyAutomatedDecision(%s) did not record a system decision", tt.outcome)
+		}
+	}
+
+	loan := newPendingLoan(t)
+	if err := loan.ApplyAutomatedDecision(DecisionAutoApprove, nil); err != ErrDecisionReasonNeeded {
+		t.Errorf("ApplyAutomatedDecision() without reasons = %v, want %v", err, ErrDecisionReasonNeeded)
+	}
+}
+
+func TestLoan_Approve_DualControl(t *testing.T) {
+	loan := newPendingLoan(t)
+	loan.ApplyAutomatedDecision(DecisionRefer, []string{"amount above auto-approval limit"})
+
+	if err := loan.Approve("maker", []string{"verified income"}, true); err != nil {
+		t.Fatalf("Approve() maker error = %v", err)
+	}
+	if loan.Status() != LoanStatusAwaitingChecker {
+		t.Fatalf("Status() = %s, want awaiting_checker", loan.Status())
+	}
+
+	if err := loan.Approve("maker", []string{"verified income"}, true); err != ErrSameApprover {
+		t.Errorf("Approve() by maker again = %v, want %v", err, ErrSameApprover)
+	}
+
+	if err := loan.Approve("checker", []string{"agree with maker"}, true); err != nil {
+		t.Fatalf("Approve() checker error = %v", err)
+	}
+	if loan.Status() != LoanStatusApproved {
+		t.Errorf("Status() = %s, want approved", loan.Status())
+	}
+	if len(loan.Decisions()) != 3 {
+		t.Errorf("Decisions() = %d, want 3", len(loan.Decisions()))
+	}
+}
+
+func TestLoan_CounterOffer(t *testing.T) {
+	loan := newPendingLoan(t)
+	loan.ApplyAutomatedDecision(DecisionRefer, []string{"thin credit file"})
+	originalFee := loan.OriginationFee().Amount()
+
+	higher := valueobject.MustNewMoney(45000*100, valueobject.NGN)
+	if err := loan.CounterOffer("underwriter", higher, 4, []string{"too high"}); err != ErrInvalidCounterOffer {
+		t.Errorf("CounterOffer() with higher amount = %v, want %v", err, ErrInvalidCounterOffer)
+	}
+
+	lower := valueobject.MustNewMoney(20000*100, valueobject.NGN)
+	if err := loan.CounterOffer("underwriter", lower, 2, []string{"reduce exposure"}); err != nil {
+		t.Fatalf("CounterOffer() error = %v", err)
+	}
+
+	if loan.Status() != LoanStatusCounterOffered {
+		t.Errorf("Status() = %s, want counter_offered", loan.Status())
+	}
+	if loan.InterestAmount().Amount() != int64(20000*100*0.04*2) {
+		t.Errorf("InterestAmount() = %d, want repriced interest", loan.InterestAmount().Amount())
+	}
+	if loan.OriginationFee().Amount() != originalFee/2 {
+		t.Errorf("OriginationFee() = %d, want %d", loan.OriginationFee().Amount(), originalFee/2)
+	}
+
+	if err := loan.AcceptCounterOffer(false); err != nil {
+		t.Fatalf("AcceptCounterOffer() error = %v", err)
+	}
+	if loan.Status() != LoanStatusApproved {
+		t.Errorf("Status() = %s, want approved", loan.Status())
+	}
+}
+
+func TestLoan_DeclineCounterOffer(t *testing.T) {
+	loan := newPendingLoan(t)
+
+	if err := loan.DeclineCounterOffer(); err != ErrNoCounterOffer {
+		t.Errorf("DeclineCounterOffer() without offer = %v, want %v", err, ErrNoCounterOffer)
+	}
+
+	loan.CounterOffer("underwriter", loan.Principal(), 2, []string{"shorter tenure"})
+	if err := loan.DeclineCounterOffer(); err != nil {
+		t.Fatalf("DeclineCounterOffer() error = %v", err)
+	}
+	if loan.Status() != LoanStatusOfferDeclined {
+		t.Errorf("Status() = %s, want offer_declined", loan.Status())
+	}
+}
@@ -248,3 +248,74 @@ func NewLoanOverdue(loanID, userID string, outstanding int64, currency string, d
 		DaysOverdue:       days,
 	}
 }
+
+// CollateralPledged is raised when a secured loan pledges collateral
+type CollateralPledged struct {
+	sharedevent.BaseEvent
+	LoanID         string
+	UserID         string
+	CollateralType string
+	SourceID       string // Circle ID or savings goal ID
+	Amount         int64
+	Currency       string
+}
+
+func NewCollateralPledged(loanID, userID, collateralType, sourceID string, amount int64, currency string) CollateralPledged {
+	return CollateralPledged{
+		BaseEvent:      sharedevent.NewBaseEvent("credit.collateral.pledged"),
+		LoanID:         loanID,
+		UserID:         userID,
+		CollateralType: collateralType,
+		SourceID:       sourceID,
+		Amount:         amount,
+		Currency:       currency,
+	}
+}
+
+// CollateralApplied is raised when collateral proceeds are applied to a loan balance
+type CollateralApplied struct {
+	sharedevent.BaseEvent
+	LoanID           string
+	UserID           string
+	CollateralType   string
+	SourceID         string
+	Amount           int64
+	Currency         string
+	RemainingBalance int64
+}
+
+func NewCollateralApplied(loanID, userID, collateralType, sourceID string, amount int64, currency string, remaining int64) CollateralApplied {
+	return CollateralApplied{
+		BaseEvent:        sharedevent.NewBaseEvent("credit.collateral.applied"),
+		LoanID:           loanID,
+		UserID:           userID,
+		CollateralType:   collateralType,
+		SourceID:         sourceID,
+		Amount:           amount,
+		Currency:         currency,
+		RemainingBalance: remaining,
+	}
+}
+
+// CollateralReleased is raised when a pledge is lifted from collateral
+type CollateralReleased struct {
+	sharedevent.BaseEvent
+	LoanID         string
+	UserID         string
+	CollateralType string
+	SourceID       string
+	Amount         int64
+	Currency       string
+}
+
+func NewCollateralReleased(loanID, userID, collateralType, sourceID string, amount int64, currency string) CollateralReleased {
+	return CollateralReleased{
+		BaseEvent:      sharedevent.NewBaseEvent("credit.collateral.released"),
+		LoanID:         loanID,
+		UserID:         userID,
+		CollateralType: collateralType,
+		SourceID:       sourceID,
+		Amount:         amount,
+		Currency:       currency,
+	}
+}
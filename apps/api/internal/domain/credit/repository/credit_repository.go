@@ -13,6 +13,9 @@ import (
 var (
 	ErrLoanNotFound        = errors.New("loan not found")
 	ErrCreditScoreNotFound = errors.New("credit score not found")
+	ErrLoanProductNotFound = errors.New("loan product not found")
+	ErrBorrowerNotFound    = errors.New("borrower profile not found")
+	ErrSubmissionNotFound  = errors.New("bureau submission not found")
 )
 
 // CreditScoreRepository defines the interface for credit score persistence
@@ -62,11 +65,16 @@ type LoanRepository interface {
 
 	// List retrieves loans with filters
 	List(ctx context.Context, filter LoanFilter) ([]*LoanDTO, int64, error)
+
+	// FindReportable retrieves loans disbursed before periodEnd that were still
+	// open at periodStart, i.e. every facility a bureau file for the period must cover
+	FindReportable(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Loan, error)
 }
 
 // LoanFilter contains filter options for listing loans
 type LoanFilter struct {
 	Status  *aggregate.LoanStatus
+	Statuses  []aggregate.LoanStatus // Matches any of the given statuses, e.g. the underwriting queue
 	MinAmount int64
 	MaxAmount int64
 	Overdue   bool
@@ -79,10 +87,12 @@ type LoanDTO struct {
 	ID             string
 	UserID         string
 	UserName       string
+	ProductID      string
 	Principal      int64
 	InterestRate   float64
 	InterestAmount int64
 	TotalAmount    int64
+	OriginationFee int64
 	AmountRepaid   int64
 	RemainingBalance int64
 	Currency       string
@@ -94,9 +104,43 @@ type LoanDTO struct {
 	DueDate        *time.Time
 	CompletedAt    *time.Time
 	IsOverdue      bool
+	LastDecision   string
+	DecisionReasons []string
+	MakerID        string
 	CreatedAt      time.Time
 }
 
+// LoanProductRepository defines the interface for the loan product catalog
+type LoanProductRepository interface {
+	// Save persists a loan product
+	Save(ctx context.Context, product *aggregate.LoanProduct) error
+
+	// FindByID retrieves a loan product by ID
+	FindByID(ctx context.Context, id string) (*aggregate.LoanProduct, error)
+
+	// FindByCode retrieves a loan product by its catalog code
+	FindByCode(ctx context.Context, code string) (*aggregate.LoanProduct, error)
+
+	// FindActive retrieves all products open for applications
+	FindActive(ctx context.Context) ([]*aggregate.LoanProduct, error)
+}
+
+// BorrowerProfileRepository reads the identity facts used by loan eligibility rules
+type BorrowerProfileRepository interface {
+	// FindByUserID retrieves the borrower profile for a user
+	FindByUserID(ctx context.Context, userID valueobject.UserID) (*BorrowerProfile, error)
+}
+
+// BorrowerProfile contains the identity facts a loan product evaluates
+type BorrowerProfile struct {
+	UserID           string
+	FullName         string
+	PhoneNumber      string
+	BVN              string
+	KYCLevel         aggregate.KYCLevel // from the identity context's KYC profile
+	AccountCreatedAt time.Time
+}
+
 // RepaymentRepository defines the interface for repayment persistence
 type RepaymentRepository interface {
 	// Save persists a repayment
@@ -116,6 +160,18 @@ type RepaymentDTO struct {
 	PaidAt        time.Time
 }
 
+// BureauSubmissionRepository defines the interface for credit bureau submission records
+type BureauSubmissionRepository interface {
+	// Save persists a bureau submission
+	Save(ctx context.Context, submission *aggregate.BureauSubmission) error
+
+	// FindByID retrieves a submission by ID
+	FindByID(ctx context.Context, id string) (*aggregate.BureauSubmission, error)
+
+	// FindByPeriod retrieves all submissions to a bureau for a reporting month, oldest first
+	FindByPeriod(ctx context.Context, bureau aggregate.CreditBureau, period time.Time) ([]*aggregate.BureauSubmission, error)
+}
+
 // CreditStatisticsRepository defines the interface for credit statistics
 type CreditStatisticsRepository interface {
 	// GetLoanStats gets loan statistics for a user
@@ -0,0 +1,367 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/domain/credit/repository"
+)
+
+// ErrInvalidBureauFile is returned when records fail the bureau format rules
+var ErrInvalidBureauFile = errors.New("bureau file failed format validation")
+
+// BureauValidationError lists every record that failed the format rules
+type BureauValidationError struct {
+	Issues []string
+}
+
+func (e *BureauValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidBureauFile.Error(), strings.Join(e.Issues, "; "))
+}
+
+func (e *BureauValidationError) Unwrap() error {
+	return ErrInvalidBureauFile
+}
+
+// AccountStatus is the facility status reported to the bureau
+type AccountStatus string
+
+const (
+	AccountOpen      AccountStatus = "OPEN"
+	AccountClosed    AccountStatus = "CLOSED"
+	AccountDefaulted AccountStatus = "DEFAULT"
+)
+
+// AssetClassification is the CBN prudential classification by days past due
+type AssetClassification string
+
+const (
+	ClassPerforming   AssetClassification = "PERFORMING"     // Not past due
+	ClassPassAndWatch AssetClassification = "PASS_AND_WATCH" // 1-30 days
+	ClassSubstandard  AssetClassification = "SUBSTANDARD"    // 31-60 days
+	ClassDoubtful     AssetClassification = "DOUBTFUL"       // 61-90 days
+	ClassLost         AssetClassification = "LOST"           // Over 90 days
+)
+
+// ClassifyDaysPastDue maps days past due to the prudential classification
+func ClassifyDaysPastDue(days int) AssetClassification {
+	switch {
+	case days <= 0:
+		return ClassPerforming
+	case days <= 30:
+		return ClassPassAndWatch
+	case days <= 60:
+		return ClassSubstandard
+	case days <= 90:
+		return ClassDoubtful
+	default:
+		return ClassLost
+	}
+}
+
+// SubmissionType distinguishes first submissions from corrections in the file header
+type SubmissionType string
+
+const (
+	SubmissionTypeNew          SubmissionType = "N"
+	SubmissionTypeCorrection   SubmissionType = "C" // Amends records in an accepted file
+	SubmissionTypeResubmission SubmissionType = "R" // Replaces a rejected file
+)
+
+// BorrowerIdentity identifies the borrower to the bureau
+type BorrowerIdentity struct {
+	BVN         string
+	FullName    string
+	PhoneNumber string
+}
+
+// BureauRecord is one credit facility in a consumer credit data file
+type BureauRecord struct {
+	BVN                string
+	BorrowerName       string
+	PhoneNumber        string
+	AccountNumber      string // Loan ID
+	FacilityType       string
+	Currency           string
+	DisbursedAt        time.Time
+	MaturityDate       time.Time
+	TenureMonths       int
+	Principal          int64 // Amounts in kobo
+	OutstandingBalance int64
+	AmountOverdue      int64
+	DaysPastDue        int
+	LastPaymentDate    *time.Time
+	LastPaymentAmount  int64
+	AccountStatus      AccountStatus
+	Classification     AssetClassification
+}
+
+// BureauFileHeader describes a submission file
+type BureauFileHeader struct {
+	InstitutionCode string
+	Bureau          aggregate.CreditBureau
+	Period          time.Time
+	GeneratedAt     time.Time
+	Type            SubmissionType
+}
+
+// BuildBureauRecord computes the facility position of a disbursed loan at the end of
+// the reporting period. Balances come from the repayment ledger so payments received
+// after the period closes do not leak into the report.
+func BuildBureauRecord(
+	loan *aggregate.Loan,
+	borrower BorrowerIdentity,
+	facilityType string,
+	repayments []*repository.RepaymentDTO,
+	asOf time.Time,
+) BureauRecord {
+	var repaid int64
+	var lastPayment *repository.RepaymentDTO
+	for _, r := range repayments {
+		if r.PaidAt.After(asOf) {
+			continue
+		}
+		repaid += r.Amount
+		if lastPayment == nil || r.PaidAt.After(lastPayment.PaidAt) {
+			lastPayment = r
+		}
+	}
+
+	outstanding := loan.TotalAmount().Amount() - repaid
+	if outstanding < 0 {
+		outstanding = 0
+	}
+
+	record := BureauRecord{
+		BVN:                borrower.BVN,
+		BorrowerName:       borrower.FullName,
+		PhoneNumber:        borrower.PhoneNumber,
+		AccountNumber:      loan.ID().String(),
+		FacilityType:       facilityType,
+		Currency:           string(loan.Principal().Currency()),
+		TenureMonths:       loan.TenureMonths(),
+		Principal:          loan.Principal().Amount(),
+		OutstandingBalance: outstanding,
+	}
+
+	if loan.DisbursedAt() != nil {
+		record.DisbursedAt = *loan.DisbursedAt()
+	}
+	if loan.DueDate() != nil {
+		record.MaturityDate = *loan.DueDate()
+	}
+	if lastPayment != nil {
+		paidAt := lastPayment.PaidAt
+		record.LastPaymentDate = &paidAt
+		record.LastPaymentAmount = lastPayment.Amount
+	}
+
+	switch {
+	case outstanding == 0:
+		record.AccountStatus = AccountClosed
+	case loan.Status() == aggregate.LoanStatusDefaulted:
+		record.AccountStatus = AccountDefaulted
+	default:
+		record.AccountStatus = AccountOpen
+	}
+
+	if outstanding > 0 {
+		record.AmountOverdue, record.DaysPastDue = arrears(loan, repaid, asOf)
+	}
+	record.Classification = ClassifyDaysPastDue(record.DaysPastDue)
+
+	return record
+}
+
+// arrears walks the monthly instalment schedule and returns the amount due but unpaid
+// at asOf, and how many days the oldest unpaid instalment is overdue
+func arrears(loan *aggregate.Loan, repaid int64, asOf time.Time) (int64, int) {
+	if loan.DisbursedAt() == nil {
+		return 0, 0
+	}
+
+	tenure := loan.TenureMonths()
+	if tenure < 1 {
+		tenure = 1
+	}
+	total := loan.TotalAmount().Amount()
+	instalment := total / int64(tenure)
+
+	var expected int64
+	var overdueSince *time.Time
+	for i := 1; i <= tenure; i++ {
+		due := loan.DisbursedAt().AddDate(0, i, loan.GracePeriodDays())
+		if !due.Before(asOf) {
+			break
+		}
+
+		expected += instalment
+		if i == tenure {
+			expected = total // Final instalment absorbs rounding
+		}
+
+		if overdueSince == nil && expected > repaid {
+			overdueSince = &due
+		}
+	}
+
+	if overdueSince == nil {
+		return 0, 0
+	}
+
+	days := int(asOf.Sub(*overdueSince).Hours() / 24)
+	if days < 1 {
+		days = 1
+	}
+
+	return expected - repaid, days
+}
+
+var bvnPattern = regexp.MustCompile(`^\d{11}$`)
+
+// ValidateBureauRecord checks a record against the consumer credit data format rules
+func ValidateBureauRecord(r BureauRecord) []string {
+	var issues []string
+	fail := func(format string, args ...interface{}) {
+		issues = append(issues, fmt.Sprintf("account %s: ", r.AccountNumber)+fmt.Sprintf(format, args...))
+	}
+
+	if r.AccountNumber == "" {
+		fail("account number is required")
+	}
+	if !bvnPattern.MatchString(r.BVN) {
+		fail("BVN must be 11 digits")
+	}
+	if strings.TrimSpace(r.BorrowerName) == "" || len(r.BorrowerName) > 100 {
+		fail("borrower name must be 1-100 characters")
+	}
+	for _, field := range [][2]string{{"borrower name", r.BorrowerName}, {"phone number", r.PhoneNumber}, {"facility type", r.FacilityType}} {
+		if strings.ContainsAny(field[1], "|\r\n") {
+			fail("%s contains a reserved character", field[0])
+		}
+	}
+	if r.FacilityType == "" {
+		fail("facility type is required")
+	}
+	if len(r.Currency) != 3 {
+		fail("currency must be an ISO 4217 code")
+	}
+	if r.DisbursedAt.IsZero() {
+		fail("disbursement date is required")
+	}
+	if r.MaturityDate.Before(r.DisbursedAt) {
+		fail("maturity date is before disbursement date")
+	}
+	if r.TenureMonths < 1 {
+		fail("tenure must be at least one month")
+	}
+	if r.Principal <= 0 {
+		fail("principal must be positive")
+	}
+	if r.OutstandingBalance < 0 || r.AmountOverdue < 0 || r.LastPaymentAmount < 0 {
+		fail("amounts must not be negative")
+	}
+	if r.AmountOverdue > r.OutstandingBalance {
+		fail("amount overdue exceeds outstanding balance")
+	}
+	if r.DaysPastDue < 0 || (r.DaysPastDue > 0) != (r.AmountOverdue > 0) {
+		fail("days past due is inconsistent with amount overdue")
+	}
+	if r.AccountStatus == AccountClosed && r.OutstandingBalance != 0 {
+		fail("closed account has an outstanding balance")
+	}
+	if r.Classification != ClassifyDaysPastDue(r.DaysPastDue) {
+		fail("classification %s does not match %d days past due", r.Classification, r.DaysPastDue)
+	}
+
+	return issues
+}
+
+// RenderBureauFile validates the records and renders the pipe-delimited submission file:
+// a header line, one detail line per facility and a trailer with control totals
+func RenderBureauFile(header BureauFileHeader, records []BureauRecord) ([]byte, error) {
+	var issues []string
+	if header.InstitutionCode == "" {
+		issues = append(issues, "institution code is required")
+	}
+	if !header.Bureau.IsValid() {
+		issues = append(issues, aggregate.ErrUnknownBureau.Error())
+	}
+	if len(records) == 0 {
+		issues = append(issues, aggregate.ErrEmptySubmission.Error())
+	}
+	for _, r := range records {
+		issues = append(issues, ValidateBureauRecord(r)...)
+	}
+	if len(issues) > 0 {
+		return nil, &BureauValidationError{Issues: issues}
+	}
+
+	var buf bytes.Buffer
+	writeLine := func(fields ...string) {
+		buf.WriteString(strings.Join(fields, "|"))
+		buf.WriteString("\r\n")
+	}
+
+	writeLine("H", header.InstitutionCode, strings.ToUpper(header.Bureau.String()),
+		header.Period.Format("200601"), header.GeneratedAt.UTC().Format("02/01/2006 15:04:05"), string(header.Type))
+
+	var totalOutstanding int64
+	for _, r := range records {
+		lastPaymentDate := ""
+		if r.LastPaymentDate != nil {
+			lastPaymentDate = formatBureauDate(*r.LastPaymentDate)
+		}
+
+		writeLine("D",
+			r.BVN,
+			r.BorrowerName,
+			r.PhoneNumber,
+			r.AccountNumber,
+			r.FacilityType,
+			r.Currency,
+			formatBureauDate(r.DisbursedAt),
+			formatBureauDate(r.MaturityDate),
+			strconv.Itoa(r.TenureMonths),
+			formatBureauAmount(r.Principal),
+			formatBureauAmount(r.OutstandingBalance),
+			formatBureauAmount(r.AmountOverdue),
+			strconv.Itoa(r.DaysPastDue),
+			lastPaymentDate,
+			formatBureauAmount(r.LastPaymentAmount),
+			string(r.AccountStatus),
+			string(r.Classification),
+		)
+		totalOutstanding += r.OutstandingBalance
+	}
+
+	writeLine("T", strconv.Itoa(len(records)), formatBureauAmount(totalOutstanding))
+
+	return buf.Bytes(), nil
+}
+
+// BureauFileName returns the conventional name for a submission file
+func BureauFileName(header BureauFileHeader) string {
+	return fmt.Sprintf("%s_%s_%s_%s_%s.txt",
+		header.InstitutionCode,
+		strings.ToUpper(header.Bureau.String()),
+		header.Period.Format("200601"),
+		header.Type,
+		header.GeneratedAt.UTC().Format("20060102150405"),
+	)
+}
+
+func formatBureauDate(t time.Time) string {
+	return t.UTC().Format("02/01/2006")
+}
+
+// formatBureauAmount renders kobo as naira with two decimal places
+func formatBureauAmount(kobo int64) string {
+	return fmt.Sprintf("%d.%02d", kobo/100, kobo%100)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/domain/credit/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+var (
+	fixtureDisbursed = time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC)
+	fixtureAsOf      = time.Date(2026, 8, 31, 23, 59, 59, 0, time.UTC)
+	fixtureBorrower  = BorrowerIdentity{BVN: "22212345678", FullName: "Adaeze Okafor", PhoneNumber: "+2348031234567"}
+)
+
+// fixtureLoan builds a disbursed ₦30,000 loan over 3 months at 4% (₦33,600 total)
+func fixtureLoan(t *testing.T, status aggregate.LoanStatus) *aggregate.Loan {
+	t.Helper()
+	ngn := func(kobo int64) valueobject.Money { return valueobject.MustNewMoney(kobo, valueobject.NGN) }
+	due := fixtureDisbursed.AddDate(0, 3, 0)
+
+	return aggregate.ReconstructLoan(
+		valueobject.GenerateLoanID(), valueobject.GenerateUserID(), "personal",
+		ngn(3000000), 0.04, ngn(360000), ngn(3360000), ngn(0), ngn(0),
+		3, 0, nil, status, "stock",
+		&fixtureDisbursed, &fixtureDisbursed, &due, nil,
+		nil, nil, "", fixtureDisbursed, fixtureDisbursed, 1,
+	)
+}
+
+func payment(amount int64, paidAt time.Time) *repository.RepaymentDTO {
+	return &repository.RepaymentDTO{ID: paidAt.String(), Amount: amount, Currency: "NGN", PaidAt: paidAt}
+}
+
+func TestBuildBureauRecord(t *testing.T) {
+	tests := []struct {
+		name        string
+		repayments  []*repository.RepaymentDTO
+		wantBalance int64
+		wantOverdue int64
+		wantDPD     int
+		wantStatus  AccountStatus
+		wantClass   AssetClassification
+	}{
+		{
+			name:        "no payments, all three instalments due",
+			wantBalance: 3360000,
+			wantOverdue: 3360000,
+			wantDPD:     82, // First instalment was due 10 June
+			wantStatus:  AccountOpen,
+			wantClass:   ClassDoubtful,
+		},
+		{
+			name: "paid to date",
+			repayments: []*repository.RepaymentDTO{
+				payment(1120000, time.Date(2026, 6, 9, 0, 0, 0, 0, time.UTC)),
+				payment(1120000, time.Date(2026, 7, 9, 0, 0, 0, 0, time.UTC)),
+				payment(1120000, time.Date(2026, 8, 9, 0, 0, 0, 0, time.UTC)),
+			},
+			wantBalance: 0,
+			wantStatus:  AccountClosed,
+			wantClass:   ClassPerforming,
+		},
+		{
+			name: "missed the last instalment",
+			repayments: []*repository.RepaymentDTO{
+				payment(1120000, time.Date(2026, 6, 9, 0, 0, 0, 0, time.UTC)),
+				payment(1120000, time.Date(2026, 7, 9, 0, 0, 0, 0, time.UTC)),
+			},
+			wantBalance: 1120000,
+			wantOverdue: 1120000,
+			wantDPD:     21,
+			wantStatus:  AccountOpen,
+			wantClass:   ClassPassAndWatch,
+		},
+		{
+			name: "payment after period end is ignored",
+			repayments: []*repository.RepaymentDTO{
+				payment(3360000, time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			wantBalance: 3360000,
+			wantOverdue: 3360000,
+			wantDPD:     82,
+			wantStatus:  AccountOpen,
+			wantClass:   ClassDoubtful,
+		},
+	}
+
+	for _, tt := range tests {
+		record := BuildBureauRecord(fixtureLoan(t, aggregate.LoanStatusRepaying), fixtureBorrower, "personal", tt.repayments, fixtureAsOf)
+
+		if record.OutstandingBalance != tt.wantBalance {
+			t.Errorf("%s: OutstandingBalance = %d, want %d", tt.name, record.OutstandingBalance, tt.wantBalance)
+		}
+		if record.AmountOverdue != tt.wantOverdue {
+			t.Errorf("%s: AmountOverdue = %d, want %d", tt.name, record.AmountOverdue, tt.wantOverdue)
+		}
+		if record.DaysPastDue != tt.wantDPD {
+			t.Errorf("%s: DaysPastDue = %d, want %d", tt.name, record.DaysPastDue, tt.wantDPD)
+		}
+		if record.AccountStatus != tt.wantStatus {
+			t.Errorf("%s: AccountStatus = %s, want %s", tt.name, record.AccountStatus, tt.wantStatus)
+		}
+		if record.Classification != tt.wantClass {
+			t.Errorf("%s: Classification = %s, want %s", tt.name, record.Classification, tt.wantClass)
+		}
+		if issues := ValidateBureauRecord(record); len(issues) > 0 {
+			t.Errorf("%s: ValidateBureauRecord() = %v", tt.name, issues)
+		}
+	}
+}
+
+func TestBuildBureauRecord_Defaulted(t *testing.T) {
+	record := BuildBureauRecord(fixtureLoan(t, aggregate.LoanStatusDefaulted), fixtureBorrower, "personal", nil, fixtureAsOf)
+	if record.AccountStatus != AccountDefaulted {
+		t.Errorf("AccountStatus = %s, want %s", record.AccountStatus, AccountDefaulted)
+	}
+}
+
+func TestValidateBureauRecord(t *testing.T) {
+	valid := BuildBureauRecord(fixtureLoan(t, aggregate.LoanStatusRepaying), fixtureBorrower, "personal", nil, fixtureAsOf)
+
+	tests := []struct {
+		name   string
+		modify func(r *BureauRecord)
+	}{
+		{"short BVN", func(r *BureauRecord) { r.BVN = "12345" }},
+		{"missing name", func(r *BureauRecord) { r.BorrowerName = " " }},
+		{"delimiter in name", func(r *BureauRecord) { r.BorrowerName = "Ada|Okafor" }},
+		{"overdue above balance", func(r *BureauRecord) { r.AmountOverdue = r.OutstandingBalance + 1 }},
+		{"closed with balance", func(r *BureauRecord) { r.AccountStatus = AccountClosed }},
+		{"wrong classification", func(r *BureauRecord) { r.Classification = ClassPerforming }},
+		{"maturity before disbursement", func(r *BureauRecord) { r.MaturityDate = r.DisbursedAt.AddDate(0, 0, -1) }},
+	}
+
+	for _, tt := range tests {
+		record := valid
+		tt.modify(&record)
+		if issues := ValidateBureauRecord(record); len(issues) == 0 {
+			t.Errorf("%s: ValidateBureauRecord() returned no issues", tt.name)
+		}
+	}
+}
+
+func TestRenderBureauFile(t *testing.T) {
+	header := BureauFileHeader{
+		InstitutionCode: "HUSTLEX",
+		Bureau:          aggregate.BureauCRC,
+		Period:          time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+		GeneratedAt:     time.Date(2026, 9, 1, 2, 0, 0, 0, time.UTC),
+		Type:            SubmissionTypeNew,
+	}
+	records := []BureauRecord{
+		BuildBureauRecord(fixtureLoan(t, aggregate.LoanStatusRepaying), fixtureBorrower, "personal", nil, fixtureAsOf),
+		BuildBureauRecord(fixtureLoan(t, aggregate.LoanStatusRepaying), fixtureBorrower, "personal", nil, fixtureAsOf),
+	}
+
+	content, err := RenderBureauFile(header, records)
+	if err != nil {
+		t.Fatalf("RenderBureauFile() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(content), "\r\n"), "\r\n")
+	if len(lines) != 4 {
+		t.Fatalf("RenderBureauFile() produced %d lines, want 4", len(lines))
+	}
+	if lines[0] != "H|HUSTLEX|CRC|202608|01/09/2026 02:00:00|N" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if fields := strings.Split(lines[1], "|"); len(fields) != 18 || fields[1] != fixtureBorrower.BVN || fields[11] != "33600.00" {
+		t.Errorf("detail = %q", lines[1])
+	}
+	if lines[3] != "T|2|67200.00" {
+		t.Errorf("trailer = %q, want T|2|67200.00", lines[3])
+	}
+
+	if name := BureauFileName(header); name != "HUSTLEX_CRC_202608_N_20260901020000.txt" {
+		t.Errorf("BureauFileName() = %s", name)
+	}
+}
+
+func TestRenderBureauFile_RejectsInvalidRecords(t *testing.T) {
+	header := BureauFileHeader{InstitutionCode: "HUSTLEX", Bureau: aggregate.BureauFirstCentral, Period: fixtureAsOf, Type: SubmissionTypeNew}
+	record := BuildBureauRecord(fixtureLoan(t, aggregate.LoanStatusRepaying), BorrowerIdentity{FullName: "No BVN"}, "personal", nil, fixtureAsOf)
+
+	_, err := RenderBureauFile(header, []BureauRecord{record})
+	if !errors.Is(err, ErrInvalidBureauFile) {
+		t.Fatalf("RenderBureauFile() error = %v, want %v", err, ErrInvalidBureauFile)
+	}
+
+	var validationErr *BureauValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Issues) != 1 {
+		t.Errorf("RenderBureauFile() issues = %v, want one BVN issue", err)
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"fmt"
+
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// UnderwritingPolicy holds the thresholds used by the loan decision engine.
+// Amounts are in kobo.
+type UnderwritingPolicy struct {
+	AutoDeclineBelowScore  int   // Scores below this are declined outright
+	AutoApproveMinScore    int   // Scores below this are referred to an underwriter
+	AutoApproveMaxAmount   int64 // Larger applications are referred
+	FirstLoanMaxAutoAmount int64 // Limit for borrowers without a completed loan
+	MaxPriorDefaults       int   // More defaults than this are declined
+	DualControlThreshold   int64 // Approvals at or above this need maker-checker sign-off
+}
+
+// DefaultUnderwritingPolicy returns the policy used in production
+func DefaultUnderwritingPolicy() UnderwritingPolicy {
+	return UnderwritingPolicy{
+		AutoDeclineBelowScore:  300,
+		AutoApproveMinScore:    500,
+		AutoApproveMaxAmount:   5000000, // ₦50,000
+		FirstLoanMaxAutoAmount: 2000000, // ₦20,000
+		MaxPriorDefaults:       0,
+		DualControlThreshold:   20000000, // ₦200,000
+	}
+}
+
+// RequiresDualControl reports whether approving the given amount needs a second underwriter
+func (p UnderwritingPolicy) RequiresDualControl(principal valueobject.Money) bool {
+	return p.DualControlThreshold > 0 && principal.Amount() >= p.DualControlThreshold
+}
+
+// BorrowerHistory summarises a borrower's previous loans
+type BorrowerHistory struct {
+	CompletedLoans     int
+	DefaultedLoans     int
+	CurrentOutstanding int64
+}
+
+// Decision is the outcome of evaluating an application together with the reasons behind it
+type Decision struct {
+	Outcome aggregate.DecisionOutcome
+	Reasons []string
+}
+
+// DecisionEngine applies the underwriting policy to loan applications.
+// Declines take precedence over referrals; an application is only auto-approved
+// when no rule asks for a human to look at it.
+type DecisionEngine struct {
+	policy UnderwritingPolicy
+}
+
+// NewDecisionEngine creates a decision engine for the given policy
+func NewDecisionEngine(policy UnderwritingPolicy) *DecisionEngine {
+	return &DecisionEngine{policy: policy}
+}
+
+// Policy returns the policy the engine applies
+func (e *DecisionEngine) Policy() UnderwritingPolicy {
+	return e.policy
+}
+
+// Evaluate decides whether a new application is approved, declined or referred
+func (e *DecisionEngine) Evaluate(loan *aggregate.Loan, applicant aggregate.LoanApplicant, history BorrowerHistory) Decision {
+	var declines []string
+	if history.DefaultedLoans > e.policy.MaxPriorDefaults {
+		declines = append(declines, fmt.Sprintf("borrower has %d defaulted loan(s)", history.DefaultedLoans))
+	}
+	if applicant.Score < e.policy.AutoDeclineBelowScore {
+		declines = append(declines, fmt.Sprintf("credit score %d is below %d", applicant.Score, e.policy.AutoDeclineBelowScore))
+	}
+	if len(declines) > 0 {
+		return Decision{Outcome: aggregate.DecisionAutoDecline, Reasons: declines}
+	}
+
+	principal := loan.Principal().Amount()
+
+	var referrals []string
+	if applicant.Score < e.policy.AutoApproveMinScore && !loan.IsSecured() {
+		referrals = append(referrals, fmt.Sprintf("credit score %d is below auto-approval minimum %d", applicant.Score, e.policy.AutoApproveMinScore))
+	}
+	if principal > e.policy.AutoApproveMaxAmount {
+		referrals = append(referrals, "amount exceeds auto-approval limit")
+	}
+	if history.CompletedLoans == 0 && principal > e.policy.FirstLoanMaxAutoAmount {
+		referrals = append(referrals, "first loan exceeds first-time borrower limit")
+	}
+	if history.CurrentOutstanding > 0 {
+		referrals = append(referrals, "borrower has an outstanding balance")
+	}
+	if e.policy.RequiresDualControl(loan.Principal()) {
+		referrals = append(referrals, "amount requires dual-control approval")
+	}
+	if len(referrals) > 0 {
+		return Decision{Outcome: aggregate.DecisionRefer, Reasons: referrals}
+	}
+
+	return Decision{Outcome: aggregate.DecisionAutoApprove, Reasons: []string{"application meets all auto-approval rules"}}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func newTestLoan(t *testing.T, amount int64) *aggregate.Loan {
+	t.Helper()
+	product, err := aggregate.NewLoanProduct(
+		"product-1", "test", "Test Loan", aggregate.LoanCategoryPersonal, valueobject.NGN,
+		100000, 50000000, 1, 6, 0, 0, aggregate.EligibilityRules{},
+	)
+	if err != nil {
+		t.Fatalf("NewLoanProduct() error = %v", err)
+	}
+	product.SetTierPricing(aggregate.TierGold, aggregate.TierPricing{MaxAmount: 50000000, MonthlyRate: 0.03})
+
+	principal := valueobject.MustNewMoney(amount, valueobject.NGN)
+	loan, err := aggregate.NewLoan(valueobject.GenerateLoanID(), valueobject.GenerateUserID(), product, aggregate.TierGold, principal, 2, "stock", nil)
+	if err != nil {
+		t.Fatalf("NewLoan() error = %v", err)
+	}
+	return loan
+}
+
+func TestDecisionEngine_Evaluate(t *testing.T) {
+	engine := NewDecisionEngine(DefaultUnderwritingPolicy())
+	goodApplicant := aggregate.LoanApplicant{Score: 650, Tier: aggregate.TierGold, KYCLevel: aggregate.KYCLevelFull, AccountAge: 365 * 24 * time.Hour}
+	goodHistory := BorrowerHistory{CompletedLoans: 2}
+
+	tests := []struct {
+		name      string
+		amount    int64
+		applicant func(a *aggregate.LoanApplicant)
+		history   BorrowerHistory
+		want      aggregate.DecisionOutcome
+	}{
+		{"small loan good borrower", 3000000, nil, goodHistory, aggregate.DecisionAutoApprove},
+		{"prior default", 3000000, nil, BorrowerHistory{CompletedLoans: 2, DefaultedLoans: 1}, aggregate.DecisionAutoDecline},
+		{"very low score", 3000000, func(a *aggregate.LoanApplicant) { a.Score = 250 }, goodHistory, aggregate.DecisionAutoDecline},
+		{"middling score", 3000000, func(a *aggregate.LoanApplicant) { a.Score = 420 }, goodHistory, aggregate.DecisionRefer},
+		{"above auto limit", 8000000, nil, goodHistory, aggregate.DecisionRefer},
+		{"first loan too large", 3000000, nil, BorrowerHistory{}, aggregate.DecisionRefer},
+		{"dual control amount", 25000000, nil, goodHistory, aggregate.DecisionRefer},
+	}
+
+	for _, tt := range tests {
+		applicant := goodApplicant
+		if tt.applicant != nil {
+			tt.applicant(&applicant)
+		}
+
+		decision := engine.Evaluate(newTestLoan(t, tt.amount), applicant, tt.history)
+		if decision.Outcome != tt.want {
+			t.Errorf("%s: Evaluate() = %s, want %s", tt.name, decision.Outcome, tt.want)
+		}
+		if len(decision.Reasons) == 0 {
+			t.Errorf("%s: Evaluate() returned no reasons", tt.name)
+		}
+	}
+}
+
+func TestUnderwritingPolicy_RequiresDualControl(t *testing.T) {
+	policy := DefaultUnderwritingPolicy()
+
+	if policy.RequiresDualControl(valueobject.MustNewMoney(policy.DualControlThreshold-1, valueobject.NGN)) {
+		t.Error("RequiresDualControl() below threshold = true, want false")
+	}
+	if !policy.RequiresDualControl(valueobject.MustNewMoney(policy.DualControlThreshold, valueobject.NGN)) {
+		t.Error("RequiresDualControl() at threshold = false, want true")
+	}
+}
@@ -0,0 +1,56 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+)
+
+// ErrReviewWindowOpen is returned when a milestone is auto-approved before the client's
+// review window has closed
+var ErrReviewWindowOpen = errors.New("client review window has not closed")
+
+// ReviewDueAt is when the client's review of a delivered milestone runs out, or nil
+// if the milestone is not awaiting review
+func (m *Milestone) ReviewDueAt(window time.Duration) *time.Time {
+	if m.status != MilestoneDelivered || m.deliveredAt == nil {
+		return nil
+	}
+	due := m.deliveredAt.Add(window)
+	return &due
+}
+
+// AutoApproveMilestone approves a delivered milestone on the client's behalf once they
+// have let the review window pass without approving, asking for a revision or disputing.
+// A milestone that was sent back and delivered again gets a fresh window.
+func (c *Contract) AutoApproveMilestone(milestoneID string, window time.Duration, asOf time.Time) (*Milestone, error) {
+	if !c.isInProgress() {
+		return nil, ErrCannotApprove
+	}
+
+	milestone := c.FindMilestone(milestoneID)
+	if milestone == nil {
+		return nil, ErrMilestoneNotFound
+	}
+
+	dueAt := milestone.ReviewDueAt(window)
+	if dueAt == nil {
+		return nil, ErrMilestoneNotDelivered
+	}
+	if asOf.Before(*dueAt) {
+		return nil, ErrReviewWindowOpen
+	}
+
+	c.RecordEvent(event.NewMilestoneAutoApproved(
+		c.id.String(),
+		milestone.id,
+		c.clientID.String(),
+		c.hustlerID.String(),
+		*milestone.deliveredAt,
+		*dueAt,
+	))
+
+	c.releaseMilestone(milestone)
+	return milestone, nil
+}
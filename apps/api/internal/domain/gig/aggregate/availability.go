@@ -0,0 +1,434 @@
+package aggregate
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Availability errors
+var (
+	ErrInvalidTimezone       = errors.New("invalid timezone")
+	ErrInvalidWeeklyWindow   = errors.New("weekly hours must start before they end, within one day")
+	ErrOverlappingWindows    = errors.New("weekly hours overlap on the same day")
+	ErrTooManyWindows        = errors.New("too many weekly hour windows")
+	ErrInvalidBlackout       = errors.New("blackout must end on or after the day it starts")
+	ErrBlackoutNotFound      = errors.New("blackout not found")
+	ErrInvalidServiceArea    = errors.New("travel radius must be positive and within the maximum")
+	ErrInvalidTravelBuffer   = errors.New("travel buffer must be between zero and the maximum")
+	ErrNoServiceArea         = errors.New("hustler has not set a service area")
+	ErrOutsideServiceArea    = errors.New("location is outside the hustler's service area")
+	ErrInvalidSlot           = errors.New("slot must start before it ends, on a single day")
+	ErrOutsideWorkingHours   = errors.New("slot is outside the hustler's working hours")
+	ErrDateBlackedOut        = errors.New("hustler is unavailable on that date")
+	ErrInvalidSlotLength     = errors.New("slot length must be positive")
+	ErrSlotSearchRangeTooBig = errors.New("slot search range is too long")
+)
+
+const (
+	// MaxWeeklyWindows is the most working-hour windows a hustler can publish
+	MaxWeeklyWindows = 28
+	// MaxTravelRadiusKm is the furthest a hustler can offer to travel
+	MaxTravelRadiusKm = 200.0
+	// MaxTravelBuffer is the longest gap a hustler can keep between visits
+	MaxTravelBuffer = 4 * time.Hour
+	// MaxSlotSearchDays bounds how far ahead open slots are listed in one call
+	MaxSlotSearchDays = 31
+)
+
+const minutesPerDay = 24 * 60
+
+// TimeSlot is a concrete period of time, such as a booked visit
+type TimeSlot struct {
+	start time.Time
+	end   time.Time
+}
+
+// NewTimeSlot creates a time slot
+func NewTimeSlot(start, end time.Time) (TimeSlot, error) {
+	if !start.Before(end) {
+		return TimeSlot{}, ErrInvalidSlot
+	}
+	return TimeSlot{start: start.UTC(), end: end.UTC()}, nil
+}
+
+func (s TimeSlot) Start() time.Time        { return s.start }
+func (s TimeSlot) End() time.Time          { return s.end }
+func (s TimeSlot) Duration() time.Duration { return s.end.Sub(s.start) }
+
+// Equals checks if two slots cover the same period
+func (s TimeSlot) Equals(other TimeSlot) bool {
+	return s.start.Equal(other.start) && s.end.Equal(other.end)
+}
+
+// Overlaps reports whether two slots are closer together than the buffer
+func (s TimeSlot) Overlaps(other TimeSlot, buffer time.Duration) bool {
+	return s.start.Before(other.end.Add(buffer)) && other.start.Before(s.end.Add(buffer))
+}
+
+// WeeklyWindow is a recurring period on one weekday when a hustler takes bookings,
+// in minutes after midnight in the hustler's timezone
+type WeeklyWindow struct {
+	weekday     time.Weekday
+	startMinute int
+	endMinute   int
+}
+
+// NewWeeklyWindow creates a weekly window
+func NewWeeklyWindow(weekday time.Weekday, startMinute, endMinute int) (WeeklyWindow, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return WeeklyWindow{}, ErrInvalidWeeklyWindow
+	}
+	if startMinute < 0 || endMinute > minutesPerDay || startMinute >= endMinute {
+		return WeeklyWindow{}, ErrInvalidWeeklyWindow
+	}
+	return WeeklyWindow{weekday: weekday, startMinute: startMinute, endMinute: endMinute}, nil
+}
+
+func (w WeeklyWindow) Weekday() time.Weekday { return w.weekday }
+func (w WeeklyWindow) StartMinute() int      { return w.startMinute }
+func (w WeeklyWindow) EndMinute() int        { return w.endMinute }
+
+// Blackout is a run of dates, inclusive, when a hustler takes no bookings
+type Blackout struct {
+	id        string
+	startDate time.Time
+	endDate   time.Time
+	reason    string
+}
+
+// ReconstructBlackout reconstructs a blackout from persistence
+func ReconstructBlackout(id string, startDate, endDate time.Time, reason string) *Blackout {
+	return &Blackout{id: id, startDate: civilDate(startDate), endDate: civilDate(endDate), reason: reason}
+}
+
+func (b *Blackout) ID() string           { return b.id }
+func (b *Blackout) StartDate() time.Time { return b.startDate }
+func (b *Blackout) EndDate() time.Time   { return b.endDate }
+func (b *Blackout) Reason() string       { return b.reason }
+
+// Covers reports whether the blackout includes the given calendar date
+func (b *Blackout) Covers(date time.Time) bool {
+	date = civilDate(date)
+	return !date.Before(b.startDate) && !date.After(b.endDate)
+}
+
+// ServiceArea is where a hustler travels for on-site work
+type ServiceArea struct {
+	center   valueobject.GeoPoint
+	radiusKm float64
+}
+
+// NewServiceArea creates a service area
+func NewServiceArea(center valueobject.GeoPoint, radiusKm float64) (ServiceArea, error) {
+	if radiusKm <= 0 || radiusKm > MaxTravelRadiusKm {
+		return ServiceArea{}, ErrInvalidServiceArea
+	}
+	return ServiceArea{center: center, radiusKm: radiusKm}, nil
+}
+
+func (a ServiceArea) Center() valueobject.GeoPoint { return a.center }
+func (a ServiceArea) RadiusKm() float64            { return a.radiusKm }
+
+// Covers reports whether a point is within the travel radius
+func (a ServiceArea) Covers(point valueobject.GeoPoint) bool {
+	return a.center.DistanceKm(point) <= a.radiusKm
+}
+
+// Availability is the aggregate root for a hustler's on-site booking calendar:
+// the weekly hours they work, the dates they are away and where they travel to
+type Availability struct {
+	sharedevent.AggregateRoot
+
+	hustlerID    valueobject.UserID
+	timezone     string
+	location     *time.Location
+	windows      []WeeklyWindow
+	blackouts    []*Blackout
+	serviceArea  *ServiceArea
+	travelBuffer time.Duration
+	visitFee     valueobject.Money // charged per on-site visit, held until the visit
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewAvailability creates an empty calendar for a hustler in the given IANA timezone
+func NewAvailability(hustlerID valueobject.UserID, timezone string) (*Availability, error) {
+	loc, err := loadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &Availability{
+		hustlerID: hustlerID,
+		timezone:  loc.String(),
+		location:  loc,
+		visitFee:  valueobject.Zero(valueobject.NGN),
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructAvailability reconstructs a calendar from persistence
+func ReconstructAvailability(
+	hustlerID valueobject.UserID,
+	timezone string,
+	windows []WeeklyWindow,
+	blackouts []*Blackout,
+	serviceArea *ServiceArea,
+	travelBuffer time.Duration,
+	visitFee valueobject.Money,
+	createdAt, updatedAt time.Time,
+) *Availability {
+	loc, err := loadTimezone(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return &Availability{
+		hustlerID:    hustlerID,
+		timezone:     timezone,
+		location:     loc,
+		windows:      windows,
+		blackouts:    blackouts,
+		serviceArea:  serviceArea,
+		travelBuffer: travelBuffer,
+		visitFee:     visitFee,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// Getters
+func (a *Availability) HustlerID() valueobject.UserID { return a.hustlerID }
+func (a *Availability) Timezone() string              { return a.timezone }
+func (a *Availability) Location() *time.Location      { return a.location }
+func (a *Availability) WeeklyWindows() []WeeklyWindow { return a.windows }
+func (a *Availability) Blackouts() []*Blackout        { return a.blackouts }
+func (a *Availability) ServiceArea() *ServiceArea     { return a.serviceArea }
+func (a *Availability) TravelBuffer() time.Duration   { return a.travelBuffer }
+func (a *Availability) VisitFee() valueobject.Money   { return a.visitFee }
+func (a *Availability) CreatedAt() time.Time          { return a.createdAt }
+func (a *Availability) UpdatedAt() time.Time          { return a.updatedAt }
+
+// SetTimezone changes the timezone weekly hours are read in
+func (a *Availability) SetTimezone(timezone string) error {
+	loc, err := loadTimezone(timezone)
+	if err != nil {
+		return err
+	}
+	if loc.String() == a.timezone {
+		return nil
+	}
+	a.timezone = loc.String()
+	a.location = loc
+	a.touch("timezone")
+	return nil
+}
+
+// SetWeeklyHours replaces the hustler's working hours
+func (a *Availability) SetWeeklyHours(windows []WeeklyWindow) error {
+	if len(windows) > MaxWeeklyWindows {
+		return ErrTooManyWindows
+	}
+
+	sorted := make([]WeeklyWindow, len(windows))
+	copy(sorted, windows)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].weekday != sorted[j].weekday {
+			return sorted[i].weekday < sorted[j].weekday
+		}
+		return sorted[i].startMinute < sorted[j].startMinute
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		prev, cur := sorted[i-1], sorted[i]
+		if prev.weekday == cur.weekday && cur.startMinute < prev.endMinute {
+			return ErrOverlappingWindows
+		}
+	}
+
+	a.windows = sorted
+	a.touch("weekly_hours")
+	return nil
+}
+
+// AddBlackout marks a run of dates, inclusive, as unavailable
+func (a *Availability) AddBlackout(startDate, endDate time.Time, reason string) (*Blackout, error) {
+	startDate, endDate = civilDate(startDate), civilDate(endDate)
+	if endDate.Before(startDate) {
+		return nil, ErrInvalidBlackout
+	}
+
+	blackout := &Blackout{
+		id:        valueobject.GenerateBlackoutID().String(),
+		startDate: startDate,
+		endDate:   endDate,
+		reason:    strings.TrimSpace(reason),
+	}
+	a.blackouts = append(a.blackouts, blackout)
+	a.touch("blackouts")
+	return blackout, nil
+}
+
+// RemoveBlackout makes previously blacked out dates bookable again
+func (a *Availability) RemoveBlackout(blackoutID string) error {
+	for i, b := range a.blackouts {
+		if b.id == blackoutID {
+			a.blackouts = append(a.blackouts[:i], a.blackouts[i+1:]...)
+			a.touch("blackouts")
+			return nil
+		}
+	}
+	return ErrBlackoutNotFound
+}
+
+// SetServiceArea sets where the hustler travels and the gap they keep between visits
+func (a *Availability) SetServiceArea(area ServiceArea, travelBuffer time.Duration) error {
+	if travelBuffer < 0 || travelBuffer > MaxTravelBuffer {
+		return ErrInvalidTravelBuffer
+	}
+	a.serviceArea = &area
+	a.travelBuffer = travelBuffer
+	a.touch("service_area")
+	return nil
+}
+
+// SetVisitFee sets what clients pay for each on-site visit
+func (a *Availability) SetVisitFee(fee valueobject.Money) {
+	a.visitFee = fee
+	a.touch("visit_fee")
+}
+
+// CheckLocation returns an error unless the hustler travels to the given point
+func (a *Availability) CheckLocation(point valueobject.GeoPoint) error {
+	if a.serviceArea == nil {
+		return ErrNoServiceArea
+	}
+	if !a.serviceArea.Covers(point) {
+		return ErrOutsideServiceArea
+	}
+	return nil
+}
+
+// CheckSlot returns an error unless the slot falls inside one of the hustler's weekly
+// windows on a date that is not blacked out. It does not look at other bookings.
+func (a *Availability) CheckSlot(slot TimeSlot) error {
+	start := slot.start.In(a.location)
+	end := slot.end.In(a.location)
+
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	if !sameDate(start, end) {
+		// A slot may run up to midnight but not past it
+		if !sameDate(start, end.Add(-time.Nanosecond)) || endMinute != 0 || end.Second() != 0 {
+			return ErrInvalidSlot
+		}
+		endMinute = minutesPerDay
+	}
+
+	if a.isBlackedOut(start) {
+		return ErrDateBlackedOut
+	}
+
+	for _, w := range a.windows {
+		if w.weekday == start.Weekday() && w.startMinute <= startMinute && endMinute <= w.endMinute {
+			return nil
+		}
+	}
+	return ErrOutsideWorkingHours
+}
+
+// OpenSlots lists the slots of the given length between from and to that fit the
+// hustler's weekly hours and blackouts and keep the travel buffer clear of the
+// already booked slots
+func (a *Availability) OpenSlots(from, to time.Time, length time.Duration, booked []TimeSlot) ([]TimeSlot, error) {
+	if length <= 0 {
+		return nil, ErrInvalidSlotLength
+	}
+	if !from.Before(to) {
+		return nil, ErrInvalidSlot
+	}
+	if to.Sub(from) > MaxSlotSearchDays*24*time.Hour {
+		return nil, ErrSlotSearchRangeTooBig
+	}
+
+	var slots []TimeSlot
+	day := from.In(a.location)
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, a.location)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if a.isBlackedOut(day) {
+			continue
+		}
+		for _, w := range a.windows {
+			if w.weekday != day.Weekday() {
+				continue
+			}
+			windowEnd := day.Add(time.Duration(w.endMinute) * time.Minute)
+			for start := day.Add(time.Duration(w.startMinute) * time.Minute); !start.Add(length).After(windowEnd); start = start.Add(length) {
+				slot := TimeSlot{start: start.UTC(), end: start.Add(length).UTC()}
+				if slot.start.Before(from) || slot.end.After(to) {
+					continue
+				}
+				if conflicts(slot, booked, a.travelBuffer) {
+					continue
+				}
+				slots = append(slots, slot)
+			}
+		}
+	}
+	return slots, nil
+}
+
+func (a *Availability) isBlackedOut(t time.Time) bool {
+	date := civilDate(t.In(a.location))
+	for _, b := range a.blackouts {
+		if b.Covers(date) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Availability) touch(field string) {
+	a.updatedAt = time.Now().UTC()
+	a.RecordEvent(event.NewAvailabilityUpdated(a.hustlerID.String(), []string{field}))
+}
+
+func conflicts(slot TimeSlot, booked []TimeSlot, buffer time.Duration) bool {
+	for _, b := range booked {
+		if slot.Overlaps(b, buffer) {
+			return true
+		}
+	}
+	return false
+}
+
+func loadTimezone(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return nil, ErrInvalidTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+	return loc, nil
+}
+
+// civilDate strips the clock from a time, keeping the calendar date it falls on
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
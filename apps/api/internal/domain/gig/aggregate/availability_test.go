@@ -0,0 +1,129 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// monday is a Monday well in the future
+var monday = time.Date(2030, time.January, 7, 0, 0, 0, 0, time.UTC)
+
+func createTestAvailability(t *testing.T) *Availability {
+	t.Helper()
+
+	a, err := NewAvailability(valueobject.GenerateUserID(), "UTC")
+	if err != nil {
+		t.Fatalf("NewAvailability() error = %v", err)
+	}
+
+	morning, _ := NewWeeklyWindow(time.Monday, 9*60, 12*60)
+	afternoon, _ := NewWeeklyWindow(time.Monday, 13*60, 17*60)
+	if err := a.SetWeeklyHours([]WeeklyWindow{afternoon, morning}); err != nil {
+		t.Fatalf("SetWeeklyHours() error = %v", err)
+	}
+
+	center, _ := valueobject.NewGeoPoint(6.5244, 3.3792) // Lagos Island
+	area, _ := NewServiceArea(center, 15)
+	if err := a.SetServiceArea(area, 30*time.Minute); err != nil {
+		t.Fatalf("SetServiceArea() error = %v", err)
+	}
+	return a
+}
+
+func slotAt(t *testing.T, day time.Time, hour, minutes int) TimeSlot {
+	t.Helper()
+	start := day.Add(time.Duration(hour) * time.Hour)
+	slot, err := NewTimeSlot(start, start.Add(time.Duration(minutes)*time.Minute))
+	if err != nil {
+		t.Fatalf("NewTimeSlot() error = %v", err)
+	}
+	return slot
+}
+
+func TestAvailability_SetWeeklyHours_RejectsOverlap(t *testing.T) {
+	a := createTestAvailability(t)
+
+	first, _ := NewWeeklyWindow(time.Tuesday, 9*60, 12*60)
+	second, _ := NewWeeklyWindow(time.Tuesday, 11*60, 14*60)
+	if err := a.SetWeeklyHours([]WeeklyWindow{first, second}); err != ErrOverlappingWindows {
+		t.Errorf("SetWeeklyHours() error = %v, want ErrOverlappingWindows", err)
+	}
+
+	if _, err := NewWeeklyWindow(time.Monday, 17*60, 9*60); err != ErrInvalidWeeklyWindow {
+		t.Errorf("NewWeeklyWindow() error = %v, want ErrInvalidWeeklyWindow", err)
+	}
+}
+
+func TestAvailability_CheckSlot(t *testing.T) {
+	a := createTestAvailability(t)
+
+	if _, err := a.AddBlackout(monday.AddDate(0, 0, 7), monday.AddDate(0, 0, 7), "Family event"); err != nil {
+		t.Fatalf("AddBlackout() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		slot TimeSlot
+		want error
+	}{
+		{"inside morning hours", slotAt(t, monday, 10, 60), nil},
+		{"runs into lunch", slotAt(t, monday, 11, 90), ErrOutsideWorkingHours},
+		{"day not worked", slotAt(t, monday.AddDate(0, 0, 1), 10, 60), ErrOutsideWorkingHours},
+		{"blacked out date", slotAt(t, monday.AddDate(0, 0, 7), 10, 60), ErrDateBlackedOut},
+		{"spans two days", slotAt(t, monday, 23, 120), ErrInvalidSlot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := a.CheckSlot(tt.slot); err != tt.want {
+				t.Errorf("CheckSlot() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAvailability_CheckLocation(t *testing.T) {
+	a := createTestAvailability(t)
+
+	ikeja, _ := valueobject.NewGeoPoint(6.6018, 3.3515) // about 9km away
+	if err := a.CheckLocation(ikeja); err != nil {
+		t.Errorf("CheckLocation(Ikeja) error = %v, want nil", err)
+	}
+
+	ibadan, _ := valueobject.NewGeoPoint(7.3775, 3.9470)
+	if err := a.CheckLocation(ibadan); err != ErrOutsideServiceArea {
+		t.Errorf("CheckLocation(Ibadan) error = %v, want ErrOutsideServiceArea", err)
+	}
+
+	empty, _ := NewAvailability(valueobject.GenerateUserID(), "UTC")
+	if err := empty.CheckLocation(ikeja); err != ErrNoServiceArea {
+		t.Errorf("CheckLocation() without area error = %v, want ErrNoServiceArea", err)
+	}
+}
+
+func TestAvailability_OpenSlots_KeepsTravelBufferClear(t *testing.T) {
+	a := createTestAvailability(t)
+
+	booked := []TimeSlot{slotAt(t, monday, 14, 60)}
+	slots, err := a.OpenSlots(monday, monday.AddDate(0, 0, 1), time.Hour, booked)
+	if err != nil {
+		t.Fatalf("OpenSlots() error = %v", err)
+	}
+
+	// 9, 10, 11 in the morning; 13 and 15 are within 30 minutes of the 14:00 visit
+	var hours []int
+	for _, s := range slots {
+		hours = append(hours, s.Start().Hour())
+	}
+	want := []int{9, 10, 11, 16}
+	if len(hours) != len(want) {
+		t.Fatalf("OpenSlots() hours = %v, want %v", hours, want)
+	}
+	for i := range want {
+		if hours[i] != want[i] {
+			t.Fatalf("OpenSlots() hours = %v, want %v", hours, want)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Booking errors
+var (
+	ErrCannotBookSelf      = errors.New("cannot book your own time")
+	ErrAddressRequired     = errors.New("an address is required for on-site work")
+	ErrSlotInPast          = errors.New("slot must be in the future")
+	ErrNotBookingParty     = errors.New("not a party to this booking")
+	ErrBookingNotConfirmed = errors.New("booking is no longer confirmed")
+	ErrBookingStarted      = errors.New("booking has already started")
+	ErrBookingNotStarted   = errors.New("booking has not started yet")
+	ErrNotBookingHustler   = errors.New("only the hustler can complete a booking")
+	ErrSameSlot            = errors.New("booking is already in that slot")
+	ErrInvalidCancelFee    = errors.New("cancellation fee cannot exceed the visit fee")
+)
+
+// BookingStatus represents the status of an on-site booking
+type BookingStatus string
+
+const (
+	BookingStatusConfirmed BookingStatus = "confirmed"
+	BookingStatusCompleted BookingStatus = "completed"
+	BookingStatusCancelled BookingStatus = "cancelled"
+)
+
+func (s BookingStatus) String() string {
+	return string(s)
+}
+
+// BookingCancellation records who cancelled a booking and how its visit fee was divided
+type BookingCancellation struct {
+	cancelledBy valueobject.UserID
+	reason      string
+	fee         valueobject.Money // paid to the hustler
+	refund      valueobject.Money // returned to the client
+	cancelledAt time.Time
+}
+
+// ReconstructBookingCancellation reconstructs a cancellation from persistence
+func ReconstructBookingCancellation(cancelledBy valueobject.UserID, reason string, fee, refund valueobject.Money, cancelledAt time.Time) *BookingCancellation {
+	return &BookingCancellation{
+		cancelledBy: cancelledBy,
+		reason:      reason,
+		fee:         fee,
+		refund:      refund,
+		cancelledAt: cancelledAt,
+	}
+}
+
+func (c *BookingCancellation) CancelledBy() valueobject.UserID { return c.cancelledBy }
+func (c *BookingCancellation) Reason() string                  { return c.reason }
+func (c *BookingCancellation) Fee() valueobject.Money          { return c.fee }
+func (c *BookingCancellation) Refund() valueobject.Money       { return c.refund }
+func (c *BookingCancellation) CancelledAt() time.Time          { return c.cancelledAt }
+
+// Booking is the aggregate root for an on-site visit a client has booked with a hustler
+type Booking struct {
+	sharedevent.AggregateRoot
+
+	id              valueobject.BookingID
+	hustlerID       valueobject.UserID
+	clientID        valueobject.UserID
+	contractID      *valueobject.ContractID // set when the visit is part of a contract
+	slot            TimeSlot
+	location        valueobject.GeoPoint
+	address         string
+	notes           string
+	visitFee        valueobject.Money
+	status          BookingStatus
+	rescheduleCount int
+	cancellation    *BookingCancellation
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewBooking confirms a booking. The caller is responsible for checking the slot
+// against the hustler's availability and other bookings.
+func NewBooking(
+	id valueobject.BookingID,
+	hustlerID valueobject.UserID,
+	clientID valueobject.UserID,
+	contractID *valueobject.ContractID,
+	slot TimeSlot,
+	location valueobject.GeoPoint,
+	address string,
+	notes string,
+	visitFee valueobject.Money,
+	now time.Time,
+) (*Booking, error) {
+	if hustlerID.Equals(clientID) {
+		return nil, ErrCannotBookSelf
+	}
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return nil, ErrAddressRequired
+	}
+	if !slot.start.After(now) {
+		return nil, ErrSlotInPast
+	}
+
+	b := &Booking{
+		id:         id,
+		hustlerID:  hustlerID,
+		clientID:   clientID,
+		contractID: contractID,
+		slot:       slot,
+		location:   location,
+		address:    address,
+		notes:      strings.TrimSpace(notes),
+		visitFee:   visitFee,
+		status:     BookingStatusConfirmed,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+
+	contractIDStr := ""
+	if contractID != nil {
+		contractIDStr = contractID.String()
+	}
+	b.RecordEvent(event.NewBookingConfirmed(
+		id.String(),
+		hustlerID.String(),
+		clientID.String(),
+		contractIDStr,
+		slot.start,
+		slot.end,
+		address,
+		visitFee.Amount(),
+		string(visitFee.Currency()),
+	))
+
+	return b, nil
+}
+
+// ReconstructBooking reconstructs a booking from persistence
+func ReconstructBooking(
+	id valueobject.BookingID,
+	hustlerID valueobject.UserID,
+	clientID valueobject.UserID,
+	contractID *valueobject.ContractID,
+	slot TimeSlot,
+	location valueobject.GeoPoint,
+	address string,
+	notes string,
+	visitFee valueobject.Money,
+	status BookingStatus,
+	rescheduleCount int,
+	cancellation *BookingCancellation,
+	createdAt, updatedAt time.Time,
+) *Booking {
+	return &Booking{
+		id:              id,
+		hustlerID:       hustlerID,
+		clientID:        clientID,
+		contractID:      contractID,
+		slot:            slot,
+		location:        location,
+		address:         address,
+		notes:           notes,
+		visitFee:        visitFee,
+		status:          status,
+		rescheduleCount: rescheduleCount,
+		cancellation:    cancellation,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
+// Getters
+func (b *Booking) ID() valueobject.BookingID           { return b.id }
+func (b *Booking) HustlerID() valueobject.UserID       { return b.hustlerID }
+func (b *Booking) ClientID() valueobject.UserID        { return b.clientID }
+func (b *Booking) ContractID() *valueobject.ContractID { return b.contractID }
+func (b *Booking) Slot() TimeSlot                      { return b.slot }
+func (b *Booking) Location() valueobject.GeoPoint      { return b.location }
+func (b *Booking) Address() string                     { return b.address }
+func (b *Booking) Notes() string                       { return b.notes }
+func (b *Booking) VisitFee() valueobject.Money         { return b.visitFee }
+func (b *Booking) Status() BookingStatus               { return b.status }
+func (b *Booking) RescheduleCount() int                { return b.rescheduleCount }
+func (b *Booking) Cancellation() *BookingCancellation  { return b.cancellation }
+func (b *Booking) CreatedAt() time.Time                { return b.createdAt }
+func (b *Booking) UpdatedAt() time.Time                { return b.updatedAt }
+
+// IsParty checks whether the user is the client or the hustler on the booking
+func (b *Booking) IsParty(userID valueobject.UserID) bool {
+	return b.clientID.Equals(userID) || b.hustlerID.Equals(userID)
+}
+
+// IsActive returns true while the booking still holds its slot
+func (b *Booking) IsActive() bool {
+	return b.status == BookingStatusConfirmed
+}
+
+// Reschedule moves the booking to a new slot. The caller is responsible for checking
+// the new slot against the hustler's availability and the cancellation policy.
+func (b *Booking) Reschedule(userID valueobject.UserID, slot TimeSlot, now time.Time) error {
+	if !b.IsParty(userID) {
+		return ErrNotBookingParty
+	}
+	if b.status != BookingStatusConfirmed {
+		return ErrBookingNotConfirmed
+	}
+	if !b.slot.start.After(now) {
+		return ErrBookingStarted
+	}
+	if !slot.start.After(now) {
+		return ErrSlotInPast
+	}
+	if slot.Equals(b.slot) {
+		return ErrSameSlot
+	}
+
+	previous := b.slot.start
+	b.slot = slot
+	b.rescheduleCount++
+	b.updatedAt = now
+
+	b.RecordEvent(event.NewBookingRescheduled(
+		b.id.String(),
+		userID.String(),
+		previous,
+		slot.start,
+		slot.end,
+	))
+
+	return nil
+}
+
+// Cancel frees the booking's slot. The fee, worked out by the cancellation policy,
+// goes to the hustler and the rest of the visit fee back to the client.
+func (b *Booking) Cancel(userID valueobject.UserID, reason string, fee valueobject.Money, now time.Time) error {
+	if !b.IsParty(userID) {
+		return ErrNotBookingParty
+	}
+	if b.status != BookingStatusConfirmed {
+		return ErrBookingNotConfirmed
+	}
+
+	refund, err := b.visitFee.Subtract(fee)
+	if err != nil {
+		return ErrInvalidCancelFee
+	}
+
+	b.status = BookingStatusCancelled
+	b.cancellation = &BookingCancellation{
+		cancelledBy: userID,
+		reason:      strings.TrimSpace(reason),
+		fee:         fee,
+		refund:      refund,
+		cancelledAt: now,
+	}
+	b.updatedAt = now
+
+	b.RecordEvent(event.NewBookingCancelled(
+		b.id.String(),
+		userID.String(),
+		b.hustlerID.String(),
+		b.clientID.String(),
+		b.cancellation.reason,
+		fee.Amount(),
+		refund.Amount(),
+	))
+
+	return nil
+}
+
+// Complete marks the visit done once it has started, paying the visit fee to the hustler
+func (b *Booking) Complete(userID valueobject.UserID, now time.Time) error {
+	if !b.hustlerID.Equals(userID) {
+		return ErrNotBookingHustler
+	}
+	if b.status != BookingStatusConfirmed {
+		return ErrBookingNotConfirmed
+	}
+	if now.Before(b.slot.start) {
+		return ErrBookingNotStarted
+	}
+
+	b.status = BookingStatusCompleted
+	b.updatedAt = now
+
+	b.RecordEvent(event.NewBookingCompleted(
+		b.id.String(),
+		b.hustlerID.String(),
+		b.clientID.String(),
+		b.visitFee.Amount(),
+	))
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func createTestBooking(t *testing.T, now time.Time) *Booking {
+	t.Helper()
+
+	location, _ := valueobject.NewGeoPoint(6.6018, 3.3515)
+	b, err := NewBooking(
+		valueobject.GenerateBookingID(),
+		valueobject.GenerateUserID(),
+		valueobject.GenerateUserID(),
+		nil,
+		slotAt(t, monday, 10, 60),
+		location,
+		"12 Allen Avenue, Ikeja",
+		"Leaking kitchen tap",
+		valueobject.MustNewMoney(500000, valueobject.NGN),
+		now,
+	)
+	if err != nil {
+		t.Fatalf("NewBooking() error = %v", err)
+	}
+	return b
+}
+
+func TestNewBooking_Validates(t *testing.T) {
+	now := monday.Add(-48 * time.Hour)
+	location, _ := valueobject.NewGeoPoint(6.6018, 3.3515)
+	fee := valueobject.MustNewMoney(500000, valueobject.NGN)
+	user := valueobject.GenerateUserID()
+
+	if _, err := NewBooking(valueobject.GenerateBookingID(), user, user, nil, slotAt(t, monday, 10, 60), location, "Ikeja", "", fee, now); err != ErrCannotBookSelf {
+		t.Errorf("NewBooking() self error = %v, want ErrCannotBookSelf", err)
+	}
+	if _, err := NewBooking(valueobject.GenerateBookingID(), user, valueobject.GenerateUserID(), nil, slotAt(t, monday, 10, 60), location, "  ", "", fee, now); err != ErrAddressRequired {
+		t.Errorf("NewBooking() no address error = %v, want ErrAddressRequired", err)
+	}
+	if _, err := NewBooking(valueobject.GenerateBookingID(), user, valueobject.GenerateUserID(), nil, slotAt(t, monday, 10, 60), location, "Ikeja", "", fee, monday.AddDate(0, 0, 1)); err != ErrSlotInPast {
+		t.Errorf("NewBooking() past slot error = %v, want ErrSlotInPast", err)
+	}
+
+	b := createTestBooking(t, now)
+	if b.Status() != BookingStatusConfirmed {
+		t.Errorf("Status() = %s, want confirmed", b.Status())
+	}
+	if len(b.DomainEvents()) != 1 {
+		t.Errorf("expected BookingConfirmed event, got %d events", len(b.DomainEvents()))
+	}
+}
+
+func TestBooking_Reschedule(t *testing.T) {
+	now := monday.Add(-48 * time.Hour)
+	b := createTestBooking(t, now)
+
+	if err := b.Reschedule(valueobject.GenerateUserID(), slotAt(t, monday, 14, 60), now); err != ErrNotBookingParty {
+		t.Errorf("Reschedule() by stranger error = %v, want ErrNotBookingParty", err)
+	}
+	if err := b.Reschedule(b.ClientID(), b.Slot(), now); err != ErrSameSlot {
+		t.Errorf("Reschedule() same slot error = %v, want ErrSameSlot", err)
+	}
+
+	if err := b.Reschedule(b.ClientID(), slotAt(t, monday, 14, 60), now); err != nil {
+		t.Fatalf("Reschedule() error = %v", err)
+	}
+	if b.Slot().Start().Hour() != 14 || b.RescheduleCount() != 1 {
+		t.Errorf("booking not moved: starts %v, count %d", b.Slot().Start(), b.RescheduleCount())
+	}
+}
+
+func TestBooking_Cancel_SplitsVisitFee(t *testing.T) {
+	now := monday.Add(2 * time.Hour)
+	b := createTestBooking(t, monday.Add(-48*time.Hour))
+
+	if err := b.Cancel(b.ClientID(), "", valueobject.MustNewMoney(600000, valueobject.NGN), now); err != ErrInvalidCancelFee {
+		t.Errorf("Cancel() fee above visit fee error = %v, want ErrInvalidCancelFee", err)
+	}
+
+	if err := b.Cancel(b.ClientID(), "Fixed it myself", valueobject.MustNewMoney(250000, valueobject.NGN), now); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if b.Status() != BookingStatusCancelled || b.IsActive() {
+		t.Errorf("Status() = %s, want cancelled", b.Status())
+	}
+	if b.Cancellation().Fee().Amount() != 250000 || b.Cancellation().Refund().Amount() != 250000 {
+		t.Errorf("fee/refund = %d/%d, want 250000/250000", b.Cancellation().Fee().Amount(), b.Cancellation().Refund().Amount())
+	}
+
+	if err := b.Cancel(b.HustlerID(), "", valueobject.Zero(valueobject.NGN), now); err != ErrBookingNotConfirmed {
+		t.Errorf("Cancel() twice error = %v, want ErrBookingNotConfirmed", err)
+	}
+}
+
+func TestBooking_Complete(t *testing.T) {
+	b := createTestBooking(t, monday.Add(-48*time.Hour))
+
+	if err := b.Complete(b.HustlerID(), monday); err != ErrBookingNotStarted {
+		t.Errorf("Complete() early error = %v, want ErrBookingNotStarted", err)
+	}
+	if err := b.Complete(b.ClientID(), monday.Add(11*time.Hour)); err != ErrNotBookingHustler {
+		t.Errorf("Complete() by client error = %v, want ErrNotBookingHustler", err)
+	}
+	if err := b.Complete(b.HustlerID(), monday.Add(11*time.Hour)); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if b.Status() != BookingStatusCompleted {
+		t.Errorf("Status() = %s, want completed", b.Status())
+	}
+}
@@ -0,0 +1,42 @@
+package aggregate
+
+import (
+	"regexp"
+)
+
+// ContactKind is a kind of contact detail that could take a deal off-platform
+type ContactKind string
+
+const (
+	ContactKindPhone       ContactKind = "phone"
+	ContactKindEmail       ContactKind = "email"
+	ContactKindBankAccount ContactKind = "bank_account" // NUBAN, BVN or card numbers
+)
+
+// RedactedContact replaces contact details screened out of a message
+const RedactedContact = "[contact info hidden]"
+
+// Patterns are tried in order, so phone numbers are caught before the looser
+// bank account pattern sees their digits
+var contactPatterns = []struct {
+	kind    ContactKind
+	pattern *regexp.Regexp
+}{
+	{ContactKindEmail, regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)},
+	{ContactKindPhone, regexp.MustCompile(`(?:\+\s*234|\b234|\b0)[\s.\-()]*[789][01](?:[\s.\-]*\d){8}\b`)},
+	{ContactKindBankAccount, regexp.MustCompile(`\b\d(?:[\s\-]?\d){9,18}\b`)},
+}
+
+// ScreenContactInfo hides phone numbers, email addresses and account numbers in
+// text and reports which kinds it found
+func ScreenContactInfo(text string) (string, []ContactKind) {
+	var found []ContactKind
+	for _, p := range contactPatterns {
+		if !p.pattern.MatchString(text) {
+			continue
+		}
+		text = p.pattern.ReplaceAllString(text, RedactedContact)
+		found = append(found, p.kind)
+	}
+	return text, found
+}
@@ -18,6 +18,7 @@ var (
 	ErrCannotDeliver        = errors.New("cannot deliver in current state")
 	ErrCannotApprove        = errors.New("cannot approve in current state")
 	ErrInvalidRating        = errors.New("rating must be between 1 and 5")
+	ErrContractDisputed     = errors.New("contract is already disputed")
 )
 
 // ContractStatus represents the status of a contract
@@ -58,6 +59,11 @@ type Review struct {
 	qualityRating       int
 	timelinessRating    int
 	isPublic            bool
+	weight              float64 // how much the review counts towards the reviewee's rating
+	flags               []ReviewFlag
+	moderation          ReviewModeration
+	revealedAt          *time.Time // hidden from the other party until both have reviewed or the window closes
+	response            *ReviewResponse
 	createdAt           time.Time
 }
 
@@ -79,10 +85,49 @@ func NewReview(
 		rating:     rating,
 		reviewText: reviewText,
 		isPublic:   true,
+		weight:     1,
+		moderation: ReviewModerationNone,
 		createdAt:  time.Now().UTC(),
 	}, nil
 }
 
+// ReconstructReview reconstructs a review from persistence
+func ReconstructReview(
+	id string,
+	reviewerID valueobject.UserID,
+	revieweeID valueobject.UserID,
+	rating int,
+	reviewText string,
+	communicationRating int,
+	qualityRating int,
+	timelinessRating int,
+	isPublic bool,
+	weight float64,
+	flags []ReviewFlag,
+	moderation ReviewModeration,
+	revealedAt *time.Time,
+	response *ReviewResponse,
+	createdAt time.Time,
+) *Review {
+	return &Review{
+		id:                  id,
+		reviewerID:          reviewerID,
+		revieweeID:          revieweeID,
+		rating:              rating,
+		reviewText:          reviewText,
+		communicationRating: communicationRating,
+		qualityRating:       qualityRating,
+		timelinessRating:    timelinessRating,
+		isPublic:            isPublic,
+		weight:              weight,
+		flags:               flags,
+		moderation:          moderation,
+		revealedAt:          revealedAt,
+		response:            response,
+		createdAt:           createdAt,
+	}
+}
+
 func (r *Review) ID() string                   { return r.id }
 func (r *Review) ReviewerID() valueobject.UserID { return r.reviewerID }
 func (r *Review) RevieweeID() valueobject.UserID { return r.revieweeID }
@@ -113,6 +158,8 @@ type Contract struct {
 	id           valueobject.ContractID
 	gigID        valueobject.GigID
 	proposalID   valueobject.ProposalID
+	packageID    *valueobject.PackageID // set when the contract came from a package order
+	packageTier  PackageTier
 	clientID     valueobject.UserID
 	hustlerID    valueobject.UserID
 	agreedPrice  valueobject.Money
@@ -126,6 +173,10 @@ type Contract struct {
 	deliverables []string
 	clientNotes  string
 	reviews      []*Review
+	fundingMode  FundingMode
+	milestones   []*Milestone
+	maxRevisions int
+	deliveries   []*Delivery
 	createdAt    time.Time
 	updatedAt    time.Time
 	version      int64
@@ -133,6 +184,27 @@ type Contract struct {
 
 // NewContract creates a new contract from accepted proposal data
 func NewContract(data *AcceptedProposalData, proposalID valueobject.ProposalID) (*Contract, error) {
+	return newContract(data, proposalID, nil, "")
+}
+
+// NewPackageContract creates a contract for a service package order. There is no gig
+// or proposal behind it; delivery, review and disputes work as for any contract.
+func NewPackageContract(order *PackageOrderData) (*Contract, error) {
+	data := &AcceptedProposalData{
+		ContractID:   order.ContractID,
+		ClientID:     order.ClientID,
+		HustlerID:    order.HustlerID,
+		AgreedPrice:  order.AgreedPrice,
+		PlatformFee:  order.PlatformFee,
+		DeliveryDays: order.DeliveryDays,
+		DeadlineAt:   order.DeadlineAt,
+		Revisions:    order.Revisions,
+	}
+	packageID := order.PackageID
+	return newContract(data, valueobject.ProposalID{}, &packageID, order.Tier)
+}
+
+func newContract(data *AcceptedProposalData, proposalID valueobject.ProposalID, packageID *valueobject.PackageID, tier PackageTier) (*Contract, error) {
 	platformFee, err := valueobject.NewMoney(data.PlatformFee, data.AgreedPrice.Currency())
 	if err != nil {
 		return nil, err
@@ -142,6 +214,8 @@ func NewContract(data *AcceptedProposalData, proposalID valueobject.ProposalID)
 		id:           data.ContractID,
 		gigID:        data.GigID,
 		proposalID:   proposalID,
+		packageID:    packageID,
+		packageTier:  tier,
 		clientID:     data.ClientID,
 		hustlerID:    data.HustlerID,
 		agreedPrice:  data.AgreedPrice,
@@ -152,12 +226,25 @@ func NewContract(data *AcceptedProposalData, proposalID valueobject.ProposalID)
 		deadlineAt:   data.DeadlineAt,
 		deliverables: make([]string, 0),
 		reviews:      make([]*Review, 0),
+		fundingMode:  FundingUpfront,
+		maxRevisions: data.Revisions,
+		deliveries:   make([]*Delivery, 0),
 		createdAt:    time.Now().UTC(),
 		updatedAt:    time.Now().UTC(),
 		version:      1,
 	}
 
-	contract.RecordEvent(event.NewContractCreated(
+	// Every contract starts as a single milestone for the whole job
+	contract.milestones = []*Milestone{newMilestone(
+		1,
+		"Complete delivery",
+		data.AgreedPrice.Amount(),
+		data.PlatformFee,
+		data.AgreedPrice.Currency(),
+		data.DeadlineAt,
+	)}
+
+	created := event.NewContractCreated(
 		data.ContractID.String(),
 		data.GigID.String(),
 		data.ClientID.String(),
@@ -166,7 +253,11 @@ func NewContract(data *AcceptedProposalData, proposalID valueobject.ProposalID)
 		data.PlatformFee,
 		data.DeliveryDays,
 		data.DeadlineAt,
-	))
+	)
+	if packageID != nil {
+		created.PackageID = packageID.String()
+	}
+	contract.RecordEvent(created)
 
 	return contract, nil
 }
@@ -176,6 +267,8 @@ func ReconstructContract(
 	id valueobject.ContractID,
 	gigID valueobject.GigID,
 	proposalID valueobject.ProposalID,
+	packageID *valueobject.PackageID,
+	packageTier PackageTier,
 	clientID valueobject.UserID,
 	hustlerID valueobject.UserID,
 	agreedPrice valueobject.Money,
@@ -189,6 +282,10 @@ func ReconstructContract(
 	deliverables []string,
 	clientNotes string,
 	reviews []*Review,
+	fundingMode FundingMode,
+	milestones []*Milestone,
+	maxRevisions int,
+	deliveries []*Delivery,
 	createdAt time.Time,
 	updatedAt time.Time,
 	version int64,
@@ -197,6 +294,8 @@ func ReconstructContract(
 		id:           id,
 		gigID:        gigID,
 		proposalID:   proposalID,
+		packageID:    packageID,
+		packageTier:  packageTier,
 		clientID:     clientID,
 		hustlerID:    hustlerID,
 		agreedPrice:  agreedPrice,
@@ -210,6 +309,10 @@ func ReconstructContract(
 		deliverables: deliverables,
 		clientNotes:  clientNotes,
 		reviews:      reviews,
+		fundingMode:  fundingMode,
+		milestones:   milestones,
+		maxRevisions: maxRevisions,
+		deliveries:   deliveries,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
 		version:      version,
@@ -220,6 +323,8 @@ func ReconstructContract(
 func (c *Contract) ID() valueobject.ContractID    { return c.id }
 func (c *Contract) GigID() valueobject.GigID      { return c.gigID }
 func (c *Contract) ProposalID() valueobject.ProposalID { return c.proposalID }
+func (c *Contract) PackageID() *valueobject.PackageID { return c.packageID }
+func (c *Contract) PackageTier() PackageTier      { return c.packageTier }
 func (c *Contract) ClientID() valueobject.UserID  { return c.clientID }
 func (c *Contract) HustlerID() valueobject.UserID { return c.hustlerID }
 func (c *Contract) AgreedPrice() valueobject.Money { return c.agreedPrice }
@@ -238,6 +343,7 @@ func (c *Contract) UpdatedAt() time.Time          { return c.updatedAt }
 func (c *Contract) Version() int64                { return c.version }
 
 func (c *Contract) IsActive() bool    { return c.status.IsActive() }
+func (c *Contract) IsPackageOrder() bool { return c.packageID != nil }
 func (c *Contract) IsDelivered() bool { return c.status.IsDelivered() }
 func (c *Contract) IsCompleted() bool { return c.status.IsCompleted() }
 
@@ -258,61 +364,61 @@ func (c *Contract) NetPayoutAmount() valueobject.Money {
 
 // Business Methods
 
-// Deliver marks the contract as delivered
-func (c *Contract) Deliver(hustlerID valueobject.UserID, deliverables []string) error {
+// Deliver submits work for the milestone the hustler is currently working on
+func (c *Contract) Deliver(hustlerID valueobject.UserID, deliverables []string, notes string) (*Milestone, error) {
 	if !c.hustlerID.Equals(hustlerID) {
-		return ErrNotContractParty
+		return nil, ErrNotContractParty
 	}
 
-	if !c.status.IsActive() {
-		return ErrCannotDeliver
+	milestone := c.currentMilestone()
+	if milestone == nil {
+		if len(c.MilestonesDueFunding()) > 0 {
+			return nil, ErrMilestoneNotFunded
+		}
+		return nil, ErrCannotDeliver
 	}
 
-	now := time.Now().UTC()
-	c.status = ContractStatusDelivered
-	c.deliveredAt = &now
-	c.deliverables = deliverables
-	c.updatedAt = now
-
-	c.RecordEvent(event.NewWorkDelivered(c.id.String(), hustlerID.String(), deliverables))
-
-	return nil
+	return c.DeliverMilestone(hustlerID, milestone.ID(), deliverables, notes)
 }
 
-// Approve approves the delivered work and completes the contract
+// Approve approves the earliest delivered milestone, completing the contract
+// once every milestone has been released
 func (c *Contract) Approve(clientID valueobject.UserID, notes string) error {
 	if !c.clientID.Equals(clientID) {
 		return ErrNotContractParty
 	}
 
-	if !c.status.IsDelivered() {
-		return ErrCannotApprove
+	for _, m := range c.milestones {
+		if m.Status() == MilestoneDelivered {
+			_, err := c.ApproveMilestone(clientID, m.ID(), notes)
+			return err
+		}
 	}
 
-	now := time.Now().UTC()
-	c.status = ContractStatusCompleted
-	c.completedAt = &now
-	c.clientNotes = notes
-	c.updatedAt = now
-
-	c.RecordEvent(event.NewWorkApproved(
-		c.id.String(),
-		clientID.String(),
-		c.hustlerID.String(),
-		c.NetPayoutAmount().Amount(),
-	))
-
-	return nil
+	return ErrCannotApprove
 }
 
-// Dispute marks the contract as disputed
-func (c *Contract) Dispute(userID valueobject.UserID, reason string) error {
+// Dispute marks the contract as disputed and freezes every milestone still held in
+// escrow. The frozen milestones are returned so a Dispute can be opened over them.
+func (c *Contract) Dispute(userID valueobject.UserID, reason string) ([]*Milestone, error) {
 	if !c.IsParty(userID) {
-		return ErrNotContractParty
+		return nil, ErrNotContractParty
 	}
 
 	if c.status.IsCompleted() {
-		return errors.New("cannot dispute completed contract")
+		return nil, errors.New("cannot dispute completed contract")
+	}
+
+	if c.status == ContractStatusDisputed {
+		return nil, ErrContractDisputed
+	}
+
+	frozen := make([]*Milestone, 0)
+	for _, m := range c.milestones {
+		if m.IsInEscrow() && m.status != MilestoneDisputed {
+			m.setStatus(MilestoneDisputed)
+			frozen = append(frozen, m)
+		}
 	}
 
 	c.status = ContractStatusDisputed
@@ -320,7 +426,7 @@ func (c *Contract) Dispute(userID valueobject.UserID, reason string) error {
 
 	c.RecordEvent(event.NewContractDisputed(c.id.String(), userID.String(), reason))
 
-	return nil
+	return frozen, nil
 }
 
 // Cancel cancels the contract
@@ -334,6 +440,7 @@ func (c *Contract) Cancel(userID valueobject.UserID, reason string, refundAmount
 	}
 
 	c.status = ContractStatusCancelled
+	c.closeMilestones()
 	c.updatedAt = time.Now().UTC()
 
 	c.RecordEvent(event.NewContractCancelled(c.id.String(), userID.String(), reason, refundAmount))
@@ -341,12 +448,17 @@ func (c *Contract) Cancel(userID valueobject.UserID, reason string, refundAmount
 	return nil
 }
 
-// AddReview adds a review to the contract
-func (c *Contract) AddReview(review *Review) error {
+// AddReview adds a review to the contract. Reviews stay hidden from the other party
+// until both have reviewed; after the window closes no more reviews are taken.
+func (c *Contract) AddReview(review *Review, window time.Duration, now time.Time) error {
 	if !c.status.IsCompleted() {
 		return errors.New("can only review completed contracts")
 	}
 
+	if c.ReviewWindowClosed(window, now) {
+		return ErrReviewWindowClosed
+	}
+
 	// Check if user has already reviewed
 	for _, r := range c.reviews {
 		if r.ReviewerID().Equals(review.ReviewerID()) {
@@ -360,7 +472,7 @@ func (c *Contract) AddReview(review *Review) error {
 	}
 
 	c.reviews = append(c.reviews, review)
-	c.updatedAt = time.Now().UTC()
+	c.updatedAt = now
 
 	c.RecordEvent(event.NewReviewSubmitted(
 		review.ID(),
@@ -370,6 +482,15 @@ func (c *Contract) AddReview(review *Review) error {
 		review.Rating(),
 	))
 
+	if review.IsHeld() {
+		c.RecordEvent(event.NewReviewFlagged(c.id.String(), review.id, string(ReviewFlagCollusion), ""))
+	}
+
+	// Both parties have now reviewed, so neither can be swayed by the other
+	if c.HasReviewFrom(c.clientID) && c.HasReviewFrom(c.hustlerID) {
+		c.revealReviews(now)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,251 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func createTestContract(t *testing.T, price int64) *Contract {
+	t.Helper()
+
+	data := &AcceptedProposalData{
+		ContractID:   valueobject.GenerateContractID(),
+		GigID:        valueobject.GenerateGigID(),
+		ClientID:     valueobject.GenerateUserID(),
+		HustlerID:    valueobject.GenerateUserID(),
+		AgreedPrice:  valueobject.MustNewMoney(price, valueobject.NGN),
+		PlatformFee:  price / 10,
+		DeliveryDays: 30,
+		DeadlineAt:   time.Now().UTC().AddDate(0, 0, 30),
+		Revisions:    2,
+	}
+	contract, err := NewContract(data, valueobject.GenerateProposalID())
+	if err != nil {
+		t.Fatalf("NewContract() error = %v", err)
+	}
+	return contract
+}
+
+func TestContract_SingleMilestoneByDefault(t *testing.T) {
+	contract := createTestContract(t, 50000)
+
+	if len(contract.Milestones()) != 1 {
+		t.Fatalf("Milestones() = %d, want 1", len(contract.Milestones()))
+	}
+	if _, err := contract.Deliver(contract.HustlerID(), []string{"logo.png"}, ""); err != ErrMilestoneNotFunded {
+		t.Errorf("Deliver() before funding = %v, want %v", err, ErrMilestoneNotFunded)
+	}
+
+	for _, m := range contract.MilestonesDueFunding() {
+		if _, err := contract.FundMilestone(contract.ClientID(), m.ID()); err != nil {
+			t.Fatalf("FundMilestone() error = %v", err)
+		}
+	}
+	if _, err := contract.Deliver(contract.HustlerID(), []string{"logo.png"}, ""); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if err := contract.Approve(contract.ClientID(), "Great work"); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+
+	if !contract.IsCompleted() || contract.CompletedAt() == nil {
+		t.Errorf("Status() = %s, want completed", contract.Status())
+	}
+	if !contract.EscrowBalance().IsZero() {
+		t.Errorf("EscrowBalance() = %d, want 0", contract.EscrowBalance().Amount())
+	}
+}
+
+func TestContract_PlanMilestonesValidation(t *testing.T) {
+	contract := createTestContract(t, 90000)
+	due := time.Now().UTC().AddDate(0, 0, 7)
+
+	tests := []struct {
+		name  string
+		specs []MilestoneSpec
+		mode  FundingMode
+		want  error
+	}{
+		{"short of price", []MilestoneSpec{{"Frame", 40000, due}}, FundingUpfront, ErrMilestoneTotalMismatch},
+		{"untitled", []MilestoneSpec{{"", 90000, due}}, FundingUpfront, ErrInvalidMilestone},
+		{"after deadline", []MilestoneSpec{{"Frame", 90000, contract.DeadlineAt().Add(time.Hour)}}, FundingUpfront, ErrInvalidMilestoneDue},
+		{"out of order", []MilestoneSpec{{"Frame", 45000, due}, {"Roof", 45000, due.AddDate(0, 0, -1)}}, FundingUpfront, ErrInvalidMilestoneDue},
+		{"unknown mode", []MilestoneSpec{{"Frame", 90000, due}}, FundingMode("later"), ErrInvalidFundingMode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := contract.PlanMilestones(tt.specs, tt.mode); err != tt.want {
+				t.Errorf("PlanMilestones() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestContract_JustInTimeMilestones(t *testing.T) {
+	contract := createTestContract(t, 100000)
+	due := time.Now().UTC()
+	err := contract.PlanMilestones([]MilestoneSpec{
+		{"Foundation", 30000, due.AddDate(0, 0, 7)},
+		{"Walls", 30000, due.AddDate(0, 0, 14)},
+		{"Roofing", 40000, due.AddDate(0, 0, 21)},
+	}, FundingJustInTime)
+	if err != nil {
+		t.Fatalf("PlanMilestones() error = %v", err)
+	}
+
+	var fees int64
+	for _, m := range contract.Milestones() {
+		fees += m.PlatformFee().Amount()
+	}
+	if fees != contract.PlatformFee().Amount() {
+		t.Errorf("milestone fees = %d, want %d", fees, contract.PlatformFee().Amount())
+	}
+
+	first, walls, roof := contract.Milestones()[0], contract.Milestones()[1], contract.Milestones()[2]
+	if _, err := contract.FundMilestone(contract.ClientID(), walls.ID()); err != ErrMilestoneOutOfOrder {
+		t.Errorf("FundMilestone() out of order = %v, want %v", err, ErrMilestoneOutOfOrder)
+	}
+
+	due1 := contract.MilestonesDueFunding()
+	if len(due1) != 1 || due1[0] != first {
+		t.Fatalf("MilestonesDueFunding() = %d milestones, want only the first", len(due1))
+	}
+	contract.FundMilestone(contract.ClientID(), first.ID())
+	if len(contract.MilestonesDueFunding()) != 0 {
+		t.Error("next milestone is due for funding while the first is still in progress")
+	}
+
+	// Revise, then approve the first stage; the contract carries on
+	if _, err := contract.DeliverMilestone(contract.HustlerID(), first.ID(), nil, ""); err != nil {
+		t.Fatalf("DeliverMilestone() error = %v", err)
+	}
+	if err := contract.RequestRevision(contract.ClientID(), first.ID(), "Level the slab", 0); err != nil {
+		t.Fatalf("RequestRevision() error = %v", err)
+	}
+	if contract.Status() != ContractStatusActive || first.Revisions() != 1 {
+		t.Errorf("after revision: contract %s, %d revisions", contract.Status(), first.Revisions())
+	}
+	contract.DeliverMilestone(contract.HustlerID(), first.ID(), nil, "")
+	if _, err := contract.ApproveMilestone(contract.ClientID(), first.ID(), ""); err != nil {
+		t.Fatalf("ApproveMilestone() error = %v", err)
+	}
+	if contract.IsCompleted() {
+		t.Fatal("contract completed with milestones outstanding")
+	}
+
+	// A disputed stage is frozen and keeps the contract from completing
+	contract.FundMilestone(contract.ClientID(), walls.ID())
+	if _, err := contract.DisputeMilestone(contract.HustlerID(), walls.ID(), "Client changed the layout"); err != nil {
+		t.Fatalf("DisputeMilestone() error = %v", err)
+	}
+	if _, err := contract.DeliverMilestone(contract.HustlerID(), walls.ID(), nil, ""); err != ErrCannotDeliver {
+		t.Errorf("DeliverMilestone() while disputed = %v, want %v", err, ErrCannotDeliver)
+	}
+
+	contract.FundMilestone(contract.ClientID(), roof.ID())
+	if got := contract.RefundableEscrow().Amount(); got != 40000 {
+		t.Errorf("RefundableEscrow() = %d, want 40000 for the undelivered roof only", got)
+	}
+	if err := contract.Cancel(contract.ClientID(), "Moving out", 40000); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if roof.Status() != MilestoneRefunded || walls.Status() != MilestoneDisputed {
+		t.Errorf("after cancel: roof %s, walls %s, want refunded and disputed", roof.Status(), walls.Status())
+	}
+}
+
+func TestContract_AutoApproveAfterReviewWindow(t *testing.T) {
+	const window = 72 * time.Hour
+
+	contract := createTestContract(t, 50000)
+	milestone := contract.Milestones()[0]
+	contract.FundMilestone(contract.ClientID(), milestone.ID())
+
+	if _, err := contract.AutoApproveMilestone(milestone.ID(), window, time.Now().UTC()); err != ErrMilestoneNotDelivered {
+		t.Errorf("AutoApproveMilestone() undelivered = %v, want %v", err, ErrMilestoneNotDelivered)
+	}
+
+	contract.Deliver(contract.HustlerID(), []string{"logo.png"}, "")
+	firstDue := *milestone.ReviewDueAt(window)
+
+	if _, err := contract.AutoApproveMilestone(milestone.ID(), window, firstDue.Add(-time.Minute)); err != ErrReviewWindowOpen {
+		t.Errorf("AutoApproveMilestone() inside window = %v, want %v", err, ErrReviewWindowOpen)
+	}
+
+	// A revision request stops the clock; delivering again starts a fresh window
+	contract.RequestRevision(contract.ClientID(), milestone.ID(), "Use the brand colours", 0)
+	if milestone.ReviewDueAt(window) != nil {
+		t.Error("ReviewDueAt() should be nil while a revision is in progress")
+	}
+	if _, err := contract.AutoApproveMilestone(milestone.ID(), window, firstDue); err != ErrMilestoneNotDelivered {
+		t.Errorf("AutoApproveMilestone() after revision request = %v, want %v", err, ErrMilestoneNotDelivered)
+	}
+
+	contract.Deliver(contract.HustlerID(), []string{"logo-v2.png"}, "")
+	dueAt := *milestone.ReviewDueAt(window)
+
+	contract.ClearEvents()
+	if _, err := contract.AutoApproveMilestone(milestone.ID(), window, dueAt); err != nil {
+		t.Fatalf("AutoApproveMilestone() error = %v", err)
+	}
+	if !milestone.IsReleased() || !contract.IsCompleted() {
+		t.Errorf("after auto-approval: milestone %s, contract %s", milestone.Status(), contract.Status())
+	}
+
+	events := contract.DomainEvents()
+	if len(events) == 0 || events[0].EventType() != "MilestoneAutoApproved" {
+		t.Errorf("first event = %v, want MilestoneAutoApproved", events)
+	}
+}
+
+func TestContract_RevisionsAndDeliveryVersions(t *testing.T) {
+	contract := createTestContract(t, 40000)
+	milestone := contract.Milestones()[0]
+	contract.FundMilestone(contract.ClientID(), milestone.ID())
+	deadline := contract.DeadlineAt()
+
+	contract.Deliver(contract.HustlerID(), []string{"draft.pdf"}, "First draft")
+	if err := contract.RequestRevision(contract.ClientID(), milestone.ID(), " ", 0); err != ErrRevisionReasonNeeded {
+		t.Errorf("RequestRevision() without reason = %v, want %v", err, ErrRevisionReasonNeeded)
+	}
+	if err := contract.RequestRevision(contract.ClientID(), milestone.ID(), "Tighten the intro", MaxRevisionExtensionDays+1); err != ErrInvalidExtension {
+		t.Errorf("RequestRevision() long extension = %v, want %v", err, ErrInvalidExtension)
+	}
+	if err := contract.RequestRevision(contract.ClientID(), milestone.ID(), "Tighten the intro", 3); err != nil {
+		t.Fatalf("RequestRevision() error = %v", err)
+	}
+	if got := contract.DeadlineAt().Sub(deadline); got != 72*time.Hour {
+		t.Errorf("deadline moved by %v, want 72h", got)
+	}
+
+	contract.Deliver(contract.HustlerID(), []string{"draft-v2.pdf"}, "Intro rewritten")
+	contract.RequestRevision(contract.ClientID(), milestone.ID(), "Fix the typos", 0)
+	contract.Deliver(contract.HustlerID(), []string{"draft-v3.pdf"}, "")
+
+	if contract.RevisionsRemaining() != 0 {
+		t.Errorf("RevisionsRemaining() = %d, want 0", contract.RevisionsRemaining())
+	}
+	if err := contract.RequestRevision(contract.ClientID(), milestone.ID(), "One more pass", 0); err != ErrRevisionLimitReached {
+		t.Errorf("RequestRevision() over limit = %v, want %v", err, ErrRevisionLimitReached)
+	}
+
+	contract.Approve(contract.ClientID(), "")
+
+	versions := contract.DeliveriesFor(milestone.ID())
+	if len(versions) != 3 {
+		t.Fatalf("DeliveriesFor() = %d versions, want 3", len(versions))
+	}
+	first, last := versions[0], versions[2]
+	if first.Version() != 1 || first.Status() != DeliveryRevisionRequested || first.RevisionReason() != "Tighten the intro" {
+		t.Errorf("v1 = version %d, status %s, reason %q", first.Version(), first.Status(), first.RevisionReason())
+	}
+	if first.Attachments()[0] != "draft.pdf" || first.Notes() != "First draft" {
+		t.Error("v1 attachments or notes were overwritten")
+	}
+	if last.Version() != 3 || last.Status() != DeliveryAccepted {
+		t.Errorf("v3 = version %d, status %s", last.Version(), last.Status())
+	}
+}
@@ -0,0 +1,423 @@
+package aggregate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Conversation errors
+var (
+	ErrInvalidConversationSubject = errors.New("invalid conversation subject")
+	ErrCannotMessageSelf          = errors.New("cannot start a conversation with yourself")
+	ErrNotConversationParty       = errors.New("not a participant in this conversation")
+	ErrEmptyMessage               = errors.New("message needs text or an attachment")
+	ErrMessageTooLong             = errors.New("message is too long")
+	ErrTooManyMessageAttachments  = errors.New("too many message attachments")
+	ErrContractAlreadyLinked      = errors.New("conversation is already linked to a contract")
+)
+
+const (
+	// MaxMessageLength is the longest message body, in characters
+	MaxMessageLength = 5000
+	// MaxMessageAttachments is the most attachments one message can carry
+	MaxMessageAttachments = 10
+)
+
+// ConversationSubject is what a conversation is about
+type ConversationSubject string
+
+const (
+	ConversationSubjectGig      ConversationSubject = "gig"      // A question about a gig or an invitation
+	ConversationSubjectProposal ConversationSubject = "proposal" // Negotiating a proposal
+	ConversationSubjectContract ConversationSubject = "contract" // Coordinating delivery
+)
+
+func (s ConversationSubject) IsValid() bool {
+	switch s {
+	case ConversationSubjectGig, ConversationSubjectProposal, ConversationSubjectContract:
+		return true
+	}
+	return false
+}
+
+// Message is a single message within a Conversation
+type Message struct {
+	id          string
+	seq         int
+	senderID    valueobject.UserID
+	body        string
+	attachments []string
+	redacted    []ContactKind
+	sentAt      time.Time
+}
+
+// ReconstructMessage reconstructs a message from persistence
+func ReconstructMessage(
+	id string,
+	seq int,
+	senderID valueobject.UserID,
+	body string,
+	attachments []string,
+	redacted []ContactKind,
+	sentAt time.Time,
+) *Message {
+	return &Message{
+		id:          id,
+		seq:         seq,
+		senderID:    senderID,
+		body:        body,
+		attachments: attachments,
+		redacted:    redacted,
+		sentAt:      sentAt,
+	}
+}
+
+func (m *Message) ID() string                   { return m.id }
+func (m *Message) Seq() int                     { return m.seq }
+func (m *Message) SenderID() valueobject.UserID { return m.senderID }
+func (m *Message) Body() string                 { return m.body }
+func (m *Message) Attachments() []string        { return m.attachments }
+func (m *Message) Redacted() []ContactKind      { return m.redacted }
+func (m *Message) SentAt() time.Time            { return m.sentAt }
+
+// ReadReceipt records how far a participant has read
+type ReadReceipt struct {
+	UpToSeq int
+	ReadAt  time.Time
+}
+
+// Conversation is the aggregate root for messages between a client and a hustler
+// about one gig, proposal or contract. Contact details are screened out of messages
+// until the two sign a contract, so deals stay under escrow protection.
+type Conversation struct {
+	sharedevent.AggregateRoot
+
+	id         valueobject.ConversationID
+	subject    ConversationSubject
+	subjectID  string
+	gigID      *valueobject.GigID
+	contractID *valueobject.ContractID
+	clientID   valueobject.UserID
+	hustlerID  valueobject.UserID
+	messages   []*Message
+	receipts   map[string]ReadReceipt // keyed by participant ID
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewConversation starts a conversation. Conversations about a contract are not
+// screened for contact details.
+func NewConversation(
+	id valueobject.ConversationID,
+	subject ConversationSubject,
+	subjectID string,
+	gigID *valueobject.GigID,
+	contractID *valueobject.ContractID,
+	clientID valueobject.UserID,
+	hustlerID valueobject.UserID,
+	startedBy valueobject.UserID,
+	now time.Time,
+) (*Conversation, error) {
+	if !subject.IsValid() || subjectID == "" {
+		return nil, ErrInvalidConversationSubject
+	}
+	if subject == ConversationSubjectContract && contractID == nil {
+		return nil, ErrInvalidConversationSubject
+	}
+	if clientID.Equals(hustlerID) {
+		return nil, ErrCannotMessageSelf
+	}
+	if !startedBy.Equals(clientID) && !startedBy.Equals(hustlerID) {
+		return nil, ErrNotConversationParty
+	}
+
+	c := &Conversation{
+		id:         id,
+		subject:    subject,
+		subjectID:  subjectID,
+		gigID:      gigID,
+		contractID: contractID,
+		clientID:   clientID,
+		hustlerID:  hustlerID,
+		messages:   make([]*Message, 0),
+		receipts:   make(map[string]ReadReceipt),
+		createdAt:  now,
+		updatedAt:  now,
+	}
+
+	gigIDStr, contractIDStr := "", ""
+	if gigID != nil {
+		gigIDStr = gigID.String()
+	}
+	if contractID != nil {
+		contractIDStr = contractID.String()
+	}
+	c.RecordEvent(event.NewConversationStarted(
+		id.String(),
+		string(subject),
+		subjectID,
+		gigIDStr,
+		contractIDStr,
+		clientID.String(),
+		hustlerID.String(),
+		startedBy.String(),
+	))
+
+	return c, nil
+}
+
+// ReconstructConversation reconstructs a conversation from persistence
+func ReconstructConversation(
+	id valueobject.ConversationID,
+	subject ConversationSubject,
+	subjectID string,
+	gigID *valueobject.GigID,
+	contractID *valueobject.ContractID,
+	clientID valueobject.UserID,
+	hustlerID valueobject.UserID,
+	messages []*Message,
+	receipts map[string]ReadReceipt,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Conversation {
+	if receipts == nil {
+		receipts = make(map[string]ReadReceipt)
+	}
+	return &Conversation{
+		id:         id,
+		subject:    subject,
+		subjectID:  subjectID,
+		gigID:      gigID,
+		contractID: contractID,
+		clientID:   clientID,
+		hustlerID:  hustlerID,
+		messages:   messages,
+		receipts:   receipts,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// Getters
+func (c *Conversation) ID() valueobject.ConversationID      { return c.id }
+func (c *Conversation) Subject() ConversationSubject        { return c.subject }
+func (c *Conversation) SubjectID() string                   { return c.subjectID }
+func (c *Conversation) GigID() *valueobject.GigID           { return c.gigID }
+func (c *Conversation) ContractID() *valueobject.ContractID { return c.contractID }
+func (c *Conversation) ClientID() valueobject.UserID        { return c.clientID }
+func (c *Conversation) HustlerID() valueobject.UserID       { return c.hustlerID }
+func (c *Conversation) Messages() []*Message                { return c.messages }
+func (c *Conversation) CreatedAt() time.Time                { return c.createdAt }
+func (c *Conversation) UpdatedAt() time.Time                { return c.updatedAt }
+
+// ReadReceipts returns how far each participant has read, keyed by user ID
+func (c *Conversation) ReadReceipts() map[string]ReadReceipt { return c.receipts }
+
+// ScreensContactInfo returns true until the parties have a contract
+func (c *Conversation) ScreensContactInfo() bool { return c.contractID == nil }
+
+// IsParticipant returns true for the client and the hustler
+func (c *Conversation) IsParticipant(userID valueobject.UserID) bool {
+	return userID.Equals(c.clientID) || userID.Equals(c.hustlerID)
+}
+
+// OtherParty returns the participant who is not the given user
+func (c *Conversation) OtherParty(userID valueobject.UserID) valueobject.UserID {
+	if userID.Equals(c.clientID) {
+		return c.hustlerID
+	}
+	return c.clientID
+}
+
+// LastMessage returns the most recent message, or nil if nothing has been sent
+func (c *Conversation) LastMessage() *Message {
+	if len(c.messages) == 0 {
+		return nil
+	}
+	return c.messages[len(c.messages)-1]
+}
+
+// LastSeq returns the sequence number of the most recent message
+func (c *Conversation) LastSeq() int {
+	if last := c.LastMessage(); last != nil {
+		return last.seq
+	}
+	return 0
+}
+
+// SendMessage posts a message. Before a contract starts, phone numbers, email
+// addresses and account numbers are hidden from the text.
+func (c *Conversation) SendMessage(senderID valueobject.UserID, body string, attachments []string, now time.Time) (*Message, error) {
+	if !c.IsParticipant(senderID) {
+		return nil, ErrNotConversationParty
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" && len(attachments) == 0 {
+		return nil, ErrEmptyMessage
+	}
+	if len([]rune(body)) > MaxMessageLength {
+		return nil, ErrMessageTooLong
+	}
+	if len(attachments) > MaxMessageAttachments {
+		return nil, ErrTooManyMessageAttachments
+	}
+
+	var redacted []ContactKind
+	if c.ScreensContactInfo() {
+		body, redacted = ScreenContactInfo(body)
+	}
+
+	message := &Message{
+		id:          valueobject.GenerateMessageID().String(),
+		seq:         c.LastSeq() + 1,
+		senderID:    senderID,
+		body:        body,
+		attachments: attachments,
+		redacted:    redacted,
+		sentAt:      now,
+	}
+	c.messages = append(c.messages, message)
+
+	// Senders have read everything up to their own message
+	c.receipts[senderID.String()] = ReadReceipt{UpToSeq: message.seq, ReadAt: now}
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewMessageSent(
+		c.id.String(),
+		message.id,
+		message.seq,
+		senderID.String(),
+		c.OtherParty(senderID).String(),
+		len(attachments),
+	))
+
+	if len(redacted) > 0 {
+		kinds := make([]string, len(redacted))
+		for i, k := range redacted {
+			kinds[i] = string(k)
+		}
+		c.RecordEvent(event.NewContactInfoRedacted(c.id.String(), message.id, senderID.String(), kinds))
+	}
+
+	return message, nil
+}
+
+// MarkRead records that a participant has read up to a message. Reading never
+// moves backwards; an upToSeq of zero means everything so far.
+func (c *Conversation) MarkRead(readerID valueobject.UserID, upToSeq int, now time.Time) error {
+	if !c.IsParticipant(readerID) {
+		return ErrNotConversationParty
+	}
+
+	last := c.LastSeq()
+	if upToSeq <= 0 || upToSeq > last {
+		upToSeq = last
+	}
+	if upToSeq <= c.receipts[readerID.String()].UpToSeq {
+		return nil
+	}
+
+	c.receipts[readerID.String()] = ReadReceipt{UpToSeq: upToSeq, ReadAt: now}
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewMessagesRead(c.id.String(), readerID.String(), upToSeq))
+
+	return nil
+}
+
+// UnreadCount returns how many messages from the other party the user has not read
+func (c *Conversation) UnreadCount(userID valueobject.UserID) int {
+	readUpTo := c.receipts[userID.String()].UpToSeq
+	count := 0
+	for _, m := range c.messages {
+		if m.seq > readUpTo && !m.senderID.Equals(userID) {
+			count++
+		}
+	}
+	return count
+}
+
+// IsReadBy returns true if the user has read the message
+func (c *Conversation) IsReadBy(message *Message, userID valueobject.UserID) bool {
+	return c.receipts[userID.String()].UpToSeq >= message.seq
+}
+
+// LinkContract ties the conversation to the contract its parties signed, which
+// stops contact details being screened from new messages
+func (c *Conversation) LinkContract(contractID valueobject.ContractID, now time.Time) error {
+	if c.contractID != nil {
+		if c.contractID.Equals(contractID) {
+			return nil
+		}
+		return ErrContractAlreadyLinked
+	}
+
+	c.contractID = &contractID
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewConversationContractLinked(c.id.String(), contractID.String()))
+
+	return nil
+}
+
+// Transcript is a tamper-evident export of a conversation, for dispute evidence
+type Transcript struct {
+	ConversationID valueobject.ConversationID
+	Subject        ConversationSubject
+	SubjectID      string
+	ClientID       valueobject.UserID
+	HustlerID      valueobject.UserID
+	Messages       []*Message
+	ExportedBy     valueobject.UserID
+	ExportedAt     time.Time
+	Checksum       string // SHA-256 of the messages in order
+}
+
+// Export produces a transcript of every message for one of the participants
+func (c *Conversation) Export(requestedBy valueobject.UserID, now time.Time) (*Transcript, error) {
+	if !c.IsParticipant(requestedBy) {
+		return nil, ErrNotConversationParty
+	}
+
+	messages := make([]*Message, len(c.messages))
+	copy(messages, c.messages)
+
+	transcript := &Transcript{
+		ConversationID: c.id,
+		Subject:        c.subject,
+		SubjectID:      c.subjectID,
+		ClientID:       c.clientID,
+		HustlerID:      c.hustlerID,
+		Messages:       messages,
+		ExportedBy:     requestedBy,
+		ExportedAt:     now,
+		Checksum:       transcriptChecksum(messages),
+	}
+
+	c.RecordEvent(event.NewConversationExported(c.id.String(), requestedBy.String(), len(messages), transcript.Checksum))
+
+	return transcript, nil
+}
+
+func transcriptChecksum(messages []*Message) string {
+	h := sha256.New()
+	for _, m := range messages {
+		fmt.Fprintf(h, "%d\x1f%s\x1f%s\x1f%s\x1f%s\x1e",
+			m.seq,
+			m.sentAt.UTC().Format(time.RFC3339Nano),
+			m.senderID.String(),
+			m.body,
+			strings.Join(m.attachments, ","),
+		)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
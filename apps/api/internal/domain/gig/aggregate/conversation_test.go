@@ -0,0 +1,154 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func createTestConversation(t *testing.T) *Conversation {
+	t.Helper()
+
+	gigID := valueobject.GenerateGigID()
+	clientID := valueobject.GenerateUserID()
+	c, err := NewConversation(
+		valueobject.GenerateConversationID(),
+		ConversationSubjectGig,
+		gigID.String(),
+		&gigID,
+		nil,
+		clientID,
+		valueobject.GenerateUserID(),
+		clientID,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		t.Fatalf("NewConversation() error = %v", err)
+	}
+	c.ClearEvents()
+	return c
+}
+
+func TestScreenContactInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []ContactKind
+	}{
+		{"local mobile", "call me on 0803 123 4567", []ContactKind{ContactKindPhone}},
+		{"international mobile", "whatsapp +234-803-123-4567 abeg", []ContactKind{ContactKindPhone}},
+		{"email", "send it to ada.obi@example.com", []ContactKind{ContactKindEmail}},
+		{"nuban", "pay into GTBank 0123456789", []ContactKind{ContactKindBankAccount}},
+		{"phone and account", "08031234567 or acct 2034567891", []ContactKind{ContactKindPhone, ContactKindBankAccount}},
+		{"prices are fine", "I can do it for 45000 in 3 days", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screened, kinds := ScreenContactInfo(tt.text)
+			if len(kinds) != len(tt.want) {
+				t.Fatalf("ScreenContactInfo(%q) kinds = %v, want %v", tt.text, kinds, tt.want)
+			}
+			for i := range tt.want {
+				if kinds[i] != tt.want[i] {
+					t.Fatalf("ScreenContactInfo(%q) kinds = %v, want %v", tt.text, kinds, tt.want)
+				}
+			}
+			if len(tt.want) == 0 && screened != tt.text {
+				t.Errorf("ScreenContactInfo(%q) changed clean text to %q", tt.text, screened)
+			}
+		})
+	}
+}
+
+func TestConversation_SendMessage_ScreensUntilContract(t *testing.T) {
+	c := createTestConversation(t)
+	now := time.Now().UTC()
+
+	if _, err := c.SendMessage(valueobject.GenerateUserID(), "hi", nil, now); err != ErrNotConversationParty {
+		t.Errorf("SendMessage() by stranger error = %v, want ErrNotConversationParty", err)
+	}
+	if _, err := c.SendMessage(c.ClientID(), "   ", nil, now); err != ErrEmptyMessage {
+		t.Errorf("SendMessage() empty error = %v, want ErrEmptyMessage", err)
+	}
+
+	m, err := c.SendMessage(c.HustlerID(), "Text me on 08031234567", nil, now)
+	if err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if m.Body() != "Text me on "+RedactedContact || len(m.Redacted()) != 1 {
+		t.Errorf("SendMessage() body = %q, redacted %v", m.Body(), m.Redacted())
+	}
+	// MessageSent and ContactInfoRedacted
+	if len(c.DomainEvents()) != 2 {
+		t.Errorf("expected 2 events, got %d", len(c.DomainEvents()))
+	}
+
+	if err := c.LinkContract(valueobject.GenerateContractID(), now); err != nil {
+		t.Fatalf("LinkContract() error = %v", err)
+	}
+	m, _ = c.SendMessage(c.HustlerID(), "Text me on 08031234567", nil, now)
+	if m.Body() != "Text me on 08031234567" || len(m.Redacted()) != 0 {
+		t.Errorf("SendMessage() after contract body = %q, want unscreened", m.Body())
+	}
+	if err := c.LinkContract(valueobject.GenerateContractID(), now); err != ErrContractAlreadyLinked {
+		t.Errorf("LinkContract() again error = %v, want ErrContractAlreadyLinked", err)
+	}
+}
+
+func TestConversation_ReadReceipts(t *testing.T) {
+	c := createTestConversation(t)
+	now := time.Now().UTC()
+
+	first, _ := c.SendMessage(c.ClientID(), "Can you start Monday?", nil, now)
+	c.SendMessage(c.ClientID(), "Materials are on site", nil, now)
+
+	if c.UnreadCount(c.HustlerID()) != 2 || c.UnreadCount(c.ClientID()) != 0 {
+		t.Fatalf("UnreadCount() = %d/%d, want 2/0", c.UnreadCount(c.HustlerID()), c.UnreadCount(c.ClientID()))
+	}
+
+	if err := c.MarkRead(c.HustlerID(), first.Seq(), now); err != nil {
+		t.Fatalf("MarkRead() error = %v", err)
+	}
+	if !c.IsReadBy(first, c.HustlerID()) || c.UnreadCount(c.HustlerID()) != 1 {
+		t.Errorf("after reading the first message unread = %d, want 1", c.UnreadCount(c.HustlerID()))
+	}
+
+	// Zero reads everything; reading never goes backwards
+	c.MarkRead(c.HustlerID(), 0, now)
+	c.MarkRead(c.HustlerID(), first.Seq(), now)
+	if c.UnreadCount(c.HustlerID()) != 0 {
+		t.Errorf("UnreadCount() = %d, want 0", c.UnreadCount(c.HustlerID()))
+	}
+}
+
+func TestConversation_Export(t *testing.T) {
+	c := createTestConversation(t)
+	now := time.Now().UTC()
+	c.SendMessage(c.ClientID(), "Please use the blue tiles", []string{"uploads/tiles.jpg"}, now)
+	c.SendMessage(c.HustlerID(), "Noted", nil, now)
+
+	if _, err := c.Export(valueobject.GenerateUserID(), now); err != ErrNotConversationParty {
+		t.Errorf("Export() by stranger error = %v, want ErrNotConversationParty", err)
+	}
+
+	first, err := c.Export(c.HustlerID(), now)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(first.Messages) != 2 || first.Checksum == "" {
+		t.Fatalf("Export() = %d messages, checksum %q", len(first.Messages), first.Checksum)
+	}
+
+	second, _ := c.Export(c.ClientID(), now.Add(time.Hour))
+	if second.Checksum != first.Checksum {
+		t.Error("Export() checksum should only depend on the messages")
+	}
+
+	c.SendMessage(c.ClientID(), "One more thing", nil, now)
+	third, _ := c.Export(c.ClientID(), now)
+	if third.Checksum == first.Checksum {
+		t.Error("Export() checksum should change when messages are added")
+	}
+}
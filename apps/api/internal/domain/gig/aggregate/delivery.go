@@ -0,0 +1,153 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Delivery and revision errors
+var (
+	ErrRevisionLimitReached = errors.New("all agreed revisions have been used")
+	ErrRevisionReasonNeeded = errors.New("a revision request needs a reason")
+	ErrInvalidExtension     = errors.New("deadline extension must be between 0 and 30 days")
+)
+
+// MaxRevisionExtensionDays is the most a single revision request can push the deadline back
+const MaxRevisionExtensionDays = 30
+
+// DeliveryStatus represents what the client made of a delivered version
+type DeliveryStatus string
+
+const (
+	DeliverySubmitted         DeliveryStatus = "submitted"
+	DeliveryRevisionRequested DeliveryStatus = "revision_requested"
+	DeliveryAccepted          DeliveryStatus = "accepted"
+)
+
+// Delivery is one numbered version of the work submitted for a milestone.
+// Earlier versions are kept when the client asks for changes.
+type Delivery struct {
+	id             string
+	milestoneID    string
+	version        int
+	attachments    []string
+	notes          string
+	status         DeliveryStatus
+	revisionReason string
+	deliveredAt    time.Time
+	reviewedAt     *time.Time
+}
+
+// ReconstructDelivery reconstructs a delivery from persistence
+func ReconstructDelivery(
+	id string,
+	milestoneID string,
+	version int,
+	attachments []string,
+	notes string,
+	status DeliveryStatus,
+	revisionReason string,
+	deliveredAt time.Time,
+	reviewedAt *time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             id,
+		milestoneID:    milestoneID,
+		version:        version,
+		attachments:    attachments,
+		notes:          notes,
+		status:         status,
+		revisionReason: revisionReason,
+		deliveredAt:    deliveredAt,
+		reviewedAt:     reviewedAt,
+	}
+}
+
+func (d *Delivery) ID() string             { return d.id }
+func (d *Delivery) MilestoneID() string    { return d.milestoneID }
+func (d *Delivery) Version() int           { return d.version }
+func (d *Delivery) Attachments() []string  { return d.attachments }
+func (d *Delivery) Notes() string          { return d.notes }
+func (d *Delivery) Status() DeliveryStatus { return d.status }
+func (d *Delivery) RevisionReason() string { return d.revisionReason }
+func (d *Delivery) DeliveredAt() time.Time { return d.deliveredAt }
+func (d *Delivery) ReviewedAt() *time.Time { return d.reviewedAt }
+
+func (d *Delivery) review(status DeliveryStatus, reason string) {
+	now := time.Now().UTC()
+	d.status = status
+	d.revisionReason = reason
+	d.reviewedAt = &now
+}
+
+// Getters
+func (c *Contract) MaxRevisions() int       { return c.maxRevisions }
+func (c *Contract) Deliveries() []*Delivery { return c.deliveries }
+
+// DeliveriesFor returns every version delivered for a milestone, oldest first
+func (c *Contract) DeliveriesFor(milestoneID string) []*Delivery {
+	versions := make([]*Delivery, 0)
+	for _, d := range c.deliveries {
+		if d.milestoneID == milestoneID {
+			versions = append(versions, d)
+		}
+	}
+	return versions
+}
+
+// LatestDelivery returns the most recent version delivered for a milestone, or nil
+func (c *Contract) LatestDelivery(milestoneID string) *Delivery {
+	for i := len(c.deliveries) - 1; i >= 0; i-- {
+		if c.deliveries[i].milestoneID == milestoneID {
+			return c.deliveries[i]
+		}
+	}
+	return nil
+}
+
+// RevisionsUsed counts the revision rounds the client has asked for across all milestones
+func (c *Contract) RevisionsUsed() int {
+	used := 0
+	for _, m := range c.milestones {
+		used += m.revisions
+	}
+	return used
+}
+
+// RevisionsRemaining is how many more revision rounds the client may ask for
+func (c *Contract) RevisionsRemaining() int {
+	remaining := c.maxRevisions - c.RevisionsUsed()
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// recordDelivery stores the next numbered version of a milestone's work
+func (c *Contract) recordDelivery(milestone *Milestone, attachments []string, notes string) *Delivery {
+	delivery := &Delivery{
+		id:          valueobject.GenerateDeliveryID().String(),
+		milestoneID: milestone.id,
+		version:     len(c.DeliveriesFor(milestone.id)) + 1,
+		attachments: attachments,
+		notes:       notes,
+		status:      DeliverySubmitted,
+		deliveredAt: *milestone.deliveredAt,
+	}
+	c.deliveries = append(c.deliveries, delivery)
+	return delivery
+}
+
+// extendFrom pushes the deadline of a milestone, every milestone after it and the
+// contract back by the given number of days, keeping due dates in order
+func (c *Contract) extendFrom(milestone *Milestone, days int) {
+	extension := time.Duration(days) * 24 * time.Hour
+	for _, m := range c.milestones {
+		if m.sequence >= milestone.sequence {
+			m.dueDate = m.dueDate.Add(extension)
+		}
+	}
+	c.deadlineAt = c.deadlineAt.Add(extension)
+}
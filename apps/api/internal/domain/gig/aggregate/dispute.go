@@ -0,0 +1,587 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Dispute errors
+var (
+	ErrDisputeReasonRequired  = errors.New("dispute reason is required")
+	ErrNothingInEscrow        = errors.New("nothing is held in escrow to dispute")
+	ErrNotDisputeParty        = errors.New("not a party to this dispute")
+	ErrDisputeResolved        = errors.New("dispute has already been resolved")
+	ErrInvalidEvidence        = errors.New("evidence needs a statement or an attachment")
+	ErrTooManyAttachments     = errors.New("too many evidence attachments")
+	ErrMediatorIsParty        = errors.New("a party to the dispute cannot mediate it")
+	ErrNotDisputeMediator     = errors.New("only the assigned mediator can do this")
+	ErrInvalidDisputeOutcome  = errors.New("invalid dispute outcome")
+	ErrInvalidSplit           = errors.New("a split must give the client between 1 and 99 percent")
+	ErrInvalidResponseWindow  = errors.New("response window must be positive")
+	ErrResponseDeadlineNotDue = errors.New("no response deadline has been missed")
+	ErrDisputeNotResolved     = errors.New("dispute has not been resolved")
+	ErrMilestoneNotDisputed   = errors.New("milestone is not in dispute")
+)
+
+// DisputeResponseWindow is how long the other party has to answer a new dispute
+const DisputeResponseWindow = 72 * time.Hour
+
+// MaxEvidenceAttachments is the most attachments one piece of evidence can carry
+const MaxEvidenceAttachments = 10
+
+// DisputeStatus represents where a dispute is in its resolution
+type DisputeStatus string
+
+const (
+	DisputeAwaitingResponse DisputeStatus = "awaiting_response" // The other party has not answered yet
+	DisputeUnderReview      DisputeStatus = "under_review"      // Both sides heard, waiting for a mediator
+	DisputeInMediation      DisputeStatus = "in_mediation"
+	DisputeStatusResolved   DisputeStatus = "resolved"
+)
+
+// DisputeOutcome is how the escrow under dispute is divided
+type DisputeOutcome string
+
+const (
+	DisputeOutcomeRefund  DisputeOutcome = "refund"  // Everything back to the client
+	DisputeOutcomeRelease DisputeOutcome = "release" // Everything to the hustler
+	DisputeOutcomeSplit   DisputeOutcome = "split"   // Divided by percentage
+)
+
+func (o DisputeOutcome) IsValid() bool {
+	return o == DisputeOutcomeRefund || o == DisputeOutcomeRelease || o == DisputeOutcomeSplit
+}
+
+// Evidence is a statement and attachment references submitted to a dispute
+type Evidence struct {
+	id          string
+	submittedBy valueobject.UserID
+	statement   string
+	attachments []string
+	submittedAt time.Time
+}
+
+// ReconstructEvidence reconstructs evidence from persistence
+func ReconstructEvidence(id string, submittedBy valueobject.UserID, statement string, attachments []string, submittedAt time.Time) *Evidence {
+	return &Evidence{
+		id:          id,
+		submittedBy: submittedBy,
+		statement:   statement,
+		attachments: attachments,
+		submittedAt: submittedAt,
+	}
+}
+
+func (e *Evidence) ID() string                      { return e.id }
+func (e *Evidence) SubmittedBy() valueobject.UserID { return e.submittedBy }
+func (e *Evidence) Statement() string               { return e.statement }
+func (e *Evidence) Attachments() []string           { return e.attachments }
+func (e *Evidence) SubmittedAt() time.Time          { return e.submittedAt }
+
+// DisputeResolution records how a dispute was decided and how its escrow is divided
+type DisputeResolution struct {
+	outcome       DisputeOutcome
+	clientPercent int
+	refund        valueobject.Money
+	release       valueobject.Money
+	releaseFee    valueobject.Money
+	resolvedBy    *valueobject.UserID // nil when resolved by a missed deadline
+	notes         string
+	resolvedAt    time.Time
+}
+
+// ReconstructDisputeResolution reconstructs a resolution from persistence
+func ReconstructDisputeResolution(
+	outcome DisputeOutcome,
+	clientPercent int,
+	refund valueobject.Money,
+	release valueobject.Money,
+	releaseFee valueobject.Money,
+	resolvedBy *valueobject.UserID,
+	notes string,
+	resolvedAt time.Time,
+) *DisputeResolution {
+	return &DisputeResolution{
+		outcome:       outcome,
+		clientPercent: clientPercent,
+		refund:        refund,
+		release:       release,
+		releaseFee:    releaseFee,
+		resolvedBy:    resolvedBy,
+		notes:         notes,
+		resolvedAt:    resolvedAt,
+	}
+}
+
+func (r *DisputeResolution) Outcome() DisputeOutcome         { return r.outcome }
+func (r *DisputeResolution) ClientPercent() int              { return r.clientPercent }
+func (r *DisputeResolution) Refund() valueobject.Money       { return r.refund }
+func (r *DisputeResolution) Release() valueobject.Money      { return r.release }
+func (r *DisputeResolution) ReleaseFee() valueobject.Money   { return r.releaseFee }
+func (r *DisputeResolution) ResolvedBy() *valueobject.UserID { return r.resolvedBy }
+func (r *DisputeResolution) Notes() string                   { return r.notes }
+func (r *DisputeResolution) ResolvedAt() time.Time           { return r.resolvedAt }
+func (r *DisputeResolution) ByDefault() bool                 { return r.resolvedBy == nil }
+
+// Dispute is the aggregate root for a disagreement over a contract's escrow.
+// It freezes the disputed milestones until a mediator, or a missed deadline, decides them.
+type Dispute struct {
+	sharedevent.AggregateRoot
+
+	id           valueobject.DisputeID
+	contractID   valueobject.ContractID
+	gigID        valueobject.GigID
+	milestoneIDs []string
+	clientID     valueobject.UserID
+	hustlerID    valueobject.UserID
+	raisedBy     valueobject.UserID
+	reason       string
+	amount       valueobject.Money // escrow under dispute
+	platformFee  valueobject.Money // fee owed on that escrow if it is released
+	status       DisputeStatus
+	evidence     []*Evidence
+
+	awaitingParty *valueobject.UserID
+	responseDueAt *time.Time
+	mediatorID    *valueobject.UserID
+	resolution    *DisputeResolution
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// OpenDispute opens a dispute over the escrow of the given contract milestones.
+// The other party has DisputeResponseWindow to answer.
+func OpenDispute(
+	id valueobject.DisputeID,
+	contract *Contract,
+	milestones []*Milestone,
+	raisedBy valueobject.UserID,
+	reason string,
+) (*Dispute, error) {
+	if !contract.IsParty(raisedBy) {
+		return nil, ErrNotContractParty
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrDisputeReasonRequired
+	}
+
+	currency := contract.AgreedPrice().Currency()
+	amount := valueobject.Zero(currency)
+	fee := valueobject.Zero(currency)
+	milestoneIDs := make([]string, 0, len(milestones))
+	for _, m := range milestones {
+		amount = amount.MustAdd(m.Amount())
+		fee = fee.MustAdd(m.PlatformFee())
+		milestoneIDs = append(milestoneIDs, m.ID())
+	}
+	if !amount.IsPositive() {
+		return nil, ErrNothingInEscrow
+	}
+
+	respondent := contract.ClientID()
+	if raisedBy.Equals(contract.ClientID()) {
+		respondent = contract.HustlerID()
+	}
+
+	now := time.Now().UTC()
+	dueAt := now.Add(DisputeResponseWindow)
+	d := &Dispute{
+		id:            id,
+		contractID:    contract.ID(),
+		gigID:         contract.GigID(),
+		milestoneIDs:  milestoneIDs,
+		clientID:      contract.ClientID(),
+		hustlerID:     contract.HustlerID(),
+		raisedBy:      raisedBy,
+		reason:        reason,
+		amount:        amount,
+		platformFee:   fee,
+		status:        DisputeAwaitingResponse,
+		evidence:      make([]*Evidence, 0),
+		awaitingParty: &respondent,
+		responseDueAt: &dueAt,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	d.RecordEvent(event.NewDisputeOpened(
+		id.String(),
+		contract.ID().String(),
+		milestoneIDs,
+		raisedBy.String(),
+		respondent.String(),
+		reason,
+		amount.Amount(),
+		dueAt,
+	))
+
+	return d, nil
+}
+
+// ReconstructDispute reconstructs a dispute from persistence
+func ReconstructDispute(
+	id valueobject.DisputeID,
+	contractID valueobject.ContractID,
+	gigID valueobject.GigID,
+	milestoneIDs []string,
+	clientID valueobject.UserID,
+	hustlerID valueobject.UserID,
+	raisedBy valueobject.UserID,
+	reason string,
+	amount valueobject.Money,
+	platformFee valueobject.Money,
+	status DisputeStatus,
+	evidence []*Evidence,
+	awaitingParty *valueobject.UserID,
+	responseDueAt *time.Time,
+	mediatorID *valueobject.UserID,
+	resolution *DisputeResolution,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Dispute {
+	return &Dispute{
+		id:            id,
+		contractID:    contractID,
+		gigID:         gigID,
+		milestoneIDs:  milestoneIDs,
+		clientID:      clientID,
+		hustlerID:     hustlerID,
+		raisedBy:      raisedBy,
+		reason:        reason,
+		amount:        amount,
+		platformFee:   platformFee,
+		status:        status,
+		evidence:      evidence,
+		awaitingParty: awaitingParty,
+		responseDueAt: responseDueAt,
+		mediatorID:    mediatorID,
+		resolution:    resolution,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Getters
+func (d *Dispute) ID() string                         { return d.id.String() }
+func (d *Dispute) DisputeID() valueobject.DisputeID   { return d.id }
+func (d *Dispute) ContractID() valueobject.ContractID { return d.contractID }
+func (d *Dispute) GigID() valueobject.GigID           { return d.gigID }
+func (d *Dispute) MilestoneIDs() []string             { return d.milestoneIDs }
+func (d *Dispute) ClientID() valueobject.UserID       { return d.clientID }
+func (d *Dispute) HustlerID() valueobject.UserID      { return d.hustlerID }
+func (d *Dispute) RaisedBy() valueobject.UserID       { return d.raisedBy }
+func (d *Dispute) Reason() string                     { return d.reason }
+func (d *Dispute) Amount() valueobject.Money          { return d.amount }
+func (d *Dispute) PlatformFee() valueobject.Money     { return d.platformFee }
+func (d *Dispute) Status() DisputeStatus              { return d.status }
+func (d *Dispute) Evidence() []*Evidence              { return d.evidence }
+func (d *Dispute) AwaitingParty() *valueobject.UserID { return d.awaitingParty }
+func (d *Dispute) ResponseDueAt() *time.Time          { return d.responseDueAt }
+func (d *Dispute) MediatorID() *valueobject.UserID    { return d.mediatorID }
+func (d *Dispute) Resolution() *DisputeResolution     { return d.resolution }
+func (d *Dispute) CreatedAt() time.Time               { return d.createdAt }
+func (d *Dispute) UpdatedAt() time.Time               { return d.updatedAt }
+func (d *Dispute) IsResolved() bool                   { return d.status == DisputeStatusResolved }
+
+// IsParty checks if a user is the client or hustler in the dispute
+func (d *Dispute) IsParty(userID valueobject.UserID) bool {
+	return d.clientID.Equals(userID) || d.hustlerID.Equals(userID)
+}
+
+// IsResponseOverdue reports whether the party the dispute is waiting on has missed their deadline
+func (d *Dispute) IsResponseOverdue(asOf time.Time) bool {
+	return !d.IsResolved() && d.awaitingParty != nil && d.responseDueAt != nil && asOf.After(*d.responseDueAt)
+}
+
+// SubmitEvidence adds a party's statement and attachment references.
+// Evidence from the party the dispute is waiting on answers the outstanding deadline.
+func (d *Dispute) SubmitEvidence(evidenceID string, userID valueobject.UserID, statement string, attachments []string) (*Evidence, error) {
+	if !d.IsParty(userID) {
+		return nil, ErrNotDisputeParty
+	}
+	if d.IsResolved() {
+		return nil, ErrDisputeResolved
+	}
+
+	statement = strings.TrimSpace(statement)
+	if statement == "" && len(attachments) == 0 {
+		return nil, ErrInvalidEvidence
+	}
+	if len(attachments) > MaxEvidenceAttachments {
+		return nil, ErrTooManyAttachments
+	}
+
+	evidence := &Evidence{
+		id:          evidenceID,
+		submittedBy: userID,
+		statement:   statement,
+		attachments: attachments,
+		submittedAt: time.Now().UTC(),
+	}
+	d.evidence = append(d.evidence, evidence)
+
+	if d.awaitingParty != nil && d.awaitingParty.Equals(userID) {
+		d.awaitingParty = nil
+		d.responseDueAt = nil
+		if d.status == DisputeAwaitingResponse {
+			d.status = DisputeUnderReview
+		}
+	}
+	d.updatedAt = evidence.submittedAt
+
+	d.RecordEvent(event.NewDisputeEvidenceSubmitted(d.id.String(), evidenceID, userID.String(), len(attachments)))
+
+	return evidence, nil
+}
+
+// AssignMediator hands the dispute to a mediator, replacing any earlier one
+func (d *Dispute) AssignMediator(mediatorID valueobject.UserID) error {
+	if d.IsResolved() {
+		return ErrDisputeResolved
+	}
+	if d.IsParty(mediatorID) {
+		return ErrMediatorIsParty
+	}
+
+	d.mediatorID = &mediatorID
+	d.status = DisputeInMediation
+	d.updatedAt = time.Now().UTC()
+
+	d.RecordEvent(event.NewDisputeMediatorAssigned(d.id.String(), mediatorID.String()))
+
+	return nil
+}
+
+// RequestResponse has the mediator ask a party for evidence within the given window.
+// The dispute is decided against that party if they miss it.
+func (d *Dispute) RequestResponse(mediatorID, partyID valueobject.UserID, window time.Duration) error {
+	if err := d.checkMediator(mediatorID); err != nil {
+		return err
+	}
+	if !d.IsParty(partyID) {
+		return ErrNotDisputeParty
+	}
+	if window <= 0 {
+		return ErrInvalidResponseWindow
+	}
+
+	dueAt := time.Now().UTC().Add(window)
+	d.awaitingParty = &partyID
+	d.responseDueAt = &dueAt
+	d.updatedAt = time.Now().UTC()
+
+	d.RecordEvent(event.NewDisputeResponseRequested(d.id.String(), mediatorID.String(), partyID.String(), dueAt))
+
+	return nil
+}
+
+// Resolve records the mediator's ruling. clientPercent is only used for a split.
+func (d *Dispute) Resolve(mediatorID valueobject.UserID, outcome DisputeOutcome, clientPercent int, notes string) error {
+	if err := d.checkMediator(mediatorID); err != nil {
+		return err
+	}
+
+	switch outcome {
+	case DisputeOutcomeRefund:
+		clientPercent = 100
+	case DisputeOutcomeRelease:
+		clientPercent = 0
+	case DisputeOutcomeSplit:
+		if clientPercent < 1 || clientPercent > 99 {
+			return ErrInvalidSplit
+		}
+	default:
+		return ErrInvalidDisputeOutcome
+	}
+
+	d.resolve(outcome, clientPercent, &mediatorID, strings.TrimSpace(notes))
+	return nil
+}
+
+// ResolveByDefault decides the dispute in favour of the party that responded when the
+// other side has missed its deadline
+func (d *Dispute) ResolveByDefault(asOf time.Time) error {
+	if d.IsResolved() {
+		return ErrDisputeResolved
+	}
+	if !d.IsResponseOverdue(asOf) {
+		return ErrResponseDeadlineNotDue
+	}
+
+	if d.awaitingParty.Equals(d.hustlerID) {
+		d.resolve(DisputeOutcomeRefund, 100, nil, "Hustler missed the response deadline")
+	} else {
+		d.resolve(DisputeOutcomeRelease, 0, nil, "Client missed the response deadline")
+	}
+	return nil
+}
+
+// LosingParty is the party ruled against in full, or nil for a split or an open dispute
+func (d *Dispute) LosingParty() *valueobject.UserID {
+	if d.resolution == nil {
+		return nil
+	}
+	switch d.resolution.outcome {
+	case DisputeOutcomeRefund:
+		return &d.hustlerID
+	case DisputeOutcomeRelease:
+		return &d.clientID
+	}
+	return nil
+}
+
+func (d *Dispute) checkMediator(mediatorID valueobject.UserID) error {
+	if d.IsResolved() {
+		return ErrDisputeResolved
+	}
+	if d.mediatorID == nil || !d.mediatorID.Equals(mediatorID) {
+		return ErrNotDisputeMediator
+	}
+	return nil
+}
+
+// resolve divides the escrow by the client's percentage. The platform fee is only
+// charged on the share released to the hustler.
+func (d *Dispute) resolve(outcome DisputeOutcome, clientPercent int, resolvedBy *valueobject.UserID, notes string) {
+	currency := d.amount.Currency()
+	refund := d.amount.Amount() * int64(clientPercent) / 100
+	release := d.amount.Amount() - refund
+	fee := d.platformFee.Amount() * release / d.amount.Amount()
+
+	now := time.Now().UTC()
+	d.resolution = &DisputeResolution{
+		outcome:       outcome,
+		clientPercent: clientPercent,
+		refund:        valueobject.MustNewMoney(refund, currency),
+		release:       valueobject.MustNewMoney(release, currency),
+		releaseFee:    valueobject.MustNewMoney(fee, currency),
+		resolvedBy:    resolvedBy,
+		notes:         notes,
+		resolvedAt:    now,
+	}
+	d.status = DisputeStatusResolved
+	d.awaitingParty = nil
+	d.responseDueAt = nil
+	d.updatedAt = now
+
+	losingPartyID := ""
+	if loser := d.LosingParty(); loser != nil {
+		losingPartyID = loser.String()
+	}
+	resolvedByID := ""
+	if resolvedBy != nil {
+		resolvedByID = resolvedBy.String()
+	}
+
+	d.RecordEvent(event.NewDisputeResolved(
+		d.id.String(),
+		d.contractID.String(),
+		d.milestoneIDs,
+		d.clientID.String(),
+		d.hustlerID.String(),
+		string(outcome),
+		clientPercent,
+		refund,
+		release,
+		losingPartyID,
+		resolvedByID,
+		resolvedBy == nil,
+	))
+}
+
+// SettleDispute applies a resolved dispute to the milestones it froze. The contract ends
+// once nothing is left in dispute or to work on: completed if the hustler was paid for
+// any of it, cancelled otherwise.
+func (c *Contract) SettleDispute(dispute *Dispute) error {
+	resolution := dispute.Resolution()
+	if resolution == nil {
+		return ErrDisputeNotResolved
+	}
+	if !dispute.ContractID().Equals(c.id) {
+		return ErrNotContractParty
+	}
+
+	milestones := make([]*Milestone, 0, len(dispute.MilestoneIDs()))
+	for _, id := range dispute.MilestoneIDs() {
+		m := c.FindMilestone(id)
+		if m == nil {
+			return ErrMilestoneNotFound
+		}
+		if m.status != MilestoneDisputed {
+			return ErrMilestoneNotDisputed
+		}
+		milestones = append(milestones, m)
+	}
+
+	status := MilestoneSettled
+	switch {
+	case resolution.Release().IsZero():
+		status = MilestoneRefunded
+	case resolution.Refund().IsZero():
+		status = MilestoneReleased
+	}
+	for _, m := range milestones {
+		settledAt := m.setStatus(status)
+		if status != MilestoneRefunded {
+			m.releasedAt = settledAt
+		}
+	}
+	c.updatedAt = time.Now().UTC()
+
+	c.finishAfterDispute()
+
+	c.RecordEvent(event.NewContractDisputeSettled(
+		c.id.String(),
+		dispute.ID(),
+		dispute.MilestoneIDs(),
+		resolution.Refund().Amount(),
+		resolution.Release().Amount(),
+		string(c.status),
+	))
+
+	return nil
+}
+
+// finishAfterDispute ends a disputed contract once no milestone is left in dispute, and
+// a running contract once a settled milestone was the last piece of work
+func (c *Contract) finishAfterDispute() {
+	for _, m := range c.milestones {
+		if m.status == MilestoneDisputed {
+			return
+		}
+	}
+
+	switch {
+	case c.isInProgress():
+		for _, m := range c.milestones {
+			if m.status == MilestonePending || m.status == MilestoneDelivered || m.isInProgress() {
+				c.syncStatus()
+				return
+			}
+		}
+	case c.status != ContractStatusDisputed:
+		return
+	}
+
+	c.status = ContractStatusCancelled
+	for _, m := range c.milestones {
+		if m.status == MilestonePending {
+			m.setStatus(MilestoneCancelled)
+		}
+	}
+	for _, m := range c.milestones {
+		if m.status == MilestoneReleased || m.status == MilestoneSettled {
+			now := time.Now().UTC()
+			c.status = ContractStatusCompleted
+			c.completedAt = &now
+			return
+		}
+	}
+}
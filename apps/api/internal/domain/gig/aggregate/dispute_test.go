@@ -0,0 +1,146 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func createFundedContract(t *testing.T, price int64) *Contract {
+	t.Helper()
+
+	contract := createTestContract(t, price)
+	for _, m := range contract.MilestonesDueFunding() {
+		if _, err := contract.FundMilestone(contract.ClientID(), m.ID()); err != nil {
+			t.Fatalf("FundMilestone() error = %v", err)
+		}
+	}
+	return contract
+}
+
+func TestDispute_MediatorSplitsEscrow(t *testing.T) {
+	contract := createFundedContract(t, 80000)
+	contract.Deliver(contract.HustlerID(), []string{"site.zip"}, "")
+
+	frozen, err := contract.Dispute(contract.ClientID(), "Half the pages are missing")
+	if err != nil {
+		t.Fatalf("Dispute() error = %v", err)
+	}
+	dispute, err := OpenDispute(valueobject.GenerateDisputeID(), contract, frozen, contract.ClientID(), "Half the pages are missing")
+	if err != nil {
+		t.Fatalf("OpenDispute() error = %v", err)
+	}
+
+	if dispute.Amount().Amount() != 80000 || dispute.Status() != DisputeAwaitingResponse {
+		t.Fatalf("dispute amount %d, status %s", dispute.Amount().Amount(), dispute.Status())
+	}
+	if !dispute.AwaitingParty().Equals(contract.HustlerID()) {
+		t.Error("dispute is not waiting on the hustler")
+	}
+
+	if _, err := dispute.SubmitEvidence("e-1", contract.HustlerID(), "", nil); err != ErrInvalidEvidence {
+		t.Errorf("SubmitEvidence() empty = %v, want %v", err, ErrInvalidEvidence)
+	}
+	if _, err := dispute.SubmitEvidence("e-1", contract.HustlerID(), "All pages were in the brief", []string{"uploads/brief.pdf"}); err != nil {
+		t.Fatalf("SubmitEvidence() error = %v", err)
+	}
+	if dispute.Status() != DisputeUnderReview || dispute.AwaitingParty() != nil {
+		t.Errorf("after response: status %s, still awaiting a party", dispute.Status())
+	}
+
+	mediator := valueobject.GenerateUserID()
+	if err := dispute.Resolve(mediator, DisputeOutcomeSplit, 50, ""); err != ErrNotDisputeMediator {
+		t.Errorf("Resolve() unassigned = %v, want %v", err, ErrNotDisputeMediator)
+	}
+	if err := dispute.AssignMediator(contract.ClientID()); err != ErrMediatorIsParty {
+		t.Errorf("AssignMediator() party = %v, want %v", err, ErrMediatorIsParty)
+	}
+	dispute.AssignMediator(mediator)
+	if err := dispute.Resolve(mediator, DisputeOutcomeSplit, 100, ""); err != ErrInvalidSplit {
+		t.Errorf("Resolve() 100%% split = %v, want %v", err, ErrInvalidSplit)
+	}
+	if err := dispute.Resolve(mediator, DisputeOutcomeSplit, 25, "Most pages delivered"); err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	r := dispute.Resolution()
+	if r.Refund().Amount() != 20000 || r.Release().Amount() != 60000 || r.ReleaseFee().Amount() != 6000 {
+		t.Errorf("split = refund %d, release %d, fee %d", r.Refund().Amount(), r.Release().Amount(), r.ReleaseFee().Amount())
+	}
+	if dispute.LosingParty() != nil {
+		t.Error("a split has no losing party")
+	}
+
+	if err := contract.SettleDispute(dispute); err != nil {
+		t.Fatalf("SettleDispute() error = %v", err)
+	}
+	if !contract.IsCompleted() || contract.Milestones()[0].Status() != MilestoneSettled {
+		t.Errorf("after split: contract %s, milestone %s", contract.Status(), contract.Milestones()[0].Status())
+	}
+}
+
+func TestDispute_MissedDeadlineFavoursRespondingParty(t *testing.T) {
+	contract := createFundedContract(t, 50000)
+
+	frozen, _ := contract.Dispute(contract.HustlerID(), "Client stopped replying")
+	dispute, err := OpenDispute(valueobject.GenerateDisputeID(), contract, frozen, contract.HustlerID(), "Client stopped replying")
+	if err != nil {
+		t.Fatalf("OpenDispute() error = %v", err)
+	}
+
+	if err := dispute.ResolveByDefault(time.Now().UTC()); err != ErrResponseDeadlineNotDue {
+		t.Errorf("ResolveByDefault() early = %v, want %v", err, ErrResponseDeadlineNotDue)
+	}
+
+	late := time.Now().UTC().Add(DisputeResponseWindow + time.Hour)
+	if err := dispute.ResolveByDefault(late); err != nil {
+		t.Fatalf("ResolveByDefault() error = %v", err)
+	}
+	if dispute.Resolution().Outcome() != DisputeOutcomeRelease || !dispute.Resolution().ByDefault() {
+		t.Errorf("outcome = %s, want release by default", dispute.Resolution().Outcome())
+	}
+	if loser := dispute.LosingParty(); loser == nil || !loser.Equals(contract.ClientID()) {
+		t.Error("client who missed the deadline is not the losing party")
+	}
+
+	contract.SettleDispute(dispute)
+	if !contract.IsCompleted() {
+		t.Errorf("Status() = %s, want completed after release", contract.Status())
+	}
+}
+
+func TestDispute_MilestoneRefundLetsContractContinue(t *testing.T) {
+	contract := createTestContract(t, 60000)
+	due := time.Now().UTC()
+	contract.PlanMilestones([]MilestoneSpec{
+		{"Design", 30000, due.AddDate(0, 0, 7)},
+		{"Build", 30000, due.AddDate(0, 0, 14)},
+	}, FundingUpfront)
+	design, build := contract.Milestones()[0], contract.Milestones()[1]
+	contract.FundMilestone(contract.ClientID(), design.ID())
+	contract.FundMilestone(contract.ClientID(), build.ID())
+
+	milestone, err := contract.DisputeMilestone(contract.ClientID(), design.ID(), "Not what was agreed")
+	if err != nil {
+		t.Fatalf("DisputeMilestone() error = %v", err)
+	}
+	dispute, _ := OpenDispute(valueobject.GenerateDisputeID(), contract, []*Milestone{milestone}, contract.ClientID(), "Not what was agreed")
+
+	mediator := valueobject.GenerateUserID()
+	dispute.AssignMediator(mediator)
+	if err := dispute.RequestResponse(mediator, contract.HustlerID(), 24*time.Hour); err != nil {
+		t.Fatalf("RequestResponse() error = %v", err)
+	}
+	dispute.ResolveByDefault(time.Now().UTC().Add(25 * time.Hour))
+
+	if err := contract.SettleDispute(dispute); err != nil {
+		t.Fatalf("SettleDispute() error = %v", err)
+	}
+	if design.Status() != MilestoneRefunded || contract.Status() != ContractStatusActive {
+		t.Errorf("after refund: design %s, contract %s", design.Status(), contract.Status())
+	}
+	if err := contract.SettleDispute(dispute); err != ErrMilestoneNotDisputed {
+		t.Errorf("SettleDispute() twice = %v, want %v", err, ErrMilestoneNotDisputed)
+	}
+}
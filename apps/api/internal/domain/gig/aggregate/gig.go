@@ -2,6 +2,9 @@ package aggregate
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"hustlex/internal/domain/gig/event"
@@ -19,6 +22,7 @@ var (
 	ErrAlreadyProposed      = errors.New("already submitted a proposal for this gig")
 	ErrProposalNotFound     = errors.New("proposal not found")
 	ErrProposalNotPending   = errors.New("proposal is no longer pending")
+	ErrInvalidRevisions     = errors.New("proposal revisions must be between 0 and 10")
 	ErrInvalidBudget        = errors.New("invalid budget range")
 	ErrPriceBelowBudget     = errors.New("proposed price is below minimum budget")
 	ErrPriceAboveBudget     = errors.New("proposed price exceeds maximum budget")
@@ -73,6 +77,12 @@ func (b Budget) Contains(amount valueobject.Money) bool {
 	return !amount.LessThan(b.min) && !amount.GreaterThan(b.max)
 }
 
+// Proposal revision limits
+const (
+	DefaultProposalRevisions = 2
+	MaxProposalRevisions     = 10
+)
+
 // Proposal represents a proposal entity within the Gig aggregate
 type Proposal struct {
 	id            valueobject.ProposalID
@@ -80,7 +90,9 @@ type Proposal struct {
 	coverLetter   string
 	proposedPrice valueobject.Money
 	deliveryDays  int
+	revisions     int
 	status        ProposalStatus
+	label         ProposalLabel
 	attachments   []string
 	createdAt     time.Time
 	updatedAt     time.Time
@@ -100,6 +112,7 @@ func NewProposal(
 		coverLetter:   coverLetter,
 		proposedPrice: proposedPrice,
 		deliveryDays:  deliveryDays,
+		revisions:     DefaultProposalRevisions,
 		status:        ProposalStatusPending,
 		attachments:   attachments,
 		createdAt:     time.Now().UTC(),
@@ -107,16 +120,58 @@ func NewProposal(
 	}
 }
 
+// ReconstructProposal reconstructs a proposal from persistence
+func ReconstructProposal(
+	id valueobject.ProposalID,
+	hustlerID valueobject.UserID,
+	coverLetter string,
+	proposedPrice valueobject.Money,
+	deliveryDays int,
+	revisions int,
+	status ProposalStatus,
+	label ProposalLabel,
+	attachments []string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Proposal {
+	return &Proposal{
+		id:            id,
+		hustlerID:     hustlerID,
+		coverLetter:   coverLetter,
+		proposedPrice: proposedPrice,
+		deliveryDays:  deliveryDays,
+		revisions:     revisions,
+		status:        status,
+		label:         label,
+		attachments:   attachments,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
 func (p *Proposal) ID() valueobject.ProposalID   { return p.id }
 func (p *Proposal) HustlerID() valueobject.UserID { return p.hustlerID }
 func (p *Proposal) CoverLetter() string           { return p.coverLetter }
 func (p *Proposal) ProposedPrice() valueobject.Money { return p.proposedPrice }
 func (p *Proposal) DeliveryDays() int             { return p.deliveryDays }
+func (p *Proposal) Revisions() int                { return p.revisions }
 func (p *Proposal) Status() ProposalStatus        { return p.status }
 func (p *Proposal) Attachments() []string         { return p.attachments }
 func (p *Proposal) CreatedAt() time.Time          { return p.createdAt }
 func (p *Proposal) UpdatedAt() time.Time          { return p.updatedAt }
 func (p *Proposal) IsPending() bool               { return p.status == ProposalStatusPending }
+func (p *Proposal) Label() ProposalLabel          { return p.label }
+func (p *Proposal) IsShortlisted() bool           { return p.label == ProposalLabelShortlisted }
+
+// SetRevisions sets how many revision rounds the hustler offers
+func (p *Proposal) SetRevisions(revisions int) error {
+	if revisions < 0 || revisions > MaxProposalRevisions {
+		return ErrInvalidRevisions
+	}
+	p.revisions = revisions
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
 
 func (p *Proposal) Accept() {
 	p.status = ProposalStatusAccepted
@@ -149,12 +204,15 @@ type Gig struct {
 	deadline      *time.Time
 	isRemote      bool
 	location      string
+	coordinates   *valueobject.GeoPoint
 	status        GigStatus
 	viewCount     int
 	isFeatured    bool
 	attachments   []string
 	tags          []string
 	proposals     []*Proposal
+	visibility    GigVisibility
+	invitations   []*Invitation
 	acceptedProposalID *valueobject.ProposalID
 	createdAt     time.Time
 	updatedAt     time.Time
@@ -186,6 +244,7 @@ func NewGig(
 		viewCount:    0,
 		isFeatured:   false,
 		proposals:    make([]*Proposal, 0),
+		visibility:   GigVisibilityPublic,
 		createdAt:    time.Now().UTC(),
 		updatedAt:    time.Now().UTC(),
 		version:      1,
@@ -218,12 +277,15 @@ func ReconstructGig(
 	deadline *time.Time,
 	isRemote bool,
 	location string,
+	coordinates *valueobject.GeoPoint,
 	status GigStatus,
 	viewCount int,
 	isFeatured bool,
 	attachments []string,
 	tags []string,
 	proposals []*Proposal,
+	visibility GigVisibility,
+	invitations []*Invitation,
 	acceptedProposalID *valueobject.ProposalID,
 	createdAt time.Time,
 	updatedAt time.Time,
@@ -242,12 +304,15 @@ func ReconstructGig(
 		deadline:           deadline,
 		isRemote:           isRemote,
 		location:           location,
+		coordinates:        coordinates,
 		status:             status,
 		viewCount:          viewCount,
 		isFeatured:         isFeatured,
 		attachments:        attachments,
 		tags:               tags,
 		proposals:          proposals,
+		visibility:         visibility,
+		invitations:        invitations,
 		acceptedProposalID: acceptedProposalID,
 		createdAt:          createdAt,
 		updatedAt:          updatedAt,
@@ -268,6 +333,7 @@ func (g *Gig) DeliveryDays() int               { return g.deliveryDays }
 func (g *Gig) Deadline() *time.Time            { return g.deadline }
 func (g *Gig) IsRemote() bool                  { return g.isRemote }
 func (g *Gig) Location() string                { return g.location }
+func (g *Gig) Coordinates() *valueobject.GeoPoint { return g.coordinates }
 func (g *Gig) Status() GigStatus               { return g.status }
 func (g *Gig) ViewCount() int                  { return g.viewCount }
 func (g *Gig) IsFeatured() bool                { return g.isFeatured }
@@ -275,6 +341,9 @@ func (g *Gig) Attachments() []string           { return g.attachments }
 func (g *Gig) Tags() []string                  { return g.tags }
 func (g *Gig) Proposals() []*Proposal          { return g.proposals }
 func (g *Gig) ProposalCount() int              { return len(g.proposals) }
+func (g *Gig) Visibility() GigVisibility       { return g.visibility }
+func (g *Gig) IsInviteOnly() bool              { return g.visibility == GigVisibilityInviteOnly }
+func (g *Gig) Invitations() []*Invitation      { return g.invitations }
 func (g *Gig) AcceptedProposalID() *valueobject.ProposalID { return g.acceptedProposalID }
 func (g *Gig) CreatedAt() time.Time            { return g.createdAt }
 func (g *Gig) UpdatedAt() time.Time            { return g.updatedAt }
@@ -315,6 +384,18 @@ func (g *Gig) Update(
 		g.category = category
 		updatedFields["category"] = category
 	}
+	if budget.Min().Amount() != g.budget.Min().Amount() || budget.Max().Amount() != g.budget.Max().Amount() {
+		updatedFields["budget"] = fmt.Sprintf("%d-%d", budget.Min().Amount(), budget.Max().Amount())
+	}
+	if isRemote != g.isRemote {
+		updatedFields["is_remote"] = strconv.FormatBool(isRemote)
+	}
+	if location != g.location {
+		updatedFields["location"] = location
+	}
+	if strings.Join(tags, ",") != strings.Join(g.tags, ",") {
+		updatedFields["tags"] = strings.Join(tags, ",")
+	}
 	g.skillID = skillID
 	g.budget = budget
 	g.deliveryDays = deliveryDays
@@ -332,6 +413,32 @@ func (g *Gig) Update(
 	return nil
 }
 
+// PinLocation sets where on the map an on-site gig takes place so hustlers nearby can
+// find it. A nil point clears it.
+func (g *Gig) PinLocation(point *valueobject.GeoPoint) error {
+	if !g.status.IsOpen() {
+		return ErrCannotUpdateGig
+	}
+
+	switch {
+	case point == nil && g.coordinates == nil:
+		return nil
+	case point != nil && g.coordinates != nil && point.Equals(*g.coordinates):
+		return nil
+	}
+
+	g.coordinates = point
+	g.updatedAt = time.Now().UTC()
+
+	pinned := ""
+	if point != nil {
+		pinned = fmt.Sprintf("%f,%f", point.Latitude(), point.Longitude())
+	}
+	g.RecordEvent(event.NewGigUpdated(g.id.String(), map[string]string{"coordinates": pinned}))
+
+	return nil
+}
+
 // SetFeatured marks the gig as featured
 func (g *Gig) SetFeatured(featured bool) {
 	g.isFeatured = featured
@@ -374,6 +481,13 @@ func (g *Gig) SubmitProposal(proposal *Proposal) error {
 		}
 	}
 
+	// Invite-only gigs take proposals from invited hustlers alone
+	now := time.Now().UTC()
+	invitation := g.PendingInvitation(proposal.HustlerID(), now)
+	if invitation == nil && g.IsInviteOnly() {
+		return ErrNotInvited
+	}
+
 	// Validate proposed price is within budget
 	if !g.budget.Contains(proposal.ProposedPrice()) {
 		if proposal.ProposedPrice().LessThan(g.budget.Min()) {
@@ -383,7 +497,7 @@ func (g *Gig) SubmitProposal(proposal *Proposal) error {
 	}
 
 	g.proposals = append(g.proposals, proposal)
-	g.updatedAt = time.Now().UTC()
+	g.updatedAt = now
 
 	g.RecordEvent(event.NewProposalSubmitted(
 		proposal.ID().String(),
@@ -393,6 +507,16 @@ func (g *Gig) SubmitProposal(proposal *Proposal) error {
 		proposal.DeliveryDays(),
 	))
 
+	if invitation != nil {
+		invitation.accept(proposal.ID(), now)
+		g.RecordEvent(event.NewGigInvitationAccepted(
+			g.id.String(),
+			invitation.id,
+			proposal.HustlerID().String(),
+			proposal.ID().String(),
+		))
+	}
+
 	return nil
 }
 
@@ -475,6 +599,7 @@ func (g *Gig) AcceptProposal(proposalID valueobject.ProposalID, contractID value
 		PlatformFee:   platformFee,
 		DeliveryDays:  proposal.DeliveryDays(),
 		DeadlineAt:    deadlineAt,
+		Revisions:     proposal.Revisions(),
 	}, nil
 }
 
@@ -510,6 +635,12 @@ func (g *Gig) MarkDisputed() {
 	g.updatedAt = time.Now().UTC()
 }
 
+// MarkCancelled marks the gig as cancelled after its contract ended without payment
+func (g *Gig) MarkCancelled() {
+	g.status = GigStatusCancelled
+	g.updatedAt = time.Now().UTC()
+}
+
 // AcceptedProposalData contains data needed to create a contract
 type AcceptedProposalData struct {
 	ContractID   valueobject.ContractID
@@ -520,4 +651,5 @@ type AcceptedProposalData struct {
 	PlatformFee  int64
 	DeliveryDays int
 	DeadlineAt   time.Time
+	Revisions    int // revision rounds the client may ask for
 }
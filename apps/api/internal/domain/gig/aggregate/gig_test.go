@@ -96,6 +96,20 @@ func TestNewProposal(t *testing.T) {
 	}
 }
 
+func TestProposal_SetRevisions(t *testing.T) {
+	proposal := createTestProposal()
+
+	if proposal.Revisions() != DefaultProposalRevisions {
+		t.Errorf("Revisions() = %d, want %d", proposal.Revisions(), DefaultProposalRevisions)
+	}
+	if err := proposal.SetRevisions(MaxProposalRevisions + 1); err != ErrInvalidRevisions {
+		t.Errorf("SetRevisions() over max = %v, want %v", err, ErrInvalidRevisions)
+	}
+	if err := proposal.SetRevisions(0); err != nil || proposal.Revisions() != 0 {
+		t.Errorf("SetRevisions(0) = %v, revisions %d", err, proposal.Revisions())
+	}
+}
+
 func TestProposal_Accept(t *testing.T) {
 	proposal := createTestProposal()
 
@@ -228,6 +242,33 @@ func TestGig_Update_NotOpen(t *testing.T) {
 	}
 }
 
+func TestGig_PinLocation(t *testing.T) {
+	gig := createTestGig()
+	gig.ClearEvents()
+	lagos, _ := valueobject.NewGeoPoint(6.5244, 3.3792)
+
+	if err := gig.PinLocation(&lagos); err != nil {
+		t.Fatalf("PinLocation() error = %v", err)
+	}
+	if gig.Coordinates() == nil || !gig.Coordinates().Equals(lagos) {
+		t.Error("PinLocation() did not set coordinates")
+	}
+	if len(gig.DomainEvents()) != 1 {
+		t.Fatalf("PinLocation() events = %d, want 1", len(gig.DomainEvents()))
+	}
+
+	gig.ClearEvents()
+	gig.PinLocation(&lagos)
+	if len(gig.DomainEvents()) != 0 {
+		t.Error("PinLocation() with the same point should not record an event")
+	}
+
+	gig.Cancel("test")
+	if err := gig.PinLocation(nil); err != ErrCannotUpdateGig {
+		t.Errorf("PinLocation() on cancelled gig error = %v, want ErrCannotUpdateGig", err)
+	}
+}
+
 func TestGig_IncrementViewCount(t *testing.T) {
 	gig := createTestGig()
 
@@ -601,12 +642,15 @@ func TestReconstructGig(t *testing.T) {
 		nil,
 		true,
 		"",
+		nil,
 		GigStatusInProgress,
 		10,
 		true,
 		[]string{"file.pdf"},
 		[]string{"tag1"},
 		[]*Proposal{},
+		GigVisibilityPublic,
+		nil,
 		nil,
 		now,
 		now,
@@ -0,0 +1,277 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Invitation errors
+var (
+	ErrInvalidVisibility    = errors.New("invalid gig visibility")
+	ErrCannotInviteSelf     = errors.New("cannot invite yourself to your own gig")
+	ErrAlreadyInvited       = errors.New("hustler already has a pending invitation to this gig")
+	ErrTooManyInvitations   = errors.New("gig has reached its invitation limit")
+	ErrInvitationNotFound   = errors.New("no pending invitation for this gig")
+	ErrNotInvited           = errors.New("this gig only takes proposals from invited hustlers")
+	ErrInvalidProposalLabel = errors.New("invalid proposal label")
+)
+
+const (
+	// InvitationTTL is how long an invited hustler has to propose
+	InvitationTTL = 7 * 24 * time.Hour
+	// MaxGigInvitations is the most hustlers one gig can invite
+	MaxGigInvitations = 50
+)
+
+// GigVisibility controls who can find a gig and propose on it
+type GigVisibility string
+
+const (
+	GigVisibilityPublic     GigVisibility = "public"      // Listed in browse and search
+	GigVisibilityInviteOnly GigVisibility = "invite_only" // Hidden; only invited hustlers can propose
+)
+
+func (v GigVisibility) IsValid() bool {
+	return v == GigVisibilityPublic || v == GigVisibilityInviteOnly
+}
+
+// ProposalLabel is how a client has sorted a proposal while deciding
+type ProposalLabel string
+
+const (
+	ProposalLabelNone        ProposalLabel = ""
+	ProposalLabelShortlisted ProposalLabel = "shortlisted"
+	ProposalLabelArchived    ProposalLabel = "archived"
+)
+
+func (l ProposalLabel) IsValid() bool {
+	return l == ProposalLabelNone || l == ProposalLabelShortlisted || l == ProposalLabelArchived
+}
+
+// InvitationStatus represents where an invitation stands
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted" // The hustler submitted a proposal
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusExpired  InvitationStatus = "expired"
+)
+
+// Invitation is a client's request, within the Gig aggregate, for a hustler to propose
+type Invitation struct {
+	id          string
+	hustlerID   valueobject.UserID
+	message     string
+	status      InvitationStatus
+	proposalID  *valueobject.ProposalID
+	invitedAt   time.Time
+	expiresAt   time.Time
+	respondedAt *time.Time
+}
+
+// ReconstructInvitation reconstructs an invitation from persistence
+func ReconstructInvitation(
+	id string,
+	hustlerID valueobject.UserID,
+	message string,
+	status InvitationStatus,
+	proposalID *valueobject.ProposalID,
+	invitedAt time.Time,
+	expiresAt time.Time,
+	respondedAt *time.Time,
+) *Invitation {
+	return &Invitation{
+		id:          id,
+		hustlerID:   hustlerID,
+		message:     message,
+		status:      status,
+		proposalID:  proposalID,
+		invitedAt:   invitedAt,
+		expiresAt:   expiresAt,
+		respondedAt: respondedAt,
+	}
+}
+
+func (i *Invitation) ID() string                          { return i.id }
+func (i *Invitation) HustlerID() valueobject.UserID       { return i.hustlerID }
+func (i *Invitation) Message() string                     { return i.message }
+func (i *Invitation) Status() InvitationStatus            { return i.status }
+func (i *Invitation) ProposalID() *valueobject.ProposalID { return i.proposalID }
+func (i *Invitation) InvitedAt() time.Time                { return i.invitedAt }
+func (i *Invitation) ExpiresAt() time.Time                { return i.expiresAt }
+func (i *Invitation) RespondedAt() *time.Time             { return i.respondedAt }
+
+// StatusAt returns the invitation's status at the given time. Pending invitations
+// expire on their own once the hustler's time to respond runs out.
+func (i *Invitation) StatusAt(now time.Time) InvitationStatus {
+	if i.status == InvitationStatusPending && !now.Before(i.expiresAt) {
+		return InvitationStatusExpired
+	}
+	return i.status
+}
+
+// IsPendingAt returns true if the hustler can still respond
+func (i *Invitation) IsPendingAt(now time.Time) bool {
+	return i.StatusAt(now) == InvitationStatusPending
+}
+
+func (i *Invitation) accept(proposalID valueobject.ProposalID, now time.Time) {
+	i.status = InvitationStatusAccepted
+	i.proposalID = &proposalID
+	i.respondedAt = &now
+}
+
+// SetVisibility makes the gig public or invite-only
+func (g *Gig) SetVisibility(clientID valueobject.UserID, visibility GigVisibility) error {
+	if !g.clientID.Equals(clientID) {
+		return ErrNotGigOwner
+	}
+	if !g.status.IsOpen() {
+		return ErrCannotUpdateGig
+	}
+	if !visibility.IsValid() {
+		return ErrInvalidVisibility
+	}
+	if visibility == g.visibility {
+		return nil
+	}
+
+	g.visibility = visibility
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewGigUpdated(g.id.String(), map[string]string{"visibility": string(visibility)}))
+
+	return nil
+}
+
+// InviteHustler asks a hustler to propose on the gig. The invitation expires after
+// InvitationTTL, or at the gig's deadline if that comes first.
+func (g *Gig) InviteHustler(clientID, hustlerID valueobject.UserID, message string, now time.Time) (*Invitation, error) {
+	if !g.clientID.Equals(clientID) {
+		return nil, ErrNotGigOwner
+	}
+	if !g.status.IsOpen() {
+		return nil, ErrGigNotOpen
+	}
+	if hustlerID.Equals(g.clientID) {
+		return nil, ErrCannotInviteSelf
+	}
+	if g.FindProposalByHustler(hustlerID) != nil {
+		return nil, ErrAlreadyProposed
+	}
+	if g.PendingInvitation(hustlerID, now) != nil {
+		return nil, ErrAlreadyInvited
+	}
+	if len(g.invitations) >= MaxGigInvitations {
+		return nil, ErrTooManyInvitations
+	}
+
+	expiresAt := now.Add(InvitationTTL)
+	if g.deadline != nil && g.deadline.After(now) && g.deadline.Before(expiresAt) {
+		expiresAt = *g.deadline
+	}
+
+	invitation := &Invitation{
+		id:        valueobject.GenerateInvitationID().String(),
+		hustlerID: hustlerID,
+		message:   strings.TrimSpace(message),
+		status:    InvitationStatusPending,
+		invitedAt: now,
+		expiresAt: expiresAt,
+	}
+	g.invitations = append(g.invitations, invitation)
+	g.updatedAt = now
+
+	g.RecordEvent(event.NewGigInvitationSent(
+		g.id.String(),
+		invitation.id,
+		clientID.String(),
+		hustlerID.String(),
+		g.title,
+		invitation.message,
+		expiresAt,
+	))
+
+	return invitation, nil
+}
+
+// DeclineInvitation lets an invited hustler turn the gig down
+func (g *Gig) DeclineInvitation(hustlerID valueobject.UserID, reason string, now time.Time) error {
+	invitation := g.PendingInvitation(hustlerID, now)
+	if invitation == nil {
+		return ErrInvitationNotFound
+	}
+
+	invitation.status = InvitationStatusDeclined
+	invitation.respondedAt = &now
+	g.updatedAt = now
+
+	g.RecordEvent(event.NewGigInvitationDeclined(
+		g.id.String(),
+		invitation.id,
+		hustlerID.String(),
+		strings.TrimSpace(reason),
+	))
+
+	return nil
+}
+
+// PendingInvitation returns the hustler's invitation if they can still respond to it
+func (g *Gig) PendingInvitation(hustlerID valueobject.UserID, now time.Time) *Invitation {
+	for _, i := range g.invitations {
+		if i.hustlerID.Equals(hustlerID) && i.IsPendingAt(now) {
+			return i
+		}
+	}
+	return nil
+}
+
+// LabelProposal shortlists or archives a pending proposal; ProposalLabelNone clears it
+func (g *Gig) LabelProposal(clientID valueobject.UserID, proposalID valueobject.ProposalID, label ProposalLabel) error {
+	if !g.clientID.Equals(clientID) {
+		return ErrNotGigOwner
+	}
+	if !label.IsValid() {
+		return ErrInvalidProposalLabel
+	}
+
+	proposal := g.FindProposal(proposalID)
+	if proposal == nil {
+		return ErrProposalNotFound
+	}
+	if !proposal.IsPending() {
+		return ErrProposalNotPending
+	}
+	if proposal.label == label {
+		return nil
+	}
+
+	proposal.label = label
+	proposal.updatedAt = time.Now().UTC()
+	g.updatedAt = proposal.updatedAt
+
+	g.RecordEvent(event.NewProposalLabelled(
+		g.id.String(),
+		proposalID.String(),
+		proposal.hustlerID.String(),
+		string(label),
+	))
+
+	return nil
+}
+
+// ShortlistedHustlers returns the hustlers whose pending proposals are shortlisted
+func (g *Gig) ShortlistedHustlers() []valueobject.UserID {
+	var hustlers []valueobject.UserID
+	for _, p := range g.proposals {
+		if p.IsPending() && p.IsShortlisted() {
+			hustlers = append(hustlers, p.hustlerID)
+		}
+	}
+	return hustlers
+}
@@ -0,0 +1,130 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestGig_InviteHustler_AcceptedBySubmittingProposal(t *testing.T) {
+	gig := createTestGig()
+	gig.ClearEvents()
+	now := time.Now().UTC()
+
+	proposal := createTestProposalWithPrice(25000)
+	invitation, err := gig.InviteHustler(gig.ClientID(), proposal.HustlerID(), "Loved your portfolio", now)
+	if err != nil {
+		t.Fatalf("InviteHustler() error = %v", err)
+	}
+	if _, err := gig.InviteHustler(gig.ClientID(), proposal.HustlerID(), "", now); err != ErrAlreadyInvited {
+		t.Errorf("InviteHustler() twice error = %v, want ErrAlreadyInvited", err)
+	}
+	if _, err := gig.InviteHustler(gig.ClientID(), gig.ClientID(), "", now); err != ErrCannotInviteSelf {
+		t.Errorf("InviteHustler() self error = %v, want ErrCannotInviteSelf", err)
+	}
+
+	if err := gig.SubmitProposal(proposal); err != nil {
+		t.Fatalf("SubmitProposal() error = %v", err)
+	}
+	if invitation.Status() != InvitationStatusAccepted {
+		t.Errorf("invitation status = %s, want accepted", invitation.Status())
+	}
+	if invitation.ProposalID() == nil || !invitation.ProposalID().Equals(proposal.ID()) {
+		t.Errorf("invitation not linked to proposal %s", proposal.ID())
+	}
+
+	// Sent, submitted and accepted
+	if len(gig.DomainEvents()) != 3 {
+		t.Errorf("expected 3 events, got %d", len(gig.DomainEvents()))
+	}
+}
+
+func TestGig_InviteOnly_RequiresInvitation(t *testing.T) {
+	gig := createTestGig()
+	if err := gig.SetVisibility(gig.ClientID(), GigVisibilityInviteOnly); err != nil {
+		t.Fatalf("SetVisibility() error = %v", err)
+	}
+
+	if err := gig.SubmitProposal(createTestProposalWithPrice(25000)); err != ErrNotInvited {
+		t.Errorf("SubmitProposal() uninvited error = %v, want ErrNotInvited", err)
+	}
+
+	proposal := createTestProposalWithPrice(5000)
+	if _, err := gig.InviteHustler(gig.ClientID(), proposal.HustlerID(), "", time.Now().UTC()); err != nil {
+		t.Fatalf("InviteHustler() error = %v", err)
+	}
+
+	// Invited hustlers still have to propose within budget
+	if err := gig.SubmitProposal(proposal); err != ErrPriceBelowBudget {
+		t.Errorf("SubmitProposal() below budget error = %v, want ErrPriceBelowBudget", err)
+	}
+	if gig.PendingInvitation(proposal.HustlerID(), time.Now().UTC()) == nil {
+		t.Error("rejected proposal should leave the invitation pending")
+	}
+}
+
+func TestInvitation_ExpiresAfterTTL(t *testing.T) {
+	gig := createTestGig()
+	hustlerID := valueobject.GenerateUserID()
+	invitedAt := time.Now().UTC().Add(-InvitationTTL - time.Hour)
+
+	invitation, err := gig.InviteHustler(gig.ClientID(), hustlerID, "", invitedAt)
+	if err != nil {
+		t.Fatalf("InviteHustler() error = %v", err)
+	}
+
+	now := time.Now().UTC()
+	if invitation.StatusAt(now) != InvitationStatusExpired {
+		t.Errorf("StatusAt() = %s, want expired", invitation.StatusAt(now))
+	}
+	if err := gig.DeclineInvitation(hustlerID, "", now); err != ErrInvitationNotFound {
+		t.Errorf("DeclineInvitation() expired error = %v, want ErrInvitationNotFound", err)
+	}
+
+	// An expired invitation can be sent again
+	if _, err := gig.InviteHustler(gig.ClientID(), hustlerID, "", now); err != nil {
+		t.Errorf("InviteHustler() after expiry error = %v", err)
+	}
+}
+
+func TestGig_DeclineInvitation(t *testing.T) {
+	gig := createTestGig()
+	hustlerID := valueobject.GenerateUserID()
+	now := time.Now().UTC()
+
+	invitation, _ := gig.InviteHustler(gig.ClientID(), hustlerID, "", now)
+	if err := gig.DeclineInvitation(hustlerID, "Fully booked this month", now); err != nil {
+		t.Fatalf("DeclineInvitation() error = %v", err)
+	}
+	if invitation.Status() != InvitationStatusDeclined || invitation.RespondedAt() == nil {
+		t.Errorf("invitation status = %s, want declined", invitation.Status())
+	}
+}
+
+func TestGig_LabelProposal_Shortlist(t *testing.T) {
+	gig := createTestGig()
+	first := createTestProposalWithPrice(25000)
+	second := createTestProposalWithPrice(30000)
+	gig.SubmitProposal(first)
+	gig.SubmitProposal(second)
+
+	if err := gig.LabelProposal(valueobject.GenerateUserID(), first.ID(), ProposalLabelShortlisted); err != ErrNotGigOwner {
+		t.Errorf("LabelProposal() by stranger error = %v, want ErrNotGigOwner", err)
+	}
+	if err := gig.LabelProposal(gig.ClientID(), first.ID(), ProposalLabel("starred")); err != ErrInvalidProposalLabel {
+		t.Errorf("LabelProposal() invalid label error = %v, want ErrInvalidProposalLabel", err)
+	}
+
+	if err := gig.LabelProposal(gig.ClientID(), first.ID(), ProposalLabelShortlisted); err != nil {
+		t.Fatalf("LabelProposal() error = %v", err)
+	}
+	if err := gig.LabelProposal(gig.ClientID(), second.ID(), ProposalLabelArchived); err != nil {
+		t.Fatalf("LabelProposal() error = %v", err)
+	}
+
+	shortlist := gig.ShortlistedHustlers()
+	if len(shortlist) != 1 || !shortlist[0].Equals(first.HustlerID()) {
+		t.Errorf("ShortlistedHustlers() = %v, want [%s]", shortlist, first.HustlerID())
+	}
+}
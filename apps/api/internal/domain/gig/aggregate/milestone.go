@@ -0,0 +1,587 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Milestone errors
+var (
+	ErrInvalidMilestone       = errors.New("milestone needs a title and a positive amount")
+	ErrMilestoneTotalMismatch = errors.New("milestone amounts must add up to the agreed price")
+	ErrTooManyMilestones      = errors.New("contract has too many milestones")
+	ErrInvalidMilestoneDue    = errors.New("milestone due dates must be in order and no later than the contract deadline")
+	ErrInvalidFundingMode     = errors.New("invalid milestone funding mode")
+	ErrMilestonesLocked       = errors.New("milestones cannot change once funding has started")
+	ErrMilestoneNotFound      = errors.New("milestone not found")
+	ErrMilestoneNotFunded     = errors.New("milestone has not been funded")
+	ErrMilestoneAlreadyFunded = errors.New("milestone is already funded")
+	ErrMilestoneOutOfOrder    = errors.New("earlier milestones must be funded first")
+	ErrMilestoneNotDelivered  = errors.New("milestone has not been delivered")
+	ErrCannotDisputeMilestone = errors.New("milestone cannot be disputed in its current state")
+)
+
+// MaxMilestones is the most milestones a contract's price can be split into
+const MaxMilestones = 20
+
+// FundingMode decides when milestone amounts are taken from the client into escrow
+type FundingMode string
+
+const (
+	FundingUpfront    FundingMode = "upfront"      // Every milestone is held when the contract starts
+	FundingJustInTime FundingMode = "just_in_time" // Each milestone is held when work on it is due to begin
+)
+
+func (m FundingMode) IsValid() bool {
+	return m == FundingUpfront || m == FundingJustInTime
+}
+
+// MilestoneStatus represents where a milestone is in its fund, deliver, approve cycle
+type MilestoneStatus string
+
+const (
+	MilestonePending           MilestoneStatus = "pending" // Not yet funded
+	MilestoneFunded            MilestoneStatus = "funded"
+	MilestoneDelivered         MilestoneStatus = "delivered"
+	MilestoneRevisionRequested MilestoneStatus = "revision_requested"
+	MilestoneReleased          MilestoneStatus = "released"
+	MilestoneDisputed          MilestoneStatus = "disputed"
+	MilestoneRefunded          MilestoneStatus = "refunded"
+	MilestoneSettled           MilestoneStatus = "settled"   // Escrow split between the parties by a dispute ruling
+	MilestoneCancelled         MilestoneStatus = "cancelled" // Never funded before the contract ended
+)
+
+// MilestoneSpec describes one stage of the work when a contract's price is split up
+type MilestoneSpec struct {
+	Title   string
+	Amount  int64
+	DueDate time.Time
+}
+
+// Milestone is a stage of a contract with its own amount, due date and escrow
+type Milestone struct {
+	id            string
+	sequence      int
+	title         string
+	amount        valueobject.Money
+	platformFee   valueobject.Money
+	dueDate       time.Time
+	status        MilestoneStatus
+	deliverables  []string
+	revisions     int
+	revisionNotes string
+	fundedAt      *time.Time
+	deliveredAt   *time.Time
+	releasedAt    *time.Time
+}
+
+// ReconstructMilestone reconstructs a milestone from persistence
+func ReconstructMilestone(
+	id string,
+	sequence int,
+	title string,
+	amount valueobject.Money,
+	platformFee valueobject.Money,
+	dueDate time.Time,
+	status MilestoneStatus,
+	deliverables []string,
+	revisions int,
+	revisionNotes string,
+	fundedAt *time.Time,
+	deliveredAt *time.Time,
+	releasedAt *time.Time,
+) *Milestone {
+	return &Milestone{
+		id:            id,
+		sequence:      sequence,
+		title:         title,
+		amount:        amount,
+		platformFee:   platformFee,
+		dueDate:       dueDate,
+		status:        status,
+		deliverables:  deliverables,
+		revisions:     revisions,
+		revisionNotes: revisionNotes,
+		fundedAt:      fundedAt,
+		deliveredAt:   deliveredAt,
+		releasedAt:    releasedAt,
+	}
+}
+
+func (m *Milestone) ID() string                     { return m.id }
+func (m *Milestone) Sequence() int                  { return m.sequence }
+func (m *Milestone) Title() string                  { return m.title }
+func (m *Milestone) Amount() valueobject.Money      { return m.amount }
+func (m *Milestone) PlatformFee() valueobject.Money { return m.platformFee }
+func (m *Milestone) DueDate() time.Time             { return m.dueDate }
+func (m *Milestone) Status() MilestoneStatus        { return m.status }
+func (m *Milestone) Deliverables() []string         { return m.deliverables }
+func (m *Milestone) Revisions() int                 { return m.revisions }
+func (m *Milestone) RevisionNotes() string          { return m.revisionNotes }
+func (m *Milestone) FundedAt() *time.Time           { return m.fundedAt }
+func (m *Milestone) DeliveredAt() *time.Time        { return m.deliveredAt }
+func (m *Milestone) ReleasedAt() *time.Time         { return m.releasedAt }
+func (m *Milestone) IsReleased() bool               { return m.status == MilestoneReleased }
+
+// NetPayout is what the hustler receives when the milestone is released
+func (m *Milestone) NetPayout() valueobject.Money {
+	return m.amount.MustSubtract(m.platformFee)
+}
+
+// IsInEscrow reports whether the client's money for this milestone is being held
+func (m *Milestone) IsInEscrow() bool {
+	switch m.status {
+	case MilestoneFunded, MilestoneDelivered, MilestoneRevisionRequested, MilestoneDisputed:
+		return true
+	}
+	return false
+}
+
+// IsOverdue reports whether the milestone is past its due date without being delivered
+func (m *Milestone) IsOverdue(asOf time.Time) bool {
+	return asOf.After(m.dueDate) && (m.status == MilestonePending || m.isInProgress())
+}
+
+func (m *Milestone) isInProgress() bool {
+	return m.status == MilestoneFunded || m.status == MilestoneRevisionRequested
+}
+
+func (m *Milestone) setStatus(status MilestoneStatus) *time.Time {
+	now := time.Now().UTC()
+	m.status = status
+	return &now
+}
+
+// Getters
+func (c *Contract) Milestones() []*Milestone { return c.milestones }
+func (c *Contract) FundingMode() FundingMode { return c.fundingMode }
+
+// FindMilestone finds a milestone by ID
+func (c *Contract) FindMilestone(milestoneID string) *Milestone {
+	for _, m := range c.milestones {
+		if m.id == milestoneID {
+			return m
+		}
+	}
+	return nil
+}
+
+// EscrowBalance is the client money currently held against this contract
+func (c *Contract) EscrowBalance() valueobject.Money {
+	total := valueobject.Zero(c.agreedPrice.Currency())
+	for _, m := range c.milestones {
+		if m.IsInEscrow() {
+			total = total.MustAdd(m.amount)
+		}
+	}
+	return total
+}
+
+// RefundableEscrow is the escrow a cancellation returns to the client straight away:
+// milestones funded but not delivered. Delivered and disputed work stays held until resolved.
+func (c *Contract) RefundableEscrow() valueobject.Money {
+	total := valueobject.Zero(c.agreedPrice.Currency())
+	for _, m := range c.milestones {
+		if m.isInProgress() {
+			total = total.MustAdd(m.amount)
+		}
+	}
+	return total
+}
+
+// PlanMilestones splits the agreed price into staged milestones. It replaces the single
+// milestone every contract starts with and is only possible before anything is funded.
+// The platform fee is shared pro rata, with any rounding left on the last milestone.
+func (c *Contract) PlanMilestones(specs []MilestoneSpec, mode FundingMode) error {
+	if !c.status.IsActive() {
+		return ErrContractNotActive
+	}
+	for _, m := range c.milestones {
+		if m.status != MilestonePending {
+			return ErrMilestonesLocked
+		}
+	}
+	if !mode.IsValid() {
+		return ErrInvalidFundingMode
+	}
+	if len(specs) == 0 {
+		return ErrInvalidMilestone
+	}
+	if len(specs) > MaxMilestones {
+		return ErrTooManyMilestones
+	}
+
+	var total int64
+	var previousDue time.Time
+	for _, spec := range specs {
+		if strings.TrimSpace(spec.Title) == "" || spec.Amount <= 0 {
+			return ErrInvalidMilestone
+		}
+		if spec.DueDate.Before(previousDue) || spec.DueDate.After(c.deadlineAt) {
+			return ErrInvalidMilestoneDue
+		}
+		previousDue = spec.DueDate
+		total += spec.Amount
+	}
+	if total != c.agreedPrice.Amount() {
+		return ErrMilestoneTotalMismatch
+	}
+
+	currency := c.agreedPrice.Currency()
+	feeLeft := c.platformFee.Amount()
+	milestones := make([]*Milestone, len(specs))
+	for i, spec := range specs {
+		fee := c.platformFee.Amount() * spec.Amount / total
+		if i == len(specs)-1 {
+			fee = feeLeft
+		}
+		feeLeft -= fee
+
+		milestones[i] = newMilestone(i+1, strings.TrimSpace(spec.Title), spec.Amount, fee, currency, spec.DueDate)
+	}
+
+	c.milestones = milestones
+	c.fundingMode = mode
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewMilestonesPlanned(c.id.String(), string(mode), len(milestones)))
+
+	return nil
+}
+
+// MilestonesDueFunding returns the milestones the client should put in escrow now.
+// Upfront contracts fund everything at once; just-in-time contracts fund the next
+// milestone once no funded work is left in progress.
+func (c *Contract) MilestonesDueFunding() []*Milestone {
+	due := make([]*Milestone, 0)
+	if !c.isInProgress() {
+		return due
+	}
+
+	for _, m := range c.milestones {
+		if c.fundingMode == FundingJustInTime && m.isInProgress() {
+			return due
+		}
+	}
+
+	for _, m := range c.milestones {
+		if m.status != MilestonePending {
+			continue
+		}
+		due = append(due, m)
+		if c.fundingMode == FundingJustInTime {
+			break
+		}
+	}
+	return due
+}
+
+// FundMilestone records that the client's money for a milestone is held in escrow.
+// Milestones are funded in order.
+func (c *Contract) FundMilestone(clientID valueobject.UserID, milestoneID string) (*Milestone, error) {
+	if !c.clientID.Equals(clientID) {
+		return nil, ErrNotContractParty
+	}
+	if !c.isInProgress() {
+		return nil, ErrContractNotActive
+	}
+
+	milestone := c.FindMilestone(milestoneID)
+	if milestone == nil {
+		return nil, ErrMilestoneNotFound
+	}
+	if milestone.status != MilestonePending {
+		return nil, ErrMilestoneAlreadyFunded
+	}
+	for _, m := range c.milestones {
+		if m.sequence < milestone.sequence && m.status == MilestonePending {
+			return nil, ErrMilestoneOutOfOrder
+		}
+	}
+
+	milestone.fundedAt = milestone.setStatus(MilestoneFunded)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewMilestoneFunded(
+		c.id.String(),
+		milestone.id,
+		clientID.String(),
+		milestone.amount.Amount(),
+	))
+
+	return milestone, nil
+}
+
+// DeliverMilestone submits the hustler's work for a funded milestone. Each delivery is
+// kept as a new numbered version alongside the ones before it.
+func (c *Contract) DeliverMilestone(hustlerID valueobject.UserID, milestoneID string, deliverables []string, notes string) (*Milestone, error) {
+	if !c.hustlerID.Equals(hustlerID) {
+		return nil, ErrNotContractParty
+	}
+	if !c.isInProgress() {
+		return nil, ErrCannotDeliver
+	}
+
+	milestone := c.FindMilestone(milestoneID)
+	if milestone == nil {
+		return nil, ErrMilestoneNotFound
+	}
+	if milestone.status == MilestonePending {
+		return nil, ErrMilestoneNotFunded
+	}
+	if !milestone.isInProgress() {
+		return nil, ErrCannotDeliver
+	}
+
+	milestone.deliveredAt = milestone.setStatus(MilestoneDelivered)
+	milestone.deliverables = deliverables
+	c.deliveredAt = milestone.deliveredAt
+	c.deliverables = deliverables
+	c.updatedAt = time.Now().UTC()
+	delivery := c.recordDelivery(milestone, deliverables, notes)
+
+	c.RecordEvent(event.NewMilestoneDelivered(
+		c.id.String(),
+		milestone.id,
+		c.clientID.String(),
+		hustlerID.String(),
+		delivery.id,
+		delivery.version,
+		deliverables,
+		notes,
+	))
+
+	// The whole job is delivered once no milestone is left to work on
+	workLeft := false
+	for _, m := range c.milestones {
+		if m.status == MilestonePending || m.isInProgress() {
+			workLeft = true
+		}
+	}
+	if !workLeft {
+		c.RecordEvent(event.NewWorkDelivered(c.id.String(), hustlerID.String(), deliverables))
+	}
+
+	c.syncStatus()
+	return milestone, nil
+}
+
+// RequestRevision sends a delivered milestone back to the hustler with the client's reason.
+// Revision rounds across the contract are capped at what the proposal agreed. The client
+// may give the hustler extra days, which moves this and every later deadline back.
+func (c *Contract) RequestRevision(clientID valueobject.UserID, milestoneID string, reason string, extendDays int) error {
+	if !c.clientID.Equals(clientID) {
+		return ErrNotContractParty
+	}
+	if strings.TrimSpace(reason) == "" {
+		return ErrRevisionReasonNeeded
+	}
+	if extendDays < 0 || extendDays > MaxRevisionExtensionDays {
+		return ErrInvalidExtension
+	}
+
+	milestone := c.FindMilestone(milestoneID)
+	if milestone == nil {
+		return ErrMilestoneNotFound
+	}
+	if milestone.status != MilestoneDelivered {
+		return ErrMilestoneNotDelivered
+	}
+	if c.RevisionsRemaining() == 0 {
+		return ErrRevisionLimitReached
+	}
+
+	milestone.setStatus(MilestoneRevisionRequested)
+	milestone.revisions++
+	milestone.revisionNotes = reason
+	c.updatedAt = time.Now().UTC()
+
+	deliveryID := ""
+	if delivery := c.LatestDelivery(milestone.id); delivery != nil {
+		delivery.review(DeliveryRevisionRequested, reason)
+		deliveryID = delivery.id
+	}
+
+	c.RecordEvent(event.NewMilestoneRevisionRequested(
+		c.id.String(),
+		milestone.id,
+		clientID.String(),
+		c.hustlerID.String(),
+		deliveryID,
+		reason,
+		milestone.revisions,
+		c.RevisionsRemaining(),
+	))
+
+	if extendDays > 0 {
+		c.extendFrom(milestone, extendDays)
+
+		c.RecordEvent(event.NewContractDeadlineExtended(
+			c.id.String(),
+			milestone.id,
+			clientID.String(),
+			c.hustlerID.String(),
+			extendDays,
+			milestone.dueDate,
+			c.deadlineAt,
+		))
+	}
+
+	c.syncStatus()
+	return nil
+}
+
+// ApproveMilestone accepts a delivered milestone and releases its escrow to the hustler.
+// The contract completes when every milestone has been released.
+func (c *Contract) ApproveMilestone(clientID valueobject.UserID, milestoneID string, notes string) (*Milestone, error) {
+	if !c.clientID.Equals(clientID) {
+		return nil, ErrNotContractParty
+	}
+	if !c.isInProgress() {
+		return nil, ErrCannotApprove
+	}
+
+	milestone := c.FindMilestone(milestoneID)
+	if milestone == nil {
+		return nil, ErrMilestoneNotFound
+	}
+	if milestone.status != MilestoneDelivered {
+		return nil, ErrMilestoneNotDelivered
+	}
+
+	if notes != "" {
+		c.clientNotes = notes
+	}
+
+	c.releaseMilestone(milestone)
+	return milestone, nil
+}
+
+// releaseMilestone pays an approved milestone out of escrow, completing the contract
+// once every milestone has been released
+func (c *Contract) releaseMilestone(milestone *Milestone) {
+	milestone.releasedAt = milestone.setStatus(MilestoneReleased)
+	c.updatedAt = time.Now().UTC()
+
+	if delivery := c.LatestDelivery(milestone.id); delivery != nil {
+		delivery.review(DeliveryAccepted, "")
+	}
+
+	c.RecordEvent(event.NewMilestoneReleased(
+		c.id.String(),
+		milestone.id,
+		c.clientID.String(),
+		c.hustlerID.String(),
+		milestone.amount.Amount(),
+		milestone.platformFee.Amount(),
+	))
+
+	for _, m := range c.milestones {
+		if !m.IsReleased() {
+			c.syncStatus()
+			return
+		}
+	}
+
+	c.status = ContractStatusCompleted
+	c.completedAt = milestone.releasedAt
+
+	c.RecordEvent(event.NewWorkApproved(
+		c.id.String(),
+		c.clientID.String(),
+		c.hustlerID.String(),
+		c.NetPayoutAmount().Amount(),
+	))
+}
+
+// DisputeMilestone freezes a single milestone's escrow while the rest of the contract continues
+func (c *Contract) DisputeMilestone(userID valueobject.UserID, milestoneID string, reason string) (*Milestone, error) {
+	if !c.IsParty(userID) {
+		return nil, ErrNotContractParty
+	}
+	if !c.isInProgress() {
+		return nil, ErrContractNotActive
+	}
+
+	milestone := c.FindMilestone(milestoneID)
+	if milestone == nil {
+		return nil, ErrMilestoneNotFound
+	}
+	if !milestone.isInProgress() && milestone.status != MilestoneDelivered {
+		return nil, ErrCannotDisputeMilestone
+	}
+
+	milestone.setStatus(MilestoneDisputed)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewMilestoneDisputed(
+		c.id.String(),
+		milestone.id,
+		userID.String(),
+		reason,
+		milestone.amount.Amount(),
+	))
+
+	c.syncStatus()
+	return milestone, nil
+}
+
+// currentMilestone is the first milestone the hustler is working on
+func (c *Contract) currentMilestone() *Milestone {
+	for _, m := range c.milestones {
+		if m.isInProgress() {
+			return m
+		}
+	}
+	return nil
+}
+
+// isInProgress reports whether work and payments are still moving on the contract
+func (c *Contract) isInProgress() bool {
+	return c.status.IsActive() || c.status.IsDelivered()
+}
+
+// syncStatus shows the contract as delivered while any milestone awaits the client's review
+func (c *Contract) syncStatus() {
+	if !c.isInProgress() {
+		return
+	}
+
+	c.status = ContractStatusActive
+	for _, m := range c.milestones {
+		if m.status == MilestoneDelivered {
+			c.status = ContractStatusDelivered
+			return
+		}
+	}
+}
+
+// closeMilestones settles milestones when the contract is cancelled: undelivered funded
+// work is refunded, unfunded work is dropped, delivered or disputed work stays held
+func (c *Contract) closeMilestones() {
+	for _, m := range c.milestones {
+		switch {
+		case m.isInProgress():
+			m.setStatus(MilestoneRefunded)
+		case m.status == MilestonePending:
+			m.setStatus(MilestoneCancelled)
+		}
+	}
+}
+
+func newMilestone(sequence int, title string, amount, platformFee int64, currency valueobject.Currency, dueDate time.Time) *Milestone {
+	return &Milestone{
+		id:           valueobject.GenerateMilestoneID().String(),
+		sequence:     sequence,
+		title:        title,
+		amount:       valueobject.MustNewMoney(amount, currency),
+		platformFee:  valueobject.MustNewMoney(platformFee, currency),
+		dueDate:      dueDate,
+		status:       MilestonePending,
+		deliverables: make([]string, 0),
+	}
+}
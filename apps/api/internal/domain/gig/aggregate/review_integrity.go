@@ -0,0 +1,286 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Review integrity errors
+var (
+	ErrReviewWindowClosed        = errors.New("the review window for this contract has closed")
+	ErrReviewNotFound            = errors.New("review not found")
+	ErrReviewNotRevealed         = errors.New("review is not visible yet")
+	ErrNotReviewee               = errors.New("only the person reviewed can respond")
+	ErrAlreadyResponded          = errors.New("review already has a response")
+	ErrResponseRequired          = errors.New("response text is required")
+	ErrResponseTooLong           = errors.New("review response is too long")
+	ErrCannotFlagOwnReview       = errors.New("cannot report your own review")
+	ErrAlreadyFlagged            = errors.New("you have already reported this review")
+	ErrReviewNotFlagged          = errors.New("review is not awaiting moderation")
+	ErrInvalidModerationDecision = errors.New("invalid moderation decision")
+	ErrReviewModeratorIsParty    = errors.New("a party to the contract cannot moderate its reviews")
+)
+
+// MaxReviewResponseLength is the longest public response to a review, in characters
+const MaxReviewResponseLength = 1000
+
+// ReviewModeration is where a review stands with the moderators
+type ReviewModeration string
+
+const (
+	ReviewModerationNone     ReviewModeration = ""
+	ReviewModerationPending  ReviewModeration = "pending"  // Reported; stays up until a moderator decides
+	ReviewModerationHeld     ReviewModeration = "held"     // Caught by integrity checks; hidden until a moderator decides
+	ReviewModerationApproved ReviewModeration = "approved" // A moderator kept it
+	ReviewModerationRemoved  ReviewModeration = "removed"  // A moderator took it down
+)
+
+// IsQueued returns true while a moderator still has to decide
+func (m ReviewModeration) IsQueued() bool {
+	return m == ReviewModerationPending || m == ReviewModerationHeld
+}
+
+// ReviewFlagReason is why a review was sent to moderation
+type ReviewFlagReason string
+
+const (
+	ReviewFlagCollusion ReviewFlagReason = "collusion" // The pair keeps rating each other
+	ReviewFlagReported  ReviewFlagReason = "reported"  // A user reported it
+)
+
+// ReviewModerationDecision is a moderator's ruling on a flagged review
+type ReviewModerationDecision string
+
+const (
+	ReviewDecisionKeep   ReviewModerationDecision = "keep"
+	ReviewDecisionRemove ReviewModerationDecision = "remove"
+)
+
+// ReviewFlag records one reason a review was sent to moderation
+type ReviewFlag struct {
+	Reason    ReviewFlagReason
+	FlaggedBy *valueobject.UserID // nil when flagged automatically
+	Note      string
+	FlaggedAt time.Time
+}
+
+// ReviewResponse is the reviewee's public reply to a review
+type ReviewResponse struct {
+	Text        string
+	RespondedAt time.Time
+}
+
+// ReviewAssessment is what the integrity checks make of a review before it is added
+type ReviewAssessment struct {
+	Weight             float64 // 0 to 1
+	SuspectedCollusion bool
+}
+
+// RatingSummary is a user's rating as shown to others and used for credit scoring
+// and search ranking. Adjusted is the weighted rating pulled towards the platform
+// mean, so a handful of reviews cannot make or break anyone.
+type RatingSummary struct {
+	UserID        valueobject.UserID
+	Adjusted      float64
+	Average       float64 // plain mean of the counted ratings
+	Count         int
+	WeightedCount float64
+	StaleCount    int
+	UpdatedAt     time.Time
+}
+
+func (r *Review) Weight() float64              { return r.weight }
+func (r *Review) Flags() []ReviewFlag          { return r.flags }
+func (r *Review) Moderation() ReviewModeration { return r.moderation }
+func (r *Review) RevealedAt() *time.Time       { return r.revealedAt }
+func (r *Review) Response() *ReviewResponse    { return r.response }
+func (r *Review) IsRevealed() bool             { return r.revealedAt != nil }
+func (r *Review) IsHeld() bool                 { return r.moderation == ReviewModerationHeld }
+func (r *Review) IsRemoved() bool              { return r.moderation == ReviewModerationRemoved }
+
+// IsVisible returns true once the review is revealed, unless moderators are holding
+// or have removed it
+func (r *Review) IsVisible() bool {
+	return r.IsRevealed() && !r.IsHeld() && !r.IsRemoved()
+}
+
+// CountsTowardRating returns true if the review feeds the reviewee's rating
+func (r *Review) CountsTowardRating() bool {
+	return r.IsVisible() && r.weight > 0
+}
+
+// Assess applies the integrity checks' verdict. Call it before the review is added
+// to its contract; suspected collusion holds the review for a moderator.
+func (r *Review) Assess(assessment ReviewAssessment) {
+	weight := assessment.Weight
+	if weight < 0 {
+		weight = 0
+	}
+	if weight > 1 {
+		weight = 1
+	}
+	r.weight = weight
+
+	if assessment.SuspectedCollusion {
+		r.flags = append(r.flags, ReviewFlag{Reason: ReviewFlagCollusion, FlaggedAt: r.createdAt})
+		r.moderation = ReviewModerationHeld
+	}
+}
+
+// ReviewWindowClosed returns true once the parties can no longer review
+func (c *Contract) ReviewWindowClosed(window time.Duration, now time.Time) bool {
+	return c.completedAt != nil && !now.Before(c.completedAt.Add(window))
+}
+
+// HasUnrevealedReviews returns true if a review is still hidden from the other party
+func (c *Contract) HasUnrevealedReviews() bool {
+	for _, r := range c.reviews {
+		if !r.IsRevealed() {
+			return true
+		}
+	}
+	return false
+}
+
+// FindReview returns a review on the contract by ID
+func (c *Contract) FindReview(reviewID string) *Review {
+	for _, r := range c.reviews {
+		if r.id == reviewID {
+			return r
+		}
+	}
+	return nil
+}
+
+// RevealReviews shows any hidden reviews once the review window has closed.
+// Returns true if anything was revealed.
+func (c *Contract) RevealReviews(window time.Duration, now time.Time) bool {
+	if !c.ReviewWindowClosed(window, now) {
+		return false
+	}
+	return c.revealReviews(now)
+}
+
+func (c *Contract) revealReviews(now time.Time) bool {
+	var reviewIDs, revieweeIDs []string
+	for _, r := range c.reviews {
+		if r.IsRevealed() {
+			continue
+		}
+		revealedAt := now
+		r.revealedAt = &revealedAt
+		reviewIDs = append(reviewIDs, r.id)
+		revieweeIDs = append(revieweeIDs, r.revieweeID.String())
+	}
+	if len(reviewIDs) == 0 {
+		return false
+	}
+
+	c.updatedAt = now
+	c.RecordEvent(event.NewReviewsRevealed(c.id.String(), reviewIDs, revieweeIDs))
+
+	return true
+}
+
+// RespondToReview posts the reviewee's one public response to a visible review
+func (c *Contract) RespondToReview(revieweeID valueobject.UserID, reviewID, text string, now time.Time) error {
+	review := c.FindReview(reviewID)
+	if review == nil || review.IsRemoved() {
+		return ErrReviewNotFound
+	}
+	if !review.revieweeID.Equals(revieweeID) {
+		return ErrNotReviewee
+	}
+	if !review.IsVisible() {
+		return ErrReviewNotRevealed
+	}
+	if review.response != nil {
+		return ErrAlreadyResponded
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ErrResponseRequired
+	}
+	if len([]rune(text)) > MaxReviewResponseLength {
+		return ErrResponseTooLong
+	}
+
+	review.response = &ReviewResponse{Text: text, RespondedAt: now}
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewReviewResponded(c.id.String(), reviewID, revieweeID.String()))
+
+	return nil
+}
+
+// FlagReview reports a visible review to the moderators. It stays up until they decide.
+func (c *Contract) FlagReview(reviewID string, reporterID valueobject.UserID, note string, now time.Time) error {
+	review := c.FindReview(reviewID)
+	if review == nil || !review.IsVisible() {
+		return ErrReviewNotFound
+	}
+	if review.reviewerID.Equals(reporterID) {
+		return ErrCannotFlagOwnReview
+	}
+	for _, f := range review.flags {
+		if f.FlaggedBy != nil && f.FlaggedBy.Equals(reporterID) {
+			return ErrAlreadyFlagged
+		}
+	}
+
+	review.flags = append(review.flags, ReviewFlag{
+		Reason:    ReviewFlagReported,
+		FlaggedBy: &reporterID,
+		Note:      strings.TrimSpace(note),
+		FlaggedAt: now,
+	})
+	if !review.moderation.IsQueued() {
+		review.moderation = ReviewModerationPending
+	}
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewReviewFlagged(c.id.String(), reviewID, string(ReviewFlagReported), reporterID.String()))
+
+	return nil
+}
+
+// ModerateReview keeps or removes a review awaiting moderation
+func (c *Contract) ModerateReview(reviewID string, moderatorID valueobject.UserID, decision ReviewModerationDecision, note string, now time.Time) error {
+	if c.IsParty(moderatorID) {
+		return ErrReviewModeratorIsParty
+	}
+
+	review := c.FindReview(reviewID)
+	if review == nil {
+		return ErrReviewNotFound
+	}
+	if !review.moderation.IsQueued() {
+		return ErrReviewNotFlagged
+	}
+
+	switch decision {
+	case ReviewDecisionKeep:
+		review.moderation = ReviewModerationApproved
+	case ReviewDecisionRemove:
+		review.moderation = ReviewModerationRemoved
+	default:
+		return ErrInvalidModerationDecision
+	}
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewReviewModerated(
+		c.id.String(),
+		reviewID,
+		review.revieweeID.String(),
+		moderatorID.String(),
+		string(decision),
+		strings.TrimSpace(note),
+	))
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+const testBlindWindow = 14 * 24 * time.Hour
+
+func createCompletedContract(t *testing.T) *Contract {
+	t.Helper()
+
+	contract := createTestContract(t, 50000)
+	for _, m := range contract.MilestonesDueFunding() {
+		contract.FundMilestone(contract.ClientID(), m.ID())
+	}
+	contract.Deliver(contract.HustlerID(), []string{"logo.png"}, "")
+	if err := contract.Approve(contract.ClientID(), "Great work"); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	contract.ClearEvents()
+	return contract
+}
+
+func newTestReview(t *testing.T, reviewer, reviewee valueobject.UserID, rating int) *Review {
+	t.Helper()
+
+	review, err := NewReview(valueobject.GenerateTransactionID().String(), reviewer, reviewee, rating, "")
+	if err != nil {
+		t.Fatalf("NewReview() error = %v", err)
+	}
+	return review
+}
+
+func TestContract_ReviewsStayHiddenUntilBothSubmit(t *testing.T) {
+	contract := createCompletedContract(t)
+	now := time.Now().UTC()
+
+	fromClient := newTestReview(t, contract.ClientID(), contract.HustlerID(), 5)
+	if err := contract.AddReview(fromClient, testBlindWindow, now); err != nil {
+		t.Fatalf("AddReview() error = %v", err)
+	}
+	if fromClient.IsRevealed() || fromClient.IsVisible() {
+		t.Fatal("first review should stay hidden until the other party reviews")
+	}
+
+	fromHustler := newTestReview(t, contract.HustlerID(), contract.ClientID(), 4)
+	if err := contract.AddReview(fromHustler, testBlindWindow, now); err != nil {
+		t.Fatalf("AddReview() error = %v", err)
+	}
+	if !fromClient.IsVisible() || !fromHustler.IsVisible() {
+		t.Error("both reviews should be revealed once both parties have reviewed")
+	}
+
+	events := contract.DomainEvents()
+	if last := events[len(events)-1]; last.EventType() != "ReviewsRevealed" {
+		t.Errorf("last event = %s, want ReviewsRevealed", last.EventType())
+	}
+}
+
+func TestContract_RevealReviewsAfterWindow(t *testing.T) {
+	contract := createCompletedContract(t)
+	completedAt := *contract.CompletedAt()
+
+	review := newTestReview(t, contract.ClientID(), contract.HustlerID(), 2)
+	contract.AddReview(review, testBlindWindow, completedAt)
+
+	if contract.RevealReviews(testBlindWindow, completedAt.Add(testBlindWindow-time.Minute)) {
+		t.Error("RevealReviews() inside the window should reveal nothing")
+	}
+	if !contract.HasUnrevealedReviews() {
+		t.Error("HasUnrevealedReviews() = false, want true")
+	}
+
+	closed := completedAt.Add(testBlindWindow)
+	late := newTestReview(t, contract.HustlerID(), contract.ClientID(), 1)
+	if err := contract.AddReview(late, testBlindWindow, closed); err != ErrReviewWindowClosed {
+		t.Errorf("AddReview() after window = %v, want ErrReviewWindowClosed", err)
+	}
+
+	if !contract.RevealReviews(testBlindWindow, closed) || !review.IsVisible() {
+		t.Error("RevealReviews() should reveal the lone review once the window closes")
+	}
+	if contract.RevealReviews(testBlindWindow, closed) {
+		t.Error("RevealReviews() again should be a no-op")
+	}
+}
+
+func TestContract_RespondToReview(t *testing.T) {
+	contract := createCompletedContract(t)
+	now := time.Now().UTC()
+
+	review := newTestReview(t, contract.ClientID(), contract.HustlerID(), 3)
+	contract.AddReview(review, testBlindWindow, now)
+
+	if err := contract.RespondToReview(contract.HustlerID(), review.ID(), "Thanks", now); err != ErrReviewNotRevealed {
+		t.Errorf("RespondToReview() while hidden = %v, want ErrReviewNotRevealed", err)
+	}
+
+	contract.AddReview(newTestReview(t, contract.HustlerID(), contract.ClientID(), 5), testBlindWindow, now)
+
+	if err := contract.RespondToReview(contract.ClientID(), review.ID(), "Thanks", now); err != ErrNotReviewee {
+		t.Errorf("RespondToReview() by reviewer = %v, want ErrNotReviewee", err)
+	}
+	if err := contract.RespondToReview(contract.HustlerID(), review.ID(), "  ", now); err != ErrResponseRequired {
+		t.Errorf("RespondToReview() blank = %v, want ErrResponseRequired", err)
+	}
+	if err := contract.RespondToReview(contract.HustlerID(), review.ID(), "The site wasn't ready on day one", now); err != nil {
+		t.Fatalf("RespondToReview() error = %v", err)
+	}
+	if review.Response() == nil || review.Response().Text != "The site wasn't ready on day one" {
+		t.Errorf("Response() = %v", review.Response())
+	}
+	if err := contract.RespondToReview(contract.HustlerID(), review.ID(), "Again", now); err != ErrAlreadyResponded {
+		t.Errorf("RespondToReview() twice = %v, want ErrAlreadyResponded", err)
+	}
+}
+
+func TestContract_FlagAndModerateReview(t *testing.T) {
+	contract := createCompletedContract(t)
+	now := time.Now().UTC()
+
+	review := newTestReview(t, contract.ClientID(), contract.HustlerID(), 1)
+	contract.AddReview(review, testBlindWindow, now)
+	contract.AddReview(newTestReview(t, contract.HustlerID(), contract.ClientID(), 5), testBlindWindow, now)
+
+	if err := contract.FlagReview(review.ID(), contract.ClientID(), "", now); err != ErrCannotFlagOwnReview {
+		t.Errorf("FlagReview() own review = %v, want ErrCannotFlagOwnReview", err)
+	}
+	if err := contract.FlagReview(review.ID(), contract.HustlerID(), "Never worked with me", now); err != nil {
+		t.Fatalf("FlagReview() error = %v", err)
+	}
+	if err := contract.FlagReview(review.ID(), contract.HustlerID(), "", now); err != ErrAlreadyFlagged {
+		t.Errorf("FlagReview() twice = %v, want ErrAlreadyFlagged", err)
+	}
+
+	// Reported reviews stay up while they wait for a moderator
+	if review.Moderation() != ReviewModerationPending || !review.IsVisible() {
+		t.Errorf("after flag: moderation %q, visible %v", review.Moderation(), review.IsVisible())
+	}
+
+	moderator := valueobject.GenerateUserID()
+	if err := contract.ModerateReview(review.ID(), contract.HustlerID(), ReviewDecisionRemove, "", now); err != ErrReviewModeratorIsParty {
+		t.Errorf("ModerateReview() by party = %v, want ErrReviewModeratorIsParty", err)
+	}
+	if err := contract.ModerateReview(review.ID(), moderator, "bury", "", now); err != ErrInvalidModerationDecision {
+		t.Errorf("ModerateReview() bad decision = %v, want ErrInvalidModerationDecision", err)
+	}
+	if err := contract.ModerateReview(review.ID(), moderator, ReviewDecisionRemove, "Retaliatory", now); err != nil {
+		t.Fatalf("ModerateReview() error = %v", err)
+	}
+	if review.IsVisible() || review.CountsTowardRating() {
+		t.Error("removed review should be hidden and not count toward the rating")
+	}
+	if err := contract.ModerateReview(review.ID(), moderator, ReviewDecisionKeep, "", now); err != ErrReviewNotFlagged {
+		t.Errorf("ModerateReview() again = %v, want ErrReviewNotFlagged", err)
+	}
+}
+
+func TestReview_AssessHoldsSuspectedCollusion(t *testing.T) {
+	contract := createCompletedContract(t)
+	now := time.Now().UTC()
+
+	review := newTestReview(t, contract.ClientID(), contract.HustlerID(), 5)
+	review.Assess(ReviewAssessment{Weight: 1.5, SuspectedCollusion: true})
+	if review.Weight() != 1 {
+		t.Errorf("Weight() = %v, want clamped to 1", review.Weight())
+	}
+
+	contract.AddReview(review, testBlindWindow, now)
+	contract.AddReview(newTestReview(t, contract.HustlerID(), contract.ClientID(), 5), testBlindWindow, now)
+
+	if !review.IsRevealed() || review.IsVisible() {
+		t.Error("held review should be revealed but stay hidden until moderated")
+	}
+	if len(review.Flags()) != 1 || review.Flags()[0].Reason != ReviewFlagCollusion {
+		t.Errorf("Flags() = %v, want one collusion flag", review.Flags())
+	}
+
+	if err := contract.ModerateReview(review.ID(), valueobject.GenerateUserID(), ReviewDecisionKeep, "", now); err != nil {
+		t.Fatalf("ModerateReview() error = %v", err)
+	}
+	if !review.IsVisible() || !review.CountsTowardRating() {
+		t.Error("approved review should be visible and count toward the rating")
+	}
+}
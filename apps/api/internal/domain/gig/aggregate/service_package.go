@@ -0,0 +1,550 @@
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"hustlex/internal/domain/gig/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Service package errors
+var (
+	ErrNotPackageOwner      = errors.New("only the package owner can perform this action")
+	ErrPackageNotAvailable  = errors.New("service package is not available to order")
+	ErrPackageArchived      = errors.New("service package has been archived")
+	ErrCannotOrderOwn       = errors.New("cannot order your own service package")
+	ErrInvalidPackageTiers  = errors.New("a package needs between one and three different tiers")
+	ErrInvalidTier          = errors.New("each tier needs a price, a delivery time and valid revisions")
+	ErrTierPricesOutOfOrder = errors.New("each tier must cost more than the one below it")
+	ErrTierNotOffered       = errors.New("package does not offer this tier")
+	ErrInvalidAddOn         = errors.New("each add-on needs a title and a price")
+	ErrAddOnNotFound        = errors.New("add-on not found")
+)
+
+// MaxPackageAddOns is the most extras a package can offer
+const MaxPackageAddOns = 10
+
+// PackageTier names a level of a service package
+type PackageTier string
+
+const (
+	TierBasic    PackageTier = "basic"
+	TierStandard PackageTier = "standard"
+	TierPremium  PackageTier = "premium"
+)
+
+func (t PackageTier) String() string {
+	return string(t)
+}
+
+// rank orders tiers from basic to premium; unknown tiers rank zero
+func (t PackageTier) rank() int {
+	switch t {
+	case TierBasic:
+		return 1
+	case TierStandard:
+		return 2
+	case TierPremium:
+		return 3
+	}
+	return 0
+}
+
+// PackageStatus represents whether a package can be ordered
+type PackageStatus string
+
+const (
+	PackageStatusActive   PackageStatus = "active"
+	PackageStatusPaused   PackageStatus = "paused"
+	PackageStatusArchived PackageStatus = "archived"
+)
+
+func (s PackageStatus) String() string {
+	return string(s)
+}
+
+// TierSpec describes one tier when publishing or repricing a package
+type TierSpec struct {
+	Tier         PackageTier
+	Summary      string
+	Price        int64
+	DeliveryDays int
+	Revisions    int
+	Features     []string
+}
+
+// AddOnSpec describes an optional extra when publishing or repricing a package
+type AddOnSpec struct {
+	Title     string
+	Price     int64
+	ExtraDays int
+}
+
+// PackageOffer is one priced tier of a service package
+type PackageOffer struct {
+	tier         PackageTier
+	summary      string
+	price        valueobject.Money
+	deliveryDays int
+	revisions    int
+	features     []string
+}
+
+// ReconstructPackageOffer reconstructs a package tier from persistence
+func ReconstructPackageOffer(tier PackageTier, summary string, price valueobject.Money, deliveryDays, revisions int, features []string) *PackageOffer {
+	return &PackageOffer{
+		tier:         tier,
+		summary:      summary,
+		price:        price,
+		deliveryDays: deliveryDays,
+		revisions:    revisions,
+		features:     features,
+	}
+}
+
+func (o *PackageOffer) Tier() PackageTier        { return o.tier }
+func (o *PackageOffer) Summary() string          { return o.summary }
+func (o *PackageOffer) Price() valueobject.Money { return o.price }
+func (o *PackageOffer) DeliveryDays() int        { return o.deliveryDays }
+func (o *PackageOffer) Revisions() int           { return o.revisions }
+func (o *PackageOffer) Features() []string       { return o.features }
+
+// PackageAddOn is an optional extra a client can buy with any tier
+type PackageAddOn struct {
+	id        string
+	title     string
+	price     valueobject.Money
+	extraDays int
+}
+
+// ReconstructPackageAddOn reconstructs an add-on from persistence
+func ReconstructPackageAddOn(id, title string, price valueobject.Money, extraDays int) *PackageAddOn {
+	return &PackageAddOn{id: id, title: title, price: price, extraDays: extraDays}
+}
+
+func (a *PackageAddOn) ID() string               { return a.id }
+func (a *PackageAddOn) Title() string            { return a.title }
+func (a *PackageAddOn) Price() valueobject.Money { return a.price }
+func (a *PackageAddOn) ExtraDays() int           { return a.extraDays }
+
+// ServicePackage is the aggregate root for a hustler's fixed-price offering.
+// Clients order a tier directly, which creates a contract without a gig or proposal.
+type ServicePackage struct {
+	sharedevent.AggregateRoot
+
+	id          valueobject.PackageID
+	hustlerID   valueobject.UserID
+	title       string
+	description string
+	category    string
+	skillID     *valueobject.SkillID
+	tags        []string
+	currency    valueobject.Currency
+	tiers       []*PackageOffer
+	addOns      []*PackageAddOn
+	status      PackageStatus
+	orderCount  int
+	createdAt   time.Time
+	updatedAt   time.Time
+	version     int64
+}
+
+// NewServicePackage publishes a new service package
+func NewServicePackage(
+	id valueobject.PackageID,
+	hustlerID valueobject.UserID,
+	title string,
+	description string,
+	category string,
+	currency valueobject.Currency,
+	tiers []TierSpec,
+	addOns []AddOnSpec,
+) (*ServicePackage, error) {
+	offers, err := buildOffers(tiers, currency)
+	if err != nil {
+		return nil, err
+	}
+	extras, err := buildAddOns(addOns, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &ServicePackage{
+		id:          id,
+		hustlerID:   hustlerID,
+		title:       title,
+		description: description,
+		category:    category,
+		tags:        make([]string, 0),
+		currency:    currency,
+		tiers:       offers,
+		addOns:      extras,
+		status:      PackageStatusActive,
+		createdAt:   time.Now().UTC(),
+		updatedAt:   time.Now().UTC(),
+		version:     1,
+	}
+
+	pkg.RecordEvent(event.NewServicePackagePublished(
+		id.String(),
+		hustlerID.String(),
+		title,
+		category,
+		pkg.StartingPrice().Amount(),
+		len(offers),
+	))
+
+	return pkg, nil
+}
+
+// ReconstructServicePackage reconstructs a service package from persistence
+func ReconstructServicePackage(
+	id valueobject.PackageID,
+	hustlerID valueobject.UserID,
+	title string,
+	description string,
+	category string,
+	skillID *valueobject.SkillID,
+	tags []string,
+	currency valueobject.Currency,
+	tiers []*PackageOffer,
+	addOns []*PackageAddOn,
+	status PackageStatus,
+	orderCount int,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int64,
+) *ServicePackage {
+	return &ServicePackage{
+		id:          id,
+		hustlerID:   hustlerID,
+		title:       title,
+		description: description,
+		category:    category,
+		skillID:     skillID,
+		tags:        tags,
+		currency:    currency,
+		tiers:       tiers,
+		addOns:      addOns,
+		status:      status,
+		orderCount:  orderCount,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		version:     version,
+	}
+}
+
+// Getters
+func (p *ServicePackage) ID() valueobject.PackageID      { return p.id }
+func (p *ServicePackage) HustlerID() valueobject.UserID  { return p.hustlerID }
+func (p *ServicePackage) Title() string                  { return p.title }
+func (p *ServicePackage) Description() string            { return p.description }
+func (p *ServicePackage) Category() string               { return p.category }
+func (p *ServicePackage) SkillID() *valueobject.SkillID  { return p.skillID }
+func (p *ServicePackage) Tags() []string                 { return p.tags }
+func (p *ServicePackage) Currency() valueobject.Currency { return p.currency }
+func (p *ServicePackage) Tiers() []*PackageOffer         { return p.tiers }
+func (p *ServicePackage) AddOns() []*PackageAddOn        { return p.addOns }
+func (p *ServicePackage) Status() PackageStatus          { return p.status }
+func (p *ServicePackage) OrderCount() int                { return p.orderCount }
+func (p *ServicePackage) CreatedAt() time.Time           { return p.createdAt }
+func (p *ServicePackage) UpdatedAt() time.Time           { return p.updatedAt }
+func (p *ServicePackage) Version() int64                 { return p.version }
+func (p *ServicePackage) IsActive() bool                 { return p.status == PackageStatusActive }
+
+// StartingPrice is the price of the cheapest tier
+func (p *ServicePackage) StartingPrice() valueobject.Money {
+	return p.tiers[0].price
+}
+
+// FindTier returns the offer for a tier, or nil if the package does not offer it
+func (p *ServicePackage) FindTier(tier PackageTier) *PackageOffer {
+	for _, o := range p.tiers {
+		if o.tier == tier {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindAddOn returns an add-on by ID, or nil
+func (p *ServicePackage) FindAddOn(addOnID string) *PackageAddOn {
+	for _, a := range p.addOns {
+		if a.id == addOnID {
+			return a
+		}
+	}
+	return nil
+}
+
+// Business Methods
+
+// UpdateDetails changes how the package is described and categorised
+func (p *ServicePackage) UpdateDetails(
+	hustlerID valueobject.UserID,
+	title string,
+	description string,
+	category string,
+	skillID *valueobject.SkillID,
+	tags []string,
+) error {
+	if err := p.checkEditable(hustlerID); err != nil {
+		return err
+	}
+
+	updatedFields := make(map[string]string)
+	if title != p.title {
+		p.title = title
+		updatedFields["title"] = title
+	}
+	if description != p.description {
+		p.description = description
+		updatedFields["description"] = description
+	}
+	if category != p.category {
+		p.category = category
+		updatedFields["category"] = category
+	}
+	p.skillID = skillID
+	p.tags = tags
+	p.updatedAt = time.Now().UTC()
+
+	if len(updatedFields) > 0 {
+		p.RecordEvent(event.NewServicePackageUpdated(p.id.String(), p.hustlerID.String(), updatedFields))
+	}
+
+	return nil
+}
+
+// Reprice replaces the package's tiers and add-ons. Contracts already ordered keep
+// the terms they were bought on.
+func (p *ServicePackage) Reprice(hustlerID valueobject.UserID, tiers []TierSpec, addOns []AddOnSpec) error {
+	if err := p.checkEditable(hustlerID); err != nil {
+		return err
+	}
+
+	offers, err := buildOffers(tiers, p.currency)
+	if err != nil {
+		return err
+	}
+	extras, err := buildAddOns(addOns, p.currency)
+	if err != nil {
+		return err
+	}
+
+	p.tiers = offers
+	p.addOns = extras
+	p.updatedAt = time.Now().UTC()
+
+	p.RecordEvent(event.NewServicePackageUpdated(p.id.String(), p.hustlerID.String(), map[string]string{
+		"starting_at": fmt.Sprintf("%d", p.StartingPrice().Amount()),
+		"tiers":       fmt.Sprintf("%d", len(offers)),
+		"add_ons":     fmt.Sprintf("%d", len(extras)),
+	}))
+
+	return nil
+}
+
+// Pause stops new orders, for example while the hustler is fully booked
+func (p *ServicePackage) Pause(hustlerID valueobject.UserID) error {
+	return p.changeStatus(hustlerID, PackageStatusPaused)
+}
+
+// Resume takes orders again after a pause
+func (p *ServicePackage) Resume(hustlerID valueobject.UserID) error {
+	return p.changeStatus(hustlerID, PackageStatusActive)
+}
+
+// Archive withdraws the package for good
+func (p *ServicePackage) Archive(hustlerID valueobject.UserID) error {
+	return p.changeStatus(hustlerID, PackageStatusArchived)
+}
+
+// Order buys a tier of the package with any add-ons. The price is the tier's plus the
+// add-ons', and so is the delivery time. The returned terms create the contract.
+func (p *ServicePackage) Order(
+	clientID valueobject.UserID,
+	tier PackageTier,
+	addOnIDs []string,
+	contractID valueobject.ContractID,
+) (*PackageOrderData, error) {
+	if !p.IsActive() {
+		return nil, ErrPackageNotAvailable
+	}
+
+	if p.hustlerID.Equals(clientID) {
+		return nil, ErrCannotOrderOwn
+	}
+
+	offer := p.FindTier(tier)
+	if offer == nil {
+		return nil, ErrTierNotOffered
+	}
+
+	price := offer.price
+	deliveryDays := offer.deliveryDays
+	chosen := make([]string, 0, len(addOnIDs))
+	seen := make(map[string]bool, len(addOnIDs))
+	for _, id := range addOnIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		addOn := p.FindAddOn(id)
+		if addOn == nil {
+			return nil, ErrAddOnNotFound
+		}
+		price = price.MustAdd(addOn.price)
+		deliveryDays += addOn.extraDays
+		chosen = append(chosen, addOn.title)
+	}
+
+	// Calculate platform fee (10%)
+	platformFee := price.Amount() / 10
+	deadlineAt := time.Now().UTC().AddDate(0, 0, deliveryDays)
+
+	p.orderCount++
+	p.updatedAt = time.Now().UTC()
+
+	p.RecordEvent(event.NewServicePackageOrdered(
+		p.id.String(),
+		contractID.String(),
+		clientID.String(),
+		p.hustlerID.String(),
+		tier.String(),
+		chosen,
+		price.Amount(),
+		deliveryDays,
+		deadlineAt,
+	))
+
+	return &PackageOrderData{
+		ContractID:   contractID,
+		PackageID:    p.id,
+		Tier:         tier,
+		AddOns:       chosen,
+		ClientID:     clientID,
+		HustlerID:    p.hustlerID,
+		AgreedPrice:  price,
+		PlatformFee:  platformFee,
+		DeliveryDays: deliveryDays,
+		DeadlineAt:   deadlineAt,
+		Revisions:    offer.revisions,
+	}, nil
+}
+
+func (p *ServicePackage) checkEditable(hustlerID valueobject.UserID) error {
+	if !p.hustlerID.Equals(hustlerID) {
+		return ErrNotPackageOwner
+	}
+	if p.status == PackageStatusArchived {
+		return ErrPackageArchived
+	}
+	return nil
+}
+
+func (p *ServicePackage) changeStatus(hustlerID valueobject.UserID, status PackageStatus) error {
+	if err := p.checkEditable(hustlerID); err != nil {
+		return err
+	}
+	if p.status == status {
+		return nil
+	}
+
+	p.status = status
+	p.updatedAt = time.Now().UTC()
+
+	p.RecordEvent(event.NewServicePackageUpdated(p.id.String(), p.hustlerID.String(), map[string]string{
+		"status": status.String(),
+	}))
+
+	return nil
+}
+
+// buildOffers validates tier specs and returns them ordered basic to premium
+func buildOffers(specs []TierSpec, currency valueobject.Currency) ([]*PackageOffer, error) {
+	if len(specs) == 0 || len(specs) > 3 {
+		return nil, ErrInvalidPackageTiers
+	}
+
+	sorted := make([]TierSpec, len(specs))
+	copy(sorted, specs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Tier.rank() < sorted[j].Tier.rank() })
+
+	offers := make([]*PackageOffer, len(sorted))
+	for i, spec := range sorted {
+		if spec.Tier.rank() == 0 || (i > 0 && spec.Tier == sorted[i-1].Tier) {
+			return nil, ErrInvalidPackageTiers
+		}
+		if spec.Price <= 0 || spec.DeliveryDays < 1 || spec.Revisions < 0 || spec.Revisions > MaxProposalRevisions {
+			return nil, ErrInvalidTier
+		}
+		if i > 0 && spec.Price <= sorted[i-1].Price {
+			return nil, ErrTierPricesOutOfOrder
+		}
+
+		price, err := valueobject.NewMoney(spec.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		features := spec.Features
+		if features == nil {
+			features = make([]string, 0)
+		}
+		offers[i] = &PackageOffer{
+			tier:         spec.Tier,
+			summary:      spec.Summary,
+			price:        price,
+			deliveryDays: spec.DeliveryDays,
+			revisions:    spec.Revisions,
+			features:     features,
+		}
+	}
+
+	return offers, nil
+}
+
+func buildAddOns(specs []AddOnSpec, currency valueobject.Currency) ([]*PackageAddOn, error) {
+	if len(specs) > MaxPackageAddOns {
+		return nil, ErrInvalidAddOn
+	}
+
+	addOns := make([]*PackageAddOn, len(specs))
+	for i, spec := range specs {
+		if spec.Title == "" || spec.Price <= 0 || spec.ExtraDays < 0 {
+			return nil, ErrInvalidAddOn
+		}
+		price, err := valueobject.NewMoney(spec.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		addOns[i] = &PackageAddOn{
+			id:        valueobject.GenerateAddOnID().String(),
+			title:     spec.Title,
+			price:     price,
+			extraDays: spec.ExtraDays,
+		}
+	}
+
+	return addOns, nil
+}
+
+// PackageOrderData contains the terms a package was bought on, used to create its contract
+type PackageOrderData struct {
+	ContractID   valueobject.ContractID
+	PackageID    valueobject.PackageID
+	Tier         PackageTier
+	AddOns       []string // titles of the add-ons bought
+	ClientID     valueobject.UserID
+	HustlerID    valueobject.UserID
+	AgreedPrice  valueobject.Money
+	PlatformFee  int64
+	DeliveryDays int
+	DeadlineAt   time.Time
+	Revisions    int // revision rounds the client may ask for
+}
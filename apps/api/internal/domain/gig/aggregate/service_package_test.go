@@ -0,0 +1,120 @@
+package aggregate
+
+import (
+	"testing"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func createTestPackage(t *testing.T) *ServicePackage {
+	t.Helper()
+
+	pkg, err := NewServicePackage(
+		valueobject.GeneratePackageID(),
+		valueobject.GenerateUserID(),
+		"Native attire sewing",
+		"Made to measure agbada and kaftan",
+		"Fashion",
+		valueobject.NGN,
+		[]TierSpec{
+			{Tier: TierPremium, Price: 9000000, DeliveryDays: 14, Revisions: 3},
+			{Tier: TierBasic, Price: 2500000, DeliveryDays: 7, Revisions: 1},
+			{Tier: TierStandard, Price: 5000000, DeliveryDays: 10, Revisions: 2},
+		},
+		[]AddOnSpec{{Title: "Express delivery", Price: 1000000, ExtraDays: 0}, {Title: "Embroidery", Price: 1500000, ExtraDays: 3}},
+	)
+	if err != nil {
+		t.Fatalf("NewServicePackage() error = %v", err)
+	}
+	return pkg
+}
+
+func TestNewServicePackage_ValidatesTiers(t *testing.T) {
+	pkg := createTestPackage(t)
+
+	if pkg.Tiers()[0].Tier() != TierBasic || pkg.Tiers()[2].Tier() != TierPremium {
+		t.Error("tiers are not ordered basic to premium")
+	}
+	if pkg.StartingPrice().Amount() != 2500000 {
+		t.Errorf("StartingPrice() = %d, want 2500000", pkg.StartingPrice().Amount())
+	}
+
+	tests := []struct {
+		name  string
+		tiers []TierSpec
+		want  error
+	}{
+		{"no tiers", nil, ErrInvalidPackageTiers},
+		{"repeated tier", []TierSpec{{Tier: TierBasic, Price: 100, DeliveryDays: 1}, {Tier: TierBasic, Price: 200, DeliveryDays: 1}}, ErrInvalidPackageTiers},
+		{"unknown tier", []TierSpec{{Tier: "gold", Price: 100, DeliveryDays: 1}}, ErrInvalidPackageTiers},
+		{"no delivery time", []TierSpec{{Tier: TierBasic, Price: 100}}, ErrInvalidTier},
+		{"premium cheaper than basic", []TierSpec{{Tier: TierBasic, Price: 500, DeliveryDays: 1}, {Tier: TierPremium, Price: 400, DeliveryDays: 2}}, ErrTierPricesOutOfOrder},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewServicePackage(valueobject.GeneratePackageID(), valueobject.GenerateUserID(), "T", "D", "C", valueobject.NGN, tt.tiers, nil)
+			if err != tt.want {
+				t.Errorf("NewServicePackage() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestServicePackage_OrderCreatesFundableContract(t *testing.T) {
+	pkg := createTestPackage(t)
+	client := valueobject.GenerateUserID()
+	embroidery := pkg.AddOns()[1].ID()
+
+	if _, err := pkg.Order(pkg.HustlerID(), TierBasic, nil, valueobject.GenerateContractID()); err != ErrCannotOrderOwn {
+		t.Errorf("Order() own package = %v, want %v", err, ErrCannotOrderOwn)
+	}
+	if _, err := pkg.Order(client, TierStandard, []string{"missing"}, valueobject.GenerateContractID()); err != ErrAddOnNotFound {
+		t.Errorf("Order() unknown add-on = %v, want %v", err, ErrAddOnNotFound)
+	}
+
+	order, err := pkg.Order(client, TierStandard, []string{embroidery, embroidery}, valueobject.GenerateContractID())
+	if err != nil {
+		t.Fatalf("Order() error = %v", err)
+	}
+	if order.AgreedPrice.Amount() != 6500000 || order.DeliveryDays != 13 || order.Revisions != 2 {
+		t.Errorf("order = price %d, %d days, %d revisions", order.AgreedPrice.Amount(), order.DeliveryDays, order.Revisions)
+	}
+	if pkg.OrderCount() != 1 {
+		t.Errorf("OrderCount() = %d, want 1", pkg.OrderCount())
+	}
+
+	contract, err := NewPackageContract(order)
+	if err != nil {
+		t.Fatalf("NewPackageContract() error = %v", err)
+	}
+	if !contract.IsPackageOrder() || contract.PackageTier() != TierStandard || !contract.GigID().IsEmpty() {
+		t.Error("contract does not record the package it was ordered from")
+	}
+	if contract.PlatformFee().Amount() != 650000 || contract.MaxRevisions() != 2 {
+		t.Errorf("contract fee %d, revisions %d", contract.PlatformFee().Amount(), contract.MaxRevisions())
+	}
+	if _, err := contract.FundMilestone(client, contract.Milestones()[0].ID()); err != nil {
+		t.Fatalf("FundMilestone() error = %v", err)
+	}
+	if _, err := contract.Deliver(pkg.HustlerID(), []string{"fitting.jpg"}, ""); err != nil {
+		t.Errorf("Deliver() error = %v", err)
+	}
+}
+
+func TestServicePackage_PauseStopsOrders(t *testing.T) {
+	pkg := createTestPackage(t)
+	client := valueobject.GenerateUserID()
+
+	if err := pkg.Pause(client); err != ErrNotPackageOwner {
+		t.Errorf("Pause() by client = %v, want %v", err, ErrNotPackageOwner)
+	}
+	pkg.Pause(pkg.HustlerID())
+	if _, err := pkg.Order(client, TierBasic, nil, valueobject.GenerateContractID()); err != ErrPackageNotAvailable {
+		t.Errorf("Order() while paused = %v, want %v", err, ErrPackageNotAvailable)
+	}
+
+	pkg.Archive(pkg.HustlerID())
+	if err := pkg.Resume(pkg.HustlerID()); err != ErrPackageArchived {
+		t.Errorf("Resume() archived = %v, want %v", err, ErrPackageArchived)
+	}
+}
@@ -0,0 +1,143 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const (
+	AggregateTypeAvailability = "Availability"
+	AggregateTypeBooking      = "Booking"
+)
+
+// AvailabilityUpdated is emitted when a hustler changes their hours, blackouts or service area
+type AvailabilityUpdated struct {
+	sharedevent.BaseEvent
+	HustlerID     string   `json:"hustler_id"`
+	UpdatedFields []string `json:"updated_fields"`
+}
+
+func NewAvailabilityUpdated(hustlerID string, updatedFields []string) *AvailabilityUpdated {
+	return &AvailabilityUpdated{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"AvailabilityUpdated",
+			hustlerID,
+			AggregateTypeAvailability,
+		),
+		HustlerID:     hustlerID,
+		UpdatedFields: updatedFields,
+	}
+}
+
+// BookingConfirmed is emitted when a client books an on-site visit.
+// The visit fee is already held in the client's escrow until the visit is completed or cancelled.
+type BookingConfirmed struct {
+	sharedevent.BaseEvent
+	BookingID  string    `json:"booking_id"`
+	HustlerID  string    `json:"hustler_id"`
+	ClientID   string    `json:"client_id"`
+	ContractID string    `json:"contract_id,omitempty"`
+	StartsAt   time.Time `json:"starts_at"`
+	EndsAt     time.Time `json:"ends_at"`
+	Address    string    `json:"address"`
+	VisitFee   int64     `json:"visit_fee"`
+	Currency   string    `json:"currency"`
+}
+
+func NewBookingConfirmed(bookingID, hustlerID, clientID, contractID string, startsAt, endsAt time.Time, address string, visitFee int64, currency string) *BookingConfirmed {
+	return &BookingConfirmed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"BookingConfirmed",
+			bookingID,
+			AggregateTypeBooking,
+		),
+		BookingID:  bookingID,
+		HustlerID:  hustlerID,
+		ClientID:   clientID,
+		ContractID: contractID,
+		StartsAt:   startsAt,
+		EndsAt:     endsAt,
+		Address:    address,
+		VisitFee:   visitFee,
+		Currency:   currency,
+	}
+}
+
+// BookingRescheduled is emitted when a booking moves to a new slot
+type BookingRescheduled struct {
+	sharedevent.BaseEvent
+	BookingID        string    `json:"booking_id"`
+	RescheduledBy    string    `json:"rescheduled_by"`
+	PreviousStartsAt time.Time `json:"previous_starts_at"`
+	StartsAt         time.Time `json:"starts_at"`
+	EndsAt           time.Time `json:"ends_at"`
+}
+
+func NewBookingRescheduled(bookingID, rescheduledBy string, previousStartsAt, startsAt, endsAt time.Time) *BookingRescheduled {
+	return &BookingRescheduled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"BookingRescheduled",
+			bookingID,
+			AggregateTypeBooking,
+		),
+		BookingID:        bookingID,
+		RescheduledBy:    rescheduledBy,
+		PreviousStartsAt: previousStartsAt,
+		StartsAt:         startsAt,
+		EndsAt:           endsAt,
+	}
+}
+
+// BookingCancelled is emitted when either party cancels a booking.
+// Fee has been paid to the hustler and Refund returned to the client from escrow.
+type BookingCancelled struct {
+	sharedevent.BaseEvent
+	BookingID   string `json:"booking_id"`
+	CancelledBy string `json:"cancelled_by"`
+	HustlerID   string `json:"hustler_id"`
+	ClientID    string `json:"client_id"`
+	Reason      string `json:"reason,omitempty"`
+	Fee         int64  `json:"fee"`
+	Refund      int64  `json:"refund"`
+}
+
+func NewBookingCancelled(bookingID, cancelledBy, hustlerID, clientID, reason string, fee, refund int64) *BookingCancelled {
+	return &BookingCancelled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"BookingCancelled",
+			bookingID,
+			AggregateTypeBooking,
+		),
+		BookingID:   bookingID,
+		CancelledBy: cancelledBy,
+		HustlerID:   hustlerID,
+		ClientID:    clientID,
+		Reason:      reason,
+		Fee:         fee,
+		Refund:      refund,
+	}
+}
+
+// BookingCompleted is emitted when the hustler marks a visit done and the visit fee is paid out
+type BookingCompleted struct {
+	sharedevent.BaseEvent
+	BookingID string `json:"booking_id"`
+	HustlerID string `json:"hustler_id"`
+	ClientID  string `json:"client_id"`
+	VisitFee  int64  `json:"visit_fee"`
+}
+
+func NewBookingCompleted(bookingID, hustlerID, clientID string, visitFee int64) *BookingCompleted {
+	return &BookingCompleted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"BookingCompleted",
+			bookingID,
+			AggregateTypeBooking,
+		),
+		BookingID: bookingID,
+		HustlerID: hustlerID,
+		ClientID:  clientID,
+		VisitFee:  visitFee,
+	}
+}
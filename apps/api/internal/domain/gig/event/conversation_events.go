@@ -0,0 +1,153 @@
+package event
+
+import (
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const AggregateTypeConversation = "Conversation"
+
+// ConversationStarted is emitted when a client and hustler first talk about a gig or contract
+type ConversationStarted struct {
+	sharedevent.BaseEvent
+	ConversationID string `json:"conversation_id"`
+	SubjectType    string `json:"subject_type"`
+	SubjectID      string `json:"subject_id"`
+	GigID          string `json:"gig_id,omitempty"`
+	ContractID     string `json:"contract_id,omitempty"`
+	ClientID       string `json:"client_id"`
+	HustlerID      string `json:"hustler_id"`
+	StartedBy      string `json:"started_by"`
+}
+
+func NewConversationStarted(conversationID, subjectType, subjectID, gigID, contractID, clientID, hustlerID, startedBy string) *ConversationStarted {
+	return &ConversationStarted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ConversationStarted",
+			conversationID,
+			AggregateTypeConversation,
+		),
+		ConversationID: conversationID,
+		SubjectType:    subjectType,
+		SubjectID:      subjectID,
+		GigID:          gigID,
+		ContractID:     contractID,
+		ClientID:       clientID,
+		HustlerID:      hustlerID,
+		StartedBy:      startedBy,
+	}
+}
+
+// MessageSent is emitted when a participant posts a message
+type MessageSent struct {
+	sharedevent.BaseEvent
+	ConversationID  string `json:"conversation_id"`
+	MessageID       string `json:"message_id"`
+	Seq             int    `json:"seq"`
+	SenderID        string `json:"sender_id"`
+	RecipientID     string `json:"recipient_id"`
+	AttachmentCount int    `json:"attachment_count"`
+}
+
+func NewMessageSent(conversationID, messageID string, seq int, senderID, recipientID string, attachmentCount int) *MessageSent {
+	return &MessageSent{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MessageSent",
+			conversationID,
+			AggregateTypeConversation,
+		),
+		ConversationID:  conversationID,
+		MessageID:       messageID,
+		Seq:             seq,
+		SenderID:        senderID,
+		RecipientID:     recipientID,
+		AttachmentCount: attachmentCount,
+	}
+}
+
+// ContactInfoRedacted is emitted when contact details are stripped from a message
+// sent before a contract started. Trust and safety watch it for repeat offenders.
+type ContactInfoRedacted struct {
+	sharedevent.BaseEvent
+	ConversationID string   `json:"conversation_id"`
+	MessageID      string   `json:"message_id"`
+	SenderID       string   `json:"sender_id"`
+	Kinds          []string `json:"kinds"`
+}
+
+func NewContactInfoRedacted(conversationID, messageID, senderID string, kinds []string) *ContactInfoRedacted {
+	return &ContactInfoRedacted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ContactInfoRedacted",
+			conversationID,
+			AggregateTypeConversation,
+		),
+		ConversationID: conversationID,
+		MessageID:      messageID,
+		SenderID:       senderID,
+		Kinds:          kinds,
+	}
+}
+
+// MessagesRead is emitted when a participant reads up to a message
+type MessagesRead struct {
+	sharedevent.BaseEvent
+	ConversationID string `json:"conversation_id"`
+	ReaderID       string `json:"reader_id"`
+	UpToSeq        int    `json:"up_to_seq"`
+}
+
+func NewMessagesRead(conversationID, readerID string, upToSeq int) *MessagesRead {
+	return &MessagesRead{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MessagesRead",
+			conversationID,
+			AggregateTypeConversation,
+		),
+		ConversationID: conversationID,
+		ReaderID:       readerID,
+		UpToSeq:        upToSeq,
+	}
+}
+
+// ConversationContractLinked is emitted when the parties sign a contract, which
+// lifts contact-info screening
+type ConversationContractLinked struct {
+	sharedevent.BaseEvent
+	ConversationID string `json:"conversation_id"`
+	ContractID     string `json:"contract_id"`
+}
+
+func NewConversationContractLinked(conversationID, contractID string) *ConversationContractLinked {
+	return &ConversationContractLinked{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ConversationContractLinked",
+			conversationID,
+			AggregateTypeConversation,
+		),
+		ConversationID: conversationID,
+		ContractID:     contractID,
+	}
+}
+
+// ConversationExported is emitted when a participant exports a transcript
+type ConversationExported struct {
+	sharedevent.BaseEvent
+	ConversationID string `json:"conversation_id"`
+	ExportedBy     string `json:"exported_by"`
+	MessageCount   int    `json:"message_count"`
+	Checksum       string `json:"checksum"`
+}
+
+func NewConversationExported(conversationID, exportedBy string, messageCount int, checksum string) *ConversationExported {
+	return &ConversationExported{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ConversationExported",
+			conversationID,
+			AggregateTypeConversation,
+		),
+		ConversationID: conversationID,
+		ExportedBy:     exportedBy,
+		MessageCount:   messageCount,
+		Checksum:       checksum,
+	}
+}
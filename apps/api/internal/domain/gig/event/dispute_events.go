@@ -0,0 +1,179 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const AggregateTypeDispute = "Dispute"
+
+// DisputeOpened is emitted when a party opens a dispute over a contract's escrow
+type DisputeOpened struct {
+	sharedevent.BaseEvent
+	DisputeID     string    `json:"dispute_id"`
+	ContractID    string    `json:"contract_id"`
+	MilestoneIDs  []string  `json:"milestone_ids"`
+	RaisedBy      string    `json:"raised_by"`
+	RespondentID  string    `json:"respondent_id"`
+	Reason        string    `json:"reason"`
+	Amount        int64     `json:"amount"`
+	ResponseDueAt time.Time `json:"response_due_at"`
+}
+
+func NewDisputeOpened(disputeID, contractID string, milestoneIDs []string, raisedBy, respondentID, reason string, amount int64, responseDueAt time.Time) *DisputeOpened {
+	return &DisputeOpened{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"DisputeOpened",
+			disputeID,
+			AggregateTypeDispute,
+		),
+		DisputeID:     disputeID,
+		ContractID:    contractID,
+		MilestoneIDs:  milestoneIDs,
+		RaisedBy:      raisedBy,
+		RespondentID:  respondentID,
+		Reason:        reason,
+		Amount:        amount,
+		ResponseDueAt: responseDueAt,
+	}
+}
+
+// DisputeEvidenceSubmitted is emitted when a party adds evidence to a dispute
+type DisputeEvidenceSubmitted struct {
+	sharedevent.BaseEvent
+	DisputeID   string `json:"dispute_id"`
+	EvidenceID  string `json:"evidence_id"`
+	SubmittedBy string `json:"submitted_by"`
+	Attachments int    `json:"attachments"`
+}
+
+func NewDisputeEvidenceSubmitted(disputeID, evidenceID, submittedBy string, attachments int) *DisputeEvidenceSubmitted {
+	return &DisputeEvidenceSubmitted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"DisputeEvidenceSubmitted",
+			disputeID,
+			AggregateTypeDispute,
+		),
+		DisputeID:   disputeID,
+		EvidenceID:  evidenceID,
+		SubmittedBy: submittedBy,
+		Attachments: attachments,
+	}
+}
+
+// DisputeMediatorAssigned is emitted when a mediator takes on a dispute
+type DisputeMediatorAssigned struct {
+	sharedevent.BaseEvent
+	DisputeID  string `json:"dispute_id"`
+	MediatorID string `json:"mediator_id"`
+}
+
+func NewDisputeMediatorAssigned(disputeID, mediatorID string) *DisputeMediatorAssigned {
+	return &DisputeMediatorAssigned{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"DisputeMediatorAssigned",
+			disputeID,
+			AggregateTypeDispute,
+		),
+		DisputeID:  disputeID,
+		MediatorID: mediatorID,
+	}
+}
+
+// DisputeResponseRequested is emitted when the mediator asks a party to respond by a deadline
+type DisputeResponseRequested struct {
+	sharedevent.BaseEvent
+	DisputeID     string    `json:"dispute_id"`
+	MediatorID    string    `json:"mediator_id"`
+	PartyID       string    `json:"party_id"`
+	ResponseDueAt time.Time `json:"response_due_at"`
+}
+
+func NewDisputeResponseRequested(disputeID, mediatorID, partyID string, responseDueAt time.Time) *DisputeResponseRequested {
+	return &DisputeResponseRequested{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"DisputeResponseRequested",
+			disputeID,
+			AggregateTypeDispute,
+		),
+		DisputeID:     disputeID,
+		MediatorID:    mediatorID,
+		PartyID:       partyID,
+		ResponseDueAt: responseDueAt,
+	}
+}
+
+// DisputeResolved is emitted when a dispute is decided, by a mediator or a missed deadline
+type DisputeResolved struct {
+	sharedevent.BaseEvent
+	DisputeID     string   `json:"dispute_id"`
+	ContractID    string   `json:"contract_id"`
+	MilestoneIDs  []string `json:"milestone_ids"`
+	ClientID      string   `json:"client_id"`
+	HustlerID     string   `json:"hustler_id"`
+	Outcome       string   `json:"outcome"`
+	ClientPercent int      `json:"client_percent"`
+	RefundAmount  int64    `json:"refund_amount"`
+	ReleaseAmount int64    `json:"release_amount"`
+	LosingPartyID string   `json:"losing_party_id,omitempty"` // empty for a split
+	ResolvedBy    string   `json:"resolved_by,omitempty"`     // empty when resolved by default
+	ByDefault     bool     `json:"by_default"`
+}
+
+func NewDisputeResolved(
+	disputeID, contractID string,
+	milestoneIDs []string,
+	clientID, hustlerID, outcome string,
+	clientPercent int,
+	refundAmount, releaseAmount int64,
+	losingPartyID, resolvedBy string,
+	byDefault bool,
+) *DisputeResolved {
+	return &DisputeResolved{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"DisputeResolved",
+			disputeID,
+			AggregateTypeDispute,
+		),
+		DisputeID:     disputeID,
+		ContractID:    contractID,
+		MilestoneIDs:  milestoneIDs,
+		ClientID:      clientID,
+		HustlerID:     hustlerID,
+		Outcome:       outcome,
+		ClientPercent: clientPercent,
+		RefundAmount:  refundAmount,
+		ReleaseAmount: releaseAmount,
+		LosingPartyID: losingPartyID,
+		ResolvedBy:    resolvedBy,
+		ByDefault:     byDefault,
+	}
+}
+
+// ContractDisputeSettled is emitted when a dispute ruling is applied to a contract's escrow
+type ContractDisputeSettled struct {
+	sharedevent.BaseEvent
+	ContractID    string   `json:"contract_id"`
+	DisputeID     string   `json:"dispute_id"`
+	MilestoneIDs  []string `json:"milestone_ids"`
+	RefundAmount  int64    `json:"refund_amount"`
+	ReleaseAmount int64    `json:"release_amount"`
+	Status        string   `json:"status"`
+}
+
+func NewContractDisputeSettled(contractID, disputeID string, milestoneIDs []string, refundAmount, releaseAmount int64, status string) *ContractDisputeSettled {
+	return &ContractDisputeSettled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ContractDisputeSettled",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:    contractID,
+		DisputeID:     disputeID,
+		MilestoneIDs:  milestoneIDs,
+		RefundAmount:  refundAmount,
+		ReleaseAmount: releaseAmount,
+		Status:        status,
+	}
+}
@@ -154,10 +154,12 @@ func NewProposalAccepted(proposalID, gigID, contractID, hustlerID, clientID stri
 }
 
 // ContractCreated is emitted when a contract is created from an accepted proposal
+// or a service package order
 type ContractCreated struct {
 	sharedevent.BaseEvent
 	ContractID   string    `json:"contract_id"`
-	GigID        string    `json:"gig_id"`
+	GigID        string    `json:"gig_id,omitempty"`
+	PackageID    string    `json:"package_id,omitempty"`
 	ClientID     string    `json:"client_id"`
 	HustlerID    string    `json:"hustler_id"`
 	AgreedPrice  int64     `json:"agreed_price"`
@@ -0,0 +1,105 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// GigInvitationSent is emitted when a client invites a hustler to propose on a gig
+type GigInvitationSent struct {
+	sharedevent.BaseEvent
+	GigID        string    `json:"gig_id"`
+	InvitationID string    `json:"invitation_id"`
+	ClientID     string    `json:"client_id"`
+	HustlerID    string    `json:"hustler_id"`
+	GigTitle     string    `json:"gig_title"`
+	Message      string    `json:"message,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func NewGigInvitationSent(gigID, invitationID, clientID, hustlerID, gigTitle, message string, expiresAt time.Time) *GigInvitationSent {
+	return &GigInvitationSent{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"GigInvitationSent",
+			gigID,
+			AggregateTypeGig,
+		),
+		GigID:        gigID,
+		InvitationID: invitationID,
+		ClientID:     clientID,
+		HustlerID:    hustlerID,
+		GigTitle:     gigTitle,
+		Message:      message,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// GigInvitationAccepted is emitted when an invited hustler submits their proposal
+type GigInvitationAccepted struct {
+	sharedevent.BaseEvent
+	GigID        string `json:"gig_id"`
+	InvitationID string `json:"invitation_id"`
+	HustlerID    string `json:"hustler_id"`
+	ProposalID   string `json:"proposal_id"`
+}
+
+func NewGigInvitationAccepted(gigID, invitationID, hustlerID, proposalID string) *GigInvitationAccepted {
+	return &GigInvitationAccepted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"GigInvitationAccepted",
+			gigID,
+			AggregateTypeGig,
+		),
+		GigID:        gigID,
+		InvitationID: invitationID,
+		HustlerID:    hustlerID,
+		ProposalID:   proposalID,
+	}
+}
+
+// GigInvitationDeclined is emitted when an invited hustler turns the gig down
+type GigInvitationDeclined struct {
+	sharedevent.BaseEvent
+	GigID        string `json:"gig_id"`
+	InvitationID string `json:"invitation_id"`
+	HustlerID    string `json:"hustler_id"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+func NewGigInvitationDeclined(gigID, invitationID, hustlerID, reason string) *GigInvitationDeclined {
+	return &GigInvitationDeclined{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"GigInvitationDeclined",
+			gigID,
+			AggregateTypeGig,
+		),
+		GigID:        gigID,
+		InvitationID: invitationID,
+		HustlerID:    hustlerID,
+		Reason:       reason,
+	}
+}
+
+// ProposalLabelled is emitted when a client shortlists or archives a proposal, or clears its label
+type ProposalLabelled struct {
+	sharedevent.BaseEvent
+	GigID      string `json:"gig_id"`
+	ProposalID string `json:"proposal_id"`
+	HustlerID  string `json:"hustler_id"`
+	Label      string `json:"label"`
+}
+
+func NewProposalLabelled(gigID, proposalID, hustlerID, label string) *ProposalLabelled {
+	return &ProposalLabelled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ProposalLabelled",
+			gigID,
+			AggregateTypeGig,
+		),
+		GigID:      gigID,
+		ProposalID: proposalID,
+		HustlerID:  hustlerID,
+		Label:      label,
+	}
+}
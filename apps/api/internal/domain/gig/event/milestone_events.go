@@ -0,0 +1,225 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// MilestonesPlanned is emitted when a contract's price is split into milestones
+type MilestonesPlanned struct {
+	sharedevent.BaseEvent
+	ContractID  string `json:"contract_id"`
+	FundingMode string `json:"funding_mode"`
+	Milestones  int    `json:"milestones"`
+}
+
+func NewMilestonesPlanned(contractID, fundingMode string, milestones int) *MilestonesPlanned {
+	return &MilestonesPlanned{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestonesPlanned",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		FundingMode: fundingMode,
+		Milestones:  milestones,
+	}
+}
+
+// MilestoneFunded is emitted when a milestone's amount is held in escrow
+type MilestoneFunded struct {
+	sharedevent.BaseEvent
+	ContractID  string `json:"contract_id"`
+	MilestoneID string `json:"milestone_id"`
+	ClientID    string `json:"client_id"`
+	Amount      int64  `json:"amount"`
+}
+
+func NewMilestoneFunded(contractID, milestoneID, clientID string, amount int64) *MilestoneFunded {
+	return &MilestoneFunded{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestoneFunded",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+		ClientID:    clientID,
+		Amount:      amount,
+	}
+}
+
+// MilestoneDelivered is emitted when the hustler submits a version of a milestone's work
+type MilestoneDelivered struct {
+	sharedevent.BaseEvent
+	ContractID   string   `json:"contract_id"`
+	MilestoneID  string   `json:"milestone_id"`
+	ClientID     string   `json:"client_id"`
+	HustlerID    string   `json:"hustler_id"`
+	DeliveryID   string   `json:"delivery_id"`
+	Deliverables []string `json:"deliverables,omitempty"`
+	Notes        string   `json:"notes,omitempty"`
+
+	DeliveryVersion int `json:"delivery_version"`
+}
+
+func NewMilestoneDelivered(contractID, milestoneID, clientID, hustlerID, deliveryID string, version int, deliverables []string, notes string) *MilestoneDelivered {
+	return &MilestoneDelivered{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestoneDelivered",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:   contractID,
+		MilestoneID:  milestoneID,
+		ClientID:     clientID,
+		HustlerID:    hustlerID,
+		DeliveryID:   deliveryID,
+		Deliverables: deliverables,
+		Notes:        notes,
+
+		DeliveryVersion: version,
+	}
+}
+
+// MilestoneRevisionRequested is emitted when the client sends a milestone back for changes
+type MilestoneRevisionRequested struct {
+	sharedevent.BaseEvent
+	ContractID         string `json:"contract_id"`
+	MilestoneID        string `json:"milestone_id"`
+	ClientID           string `json:"client_id"`
+	HustlerID          string `json:"hustler_id"`
+	DeliveryID         string `json:"delivery_id"`
+	Notes              string `json:"notes,omitempty"`
+	Revision           int    `json:"revision"`
+	RevisionsRemaining int    `json:"revisions_remaining"`
+}
+
+func NewMilestoneRevisionRequested(contractID, milestoneID, clientID, hustlerID, deliveryID, notes string, revision, revisionsRemaining int) *MilestoneRevisionRequested {
+	return &MilestoneRevisionRequested{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestoneRevisionRequested",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:         contractID,
+		MilestoneID:        milestoneID,
+		ClientID:           clientID,
+		HustlerID:          hustlerID,
+		DeliveryID:         deliveryID,
+		Notes:              notes,
+		Revision:           revision,
+		RevisionsRemaining: revisionsRemaining,
+	}
+}
+
+// ContractDeadlineExtended is emitted when the client gives the hustler more time
+// along with a revision request
+type ContractDeadlineExtended struct {
+	sharedevent.BaseEvent
+	ContractID  string    `json:"contract_id"`
+	MilestoneID string    `json:"milestone_id"`
+	ClientID    string    `json:"client_id"`
+	HustlerID   string    `json:"hustler_id"`
+	Days        int       `json:"days"`
+	DueDate     time.Time `json:"due_date"`
+	DeadlineAt  time.Time `json:"deadline_at"`
+}
+
+func NewContractDeadlineExtended(contractID, milestoneID, clientID, hustlerID string, days int, dueDate, deadlineAt time.Time) *ContractDeadlineExtended {
+	return &ContractDeadlineExtended{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ContractDeadlineExtended",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+		ClientID:    clientID,
+		HustlerID:   hustlerID,
+		Days:        days,
+		DueDate:     dueDate,
+		DeadlineAt:  deadlineAt,
+	}
+}
+
+// MilestoneReleased is emitted when a milestone is approved and its escrow paid to the hustler
+type MilestoneReleased struct {
+	sharedevent.BaseEvent
+	ContractID  string `json:"contract_id"`
+	MilestoneID string `json:"milestone_id"`
+	ClientID    string `json:"client_id"`
+	HustlerID   string `json:"hustler_id"`
+	Amount      int64  `json:"amount"`
+	PlatformFee int64  `json:"platform_fee"`
+}
+
+func NewMilestoneReleased(contractID, milestoneID, clientID, hustlerID string, amount, platformFee int64) *MilestoneReleased {
+	return &MilestoneReleased{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestoneReleased",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+		ClientID:    clientID,
+		HustlerID:   hustlerID,
+		Amount:      amount,
+		PlatformFee: platformFee,
+	}
+}
+
+// MilestoneDisputed is emitted when a party disputes a single milestone, freezing its escrow
+type MilestoneDisputed struct {
+	sharedevent.BaseEvent
+	ContractID  string `json:"contract_id"`
+	MilestoneID string `json:"milestone_id"`
+	DisputedBy  string `json:"disputed_by"`
+	Reason      string `json:"reason"`
+	Amount      int64  `json:"amount"`
+}
+
+func NewMilestoneDisputed(contractID, milestoneID, disputedBy, reason string, amount int64) *MilestoneDisputed {
+	return &MilestoneDisputed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestoneDisputed",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+		DisputedBy:  disputedBy,
+		Reason:      reason,
+		Amount:      amount,
+	}
+}
+
+// MilestoneAutoApproved is emitted when the client's review window closes without a
+// response and the delivered milestone is approved on their behalf
+type MilestoneAutoApproved struct {
+	sharedevent.BaseEvent
+	ContractID  string    `json:"contract_id"`
+	MilestoneID string    `json:"milestone_id"`
+	ClientID    string    `json:"client_id"`
+	HustlerID   string    `json:"hustler_id"`
+	DeliveredAt time.Time `json:"delivered_at"`
+	ReviewDueAt time.Time `json:"review_due_at"`
+}
+
+func NewMilestoneAutoApproved(contractID, milestoneID, clientID, hustlerID string, deliveredAt, reviewDueAt time.Time) *MilestoneAutoApproved {
+	return &MilestoneAutoApproved{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MilestoneAutoApproved",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+		ClientID:    clientID,
+		HustlerID:   hustlerID,
+		DeliveredAt: deliveredAt,
+		ReviewDueAt: reviewDueAt,
+	}
+}
@@ -0,0 +1,90 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const AggregateTypeServicePackage = "ServicePackage"
+
+// ServicePackagePublished is emitted when a hustler lists a fixed-price offering
+type ServicePackagePublished struct {
+	sharedevent.BaseEvent
+	PackageID  string `json:"package_id"`
+	HustlerID  string `json:"hustler_id"`
+	Title      string `json:"title"`
+	Category   string `json:"category"`
+	StartingAt int64  `json:"starting_at"`
+	Tiers      int    `json:"tiers"`
+}
+
+func NewServicePackagePublished(packageID, hustlerID, title, category string, startingAt int64, tiers int) *ServicePackagePublished {
+	return &ServicePackagePublished{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ServicePackagePublished",
+			packageID,
+			AggregateTypeServicePackage,
+		),
+		PackageID:  packageID,
+		HustlerID:  hustlerID,
+		Title:      title,
+		Category:   category,
+		StartingAt: startingAt,
+		Tiers:      tiers,
+	}
+}
+
+// ServicePackageUpdated is emitted when a package's details, pricing or availability change
+type ServicePackageUpdated struct {
+	sharedevent.BaseEvent
+	PackageID     string            `json:"package_id"`
+	HustlerID     string            `json:"hustler_id"`
+	UpdatedFields map[string]string `json:"updated_fields"`
+}
+
+func NewServicePackageUpdated(packageID, hustlerID string, updatedFields map[string]string) *ServicePackageUpdated {
+	return &ServicePackageUpdated{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ServicePackageUpdated",
+			packageID,
+			AggregateTypeServicePackage,
+		),
+		PackageID:     packageID,
+		HustlerID:     hustlerID,
+		UpdatedFields: updatedFields,
+	}
+}
+
+// ServicePackageOrdered is emitted when a client buys a package tier, creating a contract
+type ServicePackageOrdered struct {
+	sharedevent.BaseEvent
+	PackageID    string    `json:"package_id"`
+	ContractID   string    `json:"contract_id"`
+	ClientID     string    `json:"client_id"`
+	HustlerID    string    `json:"hustler_id"`
+	Tier         string    `json:"tier"`
+	AddOns       []string  `json:"add_ons,omitempty"`
+	Amount       int64     `json:"amount"`
+	DeliveryDays int       `json:"delivery_days"`
+	DeadlineAt   time.Time `json:"deadline_at"`
+}
+
+func NewServicePackageOrdered(packageID, contractID, clientID, hustlerID, tier string, addOns []string, amount int64, deliveryDays int, deadlineAt time.Time) *ServicePackageOrdered {
+	return &ServicePackageOrdered{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ServicePackageOrdered",
+			packageID,
+			AggregateTypeServicePackage,
+		),
+		PackageID:    packageID,
+		ContractID:   contractID,
+		ClientID:     clientID,
+		HustlerID:    hustlerID,
+		Tier:         tier,
+		AddOns:       addOns,
+		Amount:       amount,
+		DeliveryDays: deliveryDays,
+		DeadlineAt:   deadlineAt,
+	}
+}
@@ -0,0 +1,99 @@
+package event
+
+import (
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// ReviewsRevealed is emitted when a contract's reviews become visible, once both
+// parties have reviewed or the review window has closed
+type ReviewsRevealed struct {
+	sharedevent.BaseEvent
+	ContractID  string   `json:"contract_id"`
+	ReviewIDs   []string `json:"review_ids"`
+	RevieweeIDs []string `json:"reviewee_ids"`
+}
+
+func NewReviewsRevealed(contractID string, reviewIDs, revieweeIDs []string) *ReviewsRevealed {
+	return &ReviewsRevealed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ReviewsRevealed",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		ReviewIDs:   reviewIDs,
+		RevieweeIDs: revieweeIDs,
+	}
+}
+
+// ReviewResponded is emitted when a reviewee posts a public response to their review
+type ReviewResponded struct {
+	sharedevent.BaseEvent
+	ContractID string `json:"contract_id"`
+	ReviewID   string `json:"review_id"`
+	RevieweeID string `json:"reviewee_id"`
+}
+
+func NewReviewResponded(contractID, reviewID, revieweeID string) *ReviewResponded {
+	return &ReviewResponded{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ReviewResponded",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID: contractID,
+		ReviewID:   reviewID,
+		RevieweeID: revieweeID,
+	}
+}
+
+// ReviewFlagged is emitted when a review joins the moderation queue, either
+// reported by a user or caught by the integrity checks
+type ReviewFlagged struct {
+	sharedevent.BaseEvent
+	ContractID string `json:"contract_id"`
+	ReviewID   string `json:"review_id"`
+	Reason     string `json:"reason"`
+	FlaggedBy  string `json:"flagged_by,omitempty"` // empty when flagged automatically
+}
+
+func NewReviewFlagged(contractID, reviewID, reason, flaggedBy string) *ReviewFlagged {
+	return &ReviewFlagged{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ReviewFlagged",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID: contractID,
+		ReviewID:   reviewID,
+		Reason:     reason,
+		FlaggedBy:  flaggedBy,
+	}
+}
+
+// ReviewModerated is emitted when a moderator keeps or removes a flagged review
+type ReviewModerated struct {
+	sharedevent.BaseEvent
+	ContractID  string `json:"contract_id"`
+	ReviewID    string `json:"review_id"`
+	RevieweeID  string `json:"reviewee_id"`
+	ModeratorID string `json:"moderator_id"`
+	Decision    string `json:"decision"`
+	Note        string `json:"note,omitempty"`
+}
+
+func NewReviewModerated(contractID, reviewID, revieweeID, moderatorID, decision, note string) *ReviewModerated {
+	return &ReviewModerated{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ReviewModerated",
+			contractID,
+			AggregateTypeContract,
+		),
+		ContractID:  contractID,
+		ReviewID:    reviewID,
+		RevieweeID:  revieweeID,
+		ModeratorID: moderatorID,
+		Decision:    decision,
+		Note:        note,
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"hustlex/internal/domain/gig/aggregate"
@@ -25,6 +26,10 @@ type GigRepository interface {
 	// List retrieves gigs with filters
 	List(ctx context.Context, filter GigFilter) ([]*aggregate.Gig, int64, error)
 
+	// FindByInvitedHustler retrieves open gigs holding an invitation the hustler
+	// can still respond to at asOf, most recent invitation first
+	FindByInvitedHustler(ctx context.Context, hustlerID valueobject.UserID, asOf time.Time, offset, limit int) ([]*aggregate.Gig, int64, error)
+
 	// Delete soft-deletes a gig
 	Delete(ctx context.Context, id valueobject.GigID) error
 }
@@ -32,7 +37,7 @@ type GigRepository interface {
 // GigFilter contains filter options for listing gigs
 type GigFilter struct {
 	Category    string
-	SkillID     *valueobject.SkillID
+	SkillIDs    []valueobject.SkillID // a skill and everything beneath it in the taxonomy
 	MinBudget   int64
 	MaxBudget   int64
 	IsRemote    *bool
@@ -40,7 +45,11 @@ type GigFilter struct {
 	Status      *aggregate.GigStatus
 	SearchQuery string
 	ExcludeUserID *valueobject.UserID
-	SortBy      string // newest, budget_high, budget_low, deadline, popular
+	Near        *valueobject.GeoPoint // only on-site gigs within RadiusKm of this point
+	RadiusKm    float64
+	BudgetBand  string // one of the BudgetBands keys
+	IncludeInviteOnly bool // invite-only gigs are left out of listings unless set
+	SortBy      string // relevance, newest, budget_high, budget_low, deadline, popular, distance
 	Offset      int
 	Limit       int
 }
@@ -91,6 +100,20 @@ type ContractRepository interface {
 
 	// FindActiveByHustlerID retrieves active contracts for a hustler
 	FindActiveByHustlerID(ctx context.Context, hustlerID valueobject.UserID) ([]*aggregate.Contract, error)
+
+	// FindByReviewID retrieves the contract a review was left on
+	FindByReviewID(ctx context.Context, reviewID string) (*aggregate.Contract, error)
+
+	// FindWithUnrevealedReviews retrieves contracts completed before the given time
+	// that still have hidden reviews
+	FindWithUnrevealedReviews(ctx context.Context, completedBefore time.Time) ([]*aggregate.Contract, error)
+
+	// FindWithDeliveriesAwaitingReview retrieves contracts with a milestone delivered before
+	// the given time that the client has not yet approved, sent back or disputed
+	FindWithDeliveriesAwaitingReview(ctx context.Context, deliveredBefore time.Time) ([]*aggregate.Contract, error)
+
+	// FindWithQueuedReviews retrieves contracts with reviews awaiting moderation, oldest flag first
+	FindWithQueuedReviews(ctx context.Context, offset, limit int) ([]*aggregate.Contract, int64, error)
 }
 
 // ContractDTO represents contract data for listings
@@ -114,6 +137,113 @@ type ContractDTO struct {
 	HasReviewed  bool
 }
 
+// DisputeRepository defines the interface for dispute persistence
+type DisputeRepository interface {
+	// Save persists a dispute aggregate
+	Save(ctx context.Context, dispute *aggregate.Dispute) error
+
+	// SaveWithEvents persists a dispute and publishes domain events
+	SaveWithEvents(ctx context.Context, dispute *aggregate.Dispute) error
+
+	// FindByID retrieves a dispute by ID
+	FindByID(ctx context.Context, id valueobject.DisputeID) (*aggregate.Dispute, error)
+
+	// FindByContractID retrieves every dispute raised on a contract
+	FindByContractID(ctx context.Context, contractID valueobject.ContractID) ([]*aggregate.Dispute, error)
+
+	// FindOpen retrieves unresolved disputes, optionally only those assigned to a mediator
+	FindOpen(ctx context.Context, mediatorID *valueobject.UserID, offset, limit int) ([]*aggregate.Dispute, int64, error)
+
+	// FindResponseOverdue retrieves unresolved disputes whose response deadline passed before asOf
+	FindResponseOverdue(ctx context.Context, asOf time.Time) ([]*aggregate.Dispute, error)
+}
+
+// ServicePackageRepository defines the interface for service package persistence
+type ServicePackageRepository interface {
+	// Save persists a service package aggregate
+	Save(ctx context.Context, pkg *aggregate.ServicePackage) error
+
+	// SaveWithEvents persists a service package and publishes domain events
+	SaveWithEvents(ctx context.Context, pkg *aggregate.ServicePackage) error
+
+	// FindByID retrieves a service package by ID
+	FindByID(ctx context.Context, id valueobject.PackageID) (*aggregate.ServicePackage, error)
+
+	// FindByHustlerID retrieves the packages a hustler offers
+	FindByHustlerID(ctx context.Context, hustlerID valueobject.UserID, status *aggregate.PackageStatus, offset, limit int) ([]*aggregate.ServicePackage, int64, error)
+
+	// List retrieves packages with filters
+	List(ctx context.Context, filter PackageFilter) ([]*aggregate.ServicePackage, int64, error)
+}
+
+// PackageFilter contains filter options for listing service packages
+type PackageFilter struct {
+	Category      string
+	SkillIDs      []valueobject.SkillID // a skill and everything beneath it in the taxonomy
+	MaxPrice      int64 // starting price at most this
+	Status        *aggregate.PackageStatus
+	SearchQuery   string
+	ExcludeUserID *valueobject.UserID
+	SortBy        string // newest, price_low, price_high, popular, top_rated (by the hustler's adjusted rating)
+	Offset        int
+	Limit         int
+}
+
+// AvailabilityRepository defines the interface for hustlers' booking calendars
+type AvailabilityRepository interface {
+	// Save persists a calendar
+	Save(ctx context.Context, availability *aggregate.Availability) error
+
+	// SaveWithEvents persists a calendar and publishes domain events
+	SaveWithEvents(ctx context.Context, availability *aggregate.Availability) error
+
+	// FindByHustlerID retrieves a hustler's calendar
+	FindByHustlerID(ctx context.Context, hustlerID valueobject.UserID) (*aggregate.Availability, error)
+}
+
+// BookingRepository defines the interface for on-site booking persistence
+type BookingRepository interface {
+	// SaveWithEvents persists a booking and publishes domain events.
+	// Implementations must reject, atomically, a confirmed booking whose slot overlaps
+	// another confirmed booking of the same hustler, returning ErrSlotTaken.
+	SaveWithEvents(ctx context.Context, booking *aggregate.Booking) error
+
+	// FindByID retrieves a booking by ID
+	FindByID(ctx context.Context, id valueobject.BookingID) (*aggregate.Booking, error)
+
+	// FindActiveByHustler retrieves a hustler's confirmed bookings that overlap [from, to)
+	FindActiveByHustler(ctx context.Context, hustlerID valueobject.UserID, from, to time.Time) ([]*aggregate.Booking, error)
+
+	// FindByUser retrieves bookings where the user is the client or the hustler
+	FindByUser(ctx context.Context, userID valueobject.UserID, status *aggregate.BookingStatus, offset, limit int) ([]*aggregate.Booking, int64, error)
+}
+
+// ErrSlotTaken is returned by BookingRepository when a slot was booked concurrently
+var ErrSlotTaken = errors.New("slot is already booked")
+
+// ConversationRepository defines the interface for conversation persistence
+type ConversationRepository interface {
+	// SaveWithEvents persists a conversation and its new messages and publishes domain events
+	SaveWithEvents(ctx context.Context, conversation *aggregate.Conversation) error
+
+	// FindByID retrieves a conversation by ID
+	FindByID(ctx context.Context, id valueobject.ConversationID) (*aggregate.Conversation, error)
+
+	// FindByGigAndHustler retrieves the conversation between a gig's client and a hustler.
+	// Returns ErrConversationNotFound if they have not talked yet.
+	FindByGigAndHustler(ctx context.Context, gigID valueobject.GigID, hustlerID valueobject.UserID) (*aggregate.Conversation, error)
+
+	// FindByContractID retrieves the conversation linked to a contract.
+	// Returns ErrConversationNotFound if there is none.
+	FindByContractID(ctx context.Context, contractID valueobject.ContractID) (*aggregate.Conversation, error)
+
+	// FindByParticipant retrieves a user's conversations, most recently active first
+	FindByParticipant(ctx context.Context, userID valueobject.UserID, offset, limit int) ([]*aggregate.Conversation, int64, error)
+}
+
+// ErrConversationNotFound is returned by ConversationRepository lookups that find nothing
+var ErrConversationNotFound = errors.New("conversation not found")
+
 // ReviewRepository defines the interface for review persistence
 type ReviewRepository interface {
 	// Save persists a review
@@ -122,11 +252,49 @@ type ReviewRepository interface {
 	// FindByContractID retrieves reviews for a contract
 	FindByContractID(ctx context.Context, contractID valueobject.ContractID) ([]*aggregate.Review, error)
 
-	// FindByRevieweeID retrieves reviews for a user (reviewee)
+	// FindByRevieweeID retrieves the visible reviews of a user (reviewee)
 	FindByRevieweeID(ctx context.Context, userID valueobject.UserID, offset, limit int) ([]*ReviewDTO, int64, error)
 
-	// GetAverageRating gets the average rating for a user
+	// GetAverageRating gets the raw average rating for a user
 	GetAverageRating(ctx context.Context, userID valueobject.UserID) (float64, int, error)
+
+	// GetReviewerHistory counts the reviewer's completed contracts, and the reviews
+	// exchanged between reviewer and reviewee in either direction since the given time
+	GetReviewerHistory(ctx context.Context, reviewerID, revieweeID valueobject.UserID, since time.Time) (*ReviewerHistory, error)
+
+	// FindRatingSamples retrieves the reviews that count toward a user's rating
+	FindRatingSamples(ctx context.Context, revieweeID valueobject.UserID) ([]RatingSample, error)
+
+	// SaveRatingSummary stores a user's adjusted rating for profiles and search ranking
+	SaveRatingSummary(ctx context.Context, summary *aggregate.RatingSummary) error
+
+	// FindRatingSummary retrieves a user's stored rating summary
+	FindRatingSummary(ctx context.Context, userID valueobject.UserID) (*aggregate.RatingSummary, error)
+
+	// GetSkillTrackRecords counts a hustler's completed contracts and visible reviews
+	// per gig skill. Contracts on gigs without a skill are left out.
+	GetSkillTrackRecords(ctx context.Context, hustlerID valueobject.UserID) ([]SkillTrackRecord, error)
+}
+
+// SkillTrackRecord is a hustler's completed work under one skill
+type SkillTrackRecord struct {
+	SkillID            valueobject.SkillID
+	CompletedContracts int
+	ReviewCount        int
+	AverageRating      float64 // over the visible reviews
+}
+
+// ReviewerHistory is what the integrity checks know about a reviewer
+type ReviewerHistory struct {
+	CompletedContracts int // as either party
+	PairReviews        int // between reviewer and reviewee within the lookback
+}
+
+// RatingSample is one counted review's contribution to a rating
+type RatingSample struct {
+	Rating      int
+	Weight      float64
+	SubmittedAt time.Time
 }
 
 // ReviewDTO represents review data for API responses
@@ -142,6 +310,8 @@ type ReviewDTO struct {
 	CommunicationRating int
 	QualityRating       int
 	TimelinessRating    int
+	Response            string
+	RespondedAt         *time.Time
 	CreatedAt           time.Time
 }
 
@@ -150,6 +320,9 @@ type GigSearchRepository interface {
 	// Search performs full-text search on gigs
 	Search(ctx context.Context, query string, filter GigFilter) ([]*GigSearchResult, int64, error)
 
+	// Facets counts the gigs matching a search by category and budget band
+	Facets(ctx context.Context, query string, filter GigFilter) (*GigSearchFacets, error)
+
 	// IndexGig indexes a gig for search
 	IndexGig(ctx context.Context, gig *aggregate.Gig) error
 
@@ -163,13 +336,55 @@ type GigSearchResult struct {
 	Title       string
 	Description string
 	Category    string
+	Tags        []string
 	BudgetMin   int64
 	BudgetMax   int64
 	Currency    string
+	DeliveryDays int
 	IsRemote    bool
 	Location    string
+	DistanceKm  *float64 // set when the filter has a Near point
 	ClientName  string
 	Score       float64
+	CreatedAt   time.Time
+}
+
+// GigSearchFacets holds the number of matching gigs per category and budget band
+type GigSearchFacets struct {
+	Categories  []FacetCount
+	BudgetBands []FacetCount
+}
+
+// FacetCount is the number of matching gigs for one facet value
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// BudgetBand is a named range of a gig's maximum budget, in kobo
+type BudgetBand struct {
+	Key string
+	Min int64
+	Max int64 // 0 means no upper limit
+}
+
+// BudgetBands are the budget ranges search results are faceted by
+var BudgetBands = []BudgetBand{
+	{Key: "under_10k", Min: 0, Max: 1000000},
+	{Key: "10k_50k", Min: 1000000, Max: 5000000},
+	{Key: "50k_200k", Min: 5000000, Max: 20000000},
+	{Key: "200k_1m", Min: 20000000, Max: 100000000},
+	{Key: "over_1m", Min: 100000000, Max: 0},
+}
+
+// FindBudgetBand returns the budget band with the given key, or nil
+func FindBudgetBand(key string) *BudgetBand {
+	for i := range BudgetBands {
+		if BudgetBands[i].Key == key {
+			return &BudgetBands[i]
+		}
+	}
+	return nil
 }
 
 // GigStatisticsRepository defines the interface for gig statistics
@@ -194,6 +409,8 @@ type UserGigStats struct {
 	TotalEarnings        int64
 	AverageRating        float64
 	TotalReviews         int
+	DisputesRaised       int64
+	DisputesLost         int64 // ruled against in full, including by missed deadlines
 }
 
 // PlatformGigStats contains platform-wide statistics
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Booking policy errors
+var (
+	ErrInvalidBookingPolicy = errors.New("invalid booking policy")
+	ErrRescheduleLimit      = errors.New("booking has been rescheduled the maximum number of times")
+	ErrRescheduleTooLate    = errors.New("too close to the visit to reschedule")
+)
+
+// BookingPolicy sets the cancellation and rescheduling rules for on-site bookings,
+// and when both parties are reminded that a visit is coming up
+type BookingPolicy struct {
+	freeCancelWindow  time.Duration   // clients cancelling at least this far ahead pay nothing
+	lateCancelPercent float64         // share of the visit fee a later client cancellation costs
+	rescheduleNotice  time.Duration   // how far ahead a visit can still be moved
+	maxReschedules    int             // how many times one booking can be moved
+	reminders         []time.Duration // lead times before the visit, longest first
+}
+
+// NewBookingPolicy creates a booking policy. Each reminder is how long before the
+// visit it is sent.
+func NewBookingPolicy(
+	freeCancelWindow time.Duration,
+	lateCancelPercent float64,
+	rescheduleNotice time.Duration,
+	maxReschedules int,
+	reminders []time.Duration,
+) (*BookingPolicy, error) {
+	if freeCancelWindow < 0 || rescheduleNotice < 0 || maxReschedules < 0 {
+		return nil, ErrInvalidBookingPolicy
+	}
+	if lateCancelPercent < 0 || lateCancelPercent > 100 {
+		return nil, ErrInvalidBookingPolicy
+	}
+
+	sorted := make([]time.Duration, len(reminders))
+	copy(sorted, reminders)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	for _, lead := range sorted {
+		if lead <= 0 {
+			return nil, ErrInvalidBookingPolicy
+		}
+	}
+
+	return &BookingPolicy{
+		freeCancelWindow:  freeCancelWindow,
+		lateCancelPercent: lateCancelPercent,
+		rescheduleNotice:  rescheduleNotice,
+		maxReschedules:    maxReschedules,
+		reminders:         sorted,
+	}, nil
+}
+
+// DefaultBookingPolicy lets clients cancel free up to a day ahead and charges half
+// the visit fee after that. A visit can be moved twice, up to 12 hours before it,
+// and both parties are reminded a day and an hour ahead.
+func DefaultBookingPolicy() *BookingPolicy {
+	policy, _ := NewBookingPolicy(24*time.Hour, 50, 12*time.Hour, 2, []time.Duration{24 * time.Hour, time.Hour})
+	return policy
+}
+
+// CancellationFee returns what cancelling costs the party cancelling. Hustlers never
+// keep a fee for cancelling on a client, and clients cancel free inside the window.
+func (p *BookingPolicy) CancellationFee(booking *aggregate.Booking, cancelledBy valueobject.UserID, now time.Time) valueobject.Money {
+	zero := valueobject.Zero(booking.VisitFee().Currency())
+	if booking.HustlerID().Equals(cancelledBy) {
+		return zero
+	}
+	if booking.Slot().Start().Sub(now) >= p.freeCancelWindow {
+		return zero
+	}
+
+	fee, err := booking.VisitFee().Percentage(p.lateCancelPercent)
+	if err != nil {
+		return zero
+	}
+	return fee
+}
+
+// CheckReschedule returns an error if the booking can no longer be moved
+func (p *BookingPolicy) CheckReschedule(booking *aggregate.Booking, now time.Time) error {
+	if booking.RescheduleCount() >= p.maxReschedules {
+		return ErrRescheduleLimit
+	}
+	if booking.Slot().Start().Sub(now) < p.rescheduleNotice {
+		return ErrRescheduleTooLate
+	}
+	return nil
+}
+
+// ReminderTimes returns when the parties should be reminded about a booked visit
+func (p *BookingPolicy) ReminderTimes(booking *aggregate.Booking) []time.Time {
+	if !booking.IsActive() {
+		return nil
+	}
+
+	start := booking.Slot().Start()
+	times := make([]time.Time, len(p.reminders))
+	for i, lead := range p.reminders {
+		times[i] = start.Add(-lead)
+	}
+	return times
+}
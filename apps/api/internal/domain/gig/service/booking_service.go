@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Booking service errors
+var (
+	ErrNotTakingBookings = errors.New("hustler does not take on-site bookings")
+	ErrBookingNotFound   = errors.New("booking not found")
+)
+
+// BookingService books hustlers' time for on-site work, keeping each booking inside
+// the hustler's calendar and clear of their other visits
+type BookingService struct {
+	availabilityRepo repository.AvailabilityRepository
+	bookingRepo      repository.BookingRepository
+	contractRepo     repository.ContractRepository
+	escrowSvc        EscrowService
+	policy           *BookingPolicy
+}
+
+// NewBookingService creates a new booking service. A nil policy uses the default.
+func NewBookingService(
+	availabilityRepo repository.AvailabilityRepository,
+	bookingRepo repository.BookingRepository,
+	contractRepo repository.ContractRepository,
+	escrowSvc EscrowService,
+	policy *BookingPolicy,
+) *BookingService {
+	if policy == nil {
+		policy = DefaultBookingPolicy()
+	}
+	return &BookingService{
+		availabilityRepo: availabilityRepo,
+		bookingRepo:      bookingRepo,
+		contractRepo:     contractRepo,
+		escrowSvc:        escrowSvc,
+		policy:           policy,
+	}
+}
+
+// Policy returns the cancellation and reminder rules the service enforces
+func (s *BookingService) Policy() *BookingPolicy {
+	return s.policy
+}
+
+// BookVisitRequest contains the data needed to book an on-site visit
+type BookVisitRequest struct {
+	HustlerID  valueobject.UserID
+	ClientID   valueobject.UserID
+	ContractID *valueobject.ContractID // optional; must be between the same two parties
+	Slot       aggregate.TimeSlot
+	Location   valueobject.GeoPoint
+	Address    string
+	Notes      string
+}
+
+// BookVisit books a slot in the hustler's calendar at the hustler's visit fee,
+// holding the fee in the client's escrow until the visit is completed or cancelled
+func (s *BookingService) BookVisit(ctx context.Context, req BookVisitRequest) (*aggregate.Booking, error) {
+	availability, err := s.availabilityRepo.FindByHustlerID(ctx, req.HustlerID)
+	if err != nil {
+		return nil, ErrNotTakingBookings
+	}
+
+	if req.ContractID != nil {
+		contract, err := s.contractRepo.FindByID(ctx, *req.ContractID)
+		if err != nil {
+			return nil, ErrContractNotFound
+		}
+		if !contract.HustlerID().Equals(req.HustlerID) || !contract.ClientID().Equals(req.ClientID) {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	if err := availability.CheckLocation(req.Location); err != nil {
+		return nil, err
+	}
+	if err := s.checkSlot(ctx, availability, req.Slot, nil); err != nil {
+		return nil, err
+	}
+
+	booking, err := aggregate.NewBooking(
+		valueobject.GenerateBookingID(),
+		req.HustlerID,
+		req.ClientID,
+		req.ContractID,
+		req.Slot,
+		req.Location,
+		req.Address,
+		req.Notes,
+		availability.VisitFee(),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := booking.VisitFee()
+	if fee.IsPositive() {
+		if err := s.escrowSvc.HoldVisitFee(ctx, req.ClientID, booking.ID(), fee, "Visit fee for booking "+booking.ID().String()); err != nil {
+			return nil, ErrEscrowFailed
+		}
+	}
+
+	if err := s.bookingRepo.SaveWithEvents(ctx, booking); err != nil {
+		if fee.IsPositive() {
+			_ = s.escrowSvc.RefundVisitFee(ctx, req.ClientID, booking.ID(), fee, "Booking failed")
+		}
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// Reschedule moves a booking to another open slot in the hustler's calendar
+func (s *BookingService) Reschedule(ctx context.Context, bookingID valueobject.BookingID, userID valueobject.UserID, slot aggregate.TimeSlot) (*aggregate.Booking, error) {
+	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, ErrBookingNotFound
+	}
+	if !booking.IsParty(userID) {
+		return nil, aggregate.ErrNotBookingParty
+	}
+
+	now := time.Now().UTC()
+	if err := s.policy.CheckReschedule(booking, now); err != nil {
+		return nil, err
+	}
+
+	availability, err := s.availabilityRepo.FindByHustlerID(ctx, booking.HustlerID())
+	if err != nil {
+		return nil, ErrNotTakingBookings
+	}
+	if err := s.checkSlot(ctx, availability, slot, booking); err != nil {
+		return nil, err
+	}
+
+	if err := booking.Reschedule(userID, slot, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.bookingRepo.SaveWithEvents(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// Cancel cancels a booking, charging the client a fee if they cancel late. The fee
+// is paid to the hustler from escrow and the rest of the visit fee refunded.
+func (s *BookingService) Cancel(ctx context.Context, bookingID valueobject.BookingID, userID valueobject.UserID, reason string) (*aggregate.Booking, error) {
+	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	now := time.Now().UTC()
+	fee := s.policy.CancellationFee(booking, userID, now)
+	if err := booking.Cancel(userID, reason, fee, now); err != nil {
+		return nil, err
+	}
+
+	cancellation := booking.Cancellation()
+	if cancellation.Refund().IsPositive() {
+		err := s.escrowSvc.RefundVisitFee(ctx, booking.ClientID(), booking.ID(), cancellation.Refund(), "Booking cancelled: "+cancellation.Reason())
+		if err != nil {
+			return nil, ErrPaymentReleaseFailed
+		}
+	}
+	if cancellation.Fee().IsPositive() {
+		err := s.escrowSvc.ReleaseVisitFee(ctx, booking.ClientID(), booking.HustlerID(), booking.ID(), cancellation.Fee())
+		if err != nil {
+			// TODO: The refund has already gone through - log for manual review
+			return nil, ErrPaymentReleaseFailed
+		}
+	}
+
+	if err := s.bookingRepo.SaveWithEvents(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// Complete marks a visit done so its visit fee is paid to the hustler
+func (s *BookingService) Complete(ctx context.Context, bookingID valueobject.BookingID, userID valueobject.UserID) (*aggregate.Booking, error) {
+	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
+	if err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	if err := booking.Complete(userID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if booking.VisitFee().IsPositive() {
+		err := s.escrowSvc.ReleaseVisitFee(ctx, booking.ClientID(), booking.HustlerID(), booking.ID(), booking.VisitFee())
+		if err != nil {
+			return nil, ErrPaymentReleaseFailed
+		}
+	}
+
+	if err := s.bookingRepo.SaveWithEvents(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// OpenSlots lists the slots of the given length a client could book with the hustler
+func (s *BookingService) OpenSlots(ctx context.Context, hustlerID valueobject.UserID, from, to time.Time, length time.Duration) ([]aggregate.TimeSlot, error) {
+	availability, err := s.availabilityRepo.FindByHustlerID(ctx, hustlerID)
+	if err != nil {
+		return nil, ErrNotTakingBookings
+	}
+
+	now := time.Now().UTC()
+	if from.Before(now) {
+		from = now
+	}
+
+	buffer := availability.TravelBuffer()
+	bookings, err := s.bookingRepo.FindActiveByHustler(ctx, hustlerID, from.Add(-buffer), to.Add(buffer))
+	if err != nil {
+		return nil, err
+	}
+
+	booked := make([]aggregate.TimeSlot, len(bookings))
+	for i, b := range bookings {
+		booked[i] = b.Slot()
+	}
+
+	return availability.OpenSlots(from, to, length, booked)
+}
+
+// checkSlot makes sure a slot is within the hustler's calendar and leaves the travel
+// buffer clear around their other bookings. The booking being moved, if any, is ignored.
+func (s *BookingService) checkSlot(ctx context.Context, availability *aggregate.Availability, slot aggregate.TimeSlot, moving *aggregate.Booking) error {
+	if err := availability.CheckSlot(slot); err != nil {
+		return err
+	}
+
+	buffer := availability.TravelBuffer()
+	others, err := s.bookingRepo.FindActiveByHustler(ctx, availability.HustlerID(), slot.Start().Add(-buffer), slot.End().Add(buffer))
+	if err != nil {
+		return err
+	}
+
+	for _, other := range others {
+		if moving != nil && other.ID().Equals(moving.ID()) {
+			continue
+		}
+		if slot.Overlaps(other.Slot(), buffer) {
+			return repository.ErrSlotTaken
+		}
+	}
+
+	return nil
+}
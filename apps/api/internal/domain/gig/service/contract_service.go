@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"hustlex/internal/domain/gig/aggregate"
 	"hustlex/internal/domain/gig/repository"
@@ -12,6 +14,7 @@ import (
 // Domain errors
 var (
 	ErrGigNotFound         = errors.New("gig not found")
+	ErrPackageNotFound     = errors.New("service package not found")
 	ErrContractNotFound    = errors.New("contract not found")
 	ErrUnauthorized        = errors.New("unauthorized to perform this action")
 	ErrEscrowFailed        = errors.New("failed to hold funds in escrow")
@@ -29,33 +32,60 @@ type EscrowService interface {
 
 	// RefundFunds refunds escrowed funds to the original holder
 	RefundFunds(ctx context.Context, userID valueobject.UserID, contractID valueobject.ContractID, amount valueobject.Money, reason string) error
+
+	// HoldVisitFee holds a client's visit fee in escrow for an on-site booking
+	HoldVisitFee(ctx context.Context, clientID valueobject.UserID, bookingID valueobject.BookingID, amount valueobject.Money, description string) error
+
+	// ReleaseVisitFee pays an escrowed visit fee, or part of it, to the hustler
+	ReleaseVisitFee(ctx context.Context, clientID, hustlerID valueobject.UserID, bookingID valueobject.BookingID, amount valueobject.Money) error
+
+	// RefundVisitFee returns an escrowed visit fee, or part of it, to the client
+	RefundVisitFee(ctx context.Context, clientID valueobject.UserID, bookingID valueobject.BookingID, amount valueobject.Money, reason string) error
 }
 
 // ContractService handles contract-related domain operations
 type ContractService struct {
 	gigRepo      repository.GigRepository
+	packageRepo  repository.ServicePackageRepository
 	contractRepo repository.ContractRepository
 	escrowSvc    EscrowService
+	reviewPolicy *ReviewPolicy
 }
 
-// NewContractService creates a new contract service
+// NewContractService creates a new contract service. A nil review policy uses the default.
 func NewContractService(
 	gigRepo repository.GigRepository,
+	packageRepo repository.ServicePackageRepository,
 	contractRepo repository.ContractRepository,
 	escrowSvc EscrowService,
+	reviewPolicy *ReviewPolicy,
 ) *ContractService {
+	if reviewPolicy == nil {
+		reviewPolicy = DefaultReviewPolicy()
+	}
 	return &ContractService{
 		gigRepo:      gigRepo,
+		packageRepo:  packageRepo,
 		contractRepo: contractRepo,
 		escrowSvc:    escrowSvc,
+		reviewPolicy: reviewPolicy,
 	}
 }
 
+// ReviewPolicy returns the client review window the service enforces
+func (s *ContractService) ReviewPolicy() *ReviewPolicy {
+	return s.reviewPolicy
+}
+
 // AcceptProposalRequest contains the data needed to accept a proposal
 type AcceptProposalRequest struct {
 	GigID      valueobject.GigID
 	ProposalID valueobject.ProposalID
 	ClientID   valueobject.UserID // must be the gig owner
+
+	// Optional staged payments; the whole price is one milestone when empty
+	Milestones  []aggregate.MilestoneSpec
+	FundingMode aggregate.FundingMode // upfront (default) or just_in_time
 }
 
 // AcceptProposalResult contains the result of accepting a proposal
@@ -91,16 +121,19 @@ func (s *ContractService) AcceptProposal(ctx context.Context, req AcceptProposal
 		return nil, err
 	}
 
-	// Hold funds in escrow
-	err = s.escrowSvc.HoldFunds(
-		ctx,
-		req.ClientID,
-		contractID,
-		acceptedData.AgreedPrice,
-		"Escrow for gig: "+gig.Title(),
-	)
-	if err != nil {
-		return nil, ErrEscrowFailed
+	if len(req.Milestones) > 0 {
+		mode := req.FundingMode
+		if mode == "" {
+			mode = aggregate.FundingUpfront
+		}
+		if err := contract.PlanMilestones(req.Milestones, mode); err != nil {
+			return nil, err
+		}
+	}
+
+	// Hold funds in escrow for the milestones due now
+	if err := s.fundMilestones(ctx, contract, "gig: "+gig.Title(), contract.MilestonesDueFunding()); err != nil {
+		return nil, err
 	}
 
 	// Save gig with events
@@ -121,53 +154,274 @@ func (s *ContractService) AcceptProposal(ctx context.Context, req AcceptProposal
 	}, nil
 }
 
-// CompleteContractRequest contains data to complete a contract
+// OrderPackageRequest contains the data needed to buy a service package
+type OrderPackageRequest struct {
+	PackageID valueobject.PackageID
+	ClientID  valueobject.UserID
+	Tier      aggregate.PackageTier
+	AddOnIDs  []string
+}
+
+// OrderPackageResult contains the result of ordering a service package
+type OrderPackageResult struct {
+	Contract *aggregate.Contract
+	Package  *aggregate.ServicePackage
+}
+
+// OrderPackage buys a package tier and creates its contract straight away, holding
+// the full price in escrow. There is no proposal phase; the package sets the terms.
+func (s *ContractService) OrderPackage(ctx context.Context, req OrderPackageRequest) (*OrderPackageResult, error) {
+	pkg, err := s.packageRepo.FindByID(ctx, req.PackageID)
+	if err != nil {
+		return nil, ErrPackageNotFound
+	}
+
+	contractID := valueobject.GenerateContractID()
+	order, err := pkg.Order(req.ClientID, req.Tier, req.AddOnIDs, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := aggregate.NewPackageContract(order)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fundMilestones(ctx, contract, "package: "+pkg.Title(), contract.MilestonesDueFunding()); err != nil {
+		return nil, err
+	}
+
+	if err := s.packageRepo.SaveWithEvents(ctx, pkg); err != nil {
+		// TODO: Release escrow on failure (compensating transaction)
+		return nil, err
+	}
+
+	if err := s.contractRepo.SaveWithEvents(ctx, contract); err != nil {
+		// TODO: Release escrow (compensating transaction)
+		return nil, err
+	}
+
+	return &OrderPackageResult{
+		Contract: contract,
+		Package:  pkg,
+	}, nil
+}
+
+// CompleteContractRequest contains data to approve a delivered milestone
 type CompleteContractRequest struct {
-	ContractID valueobject.ContractID
-	ClientID   valueobject.UserID
-	Notes      string
+	ContractID  valueobject.ContractID
+	ClientID    valueobject.UserID
+	MilestoneID string // optional; the earliest delivered milestone when empty
+	Notes       string
+
+	// AutoApprove approves on the client's behalf once the review window has
+	// passed as of AsOf. ClientID is not checked.
+	AutoApprove bool
+	AsOf        time.Time
 }
 
-// CompleteContract approves delivery and releases payment
-func (s *ContractService) CompleteContract(ctx context.Context, req CompleteContractRequest) (*aggregate.Contract, error) {
+// CompleteContractResult contains the outcome of approving a milestone
+type CompleteContractResult struct {
+	Contract  *aggregate.Contract
+	Milestone *aggregate.Milestone
+}
+
+// CompleteContract approves a delivered milestone and releases its escrow. The approval
+// is saved first, so the release runs at most once per milestone.
+// The contract, and its gig, complete once every milestone has been released.
+// With AutoApprove it is how the review deadline job pays out work the client left unreviewed.
+func (s *ContractService) CompleteContract(ctx context.Context, req CompleteContractRequest) (*CompleteContractResult, error) {
 	// Load contract
 	contract, err := s.contractRepo.FindByID(ctx, req.ContractID)
 	if err != nil {
 		return nil, ErrContractNotFound
 	}
 
+	milestoneID := req.MilestoneID
+	if milestoneID == "" {
+		for _, m := range contract.Milestones() {
+			if m.Status() == aggregate.MilestoneDelivered {
+				milestoneID = m.ID()
+				break
+			}
+		}
+		if milestoneID == "" {
+			return nil, aggregate.ErrCannotApprove
+		}
+	}
+
 	// Approve work
-	if err := contract.Approve(req.ClientID, req.Notes); err != nil {
+	var milestone *aggregate.Milestone
+	if req.AutoApprove {
+		milestone, err = contract.AutoApproveMilestone(milestoneID, s.reviewPolicy.Window(), req.AsOf)
+	} else {
+		milestone, err = contract.ApproveMilestone(req.ClientID, milestoneID, req.Notes)
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	// Release payment from escrow
+	// Save the approval before any money moves. A retried approval then finds the
+	// milestone already released and stops, rather than releasing it again from the
+	// client's pooled escrow.
+	if err := s.contractRepo.SaveWithEvents(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	// Release the milestone's payment from escrow
 	err = s.escrowSvc.ReleaseFunds(
 		ctx,
 		contract.ClientID(),
 		contract.HustlerID(),
 		contract.ID(),
-		contract.AgreedPrice(),
-		contract.PlatformFee(),
+		milestone.Amount(),
+		milestone.PlatformFee(),
 	)
 	if err != nil {
+		// TODO: The approval is saved but the payment stays in escrow - log for manual review
 		return nil, ErrPaymentReleaseFailed
 	}
 
 	// Load and update gig status
-	gig, err := s.gigRepo.FindByID(ctx, contract.GigID())
-	if err == nil {
-		gig.MarkCompleted()
-		_ = s.gigRepo.SaveWithEvents(ctx, gig)
+	if contract.IsCompleted() && !contract.IsPackageOrder() {
+		gig, err := s.gigRepo.FindByID(ctx, contract.GigID())
+		if err == nil {
+			gig.MarkCompleted()
+			_ = s.gigRepo.SaveWithEvents(ctx, gig)
+		}
+	}
+
+	return &CompleteContractResult{
+		Contract:  contract,
+		Milestone: milestone,
+	}, nil
+}
+
+// AutoApproveOverdue approves every delivered milestone whose review window closed by
+// asOf. It backs up the per-delivery deadline task, so work is still paid if that task
+// was never queued.
+func (s *ContractService) AutoApproveOverdue(ctx context.Context, asOf time.Time) error {
+	contracts, err := s.contractRepo.FindWithDeliveriesAwaitingReview(ctx, asOf.Add(-s.reviewPolicy.Window()))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, contract := range contracts {
+		for _, m := range contract.Milestones() {
+			dueAt := s.reviewPolicy.ReviewDueAt(m)
+			if dueAt == nil || dueAt.After(asOf) {
+				continue
+			}
+
+			_, err := s.CompleteContract(ctx, CompleteContractRequest{
+				ContractID:  contract.ID(),
+				MilestoneID: m.ID(),
+				AutoApprove: true,
+				AsOf:        asOf,
+			})
+			switch {
+			case err == nil,
+				errors.Is(err, aggregate.ErrMilestoneNotDelivered),
+				errors.Is(err, aggregate.ErrCannotApprove),
+				errors.Is(err, aggregate.ErrReviewWindowOpen):
+			default:
+				errs = append(errs, fmt.Errorf("contract %s milestone %s: %w", contract.ID(), m.ID(), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// FundMilestoneRequest contains data to put a milestone in escrow
+type FundMilestoneRequest struct {
+	ContractID  valueobject.ContractID
+	ClientID    valueobject.UserID
+	MilestoneID string
+}
+
+// FundMilestone holds the client's money for the next milestone in escrow.
+// Just-in-time contracts use this as each stage of work is about to begin.
+func (s *ContractService) FundMilestone(ctx context.Context, req FundMilestoneRequest) (*aggregate.Milestone, error) {
+	contract, err := s.contractRepo.FindByID(ctx, req.ContractID)
+	if err != nil {
+		return nil, ErrContractNotFound
+	}
+
+	if !contract.ClientID().Equals(req.ClientID) {
+		return nil, ErrUnauthorized
+	}
+
+	milestone := contract.FindMilestone(req.MilestoneID)
+	if milestone == nil {
+		return nil, aggregate.ErrMilestoneNotFound
+	}
+
+	subject, err := s.escrowSubject(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fundMilestones(ctx, contract, subject, []*aggregate.Milestone{milestone}); err != nil {
+		return nil, err
 	}
 
-	// Save contract with events
 	if err := s.contractRepo.SaveWithEvents(ctx, contract); err != nil {
-		// TODO: Handle failed save (escrow already released - log for manual review)
+		// TODO: Refund the hold (compensating transaction)
 		return nil, err
 	}
 
-	return contract, nil
+	return milestone, nil
+}
+
+// escrowSubject names what a contract's escrow is for: the gig it was hired through or
+// the package that was ordered
+func (s *ContractService) escrowSubject(ctx context.Context, contract *aggregate.Contract) (string, error) {
+	if contract.IsPackageOrder() {
+		pkg, err := s.packageRepo.FindByID(ctx, *contract.PackageID())
+		if err != nil {
+			return "", ErrPackageNotFound
+		}
+		return "package: " + pkg.Title(), nil
+	}
+
+	gig, err := s.gigRepo.FindByID(ctx, contract.GigID())
+	if err != nil {
+		return "", ErrGigNotFound
+	}
+	return "gig: " + gig.Title(), nil
+}
+
+// fundMilestones holds each milestone's amount in escrow and records it on the contract.
+// Holds already taken are refunded if a later one fails.
+func (s *ContractService) fundMilestones(ctx context.Context, contract *aggregate.Contract, subject string, milestones []*aggregate.Milestone) error {
+	held := make([]*aggregate.Milestone, 0, len(milestones))
+	for _, m := range milestones {
+		if _, err := contract.FundMilestone(contract.ClientID(), m.ID()); err != nil {
+			s.refundHolds(ctx, contract, held)
+			return err
+		}
+
+		description := "Escrow for " + subject
+		if len(contract.Milestones()) > 1 {
+			description += " (" + m.Title() + ")"
+		}
+
+		err := s.escrowSvc.HoldFunds(ctx, contract.ClientID(), contract.ID(), m.Amount(), description)
+		if err != nil {
+			s.refundHolds(ctx, contract, held)
+			return ErrEscrowFailed
+		}
+		held = append(held, m)
+	}
+	return nil
+}
+
+func (s *ContractService) refundHolds(ctx context.Context, contract *aggregate.Contract, held []*aggregate.Milestone) {
+	for _, m := range held {
+		_ = s.escrowSvc.RefundFunds(ctx, contract.ClientID(), contract.ID(), m.Amount(), "Milestone funding failed")
+	}
 }
 
 // CancelContractRequest contains data to cancel a contract
@@ -184,16 +438,9 @@ func (s *ContractService) CancelContract(ctx context.Context, req CancelContract
 		return nil, ErrContractNotFound
 	}
 
-	// Calculate refund amount based on who cancelled and contract state
-	var refundAmount int64
-	if contract.IsActive() {
-		// Full refund if not yet delivered
-		refundAmount = contract.AgreedPrice().Amount()
-	} else if contract.IsDelivered() {
-		// Partial refund or dispute resolution needed
-		// For now, just mark as cancelled - admin will handle
-		refundAmount = 0
-	}
+	// Funded milestones not yet delivered are refunded straight away;
+	// delivered or disputed milestones stay in escrow for an admin to resolve
+	refundAmount := contract.RefundableEscrow().Amount()
 
 	// Cancel contract
 	if err := contract.Cancel(req.UserID, req.Reason, refundAmount); err != nil {
@@ -224,19 +471,30 @@ func (s *ContractService) CancelContract(ctx context.Context, req CancelContract
 type ReviewService struct {
 	contractRepo repository.ContractRepository
 	reviewRepo   repository.ReviewRepository
+	policy       *ReviewIntegrityPolicy
 }
 
-// NewReviewService creates a new review service
+// NewReviewService creates a new review service. A nil policy uses the default.
 func NewReviewService(
 	contractRepo repository.ContractRepository,
 	reviewRepo repository.ReviewRepository,
+	policy *ReviewIntegrityPolicy,
 ) *ReviewService {
+	if policy == nil {
+		policy = DefaultReviewIntegrityPolicy()
+	}
 	return &ReviewService{
 		contractRepo: contractRepo,
 		reviewRepo:   reviewRepo,
+		policy:       policy,
 	}
 }
 
+// Policy returns the reveal and rating rules the service enforces
+func (s *ReviewService) Policy() *ReviewIntegrityPolicy {
+	return s.policy
+}
+
 // SubmitReviewRequest contains data to submit a review
 type SubmitReviewRequest struct {
 	ContractID          valueobject.ContractID
@@ -277,8 +535,16 @@ func (s *ReviewService) SubmitReview(ctx context.Context, req SubmitReviewReques
 	// Set detailed ratings
 	review.SetDetailedRatings(req.CommunicationRating, req.QualityRating, req.TimelinessRating)
 
-	// Add review to contract
-	if err := contract.AddReview(review); err != nil {
+	// Weigh the review by the reviewer's track record and hold it if the pair keep rating each other
+	now := time.Now().UTC()
+	history, err := s.reviewRepo.GetReviewerHistory(ctx, req.ReviewerID, revieweeID, s.policy.CollusionSince(now))
+	if err != nil {
+		return nil, err
+	}
+	review.Assess(s.policy.Assess(contract, history))
+
+	// Add review to contract; it stays hidden until both parties have reviewed
+	if err := contract.AddReview(review, s.policy.BlindWindow(), now); err != nil {
 		return nil, err
 	}
 
@@ -289,3 +555,80 @@ func (s *ReviewService) SubmitReview(ctx context.Context, req SubmitReviewReques
 
 	return review, nil
 }
+
+// RespondToReview posts the reviewee's public response to a review
+func (s *ReviewService) RespondToReview(ctx context.Context, reviewID string, revieweeID valueobject.UserID, text string) error {
+	contract, err := s.contractRepo.FindByReviewID(ctx, reviewID)
+	if err != nil {
+		return aggregate.ErrReviewNotFound
+	}
+
+	if err := contract.RespondToReview(revieweeID, reviewID, text, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return s.contractRepo.SaveWithEvents(ctx, contract)
+}
+
+// FlagReview reports a review to the moderation queue
+func (s *ReviewService) FlagReview(ctx context.Context, reviewID string, reporterID valueobject.UserID, note string) error {
+	contract, err := s.contractRepo.FindByReviewID(ctx, reviewID)
+	if err != nil {
+		return aggregate.ErrReviewNotFound
+	}
+
+	if err := contract.FlagReview(reviewID, reporterID, note, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return s.contractRepo.SaveWithEvents(ctx, contract)
+}
+
+// ModerateReview records a moderator's decision on a queued review
+func (s *ReviewService) ModerateReview(ctx context.Context, reviewID string, moderatorID valueobject.UserID, decision aggregate.ReviewModerationDecision, note string) error {
+	contract, err := s.contractRepo.FindByReviewID(ctx, reviewID)
+	if err != nil {
+		return aggregate.ErrReviewNotFound
+	}
+
+	if err := contract.ModerateReview(reviewID, moderatorID, decision, note, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return s.contractRepo.SaveWithEvents(ctx, contract)
+}
+
+// RevealDueReviews reveals the reviews on every contract whose review window has closed
+func (s *ReviewService) RevealDueReviews(ctx context.Context, asOf time.Time) error {
+	contracts, err := s.contractRepo.FindWithUnrevealedReviews(ctx, asOf.Add(-s.policy.BlindWindow()))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, contract := range contracts {
+		if !contract.RevealReviews(s.policy.BlindWindow(), asOf) {
+			continue
+		}
+		if err := s.contractRepo.SaveWithEvents(ctx, contract); err != nil {
+			errs = append(errs, fmt.Errorf("contract %s: %w", contract.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// RefreshRatingSummary recomputes and stores a user's adjusted rating
+func (s *ReviewService) RefreshRatingSummary(ctx context.Context, userID valueobject.UserID) (*aggregate.RatingSummary, error) {
+	samples, err := s.reviewRepo.FindRatingSamples(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := s.policy.Summarize(userID, samples, time.Now().UTC())
+	if err := s.reviewRepo.SaveRatingSummary(ctx, summary); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// ErrDisputeNotFound is returned when a dispute does not exist
+var ErrDisputeNotFound = errors.New("dispute not found")
+
+// DisputeService opens disputes over contract escrow and executes their outcomes
+type DisputeService struct {
+	gigRepo      repository.GigRepository
+	contractRepo repository.ContractRepository
+	disputeRepo  repository.DisputeRepository
+	escrowSvc    EscrowService
+}
+
+// NewDisputeService creates a new dispute service
+func NewDisputeService(
+	gigRepo repository.GigRepository,
+	contractRepo repository.ContractRepository,
+	disputeRepo repository.DisputeRepository,
+	escrowSvc EscrowService,
+) *DisputeService {
+	return &DisputeService{
+		gigRepo:      gigRepo,
+		contractRepo: contractRepo,
+		disputeRepo:  disputeRepo,
+		escrowSvc:    escrowSvc,
+	}
+}
+
+// OpenDisputeRequest contains the data needed to open a dispute
+type OpenDisputeRequest struct {
+	ContractID  valueobject.ContractID
+	MilestoneID string // optional; the whole contract is disputed when empty
+	UserID      valueobject.UserID
+	Reason      string
+
+	// Optional opening evidence
+	Statement   string
+	Attachments []string
+}
+
+// OpenDispute freezes the disputed escrow on the contract and opens a dispute over it.
+// Disputing the whole contract also marks its gig as disputed.
+func (s *DisputeService) OpenDispute(ctx context.Context, req OpenDisputeRequest) (*aggregate.Dispute, error) {
+	contract, err := s.contractRepo.FindByID(ctx, req.ContractID)
+	if err != nil {
+		return nil, ErrContractNotFound
+	}
+
+	var milestones []*aggregate.Milestone
+	if req.MilestoneID != "" {
+		milestone, err := contract.DisputeMilestone(req.UserID, req.MilestoneID, req.Reason)
+		if err != nil {
+			return nil, err
+		}
+		milestones = []*aggregate.Milestone{milestone}
+	} else {
+		milestones, err = contract.Dispute(req.UserID, req.Reason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dispute, err := aggregate.OpenDispute(valueobject.GenerateDisputeID(), contract, milestones, req.UserID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Statement != "" || len(req.Attachments) > 0 {
+		evidenceID := valueobject.GenerateEvidenceID().String()
+		if _, err := dispute.SubmitEvidence(evidenceID, req.UserID, req.Statement, req.Attachments); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.contractRepo.SaveWithEvents(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	if err := s.disputeRepo.SaveWithEvents(ctx, dispute); err != nil {
+		// TODO: Unfreeze the contract's milestones (compensating transaction)
+		return nil, err
+	}
+
+	if req.MilestoneID == "" && !contract.IsPackageOrder() {
+		gig, err := s.gigRepo.FindByID(ctx, contract.GigID())
+		if err == nil {
+			gig.MarkDisputed()
+			_ = s.gigRepo.SaveWithEvents(ctx, gig)
+		}
+	}
+
+	return dispute, nil
+}
+
+// ResolveDisputeRequest contains a mediator's ruling
+type ResolveDisputeRequest struct {
+	DisputeID     valueobject.DisputeID
+	MediatorID    valueobject.UserID
+	Outcome       aggregate.DisputeOutcome
+	ClientPercent int // share refunded to the client, for a split
+	Notes         string
+}
+
+// ResolveDispute records the mediator's ruling and executes it against escrow
+func (s *DisputeService) ResolveDispute(ctx context.Context, req ResolveDisputeRequest) (*aggregate.Dispute, error) {
+	dispute, err := s.disputeRepo.FindByID(ctx, req.DisputeID)
+	if err != nil {
+		return nil, ErrDisputeNotFound
+	}
+
+	if err := dispute.Resolve(req.MediatorID, req.Outcome, req.ClientPercent, req.Notes); err != nil {
+		return nil, err
+	}
+
+	if err := s.settle(ctx, dispute); err != nil {
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+// ResolveOverdueDisputes decides every dispute whose response deadline has passed
+// in favour of the party that did respond
+func (s *DisputeService) ResolveOverdueDisputes(ctx context.Context, asOf time.Time) error {
+	disputes, err := s.disputeRepo.FindResponseOverdue(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, dispute := range disputes {
+		if err := dispute.ResolveByDefault(asOf); err != nil {
+			if !errors.Is(err, aggregate.ErrResponseDeadlineNotDue) {
+				errs = append(errs, fmt.Errorf("dispute %s: %w", dispute.ID(), err))
+			}
+			continue
+		}
+
+		if err := s.settle(ctx, dispute); err != nil {
+			errs = append(errs, fmt.Errorf("dispute %s: %w", dispute.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// settle applies a resolved dispute to its contract and moves the escrow:
+// the client's share is refunded, the hustler's share released less its platform fee
+func (s *DisputeService) settle(ctx context.Context, dispute *aggregate.Dispute) error {
+	contract, err := s.contractRepo.FindByID(ctx, dispute.ContractID())
+	if err != nil {
+		return ErrContractNotFound
+	}
+
+	if err := contract.SettleDispute(dispute); err != nil {
+		return err
+	}
+
+	resolution := dispute.Resolution()
+	if resolution.Refund().IsPositive() {
+		err := s.escrowSvc.RefundFunds(
+			ctx,
+			dispute.ClientID(),
+			dispute.ContractID(),
+			resolution.Refund(),
+			"Dispute resolved: "+string(resolution.Outcome()),
+		)
+		if err != nil {
+			return ErrPaymentReleaseFailed
+		}
+	}
+
+	if resolution.Release().IsPositive() {
+		err := s.escrowSvc.ReleaseFunds(
+			ctx,
+			dispute.ClientID(),
+			dispute.HustlerID(),
+			dispute.ContractID(),
+			resolution.Release(),
+			resolution.ReleaseFee(),
+		)
+		if err != nil {
+			// TODO: A split's refund has already gone through - log for manual review
+			return ErrPaymentReleaseFailed
+		}
+	}
+
+	if (contract.IsCompleted() || contract.Status() == aggregate.ContractStatusCancelled) && !contract.IsPackageOrder() {
+		gig, err := s.gigRepo.FindByID(ctx, contract.GigID())
+		if err == nil {
+			if contract.IsCompleted() {
+				gig.MarkCompleted()
+			} else {
+				gig.MarkCancelled()
+			}
+			_ = s.gigRepo.SaveWithEvents(ctx, gig)
+		}
+	}
+
+	if err := s.contractRepo.SaveWithEvents(ctx, contract); err != nil {
+		// TODO: Handle failed save (escrow already moved - log for manual review)
+		return err
+	}
+
+	return s.disputeRepo.SaveWithEvents(ctx, dispute)
+}
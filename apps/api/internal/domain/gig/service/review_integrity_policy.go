@@ -0,0 +1,130 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// ErrInvalidReviewIntegrityPolicy is returned when rating or reveal settings don't make sense
+var ErrInvalidReviewIntegrityPolicy = errors.New("invalid review integrity policy")
+
+const (
+	// CollusionLookback is how far back reviews between the same two users are counted
+	CollusionLookback = 90 * 24 * time.Hour
+	// CollusionPairReviews is how many reviews a pair can exchange within the
+	// lookback before another one is held for moderation
+	CollusionPairReviews = 4
+)
+
+// Contracts below these amounts (NGN, in kobo) count for less, since they are cheap to fake
+var (
+	lowValueContract = valueobject.MustNewMoney(500000, valueobject.NGN)  // ₦5,000
+	midValueContract = valueobject.MustNewMoney(2000000, valueobject.NGN) // ₦20,000
+)
+
+// ReviewIntegrityPolicy decides how long reviews stay hidden, how much each one
+// counts, and how a user's reviews combine into the rating everyone else sees
+type ReviewIntegrityPolicy struct {
+	blindWindow time.Duration // reviews stay hidden until both are in or this passes after completion
+	priorMean   float64       // rating every user starts from
+	priorWeight float64       // how many full-weight reviews the prior is worth
+	staleAfter  time.Duration // older reviews count for half
+}
+
+// NewReviewIntegrityPolicy creates a review integrity policy
+func NewReviewIntegrityPolicy(blindWindow time.Duration, priorMean, priorWeight float64, staleAfter time.Duration) (*ReviewIntegrityPolicy, error) {
+	if blindWindow <= 0 || staleAfter <= 0 {
+		return nil, ErrInvalidReviewIntegrityPolicy
+	}
+	if priorMean < 1 || priorMean > 5 || priorWeight < 0 {
+		return nil, ErrInvalidReviewIntegrityPolicy
+	}
+
+	return &ReviewIntegrityPolicy{
+		blindWindow: blindWindow,
+		priorMean:   priorMean,
+		priorWeight: priorWeight,
+		staleAfter:  staleAfter,
+	}, nil
+}
+
+// DefaultReviewIntegrityPolicy keeps reviews hidden for up to 14 days, starts everyone
+// at 4 stars worth five reviews, and halves the weight of reviews over a year old
+func DefaultReviewIntegrityPolicy() *ReviewIntegrityPolicy {
+	policy, _ := NewReviewIntegrityPolicy(14*24*time.Hour, 4.0, 5, 365*24*time.Hour)
+	return policy
+}
+
+// BlindWindow returns how long after completion the parties have to review
+func (p *ReviewIntegrityPolicy) BlindWindow() time.Duration {
+	return p.blindWindow
+}
+
+// CollusionSince returns the start of the lookback for reviews between a pair
+func (p *ReviewIntegrityPolicy) CollusionSince(now time.Time) time.Time {
+	return now.Add(-CollusionLookback)
+}
+
+// Assess weighs a review by the contract's value and the reviewer's track record,
+// and holds it if the pair keep reviewing each other
+func (p *ReviewIntegrityPolicy) Assess(contract *aggregate.Contract, history *repository.ReviewerHistory) aggregate.ReviewAssessment {
+	weight := valueWeight(contract.AgreedPrice())
+
+	switch {
+	case history.CompletedContracts < 2:
+		weight *= 0.5
+	case history.CompletedContracts < 5:
+		weight *= 0.75
+	}
+
+	return aggregate.ReviewAssessment{
+		Weight:             weight,
+		SuspectedCollusion: history.PairReviews >= CollusionPairReviews,
+	}
+}
+
+// Summarize combines a user's counted reviews into a Bayesian-adjusted rating
+func (p *ReviewIntegrityPolicy) Summarize(userID valueobject.UserID, samples []repository.RatingSample, now time.Time) *aggregate.RatingSummary {
+	summary := &aggregate.RatingSummary{UserID: userID, UpdatedAt: now}
+
+	var sum, weightedSum float64
+	for _, s := range samples {
+		weight := s.Weight
+		if now.Sub(s.SubmittedAt) > p.staleAfter {
+			weight *= 0.5
+			summary.StaleCount++
+		}
+
+		sum += float64(s.Rating)
+		weightedSum += weight * float64(s.Rating)
+		summary.WeightedCount += weight
+		summary.Count++
+	}
+
+	if summary.Count > 0 {
+		summary.Average = sum / float64(summary.Count)
+	}
+	if summary.WeightedCount+p.priorWeight > 0 {
+		summary.Adjusted = (p.priorWeight*p.priorMean + weightedSum) / (p.priorWeight + summary.WeightedCount)
+	}
+
+	return summary
+}
+
+// valueWeight discounts small contracts. Contracts outside NGN are not discounted.
+func valueWeight(price valueobject.Money) float64 {
+	if price.Currency() != lowValueContract.Currency() {
+		return 1
+	}
+	switch {
+	case price.LessThan(lowValueContract):
+		return 0.4
+	case price.LessThan(midValueContract):
+		return 0.7
+	}
+	return 1
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+)
+
+// ErrInvalidReviewPolicy is returned when a review window or its reminders don't make sense
+var ErrInvalidReviewPolicy = errors.New("review reminders must fall inside a positive review window")
+
+// ReviewPolicy sets how long a client has to review delivered work before it is
+// approved for them, and when both parties are reminded that the deadline is coming
+type ReviewPolicy struct {
+	window    time.Duration
+	reminders []time.Duration // lead times before the deadline, longest first
+}
+
+// NewReviewPolicy creates a review policy. Each reminder is how long before the
+// deadline it is sent, and must fall inside the window.
+func NewReviewPolicy(window time.Duration, reminders []time.Duration) (*ReviewPolicy, error) {
+	if window <= 0 {
+		return nil, ErrInvalidReviewPolicy
+	}
+
+	sorted := make([]time.Duration, len(reminders))
+	copy(sorted, reminders)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	for _, lead := range sorted {
+		if lead <= 0 || lead >= window {
+			return nil, ErrInvalidReviewPolicy
+		}
+	}
+
+	return &ReviewPolicy{window: window, reminders: sorted}, nil
+}
+
+// DefaultReviewPolicy gives clients three days to review, with reminders a day
+// and two hours before the work is approved for them
+func DefaultReviewPolicy() *ReviewPolicy {
+	policy, _ := NewReviewPolicy(72*time.Hour, []time.Duration{24 * time.Hour, 2 * time.Hour})
+	return policy
+}
+
+// Window returns how long the client has to review a delivery
+func (p *ReviewPolicy) Window() time.Duration {
+	return p.window
+}
+
+// ReviewDueAt returns when a delivered milestone will be approved automatically,
+// or nil if it is not awaiting review
+func (p *ReviewPolicy) ReviewDueAt(milestone *aggregate.Milestone) *time.Time {
+	return milestone.ReviewDueAt(p.window)
+}
+
+// ReminderTimes returns when the parties should be reminded about a delivered
+// milestone's review deadline
+func (p *ReviewPolicy) ReminderTimes(milestone *aggregate.Milestone) []time.Time {
+	dueAt := p.ReviewDueAt(milestone)
+	if dueAt == nil {
+		return nil
+	}
+
+	times := make([]time.Time, len(p.reminders))
+	for i, lead := range p.reminders {
+		times[i] = dueAt.Add(-lead)
+	}
+	return times
+}
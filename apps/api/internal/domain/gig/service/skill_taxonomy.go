@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// SkillTaxonomy resolves skills against the platform's skill tree, so gigs, packages,
+// search and recommendations agree on what a skill covers. Both methods fail for
+// skills that are not in the catalog or have been retired.
+// This is a PORT - identity bounded context provides the ADAPTER (SkillTaxonomyService)
+type SkillTaxonomy interface {
+	// SkillCategory returns the top-level category a skill is filed under
+	SkillCategory(ctx context.Context, skillID valueobject.SkillID) (string, error)
+
+	// ExpandSkill returns the skill and every skill beneath it
+	ExpandSkill(ctx context.Context, skillID valueobject.SkillID) ([]valueobject.SkillID, error)
+}
@@ -0,0 +1,446 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/identity/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Hustler profile errors
+var (
+	ErrPortfolioFull              = errors.New("portfolio is full")
+	ErrPortfolioItemNotFound      = errors.New("portfolio item not found")
+	ErrPortfolioTitleRequired     = errors.New("portfolio item title is required")
+	ErrTooManyPortfolioMedia      = errors.New("too many media files on portfolio item")
+	ErrDuplicateContractLink      = errors.New("contract is already linked to this portfolio item")
+	ErrCannotEndorseSelf          = errors.New("cannot endorse your own skill")
+	ErrAlreadyEndorsed            = errors.New("you have already endorsed this skill")
+	ErrCertificateTitleRequired   = errors.New("certificate title and document are required")
+	ErrCertificateNotFound        = errors.New("certificate not found")
+	ErrCertificateAlreadyReviewed = errors.New("certificate has already been reviewed")
+	ErrCannotReviewOwnCertificate = errors.New("cannot review your own certificate")
+)
+
+const (
+	MaxPortfolioItems = 30 // items on one profile
+	MaxPortfolioMedia = 10 // media files on one item
+
+	// VerifiedSkillContracts and VerifiedSkillRating earn the verified badge without a certificate
+	VerifiedSkillContracts = 3
+	VerifiedSkillRating    = 4.0
+
+	// TopRatedSkillContracts and TopRatedSkillRating earn the top rated badge
+	TopRatedSkillContracts = 10
+	TopRatedSkillRating    = 4.5
+)
+
+// SkillBadge is the verification a hustler has earned for a skill
+type SkillBadge string
+
+const (
+	SkillBadgeNone     SkillBadge = ""
+	SkillBadgeVerified SkillBadge = "verified"  // an approved certificate or a solid track record
+	SkillBadgeTopRated SkillBadge = "top_rated" // a long, highly rated track record
+)
+
+// CertificateStatus is where a certificate stands with the admins
+type CertificateStatus string
+
+const (
+	CertificatePending  CertificateStatus = "pending"
+	CertificateApproved CertificateStatus = "approved"
+	CertificateRejected CertificateStatus = "rejected"
+)
+
+// PortfolioItem is a piece of work a hustler shows on their profile
+type PortfolioItem struct {
+	ID          string
+	Title       string
+	Description string
+	MediaRefs   []string
+	SkillID     *valueobject.SkillID
+	ContractIDs []valueobject.ContractID // completed contracts the work came from
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SkillEndorsement is a past client vouching for a skill
+type SkillEndorsement struct {
+	EndorserID valueobject.UserID
+	ContractID valueobject.ContractID // the completed contract they hired the hustler on
+	Note       string
+	EndorsedAt time.Time
+}
+
+// SkillCertificate is a qualification a hustler submitted for an admin to check
+type SkillCertificate struct {
+	ID          string
+	Title       string
+	Issuer      string
+	DocumentRef string
+	Status      CertificateStatus
+	ReviewedBy  *valueobject.UserID
+	ReviewNote  string
+	SubmittedAt time.Time
+	ReviewedAt  *time.Time
+}
+
+// SkillTrackRecord summarises the completed gig work behind a skill
+type SkillTrackRecord struct {
+	CompletedContracts int
+	AverageRating      float64 // over the visible reviews on those contracts
+}
+
+// SkillCredential is everything backing one of a hustler's skill claims
+type SkillCredential struct {
+	SkillID      valueobject.SkillID
+	Endorsements []SkillEndorsement
+	Certificates []SkillCertificate
+	TrackRecord  SkillTrackRecord
+	Badge        SkillBadge
+	BadgeSince   *time.Time
+}
+
+// HasApprovedCertificate returns true if an admin has approved a certificate for the skill
+func (c *SkillCredential) HasApprovedCertificate() bool {
+	for _, cert := range c.Certificates {
+		if cert.Status == CertificateApproved {
+			return true
+		}
+	}
+	return false
+}
+
+// earnedBadge derives the badge from the track record and certificates
+func (c *SkillCredential) earnedBadge() SkillBadge {
+	record := c.TrackRecord
+	switch {
+	case record.CompletedContracts >= TopRatedSkillContracts && record.AverageRating >= TopRatedSkillRating:
+		return SkillBadgeTopRated
+	case record.CompletedContracts >= VerifiedSkillContracts && record.AverageRating >= VerifiedSkillRating:
+		return SkillBadgeVerified
+	case c.HasApprovedCertificate():
+		return SkillBadgeVerified
+	}
+	return SkillBadgeNone
+}
+
+// HustlerProfile is the aggregate root for the evidence behind a hustler's skills:
+// their portfolio, endorsements from past clients, certificates and earned badges
+type HustlerProfile struct {
+	sharedevent.AggregateRoot
+
+	userID      valueobject.UserID
+	portfolio   []PortfolioItem
+	credentials []*SkillCredential
+
+	createdAt time.Time
+	updatedAt time.Time
+	version   int64
+}
+
+// NewHustlerProfile creates an empty profile for a user
+func NewHustlerProfile(userID valueobject.UserID, now time.Time) *HustlerProfile {
+	return &HustlerProfile{
+		userID:      userID,
+		portfolio:   make([]PortfolioItem, 0),
+		credentials: make([]*SkillCredential, 0),
+		createdAt:   now,
+		updatedAt:   now,
+		version:     1,
+	}
+}
+
+// ReconstructHustlerProfile reconstructs a profile from persistence (no events emitted)
+func ReconstructHustlerProfile(
+	userID valueobject.UserID,
+	portfolio []PortfolioItem,
+	credentials []*SkillCredential,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int64,
+) *HustlerProfile {
+	return &HustlerProfile{
+		userID:      userID,
+		portfolio:   portfolio,
+		credentials: credentials,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		version:     version,
+	}
+}
+
+// Getters
+
+func (p *HustlerProfile) UserID() valueobject.UserID      { return p.userID }
+func (p *HustlerProfile) Portfolio() []PortfolioItem      { return p.portfolio }
+func (p *HustlerProfile) Credentials() []*SkillCredential { return p.credentials }
+func (p *HustlerProfile) CreatedAt() time.Time            { return p.createdAt }
+func (p *HustlerProfile) UpdatedAt() time.Time            { return p.updatedAt }
+func (p *HustlerProfile) Version() int64                  { return p.version }
+
+// Credential returns what backs a skill, or nil if nothing does yet
+func (p *HustlerProfile) Credential(skillID valueobject.SkillID) *SkillCredential {
+	for _, c := range p.credentials {
+		if c.SkillID.Equals(skillID) {
+			return c
+		}
+	}
+	return nil
+}
+
+// BadgeFor returns the badge earned for a skill
+func (p *HustlerProfile) BadgeFor(skillID valueobject.SkillID) SkillBadge {
+	if c := p.Credential(skillID); c != nil {
+		return c.Badge
+	}
+	return SkillBadgeNone
+}
+
+func (p *HustlerProfile) credential(skillID valueobject.SkillID) *SkillCredential {
+	if c := p.Credential(skillID); c != nil {
+		return c
+	}
+	c := &SkillCredential{SkillID: skillID}
+	p.credentials = append(p.credentials, c)
+	return c
+}
+
+// AddPortfolioItem adds a piece of work to the portfolio. Linked contracts must be
+// checked as completed by this hustler before they are passed in.
+func (p *HustlerProfile) AddPortfolioItem(
+	title, description string,
+	mediaRefs []string,
+	skillID *valueobject.SkillID,
+	contractIDs []valueobject.ContractID,
+	now time.Time,
+) (*PortfolioItem, error) {
+	if len(p.portfolio) >= MaxPortfolioItems {
+		return nil, ErrPortfolioFull
+	}
+
+	item := PortfolioItem{
+		ID:        valueobject.GeneratePortfolioItemID().String(),
+		CreatedAt: now,
+	}
+	if err := item.update(title, description, mediaRefs, skillID, contractIDs, now); err != nil {
+		return nil, err
+	}
+
+	p.portfolio = append(p.portfolio, item)
+	p.updatedAt = now
+
+	p.RecordEvent(event.NewPortfolioItemAdded(p.userID.String(), item.ID, item.Title, contractIDStrings(item.ContractIDs)))
+
+	return &p.portfolio[len(p.portfolio)-1], nil
+}
+
+// UpdatePortfolioItem replaces the details of a portfolio item
+func (p *HustlerProfile) UpdatePortfolioItem(
+	itemID, title, description string,
+	mediaRefs []string,
+	skillID *valueobject.SkillID,
+	contractIDs []valueobject.ContractID,
+	now time.Time,
+) error {
+	for i := range p.portfolio {
+		if p.portfolio[i].ID != itemID {
+			continue
+		}
+		if err := p.portfolio[i].update(title, description, mediaRefs, skillID, contractIDs, now); err != nil {
+			return err
+		}
+		p.updatedAt = now
+		return nil
+	}
+	return ErrPortfolioItemNotFound
+}
+
+// RemovePortfolioItem takes a piece of work off the portfolio
+func (p *HustlerProfile) RemovePortfolioItem(itemID string, now time.Time) error {
+	for i := range p.portfolio {
+		if p.portfolio[i].ID != itemID {
+			continue
+		}
+		p.portfolio = append(p.portfolio[:i], p.portfolio[i+1:]...)
+		p.updatedAt = now
+
+		p.RecordEvent(event.NewPortfolioItemRemoved(p.userID.String(), itemID))
+		return nil
+	}
+	return ErrPortfolioItemNotFound
+}
+
+func (item *PortfolioItem) update(
+	title, description string,
+	mediaRefs []string,
+	skillID *valueobject.SkillID,
+	contractIDs []valueobject.ContractID,
+	now time.Time,
+) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return ErrPortfolioTitleRequired
+	}
+	if len(mediaRefs) > MaxPortfolioMedia {
+		return ErrTooManyPortfolioMedia
+	}
+	for i, id := range contractIDs {
+		for _, other := range contractIDs[:i] {
+			if id.Equals(other) {
+				return ErrDuplicateContractLink
+			}
+		}
+	}
+
+	item.Title = title
+	item.Description = strings.TrimSpace(description)
+	item.MediaRefs = mediaRefs
+	item.SkillID = skillID
+	item.ContractIDs = contractIDs
+	item.UpdatedAt = now
+	return nil
+}
+
+// Endorse records a past client's endorsement of a skill. The contract must be
+// checked as completed between the endorser and this hustler before it is passed in.
+func (p *HustlerProfile) Endorse(
+	skillID valueobject.SkillID,
+	endorserID valueobject.UserID,
+	contractID valueobject.ContractID,
+	note string,
+	now time.Time,
+) error {
+	if endorserID.Equals(p.userID) {
+		return ErrCannotEndorseSelf
+	}
+
+	c := p.credential(skillID)
+	for _, e := range c.Endorsements {
+		if e.EndorserID.Equals(endorserID) {
+			return ErrAlreadyEndorsed
+		}
+	}
+
+	c.Endorsements = append(c.Endorsements, SkillEndorsement{
+		EndorserID: endorserID,
+		ContractID: contractID,
+		Note:       strings.TrimSpace(note),
+		EndorsedAt: now,
+	})
+	p.updatedAt = now
+
+	p.RecordEvent(event.NewSkillEndorsed(p.userID.String(), skillID.String(), endorserID.String(), contractID.String()))
+
+	return nil
+}
+
+// SubmitCertificate adds a certificate for an admin to review
+func (p *HustlerProfile) SubmitCertificate(
+	skillID valueobject.SkillID,
+	title, issuer, documentRef string,
+	now time.Time,
+) (*SkillCertificate, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || documentRef == "" {
+		return nil, ErrCertificateTitleRequired
+	}
+
+	c := p.credential(skillID)
+	c.Certificates = append(c.Certificates, SkillCertificate{
+		ID:          valueobject.GenerateCertificateID().String(),
+		Title:       title,
+		Issuer:      strings.TrimSpace(issuer),
+		DocumentRef: documentRef,
+		Status:      CertificatePending,
+		SubmittedAt: now,
+	})
+	p.updatedAt = now
+
+	cert := &c.Certificates[len(c.Certificates)-1]
+	p.RecordEvent(event.NewSkillCertificateSubmitted(p.userID.String(), skillID.String(), cert.ID))
+
+	return cert, nil
+}
+
+// ReviewCertificate records an admin's decision on a pending certificate.
+// Approving one can earn the skill its verified badge.
+func (p *HustlerProfile) ReviewCertificate(
+	certificateID string,
+	reviewerID valueobject.UserID,
+	approve bool,
+	note string,
+	now time.Time,
+) error {
+	if reviewerID.Equals(p.userID) {
+		return ErrCannotReviewOwnCertificate
+	}
+
+	for _, c := range p.credentials {
+		for i := range c.Certificates {
+			cert := &c.Certificates[i]
+			if cert.ID != certificateID {
+				continue
+			}
+			if cert.Status != CertificatePending {
+				return ErrCertificateAlreadyReviewed
+			}
+
+			cert.Status = CertificateRejected
+			if approve {
+				cert.Status = CertificateApproved
+			}
+			cert.ReviewedBy = &reviewerID
+			cert.ReviewNote = strings.TrimSpace(note)
+			cert.ReviewedAt = &now
+			p.updatedAt = now
+
+			p.RecordEvent(event.NewSkillCertificateReviewed(
+				p.userID.String(), c.SkillID.String(), certificateID, reviewerID.String(), approve,
+			))
+			p.refreshBadge(c, now)
+			return nil
+		}
+	}
+	return ErrCertificateNotFound
+}
+
+// ApplyTrackRecord updates a skill's completed work and rating, and the badge they earn
+func (p *HustlerProfile) ApplyTrackRecord(skillID valueobject.SkillID, record SkillTrackRecord, now time.Time) {
+	c := p.credential(skillID)
+	if c.TrackRecord != record {
+		c.TrackRecord = record
+		p.updatedAt = now
+	}
+	p.refreshBadge(c, now)
+}
+
+func (p *HustlerProfile) refreshBadge(c *SkillCredential, now time.Time) {
+	badge := c.earnedBadge()
+	if badge == c.Badge {
+		return
+	}
+
+	previous := c.Badge
+	c.Badge = badge
+	c.BadgeSince = nil
+	if badge != SkillBadgeNone {
+		since := now
+		c.BadgeSince = &since
+	}
+	p.updatedAt = now
+
+	p.RecordEvent(event.NewSkillBadgeChanged(p.userID.String(), c.SkillID.String(), string(previous), string(badge)))
+}
+
+func contractIDStrings(ids []valueobject.ContractID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
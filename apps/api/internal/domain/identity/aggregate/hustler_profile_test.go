@@ -0,0 +1,148 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestHustlerProfile_PortfolioLimits(t *testing.T) {
+	profile := NewHustlerProfile(valueobject.GenerateUserID(), time.Now().UTC())
+	now := time.Now().UTC()
+
+	if _, err := profile.AddPortfolioItem("  ", "", nil, nil, nil, now); err != ErrPortfolioTitleRequired {
+		t.Errorf("AddPortfolioItem() blank title error = %v, want %v", err, ErrPortfolioTitleRequired)
+	}
+
+	contractID := valueobject.GenerateContractID()
+	_, err := profile.AddPortfolioItem("Kitchen rewiring", "", nil, nil, []valueobject.ContractID{contractID, contractID}, now)
+	if err != ErrDuplicateContractLink {
+		t.Errorf("AddPortfolioItem() duplicate contract error = %v, want %v", err, ErrDuplicateContractLink)
+	}
+
+	media := make([]string, MaxPortfolioMedia+1)
+	if _, err := profile.AddPortfolioItem("Kitchen rewiring", "", media, nil, nil, now); err != ErrTooManyPortfolioMedia {
+		t.Errorf("AddPortfolioItem() too many media error = %v, want %v", err, ErrTooManyPortfolioMedia)
+	}
+
+	for i := 0; i < MaxPortfolioItems; i++ {
+		if _, err := profile.AddPortfolioItem("Job", "", nil, nil, nil, now); err != nil {
+			t.Fatalf("AddPortfolioItem() error = %v", err)
+		}
+	}
+	if _, err := profile.AddPortfolioItem("One more", "", nil, nil, nil, now); err != ErrPortfolioFull {
+		t.Errorf("AddPortfolioItem() on full portfolio error = %v, want %v", err, ErrPortfolioFull)
+	}
+}
+
+func TestHustlerProfile_RemovePortfolioItem(t *testing.T) {
+	profile := NewHustlerProfile(valueobject.GenerateUserID(), time.Now().UTC())
+	now := time.Now().UTC()
+
+	item, err := profile.AddPortfolioItem("Borehole", "Drilled and fitted", []string{"bore.jpg"}, nil, nil, now)
+	if err != nil {
+		t.Fatalf("AddPortfolioItem() error = %v", err)
+	}
+
+	if err := profile.RemovePortfolioItem(item.ID, now); err != nil {
+		t.Fatalf("RemovePortfolioItem() error = %v", err)
+	}
+	if len(profile.Portfolio()) != 0 {
+		t.Errorf("portfolio has %d items, want 0", len(profile.Portfolio()))
+	}
+	if err := profile.RemovePortfolioItem(item.ID, now); err != ErrPortfolioItemNotFound {
+		t.Errorf("RemovePortfolioItem() twice error = %v, want %v", err, ErrPortfolioItemNotFound)
+	}
+}
+
+func TestHustlerProfile_Endorse(t *testing.T) {
+	hustlerID := valueobject.GenerateUserID()
+	profile := NewHustlerProfile(hustlerID, time.Now().UTC())
+	skillID := valueobject.GenerateSkillID()
+	clientID := valueobject.GenerateUserID()
+	now := time.Now().UTC()
+
+	if err := profile.Endorse(skillID, hustlerID, valueobject.GenerateContractID(), "", now); err != ErrCannotEndorseSelf {
+		t.Errorf("Endorse() by self error = %v, want %v", err, ErrCannotEndorseSelf)
+	}
+
+	if err := profile.Endorse(skillID, clientID, valueobject.GenerateContractID(), "Neat work", now); err != nil {
+		t.Fatalf("Endorse() error = %v", err)
+	}
+	if err := profile.Endorse(skillID, clientID, valueobject.GenerateContractID(), "", now); err != ErrAlreadyEndorsed {
+		t.Errorf("Endorse() twice error = %v, want %v", err, ErrAlreadyEndorsed)
+	}
+
+	if got := len(profile.Credential(skillID).Endorsements); got != 1 {
+		t.Errorf("endorsements = %d, want 1", got)
+	}
+	if profile.BadgeFor(skillID) != SkillBadgeNone {
+		t.Error("endorsements alone should not earn a badge")
+	}
+}
+
+func TestHustlerProfile_ApprovedCertificateEarnsVerifiedBadge(t *testing.T) {
+	hustlerID := valueobject.GenerateUserID()
+	profile := NewHustlerProfile(hustlerID, time.Now().UTC())
+	skillID := valueobject.GenerateSkillID()
+	adminID := valueobject.GenerateUserID()
+	now := time.Now().UTC()
+
+	cert, err := profile.SubmitCertificate(skillID, "COREN registration", "COREN", "certs/coren.pdf", now)
+	if err != nil {
+		t.Fatalf("SubmitCertificate() error = %v", err)
+	}
+	if cert.Status != CertificatePending {
+		t.Errorf("certificate status = %s, want %s", cert.Status, CertificatePending)
+	}
+
+	if err := profile.ReviewCertificate(cert.ID, hustlerID, true, "", now); err != ErrCannotReviewOwnCertificate {
+		t.Errorf("ReviewCertificate() by owner error = %v, want %v", err, ErrCannotReviewOwnCertificate)
+	}
+
+	if err := profile.ReviewCertificate(cert.ID, adminID, true, "Checked with issuer", now); err != nil {
+		t.Fatalf("ReviewCertificate() error = %v", err)
+	}
+	if got := profile.BadgeFor(skillID); got != SkillBadgeVerified {
+		t.Errorf("badge = %q, want %q", got, SkillBadgeVerified)
+	}
+	if err := profile.ReviewCertificate(cert.ID, adminID, false, "", now); err != ErrCertificateAlreadyReviewed {
+		t.Errorf("ReviewCertificate() twice error = %v, want %v", err, ErrCertificateAlreadyReviewed)
+	}
+
+	events := profile.DomainEvents()
+	if last := events[len(events)-1]; last.EventType() != "SkillBadgeChanged" {
+		t.Errorf("last event = %s, want SkillBadgeChanged", last.EventType())
+	}
+}
+
+func TestHustlerProfile_TrackRecordBadges(t *testing.T) {
+	profile := NewHustlerProfile(valueobject.GenerateUserID(), time.Now().UTC())
+	skillID := valueobject.GenerateSkillID()
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name   string
+		record SkillTrackRecord
+		want   SkillBadge
+	}{
+		{"too few contracts", SkillTrackRecord{CompletedContracts: 2, AverageRating: 5}, SkillBadgeNone},
+		{"verified", SkillTrackRecord{CompletedContracts: 3, AverageRating: 4.2}, SkillBadgeVerified},
+		{"top rated", SkillTrackRecord{CompletedContracts: 12, AverageRating: 4.7}, SkillBadgeTopRated},
+		{"rating dropped", SkillTrackRecord{CompletedContracts: 12, AverageRating: 3.6}, SkillBadgeNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile.ApplyTrackRecord(skillID, tt.record, now)
+			if got := profile.BadgeFor(skillID); got != tt.want {
+				t.Errorf("badge = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if since := profile.Credential(skillID).BadgeSince; since != nil {
+		t.Errorf("BadgeSince = %v after losing the badge, want nil", since)
+	}
+}
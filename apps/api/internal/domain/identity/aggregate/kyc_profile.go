@@ -0,0 +1,258 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/identity/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// KYC errors
+var (
+	ErrKYCLevelRequired     = errors.New("complete the previous KYC level first")
+	ErrKYCCheckNotSupported = errors.New("unsupported KYC check")
+	ErrAlreadyKYCVerified   = errors.New("this check has already been verified")
+	ErrTooManyKYCAttempts   = errors.New("too many failed verification attempts, try again tomorrow")
+)
+
+// MaxKYCAttemptsPerDay is how many unsuccessful checks of one type a user can run in 24 hours.
+// Every attempt is a paid provider call, and repeated misses suggest someone trying numbers.
+const MaxKYCAttemptsPerDay = 3
+
+// KYCLevel is how far a user has progressed through identity verification.
+// Wallet limits and loan eligibility are keyed on it.
+type KYCLevel int
+
+const (
+	KYCLevelNone     KYCLevel = 0
+	KYCLevelPhone    KYCLevel = 1 // Tier 1: phone verified by OTP
+	KYCLevelIdentity KYCLevel = 2 // Tier 2: BVN or NIN matched to the profile
+	KYCLevelFull     KYCLevel = 3 // Tier 3: address and ID document verified
+)
+
+func (l KYCLevel) String() string {
+	switch l {
+	case KYCLevelPhone:
+		return "tier_1"
+	case KYCLevelIdentity:
+		return "tier_2"
+	case KYCLevelFull:
+		return "tier_3"
+	}
+	return "none"
+}
+
+// KYCCheckType is what a KYC check verifies
+type KYCCheckType string
+
+const (
+	KYCCheckBVN        KYCCheckType = "bvn"
+	KYCCheckNIN        KYCCheckType = "nin"
+	KYCCheckIDDocument KYCCheckType = "id_document"
+	KYCCheckAddress    KYCCheckType = "address"
+)
+
+// IsValid returns true for the check types the platform runs
+func (t KYCCheckType) IsValid() bool {
+	switch t {
+	case KYCCheckBVN, KYCCheckNIN, KYCCheckIDDocument, KYCCheckAddress:
+		return true
+	}
+	return false
+}
+
+// RequiredLevel returns the level a user must hold before running the check
+func (t KYCCheckType) RequiredLevel() KYCLevel {
+	if t == KYCCheckIDDocument || t == KYCCheckAddress {
+		return KYCLevelIdentity
+	}
+	return KYCLevelPhone
+}
+
+// KYCCheckStatus is the outcome of a KYC check
+type KYCCheckStatus string
+
+const (
+	KYCCheckVerified KYCCheckStatus = "verified"
+	KYCCheckMismatch KYCCheckStatus = "mismatch"  // the record exists but does not match the profile
+	KYCCheckNotFound KYCCheckStatus = "not_found" // the provider has no such record
+)
+
+// KYCCheck is one answered verification request
+type KYCCheck struct {
+	ID           string
+	Type         KYCCheckType
+	Provider     string
+	ProviderRef  string
+	Status       KYCCheckStatus
+	MaskedNumber string  // last digits of the BVN, NIN or document number
+	NumberHash   string  // keyed hash of the BVN or NIN, unique among verified checks
+	NameScore    float64 // 0-1 similarity of the provider's name to the profile name
+	DOBMatched   bool
+	PhoneMatched bool   // the record is registered to the account's OTP-verified phone
+	SealedResult string // the provider's response, encrypted
+	CheckedAt    time.Time
+}
+
+// KYCProfile is the aggregate root for a user's identity verification
+type KYCProfile struct {
+	sharedevent.AggregateRoot
+
+	userID        valueobject.UserID
+	phoneVerified bool
+	checks        []KYCCheck
+	level         KYCLevel
+	levelSince    time.Time
+
+	createdAt time.Time
+	updatedAt time.Time
+	version   int64
+}
+
+// NewKYCProfile creates a KYC profile. Users who signed up by OTP start at Tier 1.
+func NewKYCProfile(userID valueobject.UserID, phoneVerified bool, now time.Time) *KYCProfile {
+	p := &KYCProfile{
+		userID:        userID,
+		phoneVerified: phoneVerified,
+		checks:        make([]KYCCheck, 0),
+		level:         KYCLevelNone,
+		levelSince:    now,
+		createdAt:     now,
+		updatedAt:     now,
+		version:       1,
+	}
+	if phoneVerified {
+		p.level = KYCLevelPhone
+	}
+	return p
+}
+
+// ReconstructKYCProfile reconstructs a KYC profile from persistence (no events emitted)
+func ReconstructKYCProfile(
+	userID valueobject.UserID,
+	phoneVerified bool,
+	checks []KYCCheck,
+	level KYCLevel,
+	levelSince time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int64,
+) *KYCProfile {
+	return &KYCProfile{
+		userID:        userID,
+		phoneVerified: phoneVerified,
+		checks:        checks,
+		level:         level,
+		levelSince:    levelSince,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		version:       version,
+	}
+}
+
+// Getters
+
+func (p *KYCProfile) UserID() valueobject.UserID { return p.userID }
+func (p *KYCProfile) PhoneVerified() bool        { return p.phoneVerified }
+func (p *KYCProfile) Checks() []KYCCheck         { return p.checks }
+func (p *KYCProfile) Level() KYCLevel            { return p.level }
+func (p *KYCProfile) LevelSince() time.Time      { return p.levelSince }
+func (p *KYCProfile) CreatedAt() time.Time       { return p.createdAt }
+func (p *KYCProfile) UpdatedAt() time.Time       { return p.updatedAt }
+func (p *KYCProfile) Version() int64             { return p.version }
+
+// IsVerified returns true if a check of the type has passed
+func (p *KYCProfile) IsVerified(checkType KYCCheckType) bool {
+	for _, c := range p.checks {
+		if c.Type == checkType && c.Status == KYCCheckVerified {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRun reports why a check of the type cannot be run now, or nil if it can
+func (p *KYCProfile) CanRun(checkType KYCCheckType, now time.Time) error {
+	if !checkType.IsValid() {
+		return ErrKYCCheckNotSupported
+	}
+	if p.level < checkType.RequiredLevel() {
+		return ErrKYCLevelRequired
+	}
+	if p.IsVerified(checkType) {
+		return ErrAlreadyKYCVerified
+	}
+
+	failed := 0
+	for _, c := range p.checks {
+		if c.Type == checkType && c.Status != KYCCheckVerified && now.Sub(c.CheckedAt) < 24*time.Hour {
+			failed++
+		}
+	}
+	if failed >= MaxKYCAttemptsPerDay {
+		return ErrTooManyKYCAttempts
+	}
+
+	return nil
+}
+
+// MarkPhoneVerified records that the user has confirmed their phone by OTP
+func (p *KYCProfile) MarkPhoneVerified(now time.Time) {
+	if p.phoneVerified {
+		return
+	}
+	p.phoneVerified = true
+	p.updatedAt = now
+	p.refreshLevel(now)
+}
+
+// RecordCheck records a provider's answer to a check and moves the user up a
+// level when it completes one
+func (p *KYCProfile) RecordCheck(check KYCCheck, now time.Time) (*KYCCheck, error) {
+	if err := p.CanRun(check.Type, now); err != nil {
+		return nil, err
+	}
+
+	check.ID = valueobject.GenerateKYCCheckID().String()
+	check.CheckedAt = now
+	p.checks = append(p.checks, check)
+	p.updatedAt = now
+
+	p.RecordEvent(event.NewKYCCheckCompleted(
+		p.userID.String(), check.ID, string(check.Type), check.Provider, string(check.Status), check.NameScore,
+	))
+	p.refreshLevel(now)
+
+	return &p.checks[len(p.checks)-1], nil
+}
+
+// earnedLevel derives the level from the checks that have passed. Each tier
+// builds on the one below it.
+func (p *KYCProfile) earnedLevel() KYCLevel {
+	if !p.phoneVerified {
+		return KYCLevelNone
+	}
+	if !p.IsVerified(KYCCheckBVN) && !p.IsVerified(KYCCheckNIN) {
+		return KYCLevelPhone
+	}
+	if !p.IsVerified(KYCCheckIDDocument) || !p.IsVerified(KYCCheckAddress) {
+		return KYCLevelIdentity
+	}
+	return KYCLevelFull
+}
+
+func (p *KYCProfile) refreshLevel(now time.Time) {
+	level := p.earnedLevel()
+	if level == p.level {
+		return
+	}
+
+	previous := p.level
+	p.level = level
+	p.levelSince = now
+	p.updatedAt = now
+
+	p.RecordEvent(event.NewKYCLevelChanged(p.userID.String(), int(previous), int(level)))
+}
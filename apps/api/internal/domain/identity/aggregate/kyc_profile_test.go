@@ -0,0 +1,101 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/identity/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestKYCProfile_LevelProgression(t *testing.T) {
+	now := time.Now().UTC()
+	profile := NewKYCProfile(valueobject.GenerateUserID(), true, now)
+
+	if profile.Level() != KYCLevelPhone {
+		t.Fatalf("Level() = %v, want %v", profile.Level(), KYCLevelPhone)
+	}
+	if err := profile.CanRun(KYCCheckAddress, now); err != ErrKYCLevelRequired {
+		t.Errorf("CanRun() address at tier 1 error = %v, want %v", err, ErrKYCLevelRequired)
+	}
+
+	if _, err := profile.RecordCheck(KYCCheck{Type: KYCCheckNIN, Provider: "dojah", Status: KYCCheckVerified}, now); err != nil {
+		t.Fatalf("RecordCheck() nin error = %v", err)
+	}
+	if profile.Level() != KYCLevelIdentity {
+		t.Fatalf("Level() after NIN = %v, want %v", profile.Level(), KYCLevelIdentity)
+	}
+
+	if _, err := profile.RecordCheck(KYCCheck{Type: KYCCheckAddress, Provider: "verifyme", Status: KYCCheckVerified}, now); err != nil {
+		t.Fatalf("RecordCheck() address error = %v", err)
+	}
+	if profile.Level() != KYCLevelIdentity {
+		t.Errorf("Level() with address only = %v, want %v", profile.Level(), KYCLevelIdentity)
+	}
+
+	if _, err := profile.RecordCheck(KYCCheck{Type: KYCCheckIDDocument, Provider: "smile_id", Status: KYCCheckVerified}, now); err != nil {
+		t.Fatalf("RecordCheck() id_document error = %v", err)
+	}
+	if profile.Level() != KYCLevelFull || profile.Level().String() != "tier_3" {
+		t.Errorf("Level() = %v, want %v", profile.Level(), KYCLevelFull)
+	}
+
+	levelChanges := 0
+	for _, e := range profile.DomainEvents() {
+		if _, ok := e.(*event.KYCLevelChanged); ok {
+			levelChanges++
+		}
+	}
+	if levelChanges != 2 {
+		t.Errorf("KYCLevelChanged events = %d, want 2", levelChanges)
+	}
+}
+
+func TestKYCProfile_PhoneRequired(t *testing.T) {
+	now := time.Now().UTC()
+	profile := NewKYCProfile(valueobject.GenerateUserID(), false, now)
+
+	if err := profile.CanRun(KYCCheckBVN, now); err != ErrKYCLevelRequired {
+		t.Errorf("CanRun() without phone error = %v, want %v", err, ErrKYCLevelRequired)
+	}
+
+	profile.MarkPhoneVerified(now)
+	if profile.Level() != KYCLevelPhone {
+		t.Errorf("Level() after phone = %v, want %v", profile.Level(), KYCLevelPhone)
+	}
+	if err := profile.CanRun(KYCCheckBVN, now); err != nil {
+		t.Errorf("CanRun() after phone error = %v", err)
+	}
+}
+
+func TestKYCProfile_RecordCheckRules(t *testing.T) {
+	now := time.Now().UTC()
+	profile := NewKYCProfile(valueobject.GenerateUserID(), true, now)
+
+	if _, err := profile.RecordCheck(KYCCheck{Type: "passport_scan"}, now); err != ErrKYCCheckNotSupported {
+		t.Errorf("RecordCheck() unknown type error = %v, want %v", err, ErrKYCCheckNotSupported)
+	}
+
+	for i := 0; i < MaxKYCAttemptsPerDay; i++ {
+		if _, err := profile.RecordCheck(KYCCheck{Type: KYCCheckBVN, Status: KYCCheckMismatch}, now); err != nil {
+			t.Fatalf("RecordCheck() attempt %d error = %v", i+1, err)
+		}
+	}
+	if profile.Level() != KYCLevelPhone {
+		t.Errorf("Level() after failed checks = %v, want %v", profile.Level(), KYCLevelPhone)
+	}
+	if err := profile.CanRun(KYCCheckBVN, now); err != ErrTooManyKYCAttempts {
+		t.Errorf("CanRun() after %d failures error = %v, want %v", MaxKYCAttemptsPerDay, err, ErrTooManyKYCAttempts)
+	}
+	if err := profile.CanRun(KYCCheckNIN, now); err != nil {
+		t.Errorf("CanRun() nin should not be limited by bvn failures, error = %v", err)
+	}
+
+	tomorrow := now.Add(25 * time.Hour)
+	if _, err := profile.RecordCheck(KYCCheck{Type: KYCCheckBVN, Status: KYCCheckVerified}, tomorrow); err != nil {
+		t.Fatalf("RecordCheck() next day error = %v", err)
+	}
+	if err := profile.CanRun(KYCCheckBVN, tomorrow); err != ErrAlreadyKYCVerified {
+		t.Errorf("CanRun() verified bvn error = %v, want %v", err, ErrAlreadyKYCVerified)
+	}
+}
@@ -17,6 +17,7 @@ var (
 	ErrSkillAlreadyAdded = errors.New("skill already added to profile")
 	ErrSkillNotFound     = errors.New("skill not found in profile")
 	ErrCannotDeactivate  = errors.New("cannot deactivate user with active obligations")
+	ErrIdentityLocked    = errors.New("name and date of birth cannot be changed after identity verification")
 )
 
 // UserTier represents the credit tier of a user
@@ -239,7 +240,9 @@ func (u *User) SetReferrer(referrerID valueobject.UserID) {
 	u.updatedAt = time.Now().UTC()
 }
 
-// UpdateProfile updates the user's profile information
+// UpdateProfile updates the user's profile information. Once a BVN or NIN has
+// verified the user, the date of birth it was matched on is frozen; the name has
+// no setter at all.
 func (u *User) UpdateProfile(
 	username string,
 	bio string,
@@ -252,6 +255,9 @@ func (u *User) UpdateProfile(
 	if !u.isActive {
 		return ErrUserNotActive
 	}
+	if u.isVerified && dateOfBirth != nil && !sameDay(u.dateOfBirth, dateOfBirth) {
+		return ErrIdentityLocked
+	}
 
 	updatedFields := make(map[string]string)
 
@@ -387,6 +393,21 @@ func (u *User) RemoveSkill(skillID valueobject.SkillID) error {
 	return nil
 }
 
+// SetSkillVerified marks a skill as backed by an earned badge, or not
+func (u *User) SetSkillVerified(skillID valueobject.SkillID, verified bool) error {
+	for i := range u.skills {
+		if u.skills[i].SkillID != skillID {
+			continue
+		}
+		if u.skills[i].IsVerified != verified {
+			u.skills[i].IsVerified = verified
+			u.updatedAt = time.Now().UTC()
+		}
+		return nil
+	}
+	return ErrSkillNotFound
+}
+
 // Deactivate deactivates the user account
 func (u *User) Deactivate(reason, deactivatedBy string) error {
 	if !u.isActive {
@@ -434,3 +455,13 @@ func (u *User) GetSkill(skillID valueobject.SkillID) (*UserSkill, bool) {
 	}
 	return nil, false
 }
+
+// sameDay returns true if both dates fall on the same calendar day
+func sameDay(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,57 @@
+package event
+
+import (
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const (
+	AggregateTypeKYCProfile = "KYCProfile"
+)
+
+// KYCCheckCompleted is emitted when a provider has answered a KYC check
+type KYCCheckCompleted struct {
+	sharedevent.BaseEvent
+	UserID    string  `json:"user_id"`
+	CheckID   string  `json:"check_id"`
+	CheckType string  `json:"check_type"` // bvn, nin, id_document, address
+	Provider  string  `json:"provider"`
+	Status    string  `json:"status"` // verified, mismatch, not_found
+	NameScore float64 `json:"name_score"`
+}
+
+func NewKYCCheckCompleted(userID, checkID, checkType, provider, status string, nameScore float64) *KYCCheckCompleted {
+	return &KYCCheckCompleted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"KYCCheckCompleted",
+			userID,
+			AggregateTypeKYCProfile,
+		),
+		UserID:    userID,
+		CheckID:   checkID,
+		CheckType: checkType,
+		Provider:  provider,
+		Status:    status,
+		NameScore: nameScore,
+	}
+}
+
+// KYCLevelChanged is emitted when a user moves between KYC levels
+type KYCLevelChanged struct {
+	sharedevent.BaseEvent
+	UserID        string `json:"user_id"`
+	PreviousLevel int    `json:"previous_level"`
+	NewLevel      int    `json:"new_level"`
+}
+
+func NewKYCLevelChanged(userID string, previousLevel, newLevel int) *KYCLevelChanged {
+	return &KYCLevelChanged{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"KYCLevelChanged",
+			userID,
+			AggregateTypeKYCProfile,
+		),
+		UserID:        userID,
+		PreviousLevel: previousLevel,
+		NewLevel:      newLevel,
+	}
+}
@@ -0,0 +1,143 @@
+package event
+
+import (
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const (
+	AggregateTypeHustlerProfile = "HustlerProfile"
+)
+
+// PortfolioItemAdded is emitted when a hustler adds work to their portfolio
+type PortfolioItemAdded struct {
+	sharedevent.BaseEvent
+	UserID      string   `json:"user_id"`
+	ItemID      string   `json:"item_id"`
+	Title       string   `json:"title"`
+	ContractIDs []string `json:"contract_ids,omitempty"`
+}
+
+func NewPortfolioItemAdded(userID, itemID, title string, contractIDs []string) *PortfolioItemAdded {
+	return &PortfolioItemAdded{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PortfolioItemAdded",
+			userID,
+			AggregateTypeHustlerProfile,
+		),
+		UserID:      userID,
+		ItemID:      itemID,
+		Title:       title,
+		ContractIDs: contractIDs,
+	}
+}
+
+// PortfolioItemRemoved is emitted when a hustler takes work off their portfolio
+type PortfolioItemRemoved struct {
+	sharedevent.BaseEvent
+	UserID string `json:"user_id"`
+	ItemID string `json:"item_id"`
+}
+
+func NewPortfolioItemRemoved(userID, itemID string) *PortfolioItemRemoved {
+	return &PortfolioItemRemoved{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PortfolioItemRemoved",
+			userID,
+			AggregateTypeHustlerProfile,
+		),
+		UserID: userID,
+		ItemID: itemID,
+	}
+}
+
+// SkillEndorsed is emitted when a past client vouches for a hustler's skill
+type SkillEndorsed struct {
+	sharedevent.BaseEvent
+	UserID     string `json:"user_id"`
+	SkillID    string `json:"skill_id"`
+	EndorserID string `json:"endorser_id"`
+	ContractID string `json:"contract_id"`
+}
+
+func NewSkillEndorsed(userID, skillID, endorserID, contractID string) *SkillEndorsed {
+	return &SkillEndorsed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SkillEndorsed",
+			userID,
+			AggregateTypeHustlerProfile,
+		),
+		UserID:     userID,
+		SkillID:    skillID,
+		EndorserID: endorserID,
+		ContractID: contractID,
+	}
+}
+
+// SkillCertificateSubmitted is emitted when a hustler uploads a certificate for review
+type SkillCertificateSubmitted struct {
+	sharedevent.BaseEvent
+	UserID        string `json:"user_id"`
+	SkillID       string `json:"skill_id"`
+	CertificateID string `json:"certificate_id"`
+}
+
+func NewSkillCertificateSubmitted(userID, skillID, certificateID string) *SkillCertificateSubmitted {
+	return &SkillCertificateSubmitted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SkillCertificateSubmitted",
+			userID,
+			AggregateTypeHustlerProfile,
+		),
+		UserID:        userID,
+		SkillID:       skillID,
+		CertificateID: certificateID,
+	}
+}
+
+// SkillCertificateReviewed is emitted when an admin approves or rejects a certificate
+type SkillCertificateReviewed struct {
+	sharedevent.BaseEvent
+	UserID        string `json:"user_id"`
+	SkillID       string `json:"skill_id"`
+	CertificateID string `json:"certificate_id"`
+	ReviewerID    string `json:"reviewer_id"`
+	Approved      bool   `json:"approved"`
+}
+
+func NewSkillCertificateReviewed(userID, skillID, certificateID, reviewerID string, approved bool) *SkillCertificateReviewed {
+	return &SkillCertificateReviewed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SkillCertificateReviewed",
+			userID,
+			AggregateTypeHustlerProfile,
+		),
+		UserID:        userID,
+		SkillID:       skillID,
+		CertificateID: certificateID,
+		ReviewerID:    reviewerID,
+		Approved:      approved,
+	}
+}
+
+// SkillBadgeChanged is emitted when a skill's verification badge is earned or lost
+type SkillBadgeChanged struct {
+	sharedevent.BaseEvent
+	UserID   string `json:"user_id"`
+	SkillID  string `json:"skill_id"`
+	Previous string `json:"previous,omitempty"`
+	Badge    string `json:"badge,omitempty"` // empty when the badge was lost
+}
+
+func NewSkillBadgeChanged(userID, skillID, previous, badge string) *SkillBadgeChanged {
+	return &SkillBadgeChanged{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SkillBadgeChanged",
+			userID,
+			AggregateTypeHustlerProfile,
+		),
+		UserID:   userID,
+		SkillID:  skillID,
+		Previous: previous,
+		Badge:    badge,
+	}
+}
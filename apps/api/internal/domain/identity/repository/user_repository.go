@@ -2,12 +2,22 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"hustlex/internal/domain/identity/aggregate"
 	"hustlex/internal/domain/shared/valueobject"
 )
 
+// Repository errors
+var (
+	// ErrHustlerProfileNotFound is returned for users who have no portfolio or skill credentials yet
+	ErrHustlerProfileNotFound = errors.New("hustler profile not found")
+
+	// ErrKYCProfileNotFound is returned for users who have not started KYC beyond sign-up
+	ErrKYCProfileNotFound = errors.New("KYC profile not found")
+)
+
 // UserRepository defines the interface for user persistence
 // This is a PORT - infrastructure provides the ADAPTER
 type UserRepository interface {
@@ -78,7 +88,8 @@ type OTPCode struct {
 	CreatedAt time.Time
 }
 
-// SkillRepository defines the interface for skill catalog persistence
+// SkillRepository defines the interface for skill catalog persistence.
+// The catalog is a tree: top-level skills are the categories gigs are filed under.
 type SkillRepository interface {
 	// Save persists a skill
 	Save(ctx context.Context, skill *Skill) error
@@ -94,13 +105,22 @@ type SkillRepository interface {
 
 	// Search searches skills by name
 	Search(ctx context.Context, query string, limit int) ([]*Skill, error)
+
+	// FindChildren retrieves the active skills directly beneath a skill, or the
+	// top-level skills when parentID is nil
+	FindChildren(ctx context.Context, parentID *valueobject.SkillID) ([]*Skill, error)
+
+	// FindDescendantIDs retrieves a skill's ID and the IDs of every active skill beneath it
+	FindDescendantIDs(ctx context.Context, id valueobject.SkillID) ([]valueobject.SkillID, error)
 }
 
 // Skill represents a skill in the catalog
 type Skill struct {
 	ID          string
+	ParentID    string   // empty for top-level skills
+	Path        []string // IDs from the top-level skill down to this one
 	Name        string
-	Category    string
+	Category    string // name of the top-level skill
 	Description string
 	Icon        string
 	IsActive    bool
@@ -108,6 +128,43 @@ type Skill struct {
 	UpdatedAt   time.Time
 }
 
+// Depth returns how far below the top level the skill sits
+func (s *Skill) Depth() int {
+	return len(s.Path) - 1
+}
+
+// HustlerProfileRepository defines the interface for portfolio and skill credential persistence
+type HustlerProfileRepository interface {
+	// Save persists a hustler profile
+	Save(ctx context.Context, profile *aggregate.HustlerProfile) error
+
+	// SaveWithEvents persists a hustler profile and publishes domain events
+	SaveWithEvents(ctx context.Context, profile *aggregate.HustlerProfile) error
+
+	// FindByUserID retrieves a user's hustler profile
+	FindByUserID(ctx context.Context, userID valueobject.UserID) (*aggregate.HustlerProfile, error)
+
+	// FindPendingCertificates retrieves profiles with certificates awaiting review, oldest submission first
+	FindPendingCertificates(ctx context.Context, offset, limit int) ([]*aggregate.HustlerProfile, int64, error)
+}
+
+// KYCProfileRepository defines the interface for identity verification persistence.
+// Checks hold sealed provider responses; adapters must never log them.
+type KYCProfileRepository interface {
+	// Save persists a KYC profile
+	Save(ctx context.Context, profile *aggregate.KYCProfile) error
+
+	// SaveWithEvents persists a KYC profile and publishes domain events
+	SaveWithEvents(ctx context.Context, profile *aggregate.KYCProfile) error
+
+	// FindByUserID retrieves a user's KYC profile
+	FindByUserID(ctx context.Context, userID valueobject.UserID) (*aggregate.KYCProfile, error)
+
+	// FindByVerifiedIDNumber retrieves the profile holding a verified check with this ID number hash.
+	// Adapters should back it with a unique index on the hash of verified checks.
+	FindByVerifiedIDNumber(ctx context.Context, numberHash string) (*aggregate.KYCProfile, error)
+}
+
 // UserSkillRepository defines the interface for user skill persistence
 type UserSkillRepository interface {
 	// Save persists a user skill
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+)
+
+// Identity verifier errors
+var (
+	// ErrProviderUnavailable means the provider could not answer (outage, timeout,
+	// rate limit) and the check can be retried with another provider
+	ErrProviderUnavailable = errors.New("identity provider unavailable")
+	ErrNoVerifier          = errors.New("no identity provider supports this check")
+)
+
+// IdentityVerifier looks up identity records held by a KYC provider
+// This is a PORT - infrastructure provides the ADAPTERS (Smile ID, Dojah, VerifyMe)
+type IdentityVerifier interface {
+	// Name identifies the provider on stored checks
+	Name() string
+
+	// Supports returns true if the provider can run the check
+	Supports(checkType aggregate.KYCCheckType) bool
+
+	// Verify runs the check. A record the provider does not hold is reported
+	// with Found false rather than an error.
+	Verify(ctx context.Context, req VerificationRequest) (*VerificationResult, error)
+}
+
+// VerificationRequest is what a provider is asked to verify
+type VerificationRequest struct {
+	Reference string // our reference for the provider's logs
+	CheckType aggregate.KYCCheckType
+	IDNumber  string // BVN, NIN or document number
+
+	// Profile details, which some providers match on their side as well
+	FirstName   string
+	LastName    string
+	DateOfBirth *time.Time
+	Phone       string
+
+	// ID document checks
+	DocumentType     string // passport, drivers_license, voters_card, national_id
+	DocumentImageRef string
+	SelfieImageRef   string
+
+	// Address checks
+	Address AddressDetails
+}
+
+// AddressDetails is a Nigerian street address
+type AddressDetails struct {
+	Street string
+	City   string
+	LGA    string
+	State  string
+}
+
+// VerificationResult is a provider's answer
+type VerificationResult struct {
+	Found       bool
+	ProviderRef string
+
+	// What the provider holds for the ID number
+	FirstName   string
+	MiddleName  string
+	LastName    string
+	DateOfBirth *time.Time
+	Phone       string // the phone number the BVN or NIN is registered to
+
+	FaceMatched    bool // ID document checks: the selfie matches the document photo
+	AddressMatched bool // address checks: the address was confirmed
+
+	Raw []byte // the provider's response, kept encrypted for audit
+}
+
+// IDNumberHasher computes a keyed digest of a verified ID number, so one BVN or NIN
+// can be held to one account without storing the number itself
+// This is a PORT - infrastructure provides the ADAPTER (crypto.KeyedHasher)
+type IDNumberHasher interface {
+	Hash(value string) string
+}
+
+// ResultCipher encrypts provider responses before they are stored
+// This is a PORT - infrastructure provides the ADAPTER (crypto.AESEncryptionService)
+type ResultCipher interface {
+	EncryptString(plaintext string) (string, error)
+	DecryptString(ciphertext string) (string, error)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// DefaultNameMatchThreshold is the NameMatchScore a provider's record must reach
+// to count as the same person. A two-name profile still matches with one name
+// spelt differently (Muhammad Bello / Mohammed Bello) but not with one name wrong
+// or reduced to an initial.
+const DefaultNameMatchThreshold = 0.85
+
+// initialMatchScore is what an initial on the profile scores against a name starting
+// with it. It is only half a match: an initial cannot tell Adaeze from Amaka.
+const initialMatchScore = 0.5
+
+// NameMatchScore scores how well the name on a user's profile matches the names
+// a provider holds, from 0 to 1. Every name on the profile must appear in the
+// record, in any order; names the record has beyond them (usually a middle name)
+// are ignored. Case, accents and punctuation do not count.
+func NameMatchScore(profileName string, recordNames ...string) float64 {
+	profile := nameTokens(profileName)
+	record := nameTokens(strings.Join(recordNames, " "))
+	if len(profile) == 0 || len(record) == 0 {
+		return 0
+	}
+
+	var total float64
+	used := make([]bool, len(record))
+	for _, p := range profile {
+		best, bestIdx := 0.0, -1
+		for i, r := range record {
+			if used[i] {
+				continue
+			}
+			if s := tokenSimilarity(p, r); s > best {
+				best, bestIdx = s, i
+			}
+		}
+		if bestIdx >= 0 {
+			used[bestIdx] = true
+		}
+		total += best
+	}
+
+	return total / float64(len(profile))
+}
+
+// SamePhone returns true if the phone number on a provider's record is the account's phone
+func SamePhone(account valueobject.PhoneNumber, recordPhone string) bool {
+	record, err := valueobject.NewPhoneNumber(recordPhone)
+	if err != nil {
+		return false
+	}
+	return record.String() == account.String()
+}
+
+// SameDate returns true if both dates fall on the same calendar day
+func SameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// nameTokens lowercases a name, strips accents and punctuation and splits it into names
+func nameTokens(name string) []string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// combining accent, as in Yoruba tone marks
+		case unicode.IsLetter(r):
+			b.WriteRune(foldAccent(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
+
+// foldAccent maps precomposed accented Latin letters to their base letter
+func foldAccent(r rune) rune {
+	switch r {
+	case 'à', 'á', 'â', 'ä', 'ã':
+		return 'a'
+	case 'è', 'é', 'ê', 'ë', 'ẹ':
+		return 'e'
+	case 'ì', 'í', 'î', 'ï':
+		return 'i'
+	case 'ò', 'ó', 'ô', 'ö', 'õ', 'ọ':
+		return 'o'
+	case 'ù', 'ú', 'û', 'ü':
+		return 'u'
+	case 'ṣ':
+		return 's'
+	case 'ń', 'ñ':
+		return 'n'
+	}
+	return r
+}
+
+// tokenSimilarity scores two names from 0 to 1 by edit distance. A single
+// letter on the profile scores initialMatchScore against any name starting with it.
+func tokenSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 1 && len(rb) > 0 && ra[0] == rb[0] {
+		return initialMatchScore
+	}
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
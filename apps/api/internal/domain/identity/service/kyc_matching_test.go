@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestNameMatchScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   string
+		record    []string
+		wantMatch bool
+	}{
+		{"exact", "Adaeze Okafor", []string{"ADAEZE", "OKAFOR"}, true},
+		{"reordered with middle name", "Okafor Adaeze", []string{"ADAEZE", "NGOZI", "OKAFOR"}, true},
+		{"accents and punctuation", "Fọlákẹ́ Adébáyọ̀-Smith", []string{"FOLAKE", "ADEBAYO SMITH"}, true},
+		{"spelling variant", "Muhammad Bello", []string{"MOHAMMED", "BELLO"}, true},
+		{"initial", "A. Okafor", []string{"ADAEZE", "OKAFOR"}, false},
+		{"different first name", "Chinedu Okafor", []string{"ADAEZE", "OKAFOR"}, false},
+		{"different person", "Tunde Bakare", []string{"ADAEZE", "OKAFOR"}, false},
+		{"empty record", "Adaeze Okafor", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := NameMatchScore(tt.profile, tt.record...)
+			if got := score >= DefaultNameMatchThreshold; got != tt.wantMatch {
+				t.Errorf("NameMatchScore(%q, %v) = %.2f, want match %v", tt.profile, tt.record, score, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestSamePhone(t *testing.T) {
+	account, err := valueobject.NewPhoneNumber("+2348012345678")
+	if err != nil {
+		t.Fatalf("NewPhoneNumber() error = %v", err)
+	}
+
+	if !SamePhone(account, "08012345678") {
+		t.Error("SamePhone() should match the local form of the account's phone")
+	}
+	if SamePhone(account, "08098765432") {
+		t.Error("SamePhone() should reject a different number")
+	}
+	if SamePhone(account, "") {
+		t.Error("SamePhone() should reject a record without a phone")
+	}
+}
+
+func TestSameDate(t *testing.T) {
+	a := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	b := time.Date(1990, 5, 17, 23, 0, 0, 0, time.UTC)
+	c := time.Date(1990, 5, 18, 0, 0, 0, 0, time.UTC)
+
+	if !SameDate(&a, &b) {
+		t.Error("SameDate() should ignore the time of day")
+	}
+	if SameDate(&a, &c) {
+		t.Error("SameDate() should reject different days")
+	}
+	if SameDate(&a, nil) {
+		t.Error("SameDate() should reject a missing date")
+	}
+}
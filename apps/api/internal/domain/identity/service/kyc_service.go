@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// KYC service errors
+var (
+	ErrDateOfBirthRequired = errors.New("add your date of birth to your profile before verifying your identity")
+	ErrIDNumberRequired    = errors.New("ID number is required")
+	ErrKYCDocumentRequired = errors.New("document and selfie images are required")
+	ErrKYCAddressRequired  = errors.New("street, city and state are required")
+	ErrIDNumberInUse       = errors.New("this ID number is already verified on another account")
+)
+
+// KYCService runs KYC checks against identity providers and matches what they
+// hold against the user's profile
+type KYCService struct {
+	kycRepo            repository.KYCProfileRepository
+	verifiers          []IdentityVerifier
+	cipher             ResultCipher
+	hasher             IDNumberHasher
+	nameMatchThreshold float64
+}
+
+// NewKYCService creates a new KYC service. Verifiers are tried in order; a zero
+// threshold uses DefaultNameMatchThreshold.
+func NewKYCService(
+	kycRepo repository.KYCProfileRepository,
+	verifiers []IdentityVerifier,
+	cipher ResultCipher,
+	hasher IDNumberHasher,
+	nameMatchThreshold float64,
+) *KYCService {
+	if nameMatchThreshold <= 0 {
+		nameMatchThreshold = DefaultNameMatchThreshold
+	}
+	return &KYCService{
+		kycRepo:            kycRepo,
+		verifiers:          verifiers,
+		cipher:             cipher,
+		hasher:             hasher,
+		nameMatchThreshold: nameMatchThreshold,
+	}
+}
+
+// Verify runs a check with the first provider that supports it, moving on to
+// the next when one is unavailable, and records the outcome on the profile.
+// A record that does not match the user is recorded as a mismatch, not an error.
+// A BVN or NIN already verified on another account is refused before any provider is asked.
+func (s *KYCService) Verify(
+	ctx context.Context,
+	user *aggregate.User,
+	profile *aggregate.KYCProfile,
+	req VerificationRequest,
+) (*aggregate.KYCCheck, error) {
+	now := time.Now().UTC()
+	if err := profile.CanRun(req.CheckType, now); err != nil {
+		return nil, err
+	}
+	if err := validateRequest(user, req); err != nil {
+		return nil, err
+	}
+
+	var numberHash string
+	if req.CheckType == aggregate.KYCCheckBVN || req.CheckType == aggregate.KYCCheckNIN {
+		numberHash = s.hasher.Hash(string(req.CheckType) + ":" + strings.TrimSpace(req.IDNumber))
+		if err := s.checkNumberUnclaimed(ctx, user.ID(), numberHash); err != nil {
+			return nil, err
+		}
+	}
+
+	req.FirstName = user.FullName().FirstName()
+	req.LastName = user.FullName().LastName()
+	req.DateOfBirth = user.DateOfBirth()
+	req.Phone = user.Phone().String()
+
+	provider, result, err := s.lookup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := s.cipher.EncryptString(string(result.Raw))
+	if err != nil {
+		return nil, err
+	}
+
+	check := aggregate.KYCCheck{
+		Type:         req.CheckType,
+		Provider:     provider,
+		ProviderRef:  result.ProviderRef,
+		Status:       aggregate.KYCCheckNotFound,
+		MaskedNumber: maskIDNumber(req.IDNumber),
+		NumberHash:   numberHash,
+		SealedResult: sealed,
+	}
+	if result.Found {
+		check.NameScore = NameMatchScore(user.FullName().String(), result.FirstName, result.MiddleName, result.LastName)
+		check.DOBMatched = SameDate(user.DateOfBirth(), result.DateOfBirth)
+		check.PhoneMatched = SamePhone(user.Phone(), result.Phone)
+		check.Status = aggregate.KYCCheckMismatch
+		if s.matches(req.CheckType, check, result) {
+			check.Status = aggregate.KYCCheckVerified
+		}
+	}
+
+	return profile.RecordCheck(check, now)
+}
+
+// lookup asks each provider that supports the check in turn until one answers
+func (s *KYCService) lookup(ctx context.Context, req VerificationRequest) (string, *VerificationResult, error) {
+	tried := false
+	for _, v := range s.verifiers {
+		if !v.Supports(req.CheckType) {
+			continue
+		}
+		tried = true
+
+		result, err := v.Verify(ctx, req)
+		if errors.Is(err, ErrProviderUnavailable) {
+			continue
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		return v.Name(), result, nil
+	}
+
+	if !tried {
+		return "", nil, ErrNoVerifier
+	}
+	return "", nil, ErrProviderUnavailable
+}
+
+// checkNumberUnclaimed refuses an ID number already verified on another account
+func (s *KYCService) checkNumberUnclaimed(ctx context.Context, userID valueobject.UserID, numberHash string) error {
+	owner, err := s.kycRepo.FindByVerifiedIDNumber(ctx, numberHash)
+	if errors.Is(err, repository.ErrKYCProfileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !owner.UserID().Equals(userID) {
+		return ErrIDNumberInUse
+	}
+	return nil
+}
+
+// matches decides whether a found record is the user. A BVN or NIN must also be
+// registered to the phone the account verified by OTP, so a leaked number and
+// matching name and birthday are not enough on their own.
+func (s *KYCService) matches(checkType aggregate.KYCCheckType, check aggregate.KYCCheck, result *VerificationResult) bool {
+	switch checkType {
+	case aggregate.KYCCheckBVN, aggregate.KYCCheckNIN:
+		return check.NameScore >= s.nameMatchThreshold && check.DOBMatched && check.PhoneMatched
+	case aggregate.KYCCheckIDDocument:
+		// Not every document carries a date of birth the provider can read
+		dobOK := result.DateOfBirth == nil || check.DOBMatched
+		return check.NameScore >= s.nameMatchThreshold && dobOK && result.FaceMatched
+	case aggregate.KYCCheckAddress:
+		return result.AddressMatched
+	}
+	return false
+}
+
+func validateRequest(user *aggregate.User, req VerificationRequest) error {
+	switch req.CheckType {
+	case aggregate.KYCCheckBVN, aggregate.KYCCheckNIN:
+		if strings.TrimSpace(req.IDNumber) == "" {
+			return ErrIDNumberRequired
+		}
+		if user.DateOfBirth() == nil {
+			return ErrDateOfBirthRequired
+		}
+	case aggregate.KYCCheckIDDocument:
+		if strings.TrimSpace(req.IDNumber) == "" {
+			return ErrIDNumberRequired
+		}
+		if req.DocumentImageRef == "" || req.SelfieImageRef == "" {
+			return ErrKYCDocumentRequired
+		}
+	case aggregate.KYCCheckAddress:
+		a := req.Address
+		if strings.TrimSpace(a.Street) == "" || strings.TrimSpace(a.City) == "" || strings.TrimSpace(a.State) == "" {
+			return ErrKYCAddressRequired
+		}
+	}
+	return nil
+}
+
+// maskIDNumber keeps the last four digits of an ID number
+func maskIDNumber(number string) string {
+	number = strings.TrimSpace(number)
+	if len(number) <= 4 {
+		return number
+	}
+	return strings.Repeat("*", len(number)-4) + number[len(number)-4:]
+}
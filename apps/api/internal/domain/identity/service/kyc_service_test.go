@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+type fakeVerifier struct {
+	name   string
+	result *VerificationResult
+	err    error
+	calls  int
+}
+
+func (f *fakeVerifier) Name() string { return f.name }
+
+func (f *fakeVerifier) Supports(checkType aggregate.KYCCheckType) bool {
+	return checkType == aggregate.KYCCheckBVN
+}
+
+func (f *fakeVerifier) Verify(ctx context.Context, req VerificationRequest) (*VerificationResult, error) {
+	f.calls++
+	return f.result, f.err
+}
+
+type fakeKYCProfileRepo struct {
+	byNumber map[string]*aggregate.KYCProfile
+}
+
+func (r *fakeKYCProfileRepo) Save(ctx context.Context, profile *aggregate.KYCProfile) error {
+	return nil
+}
+
+func (r *fakeKYCProfileRepo) SaveWithEvents(ctx context.Context, profile *aggregate.KYCProfile) error {
+	return nil
+}
+
+func (r *fakeKYCProfileRepo) FindByUserID(ctx context.Context, userID valueobject.UserID) (*aggregate.KYCProfile, error) {
+	return nil, repository.ErrKYCProfileNotFound
+}
+
+func (r *fakeKYCProfileRepo) FindByVerifiedIDNumber(ctx context.Context, numberHash string) (*aggregate.KYCProfile, error) {
+	if profile, ok := r.byNumber[numberHash]; ok {
+		return profile, nil
+	}
+	return nil, repository.ErrKYCProfileNotFound
+}
+
+type prefixHasher struct{}
+
+func (prefixHasher) Hash(value string) string { return "hash:" + value }
+
+type reverseCipher struct{}
+
+func (reverseCipher) EncryptString(plaintext string) (string, error) {
+	runes := []rune(plaintext)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes), nil
+}
+
+func (c reverseCipher) DecryptString(ciphertext string) (string, error) {
+	return c.EncryptString(ciphertext)
+}
+
+func newKYCTestUser(t *testing.T, dob *time.Time) *aggregate.User {
+	t.Helper()
+	phone, err := valueobject.NewPhoneNumber("+2348012345678")
+	if err != nil {
+		t.Fatalf("NewPhoneNumber() error = %v", err)
+	}
+	name, err := valueobject.NewFullName("Adaeze Okafor")
+	if err != nil {
+		t.Fatalf("NewFullName() error = %v", err)
+	}
+	now := time.Now().UTC()
+	return aggregate.ReconstructUser(
+		valueobject.GenerateUserID(), phone, valueobject.Email{}, name,
+		"", "", "", "", "", dob, "", false, true, aggregate.TierBronze, "", nil, nil, nil, now, now, 1,
+	)
+}
+
+func TestKYCService_Verify(t *testing.T) {
+	dob := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	otherDOB := time.Date(1991, 5, 17, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		result     *VerificationResult
+		wantStatus aggregate.KYCCheckStatus
+		wantLevel  aggregate.KYCLevel
+	}{
+		{
+			"matching record",
+			&VerificationResult{Found: true, FirstName: "ADAEZE", MiddleName: "NGOZI", LastName: "OKAFOR", DateOfBirth: &dob, Phone: "08012345678", Raw: []byte(`{"bvn":"ok"}`)},
+			aggregate.KYCCheckVerified, aggregate.KYCLevelIdentity,
+		},
+		{
+			"different date of birth",
+			&VerificationResult{Found: true, FirstName: "ADAEZE", LastName: "OKAFOR", DateOfBirth: &otherDOB, Phone: "08012345678"},
+			aggregate.KYCCheckMismatch, aggregate.KYCLevelPhone,
+		},
+		{
+			"different name",
+			&VerificationResult{Found: true, FirstName: "TUNDE", LastName: "BAKARE", DateOfBirth: &dob, Phone: "08012345678"},
+			aggregate.KYCCheckMismatch, aggregate.KYCLevelPhone,
+		},
+		{
+			"registered to another phone",
+			&VerificationResult{Found: true, FirstName: "ADAEZE", LastName: "OKAFOR", DateOfBirth: &dob, Phone: "08098765432"},
+			aggregate.KYCCheckMismatch, aggregate.KYCLevelPhone,
+		},
+		{
+			"unknown number",
+			&VerificationResult{},
+			aggregate.KYCCheckNotFound, aggregate.KYCLevelPhone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newKYCTestUser(t, &dob)
+			profile := aggregate.NewKYCProfile(user.ID(), true, time.Now().UTC())
+			svc := NewKYCService(&fakeKYCProfileRepo{}, []IdentityVerifier{&fakeVerifier{name: "fake", result: tt.result}}, reverseCipher{}, prefixHasher{}, 0)
+
+			check, err := svc.Verify(context.Background(), user, profile, VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"})
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if check.Status != tt.wantStatus {
+				t.Errorf("Verify() status = %s, want %s (name score %.2f)", check.Status, tt.wantStatus, check.NameScore)
+			}
+			if profile.Level() != tt.wantLevel {
+				t.Errorf("Level() = %v, want %v", profile.Level(), tt.wantLevel)
+			}
+			if check.MaskedNumber != "*******5678" {
+				t.Errorf("MaskedNumber = %s, want *******5678", check.MaskedNumber)
+			}
+			if check.NumberHash != "hash:bvn:22212345678" {
+				t.Errorf("NumberHash = %s, want the hashed BVN", check.NumberHash)
+			}
+			if len(tt.result.Raw) > 0 && check.SealedResult == string(tt.result.Raw) {
+				t.Error("Verify() should store the provider response sealed")
+			}
+		})
+	}
+}
+
+func TestKYCService_FallsBackWhenProviderUnavailable(t *testing.T) {
+	dob := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	user := newKYCTestUser(t, &dob)
+	profile := aggregate.NewKYCProfile(user.ID(), true, time.Now().UTC())
+
+	down := &fakeVerifier{name: "down", err: ErrProviderUnavailable}
+	up := &fakeVerifier{name: "up", result: &VerificationResult{Found: true, FirstName: "Adaeze", LastName: "Okafor", DateOfBirth: &dob, Phone: "+2348012345678"}}
+	svc := NewKYCService(&fakeKYCProfileRepo{}, []IdentityVerifier{down, up}, reverseCipher{}, prefixHasher{}, 0)
+
+	check, err := svc.Verify(context.Background(), user, profile, VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if check.Provider != "up" || down.calls != 1 {
+		t.Errorf("Verify() provider = %s, down calls = %d, want fallback to up", check.Provider, down.calls)
+	}
+
+	allDown := NewKYCService(&fakeKYCProfileRepo{}, []IdentityVerifier{down}, reverseCipher{}, prefixHasher{}, 0)
+	other := aggregate.NewKYCProfile(user.ID(), true, time.Now().UTC())
+	if _, err := allDown.Verify(context.Background(), user, other, VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"}); !errors.Is(err, ErrProviderUnavailable) {
+		t.Errorf("Verify() all providers down error = %v, want %v", err, ErrProviderUnavailable)
+	}
+	if len(other.Checks()) != 0 {
+		t.Error("Verify() should not record an attempt when no provider answered")
+	}
+
+	if _, err := svc.Verify(context.Background(), user, other, VerificationRequest{CheckType: aggregate.KYCCheckAddress}); !errors.Is(err, aggregate.ErrKYCLevelRequired) {
+		t.Errorf("Verify() address at tier 1 error = %v, want %v", err, aggregate.ErrKYCLevelRequired)
+	}
+}
+
+func TestKYCService_RequiresDateOfBirth(t *testing.T) {
+	user := newKYCTestUser(t, nil)
+	profile := aggregate.NewKYCProfile(user.ID(), true, time.Now().UTC())
+	svc := NewKYCService(&fakeKYCProfileRepo{}, nil, reverseCipher{}, prefixHasher{}, 0)
+
+	if _, err := svc.Verify(context.Background(), user, profile, VerificationRequest{CheckType: aggregate.KYCCheckNIN, IDNumber: "12345678901"}); err != ErrDateOfBirthRequired {
+		t.Errorf("Verify() without date of birth error = %v, want %v", err, ErrDateOfBirthRequired)
+	}
+}
+
+func TestKYCService_RefusesNumberVerifiedElsewhere(t *testing.T) {
+	dob := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	user := newKYCTestUser(t, &dob)
+	profile := aggregate.NewKYCProfile(user.ID(), true, time.Now().UTC())
+
+	owner := aggregate.NewKYCProfile(valueobject.GenerateUserID(), true, time.Now().UTC())
+	repo := &fakeKYCProfileRepo{byNumber: map[string]*aggregate.KYCProfile{"hash:bvn:22212345678": owner}}
+	verifier := &fakeVerifier{name: "fake", result: &VerificationResult{Found: true, FirstName: "Adaeze", LastName: "Okafor", DateOfBirth: &dob, Phone: "08012345678"}}
+	svc := NewKYCService(repo, []IdentityVerifier{verifier}, reverseCipher{}, prefixHasher{}, 0)
+
+	if _, err := svc.Verify(context.Background(), user, profile, VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"}); !errors.Is(err, ErrIDNumberInUse) {
+		t.Errorf("Verify() error = %v, want %v", err, ErrIDNumberInUse)
+	}
+	if verifier.calls != 0 || len(profile.Checks()) != 0 {
+		t.Error("Verify() should refuse a number in use before asking a provider or recording a check")
+	}
+
+	repo.byNumber["hash:bvn:22212345678"] = profile
+	if _, err := svc.Verify(context.Background(), user, profile, VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"}); err != nil {
+		t.Errorf("Verify() of the user's own number error = %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/identity/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Skill taxonomy errors
+var (
+	ErrSkillNotInCatalog   = errors.New("skill is not in the catalog")
+	ErrSkillNameRequired   = errors.New("skill name is required")
+	ErrSkillParentNotFound = errors.New("parent skill not found")
+	ErrSkillTreeTooDeep    = errors.New("skills can only be nested three levels deep")
+)
+
+// MaxSkillDepth is how far below a top-level category a skill can sit,
+// e.g. Home Services > Plumbing > Borehole installation
+const MaxSkillDepth = 2
+
+// SkillTaxonomyService maintains the skill tree that gigs, packages, search and
+// recommendations all resolve skills against
+type SkillTaxonomyService struct {
+	skillRepo repository.SkillRepository
+}
+
+// NewSkillTaxonomyService creates a new skill taxonomy service
+func NewSkillTaxonomyService(skillRepo repository.SkillRepository) *SkillTaxonomyService {
+	return &SkillTaxonomyService{skillRepo: skillRepo}
+}
+
+// CreateSkill adds a skill to the tree. A nil parent makes it a top-level category.
+func (s *SkillTaxonomyService) CreateSkill(ctx context.Context, name string, parentID *valueobject.SkillID, description, icon string) (*repository.Skill, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrSkillNameRequired
+	}
+
+	now := time.Now().UTC()
+	id := valueobject.GenerateSkillID().String()
+	skill := &repository.Skill{
+		ID:          id,
+		Path:        []string{id},
+		Name:        name,
+		Category:    name,
+		Description: strings.TrimSpace(description),
+		Icon:        icon,
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if parentID != nil {
+		parent, err := s.Lookup(ctx, *parentID)
+		if err != nil {
+			return nil, ErrSkillParentNotFound
+		}
+		if parent.Depth() >= MaxSkillDepth {
+			return nil, ErrSkillTreeTooDeep
+		}
+		skill.ParentID = parent.ID
+		skill.Path = append(append([]string{}, parent.Path...), id)
+		skill.Category = parent.Category
+	}
+
+	if err := s.skillRepo.Save(ctx, skill); err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+// Lookup returns an active skill from the catalog
+func (s *SkillTaxonomyService) Lookup(ctx context.Context, skillID valueobject.SkillID) (*repository.Skill, error) {
+	skill, err := s.skillRepo.FindByID(ctx, skillID)
+	if err != nil || !skill.IsActive {
+		return nil, ErrSkillNotInCatalog
+	}
+	return skill, nil
+}
+
+// SkillCategory returns the top-level category a skill is filed under
+func (s *SkillTaxonomyService) SkillCategory(ctx context.Context, skillID valueobject.SkillID) (string, error) {
+	skill, err := s.Lookup(ctx, skillID)
+	if err != nil {
+		return "", err
+	}
+	return skill.Category, nil
+}
+
+// ExpandSkill returns the skill and every active skill beneath it, so a search
+// for Plumbing also finds gigs tagged Borehole installation
+func (s *SkillTaxonomyService) ExpandSkill(ctx context.Context, skillID valueobject.SkillID) ([]valueobject.SkillID, error) {
+	if _, err := s.Lookup(ctx, skillID); err != nil {
+		return nil, err
+	}
+	return s.skillRepo.FindDescendantIDs(ctx, skillID)
+}
@@ -0,0 +1,130 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Auto-debit errors
+var (
+	ErrCircleClosed           = errors.New("circle is completed or cancelled")
+	ErrContributionNotPending = errors.New("contribution is not pending")
+)
+
+// AutoDebitRetryInterval is how often a failed auto-debit is retried within the grace period
+const AutoDebitRetryInterval = 6 * time.Hour
+
+// SetAutoDebit opts a member in to or out of having contributions taken from their wallet on each due date
+func (c *Circle) SetAutoDebit(userID valueobject.UserID, enabled bool) error {
+	if c.status == CircleStatusCompleted || c.status == CircleStatusCancelled {
+		return ErrCircleClosed
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil || !member.IsActive() {
+		return ErrNotMember
+	}
+
+	if member.HasAutoDebit() == enabled {
+		return nil
+	}
+
+	member.SetAutoDebit(enabled)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewAutoDebitMandateChanged(
+		c.id.String(),
+		member.ID().String(),
+		userID.String(),
+		enabled,
+	))
+
+	return nil
+}
+
+// DueAutoDebits returns this round's contributions to take from members with a mandate:
+// due by asOf and still within the grace period
+func (c *Circle) DueAutoDebits(asOf time.Time) []*Contribution {
+	due := make([]*Contribution, 0)
+	if !c.status.IsActive() {
+		return due
+	}
+
+	for _, cont := range c.contributions {
+		if cont.Round() != c.currentRound || !cont.IsPending() || cont.DueDate().After(asOf) {
+			continue
+		}
+		if cont.IsPastGrace(asOf, c.GracePeriod()) {
+			continue
+		}
+
+		member := c.FindMemberByID(cont.MemberID())
+		if member != nil && member.HasAutoDebit() {
+			due = append(due, cont)
+		}
+	}
+	return due
+}
+
+// AmountDue is what a member must pay to settle a pending contribution, including any late fee
+func (c *Circle) AmountDue(cont *Contribution) valueobject.Money {
+	if !cont.IsOverdue() {
+		return cont.Amount()
+	}
+	return cont.Amount().MustAdd(valueobject.MustNewMoney(c.lateFee(cont.Amount().Amount()), cont.Amount().Currency()))
+}
+
+// RecordAutoDebitFailure notes a failed attempt to take a contribution from the member's wallet.
+// The member and the circle's admins are told, and whether another attempt fits in the grace period.
+func (c *Circle) RecordAutoDebitFailure(contributionID valueobject.ContributionID, reason string, asOf time.Time) error {
+	var contribution *Contribution
+	for _, cont := range c.contributions {
+		if cont.ID().Equals(contributionID) {
+			contribution = cont
+			break
+		}
+	}
+	if contribution == nil || !contribution.IsPending() {
+		return ErrContributionNotPending
+	}
+
+	member := c.FindMemberByID(contribution.MemberID())
+	if member == nil {
+		return ErrNotMember
+	}
+
+	contribution.RecordDebitAttempt()
+	c.updatedAt = time.Now().UTC()
+
+	graceEndsAt := contribution.DueDate().Add(c.GracePeriod())
+
+	c.RecordEvent(event.NewAutoDebitFailed(
+		c.id.String(),
+		contribution.ID().String(),
+		member.ID().String(),
+		member.UserID().String(),
+		c.AdminUserIDs(),
+		contribution.Round(),
+		c.AmountDue(contribution).Amount(),
+		contribution.DebitAttempts(),
+		reason,
+		graceEndsAt,
+		asOf.Add(AutoDebitRetryInterval).After(graceEndsAt),
+	))
+
+	return nil
+}
+
+// AdminUserIDs returns the users who administer the circle
+func (c *Circle) AdminUserIDs() []string {
+	admins := make([]string, 0)
+	for _, m := range c.members {
+		if m.IsAdmin() && m.IsActive() {
+			admins = append(admins, m.UserID().String())
+		}
+	}
+	return admins
+}
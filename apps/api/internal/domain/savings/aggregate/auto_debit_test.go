@@ -0,0 +1,69 @@
+package aggregate
+
+import (
+	"testing"
+
+	"hustlex/internal/domain/savings/event"
+)
+
+func TestCircle_DueAutoDebitsOnlyForMandatedMembers(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	users = fillCircle(t, circle, users)
+
+	if err := circle.SetAutoDebit(users[1], true); err != nil {
+		t.Fatalf("SetAutoDebit() error = %v", err)
+	}
+	if _, ok := findEvent[*event.AutoDebitMandateChanged](circle.DomainEvents()); !ok {
+		t.Fatal("no AutoDebitMandateChanged event recorded")
+	}
+
+	mandated := circle.FindMemberByUserID(users[1])
+	dueDate := circle.GetPendingContributions(mandated.ID())[0].DueDate()
+
+	if due := circle.DueAutoDebits(dueDate.Add(-1)); len(due) != 0 {
+		t.Errorf("DueAutoDebits() before due date = %d, want 0", len(due))
+	}
+
+	due := circle.DueAutoDebits(dueDate)
+	if len(due) != 1 || !due[0].MemberID().Equals(mandated.ID()) {
+		t.Fatalf("DueAutoDebits() = %v, want only the mandated member's contribution", due)
+	}
+
+	if due := circle.DueAutoDebits(pastGrace(circle)); len(due) != 0 {
+		t.Errorf("DueAutoDebits() past grace = %d, want 0", len(due))
+	}
+}
+
+func TestCircle_RecordAutoDebitFailureNotifiesAdmins(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	users = fillCircle(t, circle, users)
+
+	if err := circle.SetAutoDebit(users[2], true); err != nil {
+		t.Fatalf("SetAutoDebit() error = %v", err)
+	}
+	circle.DomainEvents()
+
+	cont := circle.GetPendingContributions(circle.FindMemberByUserID(users[2]).ID())[0]
+	if err := circle.RecordAutoDebitFailure(cont.ID(), "insufficient funds", cont.DueDate()); err != nil {
+		t.Fatalf("RecordAutoDebitFailure() error = %v", err)
+	}
+
+	failed, ok := findEvent[*event.AutoDebitFailed](circle.DomainEvents())
+	if !ok {
+		t.Fatal("no AutoDebitFailed event recorded")
+	}
+	if failed.UserID != users[2].String() || failed.Attempt != 1 || failed.FinalAttempt {
+		t.Errorf("AutoDebitFailed = %s attempt %d final %v, want users[2] attempt 1 with retries left", failed.UserID, failed.Attempt, failed.FinalAttempt)
+	}
+	if len(failed.AdminIDs) != 1 || failed.AdminIDs[0] != users[0].String() {
+		t.Errorf("AdminIDs = %v, want the circle creator", failed.AdminIDs)
+	}
+
+	graceEnd := cont.DueDate().Add(circle.GracePeriod())
+	if err := circle.RecordAutoDebitFailure(cont.ID(), "insufficient funds", graceEnd.Add(-AutoDebitRetryInterval/2)); err != nil {
+		t.Fatalf("RecordAutoDebitFailure() error = %v", err)
+	}
+	if failed, _ := findEvent[*event.AutoDebitFailed](circle.DomainEvents()); !failed.FinalAttempt {
+		t.Error("FinalAttempt = false on the last retry in the grace period, want true")
+	}
+}
@@ -23,6 +23,9 @@ var (
 	ErrNoPendingContribution  = errors.New("no pending contribution for this round")
 	ErrNotAdmin               = errors.New("only admin can perform this action")
 	ErrInvalidFrequency       = errors.New("invalid contribution frequency")
+	ErrMemberHasLien          = errors.New("member's payout is pledged as loan collateral")
+	ErrPayoutAlreadyReceived  = errors.New("member has already received their payout")
+	ErrLienNotFound           = errors.New("no lien for this loan on the member's payout")
 )
 
 // CircleType represents the type of savings circle
@@ -110,10 +113,13 @@ const (
 type ContributionStatus string
 
 const (
-	ContributionPending ContributionStatus = "pending"
-	ContributionPaid    ContributionStatus = "paid"
-	ContributionOverdue ContributionStatus = "overdue"
-	ContributionWaived  ContributionStatus = "waived"
+	ContributionPending   ContributionStatus = "pending"
+	ContributionPaid      ContributionStatus = "paid"
+	ContributionOverdue   ContributionStatus = "overdue"
+	ContributionWaived    ContributionStatus = "waived"
+	ContributionMissed    ContributionStatus = "missed"    // Unpaid after the grace period
+	ContributionCovered   ContributionStatus = "covered"   // Missed, paid in from the circle reserve
+	ContributionRecovered ContributionStatus = "recovered" // Missed, later paid off by the member
 )
 
 // Member represents a circle member entity
@@ -126,6 +132,11 @@ type Member struct {
 	totalContrib   int64
 	missedPayments int
 	hasReceived    bool
+	lienLoanID     string
+	arrears        int64
+	defaulted      bool
+	autoDebit      bool
+	refundDue      int64
 	joinedAt       time.Time
 }
 
@@ -151,9 +162,15 @@ func (m *Member) Status() MemberStatus { return m.status }
 func (m *Member) TotalContrib() int64 { return m.totalContrib }
 func (m *Member) MissedPayments() int { return m.missedPayments }
 func (m *Member) HasReceived() bool { return m.hasReceived }
+func (m *Member) LienLoanID() string { return m.lienLoanID }
+func (m *Member) HasLien() bool { return m.lienLoanID != "" }
 func (m *Member) JoinedAt() time.Time { return m.joinedAt }
 func (m *Member) IsAdmin() bool { return m.role == RoleAdmin }
 func (m *Member) IsActive() bool { return m.status == MemberStatusActive }
+func (m *Member) Arrears() int64 { return m.arrears }
+func (m *Member) IsDefaulted() bool { return m.defaulted }
+func (m *Member) HasAutoDebit() bool { return m.autoDebit }
+func (m *Member) RefundDue() int64 { return m.refundDue }
 
 func (m *Member) RecordContribution(amount int64) {
 	m.totalContrib += amount
@@ -167,6 +184,37 @@ func (m *Member) MarkReceived() {
 	m.hasReceived = true
 }
 
+func (m *Member) PlaceLien(loanID string) {
+	m.lienLoanID = loanID
+}
+
+func (m *Member) ReleaseLien() {
+	m.lienLoanID = ""
+}
+
+func (m *Member) AddArrears(amount int64) {
+	m.arrears += amount
+}
+
+func (m *Member) SetAutoDebit(enabled bool) {
+	m.autoDebit = enabled
+}
+
+func (m *Member) SettleArrears(amount int64) {
+	m.arrears -= amount
+	if m.arrears == 0 {
+		m.defaulted = false
+	}
+}
+
+func (m *Member) MarkDefaulted() {
+	m.defaulted = true
+}
+
+func (m *Member) PromoteToAdmin() {
+	m.role = RoleAdmin
+}
+
 func (m *Member) ResetForNewCycle() {
 	m.hasReceived = false
 }
@@ -175,6 +223,10 @@ func (m *Member) Leave() {
 	m.status = MemberStatusLeft
 }
 
+func (m *Member) Remove() {
+	m.status = MemberStatusRemoved
+}
+
 func (m *Member) UpdatePosition(newPosition int) {
 	m.position = newPosition
 }
@@ -190,6 +242,9 @@ type Contribution struct {
 	status        ContributionStatus
 	transactionID *valueobject.TransactionID
 	lateFee       int64
+	recovered     int64
+	shortfallTo   *valueobject.MemberID
+	debitAttempts int
 }
 
 func NewContribution(id valueobject.ContributionID, memberID valueobject.MemberID, round int, amount valueobject.Money, dueDate time.Time) *Contribution {
@@ -214,6 +269,17 @@ func (c *Contribution) Status() ContributionStatus { return c.status }
 func (c *Contribution) TransactionID() *valueobject.TransactionID { return c.transactionID }
 func (c *Contribution) LateFee() int64 { return c.lateFee }
 func (c *Contribution) IsPending() bool { return c.status == ContributionPending }
+func (c *Contribution) Recovered() int64 { return c.recovered }
+func (c *Contribution) ShortfallTo() *valueobject.MemberID { return c.shortfallTo }
+func (c *Contribution) DebitAttempts() int { return c.debitAttempts }
+
+// Outstanding is what a member still owes for a missed contribution
+func (c *Contribution) Outstanding() int64 {
+	if c.status != ContributionMissed && c.status != ContributionCovered {
+		return 0
+	}
+	return c.amount.Amount() - c.recovered
+}
 
 func (c *Contribution) MarkPaid(transactionID valueobject.TransactionID, lateFee int64) {
 	now := time.Now().UTC()
@@ -223,10 +289,38 @@ func (c *Contribution) MarkPaid(transactionID valueobject.TransactionID, lateFee
 	c.lateFee = lateFee
 }
 
+func (c *Contribution) Waive() {
+	c.status = ContributionWaived
+}
+
+func (c *Contribution) RecordDebitAttempt() {
+	c.debitAttempts++
+}
+
+func (c *Contribution) MarkMissed() {
+	c.status = ContributionMissed
+}
+
+func (c *Contribution) MarkCovered() {
+	c.status = ContributionCovered
+}
+
+func (c *Contribution) Recover(amount int64) {
+	c.recovered += amount
+	if c.recovered == c.amount.Amount() {
+		c.status = ContributionRecovered
+	}
+}
+
 func (c *Contribution) IsOverdue() bool {
 	return c.status == ContributionPending && time.Now().After(c.dueDate)
 }
 
+// IsPastGrace reports whether a contribution is still unpaid once the grace period has run out
+func (c *Contribution) IsPastGrace(asOf time.Time, grace time.Duration) bool {
+	return c.status == ContributionPending && asOf.After(c.dueDate.Add(grace))
+}
+
 // Circle is the aggregate root for savings circles
 type Circle struct {
 	sharedevent.AggregateRoot
@@ -250,6 +344,25 @@ type Circle struct {
 	nextPayoutDate  *time.Time
 	members         []*Member
 	contributions   []*Contribution
+	payoutOrder     PayoutOrder
+	drawSeed        string
+	bids            []*PayoutBid
+	positionSwaps   []*PositionSwap
+	targetAmount    int64
+	targetDate      *time.Time
+	targetPayout    TargetPayoutMode
+	targetReached   bool
+	withdrawals     []*EmergencyWithdrawal
+	missedPolicy    MissedContributionPolicy
+	gracePeriodDays int
+	reserveBalance  int64
+	circleRules     CircleRules
+	votingPolicies  map[ProposalAction]VotingPolicy
+	proposals       []*Proposal
+	location        string
+	plannedStart    *time.Time
+	joinApproval    bool
+	joinRequests    []*JoinRequest
 	createdBy       valueobject.UserID
 	createdAt       time.Time
 	updatedAt       time.Time
@@ -288,6 +401,16 @@ func NewCircle(
 		rules:           make([]string, 0),
 		members:         make([]*Member, 0),
 		contributions:   make([]*Contribution, 0),
+		payoutOrder:     PayoutOrderFixed,
+		bids:            make([]*PayoutBid, 0),
+		positionSwaps:   make([]*PositionSwap, 0),
+		withdrawals:     make([]*EmergencyWithdrawal, 0),
+		missedPolicy:    MissedPolicyShortPayout,
+		gracePeriodDays: DefaultGracePeriodDays,
+		circleRules:     DefaultCircleRules(),
+		votingPolicies:  make(map[ProposalAction]VotingPolicy),
+		proposals:       make([]*Proposal, 0),
+		joinRequests:    make([]*JoinRequest, 0),
 		createdBy:       creatorID,
 		createdAt:       time.Now().UTC(),
 		updatedAt:       time.Now().UTC(),
@@ -404,10 +527,15 @@ func (c *Circle) RemoveMember(userID valueobject.UserID) error {
 		return ErrCannotLeaveActiveCircle
 	}
 
-	if member.IsAdmin() {
+	// The last admin can only leave if someone is left to take over
+	if member.IsAdmin() && c.CurrentMembers() == 1 {
 		return ErrAdminCannotLeave
 	}
 
+	if member.HasLien() {
+		return ErrMemberHasLien
+	}
+
 	member.Leave()
 	c.reorderPositions()
 	c.updatedAt = time.Now().UTC()
@@ -418,6 +546,103 @@ func (c *Circle) RemoveMember(userID valueobject.UserID) error {
 		userID.String(),
 	))
 
+	if member.IsAdmin() {
+		c.handOverAdmin(member)
+	}
+
+	return nil
+}
+
+// ExpectedPayout returns the pool a member receives when their round completes
+func (c *Circle) ExpectedPayout() valueobject.Money {
+	amount := c.contributionAmt.Amount() * int64(c.CurrentMembers())
+	return valueobject.MustNewMoney(amount, c.contributionAmt.Currency())
+}
+
+// PlaceLien pledges a member's upcoming payout as collateral for a loan
+func (c *Circle) PlaceLien(userID valueobject.UserID, loanID string) error {
+	if c.status != CircleStatusRecruiting && c.status != CircleStatusActive {
+		return ErrCircleNotActive
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return ErrNotMember
+	}
+
+	if member.HasReceived() {
+		return ErrPayoutAlreadyReceived
+	}
+
+	if member.HasLien() {
+		return ErrMemberHasLien
+	}
+
+	member.PlaceLien(loanID)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPayoutLienPlaced(
+		c.id.String(),
+		member.ID().String(),
+		userID.String(),
+		loanID,
+	))
+
+	return nil
+}
+
+// ReleaseLien lifts a loan's lien from a member's payout
+func (c *Circle) ReleaseLien(userID valueobject.UserID, loanID string) error {
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return ErrNotMember
+	}
+
+	if member.LienLoanID() != loanID {
+		return ErrLienNotFound
+	}
+
+	member.ReleaseLien()
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPayoutLienReleased(
+		c.id.String(),
+		member.ID().String(),
+		userID.String(),
+		loanID,
+	))
+
+	return nil
+}
+
+// SettlePayoutLien records the part of a member's payout paid to the loan holding a lien on it
+func (c *Circle) SettlePayoutLien(userID valueobject.UserID, loanID string, round int, amount valueobject.Money, reference string, transactionID valueobject.TransactionID) error {
+	member := c.FindPayee(userID)
+	if member == nil {
+		return ErrNotMember
+	}
+
+	if member.LienLoanID() != loanID {
+		return ErrLienNotFound
+	}
+
+	if !amount.IsPositive() {
+		return ErrInvalidLienAmount
+	}
+
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPayoutLienSettled(
+		c.id.String(),
+		member.ID().String(),
+		userID.String(),
+		loanID,
+		round,
+		amount.Amount(),
+		reference,
+		transactionID.String(),
+	))
+
 	return nil
 }
 
@@ -441,6 +666,10 @@ func (c *Circle) Start() error {
 		return ErrMinimumMembers
 	}
 
+	if c.circleType == CircleTypeFixedTarget && c.targetDate == nil {
+		return ErrTargetNotSet
+	}
+
 	now := time.Now().UTC()
 	c.status = CircleStatusActive
 	c.startDate = &now
@@ -451,6 +680,7 @@ func (c *Circle) Start() error {
 	c.scheduleContributions()
 
 	c.RecordEvent(event.NewCircleStarted(c.id.String()))
+	c.lapseJoinRequests("circle has started")
 
 	return nil
 }
@@ -492,22 +722,28 @@ func (c *Circle) RecordContribution(memberID valueobject.MemberID, transactionID
 		return nil, ErrNoPendingContribution
 	}
 
-	// Calculate late fee (5% if overdue)
+	// Calculate late fee under the circle's rules if overdue
+	amount := contribution.Amount().Amount()
 	var lateFee int64 = 0
 	if contribution.IsOverdue() {
-		lateFee = c.contributionAmt.Amount() / 20
+		lateFee = c.lateFee(amount)
 	}
 
 	contribution.MarkPaid(transactionID, lateFee)
 
-	// Update pool balance
-	c.poolBalance += c.contributionAmt.Amount() + lateFee
-	c.totalSaved += c.contributionAmt.Amount() + lateFee
+	// Update pool balance. Circles that cover missed contributions build their reserve from late fees.
+	if c.missedPolicy == MissedPolicyCoverFromReserve {
+		c.poolBalance += amount
+		c.reserveBalance += lateFee
+	} else {
+		c.poolBalance += amount + lateFee
+	}
+	c.totalSaved += amount + lateFee
 
 	// Update member stats
 	member := c.FindMemberByID(memberID)
 	if member != nil {
-		member.RecordContribution(c.contributionAmt.Amount() + lateFee)
+		member.RecordContribution(amount + lateFee)
 	}
 
 	c.updatedAt = time.Now().UTC()
@@ -517,10 +753,12 @@ func (c *Circle) RecordContribution(memberID valueobject.MemberID, transactionID
 		contribution.ID().String(),
 		memberID.String(),
 		c.currentRound,
-		c.contributionAmt.Amount(),
+		amount,
 		lateFee,
 	))
 
+	c.checkTargetReached()
+
 	// Check if round is complete
 	if c.isRoundComplete() {
 		c.completeRound()
@@ -538,34 +776,50 @@ func (c *Circle) isRoundComplete() bool {
 	return true
 }
 
+// completeRound closes the round. Rotational circles pay this round's recipient;
+// fixed-target and emergency circles keep pooling and distribute the fund after the last round.
 func (c *Circle) completeRound() {
-	// Find recipient for this round
-	recipient := c.FindMemberByPosition(c.currentRound)
-	if recipient != nil {
-		recipient.MarkReceived()
-
-		c.RecordEvent(event.NewPayoutTriggered(
-			c.id.String(),
-			recipient.ID().String(),
-			recipient.UserID().String(),
-			c.currentRound,
-			c.poolBalance,
-		))
+	round := c.currentRound
+
+	if c.circleType == CircleTypeRotational {
+		c.refundRemovedMembers(round, int64(c.totalRounds-round+1))
+
+		// Find recipient for this round
+		recipient, payout := c.selectRecipient()
+		if recipient != nil {
+			recipient.MarkReceived()
+			c.recordShortfall(round, recipient)
+
+			c.RecordEvent(event.NewPayoutTriggered(
+				c.id.String(),
+				recipient.ID().String(),
+				recipient.UserID().String(),
+				round,
+				payout,
+				recipient.LienLoanID(),
+			))
+		}
 	}
 
 	c.RecordEvent(event.NewRoundCompleted(
 		c.id.String(),
-		c.currentRound,
-		c.poolBalance,
+		round,
+		c.roundCollected(round),
 	))
 
-	// Reset pool and advance round
-	c.poolBalance = 0
+	if c.circleType == CircleTypeRotational {
+		// Reset pool and bids
+		c.poolBalance = 0
+		c.bids = make([]*PayoutBid, 0)
+	}
+
 	c.currentRound++
 
 	if c.currentRound > c.totalRounds {
-		c.status = CircleStatusCompleted
-		c.RecordEvent(event.NewCircleCompleted(c.id.String(), c.totalRounds, c.totalSaved))
+		if c.circleType != CircleTypeRotational {
+			c.distributeFund(round, DistributionFinalRound)
+		}
+		c.complete()
 	} else {
 		c.scheduleContributions()
 	}
@@ -573,6 +827,23 @@ func (c *Circle) completeRound() {
 	c.updatedAt = time.Now().UTC()
 }
 
+// roundCollected sums what was paid in for a round, including late fees
+func (c *Circle) roundCollected(round int) int64 {
+	var total int64
+	for _, cont := range c.contributions {
+		if cont.Round() == round && (cont.Status() == ContributionPaid || cont.Status() == ContributionCovered) {
+			total += cont.Amount().Amount() + cont.LateFee()
+		}
+	}
+	return total
+}
+
+func (c *Circle) complete() {
+	c.status = CircleStatusCompleted
+	c.releaseReserve("")
+	c.RecordEvent(event.NewCircleCompleted(c.id.String(), c.totalRounds, c.totalSaved))
+}
+
 // Helper methods
 
 func (c *Circle) FindMemberByUserID(userID valueobject.UserID) *Member {
@@ -584,6 +855,19 @@ func (c *Circle) FindMemberByUserID(userID valueobject.UserID) *Member {
 	return nil
 }
 
+// FindPayee finds who a payout is for: an active member, or a removed member still owed a refund
+func (c *Circle) FindPayee(userID valueobject.UserID) *Member {
+	if member := c.FindMemberByUserID(userID); member != nil {
+		return member
+	}
+	for _, m := range c.members {
+		if m.UserID().Equals(userID) && m.Status() == MemberStatusRemoved {
+			return m
+		}
+	}
+	return nil
+}
+
 func (c *Circle) FindMemberByID(memberID valueobject.MemberID) *Member {
 	for _, m := range c.members {
 		if m.ID().Equals(memberID) {
@@ -0,0 +1,332 @@
+package aggregate
+
+import (
+	"testing"
+
+	"hustlex/internal/domain/savings/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// newTestCircle builds a recruiting rotational circle of ₦10,000 contributions.
+// The admin is users[0].
+func newTestCircle(t *testing.T, members int) (*Circle, []valueobject.UserID) {
+	t.Helper()
+
+	users := []valueobject.UserID{valueobject.GenerateUserID()}
+	circle, err := NewCircle(
+		valueobject.GenerateCircleID(), users[0], "Market Ajo", "", CircleTypeRotational,
+		valueobject.MustNewMoney(1000000, valueobject.NGN), FrequencyWeekly, members, members, false, "ABCD2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+	return circle, users
+}
+
+// fillCircle adds members until the circle auto-starts
+func fillCircle(t *testing.T, circle *Circle, users []valueobject.UserID) []valueobject.UserID {
+	t.Helper()
+	for !circle.IsFull() {
+		user := valueobject.GenerateUserID()
+		if _, err := circle.AddMember(user); err != nil {
+			t.Fatalf("AddMember() error = %v", err)
+		}
+		users = append(users, user)
+	}
+	return users
+}
+
+// payRound has every member contribute for the current round
+func payRound(t *testing.T, circle *Circle, users []valueobject.UserID) {
+	t.Helper()
+	for _, user := range users {
+		member := circle.FindMemberByUserID(user)
+		if _, err := circle.RecordContribution(member.ID(), valueobject.GenerateTransactionID()); err != nil {
+			t.Fatalf("RecordContribution() error = %v", err)
+		}
+	}
+}
+
+// lastPayout finds the most recent payout; DomainEvents drains the recorded events
+func lastPayout(t *testing.T, events []sharedevent.DomainEvent) *event.PayoutTriggered {
+	t.Helper()
+	for i := len(events) - 1; i >= 0; i-- {
+		if e, ok := events[i].(*event.PayoutTriggered); ok {
+			return e
+		}
+	}
+	t.Fatal("no PayoutTriggered event recorded")
+	return nil
+}
+
+func TestCircle_FixedOrderPaysByPosition(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	users = fillCircle(t, circle, users)
+
+	if circle.PayoutOrder() != PayoutOrderFixed {
+		t.Fatalf("PayoutOrder() = %s, want fixed", circle.PayoutOrder())
+	}
+
+	payRound(t, circle, users)
+
+	payout := lastPayout(t, circle.DomainEvents())
+	if payout.UserID != users[0].String() || payout.Amount != 3000000 {
+		t.Errorf("round 1 paid %s %d, want admin 3000000", payout.UserID, payout.Amount)
+	}
+}
+
+func TestCircle_SetPayoutOrder(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+
+	if err := circle.SetPayoutOrder(PayoutOrderRandomDraw, ""); err != ErrDrawSeedRequired {
+		t.Errorf("SetPayoutOrder() without seed = %v, want %v", err, ErrDrawSeedRequired)
+	}
+	if err := circle.SetPayoutOrder(PayoutOrder("lottery"), "seed"); err != ErrInvalidPayoutOrder {
+		t.Errorf("SetPayoutOrder() unknown order = %v, want %v", err, ErrInvalidPayoutOrder)
+	}
+
+	fillCircle(t, circle, users)
+	if err := circle.SetPayoutOrder(PayoutOrderAuction, ""); err != ErrAlreadyStarted {
+		t.Errorf("SetPayoutOrder() after start = %v, want %v", err, ErrAlreadyStarted)
+	}
+
+	target, err := NewCircle(
+		valueobject.GenerateCircleID(), valueobject.GenerateUserID(), "Rent", "", CircleTypeFixedTarget,
+		valueobject.MustNewMoney(500000, valueobject.NGN), FrequencyMonthly, 5, 5, false, "RENT2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+	if err := target.SetPayoutOrder(PayoutOrderAuction, ""); err != ErrPayoutOrderNotSupported {
+		t.Errorf("SetPayoutOrder() on fixed target = %v, want %v", err, ErrPayoutOrderNotSupported)
+	}
+}
+
+func TestCircle_RandomDrawIsVerifiable(t *testing.T) {
+	circle, users := newTestCircle(t, 4)
+	if err := circle.SetPayoutOrder(PayoutOrderRandomDraw, "b1946ac92492d2347c6235b4d2611184"); err != nil {
+		t.Fatalf("SetPayoutOrder() error = %v", err)
+	}
+	var commitment string
+	for _, e := range circle.DomainEvents() {
+		if set, ok := e.(*event.PayoutOrderSet); ok {
+			commitment = set.SeedCommitment
+		}
+	}
+	users = fillCircle(t, circle, users)
+
+	paid := make(map[string]bool)
+	seeds := make([]string, 0, 4)
+	for round := 1; round <= 4; round++ {
+		circle.ClearEvents()
+		payRound(t, circle, users)
+
+		events := circle.DomainEvents()
+		var drawn *event.PayoutDrawn
+		for _, e := range events {
+			if d, ok := e.(*event.PayoutDrawn); ok {
+				drawn = d
+			}
+		}
+		if drawn == nil {
+			t.Fatalf("round %d: no PayoutDrawn event", round)
+		}
+
+		// Anyone holding the event can recompute the draw
+		index := DrawIndex(drawn.Seed, drawn.Round, drawn.TransactionIDs, len(drawn.Candidates))
+		if drawn.Candidates[index] != drawn.MemberID {
+			t.Errorf("round %d: recomputed draw picked %s, event says %s", round, drawn.Candidates[index], drawn.MemberID)
+		}
+		if len(drawn.Candidates) != 5-round {
+			t.Errorf("round %d: %d candidates, want %d", round, len(drawn.Candidates), 5-round)
+		}
+		if !VerifyDrawSeed(commitment, drawn.Seed, drawn.Round) {
+			t.Errorf("round %d: revealed seed does not verify against the commitment", round)
+		}
+		// Each round's seed hashes to the one revealed before it, so earlier reveals
+		// only ever expose seeds further down the chain
+		if round > 1 && (drawn.Seed == seeds[round-2] || !VerifyDrawSeed(seeds[round-2], drawn.Seed, 1)) {
+			t.Errorf("round %d: seed is not the next link back up the chain", round)
+		}
+		seeds = append(seeds, drawn.Seed)
+
+		payout := lastPayout(t, events)
+		if paid[payout.UserID] {
+			t.Errorf("round %d: %s paid twice", round, payout.UserID)
+		}
+		paid[payout.UserID] = true
+
+		var recipient *Member
+		for _, m := range circle.Members() {
+			if m.ID().String() == drawn.MemberID {
+				recipient = m
+			}
+		}
+		if recipient.Position() != round {
+			t.Errorf("round %d: recipient position = %d, want %d", round, recipient.Position(), round)
+		}
+	}
+
+	if circle.Status() != CircleStatusCompleted {
+		t.Errorf("Status() = %s, want completed", circle.Status())
+	}
+}
+
+func TestCircle_AuctionPaysHighestBidder(t *testing.T) {
+	circle, users := newTestCircle(t, 4)
+	if err := circle.SetPayoutOrder(PayoutOrderAuction, ""); err != nil {
+		t.Fatalf("SetPayoutOrder() error = %v", err)
+	}
+	users = fillCircle(t, circle, users)
+
+	if _, err := circle.PlaceBid(users[1], 4000000); err != ErrInvalidBidDiscount {
+		t.Errorf("PlaceBid() of whole pool = %v, want %v", err, ErrInvalidBidDiscount)
+	}
+	if _, err := circle.PlaceBid(users[1], 200000); err != nil {
+		t.Fatalf("PlaceBid() error = %v", err)
+	}
+	if _, err := circle.PlaceBid(users[2], 300000); err != nil {
+		t.Fatalf("PlaceBid() error = %v", err)
+	}
+	if _, err := circle.PlaceBid(users[1], 300000); err != nil {
+		t.Fatalf("PlaceBid() raise error = %v", err)
+	}
+	if len(circle.Bids()) != 2 {
+		t.Errorf("Bids() = %d, want 2 after a raise replaces the earlier bid", len(circle.Bids()))
+	}
+
+	payRound(t, circle, users)
+
+	// users[2] bid ₦3,000 first; the later matching bid loses the tie
+	events := circle.DomainEvents()
+	var settled *event.AuctionSettled
+	for _, e := range events {
+		if s, ok := e.(*event.AuctionSettled); ok {
+			settled = s
+		}
+	}
+	if settled == nil {
+		t.Fatal("no AuctionSettled event")
+	}
+	if settled.WinnerUserID != users[2].String() {
+		t.Errorf("winner = %s, want %s", settled.WinnerUserID, users[2])
+	}
+	if settled.DividendPerMember != 100000 || len(settled.DividendRecipients) != 3 {
+		t.Errorf("dividend = %d to %d members, want 100000 to 3", settled.DividendPerMember, len(settled.DividendRecipients))
+	}
+	dividends := 0
+	for _, e := range events {
+		if p, ok := e.(*event.PayoutTriggered); ok && p.Kind == event.PayoutKindAuctionDividend {
+			if p.Amount != 100000 || p.UserID == users[2].String() {
+				t.Errorf("dividend payout = %s %d, want 100000 to a losing member", p.UserID, p.Amount)
+			}
+			dividends++
+		}
+	}
+	if dividends != 3 {
+		t.Errorf("dividend payouts = %d, want 3", dividends)
+	}
+
+	payout := lastPayout(t, events)
+	if payout.UserID != users[2].String() || payout.Amount != 3700000 {
+		t.Errorf("payout = %s %d, want %s 3700000", payout.UserID, payout.Amount, users[2])
+	}
+	if circle.FindMemberByUserID(users[2]).Position() != 1 {
+		t.Errorf("winner position = %d, want 1", circle.FindMemberByUserID(users[2]).Position())
+	}
+	if len(circle.Bids()) != 0 {
+		t.Errorf("Bids() = %d after settlement, want 0", len(circle.Bids()))
+	}
+
+	if _, err := circle.PlaceBid(users[2], 100000); err != ErrPayoutAlreadyReceived {
+		t.Errorf("PlaceBid() by past winner = %v, want %v", err, ErrPayoutAlreadyReceived)
+	}
+}
+
+func TestCircle_AuctionWithoutBidsFallsBackToPosition(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	if err := circle.SetPayoutOrder(PayoutOrderAuction, ""); err != nil {
+		t.Fatalf("SetPayoutOrder() error = %v", err)
+	}
+	users = fillCircle(t, circle, users)
+
+	payRound(t, circle, users)
+
+	payout := lastPayout(t, circle.DomainEvents())
+	if payout.UserID != users[0].String() || payout.Amount != 3000000 {
+		t.Errorf("payout = %s %d, want admin 3000000", payout.UserID, payout.Amount)
+	}
+}
+
+func TestCircle_PositionSwap(t *testing.T) {
+	circle, users := newTestCircle(t, 4)
+	users = fillCircle(t, circle, users)
+
+	if _, err := circle.RequestPositionSwap("swap-1", users[0], users[3]); err != ErrPayoutAlreadyReceived {
+		t.Errorf("RequestPositionSwap() for current recipient = %v, want %v", err, ErrPayoutAlreadyReceived)
+	}
+
+	swap, err := circle.RequestPositionSwap("swap-2", users[3], users[1])
+	if err != nil {
+		t.Fatalf("RequestPositionSwap() error = %v", err)
+	}
+
+	if err := circle.ApprovePositionSwap(swap.ID(), users[0]); err != ErrSwapNotAgreed {
+		t.Errorf("ApprovePositionSwap() before consent = %v, want %v", err, ErrSwapNotAgreed)
+	}
+	if err := circle.RespondToPositionSwap(swap.ID(), users[3], true); err != ErrNotSwapCounterparty {
+		t.Errorf("RespondToPositionSwap() by requester = %v, want %v", err, ErrNotSwapCounterparty)
+	}
+	if err := circle.RespondToPositionSwap(swap.ID(), users[1], true); err != nil {
+		t.Fatalf("RespondToPositionSwap() error = %v", err)
+	}
+	if err := circle.ApprovePositionSwap(swap.ID(), users[0]); err != nil {
+		t.Fatalf("ApprovePositionSwap() error = %v", err)
+	}
+
+	if circle.FindMemberByUserID(users[3]).Position() != 2 || circle.FindMemberByUserID(users[1]).Position() != 4 {
+		t.Errorf("positions after swap = %d, %d; want 2, 4",
+			circle.FindMemberByUserID(users[3]).Position(), circle.FindMemberByUserID(users[1]).Position())
+	}
+	if swap.Status() != SwapApproved {
+		t.Errorf("swap status = %s, want approved", swap.Status())
+	}
+
+	payRound(t, circle, users)
+	payRound(t, circle, users)
+	if payout := lastPayout(t, circle.DomainEvents()); payout.UserID != users[3].String() {
+		t.Errorf("round 2 paid %s, want %s", payout.UserID, users[3])
+	}
+}
+
+func TestCircle_PositionSwapRejected(t *testing.T) {
+	circle, users := newTestCircle(t, 4)
+	users = fillCircle(t, circle, users)
+
+	swap, err := circle.RequestPositionSwap("swap-1", users[2], users[3])
+	if err != nil {
+		t.Fatalf("RequestPositionSwap() error = %v", err)
+	}
+	if err := circle.RejectPositionSwap(swap.ID(), users[0], "members did not confirm in person"); err != nil {
+		t.Fatalf("RejectPositionSwap() error = %v", err)
+	}
+	if err := circle.RespondToPositionSwap(swap.ID(), users[3], true); err != ErrSwapNotPending {
+		t.Errorf("RespondToPositionSwap() after rejection = %v, want %v", err, ErrSwapNotPending)
+	}
+	if circle.FindMemberByUserID(users[2]).Position() != 3 {
+		t.Errorf("position changed after rejection")
+	}
+}
+
+func TestCircle_PositionSwapRequiresFixedOrder(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	if err := circle.SetPayoutOrder(PayoutOrderAuction, ""); err != nil {
+		t.Fatalf("SetPayoutOrder() error = %v", err)
+	}
+	users = fillCircle(t, circle, users)
+
+	if _, err := circle.RequestPositionSwap("swap-1", users[1], users[2]); err != ErrSwapsNotAllowed {
+		t.Errorf("RequestPositionSwap() in auction circle = %v, want %v", err, ErrSwapsNotAllowed)
+	}
+}
@@ -0,0 +1,344 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Emergency fund errors
+var (
+	ErrNotEmergencyCircle       = errors.New("circle is not an emergency fund")
+	ErrAlreadyAdmin             = errors.New("member is already an admin")
+	ErrWithdrawalReasonRequired = errors.New("a reason is required for an emergency withdrawal")
+	ErrInvalidWithdrawalAmount  = errors.New("withdrawal amount must be positive")
+	ErrInsufficientFund         = errors.New("emergency fund does not have enough to cover this withdrawal")
+	ErrWithdrawalOutstanding    = errors.New("member already has an open or unrepaid withdrawal")
+	ErrNoEligibleVoters         = errors.New("circle needs another admin to vote on withdrawals")
+	ErrWithdrawalNotFound       = errors.New("emergency withdrawal not found")
+	ErrWithdrawalNotPending     = errors.New("emergency withdrawal has already been decided")
+	ErrCannotVoteOwnWithdrawal  = errors.New("cannot vote on your own withdrawal")
+	ErrAlreadyVoted             = errors.New("admin has already voted on this withdrawal")
+	ErrNothingToRepay           = errors.New("withdrawal has nothing left to repay")
+	ErrInvalidRepayment         = errors.New("repayment must be positive and no more than the outstanding amount")
+)
+
+// WithdrawalStatus tracks an emergency withdrawal from request to repayment
+type WithdrawalStatus string
+
+const (
+	WithdrawalPending  WithdrawalStatus = "pending"
+	WithdrawalApproved WithdrawalStatus = "approved" // Paid out, being repaid
+	WithdrawalRejected WithdrawalStatus = "rejected"
+	WithdrawalRepaid   WithdrawalStatus = "repaid"
+)
+
+// WithdrawalVote is one admin's vote on a withdrawal
+type WithdrawalVote struct {
+	VoterID valueobject.MemberID
+	Approve bool
+	VotedAt time.Time
+}
+
+// EmergencyWithdrawal is a member's draw on an emergency fund, repaid back into the pool
+type EmergencyWithdrawal struct {
+	id          string
+	memberID    valueobject.MemberID
+	amount      int64
+	reason      string
+	status      WithdrawalStatus
+	quorum      int
+	eligible    int
+	votes       []WithdrawalVote
+	repaid      int64
+	requestedAt time.Time
+	decidedAt   *time.Time
+}
+
+func (w *EmergencyWithdrawal) ID() string                     { return w.id }
+func (w *EmergencyWithdrawal) MemberID() valueobject.MemberID { return w.memberID }
+func (w *EmergencyWithdrawal) Amount() int64                  { return w.amount }
+func (w *EmergencyWithdrawal) Reason() string                 { return w.reason }
+func (w *EmergencyWithdrawal) Status() WithdrawalStatus       { return w.status }
+func (w *EmergencyWithdrawal) Quorum() int                    { return w.quorum }
+func (w *EmergencyWithdrawal) Votes() []WithdrawalVote        { return w.votes }
+func (w *EmergencyWithdrawal) Repaid() int64                  { return w.repaid }
+func (w *EmergencyWithdrawal) RequestedAt() time.Time         { return w.requestedAt }
+func (w *EmergencyWithdrawal) DecidedAt() *time.Time          { return w.decidedAt }
+
+// Outstanding is what has been paid out and not yet repaid
+func (w *EmergencyWithdrawal) Outstanding() int64 {
+	if w.status != WithdrawalApproved {
+		return 0
+	}
+	return w.amount - w.repaid
+}
+
+// IsOpen reports whether the withdrawal is awaiting a vote or repayment
+func (w *EmergencyWithdrawal) IsOpen() bool {
+	return w.status == WithdrawalPending || w.status == WithdrawalApproved
+}
+
+func (w *EmergencyWithdrawal) tally() (approvals, rejections int) {
+	for _, v := range w.votes {
+		if v.Approve {
+			approvals++
+		} else {
+			rejections++
+		}
+	}
+	return approvals, rejections
+}
+
+func (w *EmergencyWithdrawal) hasVoted(memberID valueobject.MemberID) bool {
+	for _, v := range w.votes {
+		if v.VoterID.Equals(memberID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Getters
+func (c *Circle) Withdrawals() []*EmergencyWithdrawal { return c.withdrawals }
+
+// AppointAdmin makes a member an admin, e.g. to form a quorum for emergency withdrawals.
+// The caller must have checked the appointer is an admin.
+func (c *Circle) AppointAdmin(userID valueobject.UserID, appointedBy valueobject.UserID) error {
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return ErrNotMember
+	}
+	if member.IsAdmin() {
+		return ErrAlreadyAdmin
+	}
+
+	member.PromoteToAdmin()
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewCircleAdminAppointed(
+		c.id.String(),
+		member.ID().String(),
+		userID.String(),
+		appointedBy.String(),
+	))
+
+	return nil
+}
+
+// RequestEmergencyWithdrawal asks the admins to release money from the fund.
+// A majority of the admins other than the requester must approve.
+func (c *Circle) RequestEmergencyWithdrawal(withdrawalID string, userID valueobject.UserID, amount int64, reason string) (*EmergencyWithdrawal, error) {
+	if c.circleType != CircleTypeEmergency {
+		return nil, ErrNotEmergencyCircle
+	}
+	if !c.status.IsActive() {
+		return nil, ErrCircleNotActive
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return nil, ErrNotMember
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrWithdrawalReasonRequired
+	}
+	if amount <= 0 {
+		return nil, ErrInvalidWithdrawalAmount
+	}
+	if amount > c.poolBalance {
+		return nil, ErrInsufficientFund
+	}
+
+	for _, w := range c.withdrawals {
+		if w.memberID.Equals(member.ID()) && w.IsOpen() {
+			return nil, ErrWithdrawalOutstanding
+		}
+	}
+
+	eligible := 0
+	for _, m := range c.activeMembers() {
+		if m.IsAdmin() && !m.ID().Equals(member.ID()) {
+			eligible++
+		}
+	}
+	if eligible == 0 {
+		return nil, ErrNoEligibleVoters
+	}
+
+	withdrawal := &EmergencyWithdrawal{
+		id:          withdrawalID,
+		memberID:    member.ID(),
+		amount:      amount,
+		reason:      reason,
+		status:      WithdrawalPending,
+		quorum:      eligible/2 + 1,
+		eligible:    eligible,
+		votes:       make([]WithdrawalVote, 0),
+		requestedAt: time.Now().UTC(),
+	}
+	c.withdrawals = append(c.withdrawals, withdrawal)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewEmergencyWithdrawalRequested(
+		c.id.String(),
+		withdrawalID,
+		member.ID().String(),
+		userID.String(),
+		amount,
+		reason,
+		withdrawal.quorum,
+	))
+
+	return withdrawal, nil
+}
+
+// VoteOnWithdrawal records an admin's vote. The withdrawal is paid out from the pool to the
+// requester's wallet as soon as the quorum approves, and rejected once a quorum can no
+// longer be reached.
+func (c *Circle) VoteOnWithdrawal(withdrawalID string, voterUserID valueobject.UserID, approve bool) error {
+	withdrawal := c.FindWithdrawal(withdrawalID)
+	if withdrawal == nil {
+		return ErrWithdrawalNotFound
+	}
+	if withdrawal.status != WithdrawalPending {
+		return ErrWithdrawalNotPending
+	}
+
+	voter := c.FindMemberByUserID(voterUserID)
+	if voter == nil || !voter.IsAdmin() {
+		return ErrNotAdmin
+	}
+	if voter.ID().Equals(withdrawal.memberID) {
+		return ErrCannotVoteOwnWithdrawal
+	}
+	if withdrawal.hasVoted(voter.ID()) {
+		return ErrAlreadyVoted
+	}
+
+	approvals, rejections := withdrawal.tally()
+	if approve {
+		approvals++
+	} else {
+		rejections++
+	}
+
+	// The deciding approval must still be covered by the fund
+	if approvals >= withdrawal.quorum && withdrawal.amount > c.poolBalance {
+		return ErrInsufficientFund
+	}
+
+	now := time.Now().UTC()
+	withdrawal.votes = append(withdrawal.votes, WithdrawalVote{VoterID: voter.ID(), Approve: approve, VotedAt: now})
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewEmergencyWithdrawalVoted(c.id.String(), withdrawalID, voter.ID().String(), approve))
+
+	requester := c.FindMemberByID(withdrawal.memberID)
+
+	switch {
+	case approvals >= withdrawal.quorum:
+		withdrawal.status = WithdrawalApproved
+		withdrawal.decidedAt = &now
+		c.poolBalance -= withdrawal.amount
+
+		c.RecordEvent(event.NewEmergencyWithdrawalApproved(
+			c.id.String(),
+			withdrawalID,
+			requester.ID().String(),
+			requester.UserID().String(),
+			withdrawal.amount,
+		))
+		c.RecordEvent(event.NewPayoutTriggeredOfKind(
+			c.id.String(),
+			requester.ID().String(),
+			requester.UserID().String(),
+			c.currentRound,
+			withdrawal.amount,
+			event.PayoutKindEmergencyWithdrawal,
+			withdrawalID,
+		))
+
+	case rejections > withdrawal.eligible-withdrawal.quorum:
+		withdrawal.status = WithdrawalRejected
+		withdrawal.decidedAt = &now
+
+		c.RecordEvent(event.NewEmergencyWithdrawalRejected(
+			c.id.String(),
+			withdrawalID,
+			requester.ID().String(),
+			requester.UserID().String(),
+		))
+	}
+
+	return nil
+}
+
+// RepayWithdrawal pays part or all of an approved withdrawal back into the fund
+func (c *Circle) RepayWithdrawal(withdrawalID string, userID valueobject.UserID, amount int64, transactionID valueobject.TransactionID) error {
+	if !c.status.IsActive() {
+		return ErrCircleNotActive
+	}
+
+	withdrawal := c.FindWithdrawal(withdrawalID)
+	if withdrawal == nil {
+		return ErrWithdrawalNotFound
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil || !member.ID().Equals(withdrawal.memberID) {
+		return ErrNotMember
+	}
+
+	outstanding := withdrawal.Outstanding()
+	if outstanding == 0 {
+		return ErrNothingToRepay
+	}
+	if amount <= 0 || amount > outstanding {
+		return ErrInvalidRepayment
+	}
+
+	withdrawal.repaid += amount
+	if withdrawal.repaid == withdrawal.amount {
+		withdrawal.status = WithdrawalRepaid
+	}
+	c.poolBalance += amount
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewEmergencyWithdrawalRepaid(
+		c.id.String(),
+		withdrawalID,
+		member.ID().String(),
+		userID.String(),
+		transactionID.String(),
+		amount,
+		withdrawal.amount-withdrawal.repaid,
+	))
+
+	return nil
+}
+
+func (c *Circle) FindWithdrawal(withdrawalID string) *EmergencyWithdrawal {
+	for _, w := range c.withdrawals {
+		if w.id == withdrawalID {
+			return w
+		}
+	}
+	return nil
+}
+
+// outstandingWithdrawals sums what a member has drawn and not yet repaid
+func (c *Circle) outstandingWithdrawals(memberID valueobject.MemberID) int64 {
+	var total int64
+	for _, w := range c.withdrawals {
+		if w.memberID.Equals(memberID) {
+			total += w.Outstanding()
+		}
+	}
+	return total
+}
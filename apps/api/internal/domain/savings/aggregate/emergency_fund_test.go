@@ -0,0 +1,147 @@
+package aggregate
+
+import (
+	"testing"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// newEmergencyCircle builds an active three-member emergency fund where
+// users[0] and users[1] are admins and round 1 has been paid in
+func newEmergencyCircle(t *testing.T) (*Circle, []valueobject.UserID) {
+	t.Helper()
+
+	users := []valueobject.UserID{valueobject.GenerateUserID()}
+	circle, err := NewCircle(
+		valueobject.GenerateCircleID(), users[0], "Family Fund", "", CircleTypeEmergency,
+		valueobject.MustNewMoney(1000000, valueobject.NGN), FrequencyMonthly, 3, 3, true, "FAMI2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+	users = fillCircle(t, circle, users)
+
+	if err := circle.AppointAdmin(users[1], users[0]); err != nil {
+		t.Fatalf("AppointAdmin() error = %v", err)
+	}
+	payRound(t, circle, users)
+
+	return circle, users
+}
+
+func TestCircle_EmergencyWithdrawalLifecycle(t *testing.T) {
+	circle, users := newEmergencyCircle(t)
+
+	if _, err := circle.RequestEmergencyWithdrawal("w-1", users[2], 4000000, "hospital bill"); err != ErrInsufficientFund {
+		t.Errorf("RequestEmergencyWithdrawal() above fund = %v, want %v", err, ErrInsufficientFund)
+	}
+
+	withdrawal, err := circle.RequestEmergencyWithdrawal("w-1", users[2], 1500000, "hospital bill")
+	if err != nil {
+		t.Fatalf("RequestEmergencyWithdrawal() error = %v", err)
+	}
+	if withdrawal.Quorum() != 2 {
+		t.Errorf("Quorum() = %d, want 2 of 2 admins", withdrawal.Quorum())
+	}
+
+	if err := circle.VoteOnWithdrawal("w-1", users[2], true); err != ErrNotAdmin {
+		t.Errorf("VoteOnWithdrawal() by member = %v, want %v", err, ErrNotAdmin)
+	}
+	if err := circle.VoteOnWithdrawal("w-1", users[0], true); err != nil {
+		t.Fatalf("VoteOnWithdrawal() error = %v", err)
+	}
+	if err := circle.VoteOnWithdrawal("w-1", users[0], true); err != ErrAlreadyVoted {
+		t.Errorf("second vote = %v, want %v", err, ErrAlreadyVoted)
+	}
+	if withdrawal.Status() != WithdrawalPending {
+		t.Fatalf("Status() after one vote = %s, want pending", withdrawal.Status())
+	}
+
+	if err := circle.VoteOnWithdrawal("w-1", users[1], true); err != nil {
+		t.Fatalf("VoteOnWithdrawal() error = %v", err)
+	}
+	if withdrawal.Status() != WithdrawalApproved || circle.PoolBalance() != 1500000 {
+		t.Fatalf("after quorum: status %s, pool %d; want approved, 1500000", withdrawal.Status(), circle.PoolBalance())
+	}
+	payout := lastPayout(t, circle.DomainEvents())
+	if payout.Kind != event.PayoutKindEmergencyWithdrawal || payout.SourceID != "w-1" || payout.UserID != users[2].String() || payout.Amount != 1500000 {
+		t.Errorf("payout = %s %s %d, want the approved withdrawal to %s", payout.Kind, payout.UserID, payout.Amount, users[2])
+	}
+
+	if err := circle.RepayWithdrawal("w-1", users[2], 2000000, valueobject.GenerateTransactionID()); err != ErrInvalidRepayment {
+		t.Errorf("RepayWithdrawal() above outstanding = %v, want %v", err, ErrInvalidRepayment)
+	}
+	if err := circle.RepayWithdrawal("w-1", users[2], 500000, valueobject.GenerateTransactionID()); err != nil {
+		t.Fatalf("RepayWithdrawal() error = %v", err)
+	}
+	if withdrawal.Outstanding() != 1000000 || circle.PoolBalance() != 2000000 {
+		t.Errorf("after repayment: outstanding %d, pool %d; want 1000000, 2000000", withdrawal.Outstanding(), circle.PoolBalance())
+	}
+
+	if _, err := circle.RequestEmergencyWithdrawal("w-2", users[2], 100000, "rent"); err != ErrWithdrawalOutstanding {
+		t.Errorf("second withdrawal = %v, want %v", err, ErrWithdrawalOutstanding)
+	}
+
+	// The unrepaid ₦10,000 comes out of the borrower's share at the end
+	circle.DomainEvents()
+	payRound(t, circle, users)
+	payRound(t, circle, users)
+
+	want := map[string]int64{users[0].String(): 3000000, users[1].String(): 3000000, users[2].String(): 2000000}
+	var distributed *event.CircleFundDistributed
+	for _, e := range circle.DomainEvents() {
+		if d, ok := e.(*event.CircleFundDistributed); ok {
+			distributed = d
+		}
+	}
+	if distributed == nil {
+		t.Fatal("no CircleFundDistributed event")
+	}
+	for _, share := range distributed.Shares {
+		if share.Amount != want[share.UserID] {
+			t.Errorf("share for %s = %d, want %d", share.UserID, share.Amount, want[share.UserID])
+		}
+	}
+}
+
+func TestCircle_EmergencyWithdrawalRejected(t *testing.T) {
+	circle, users := newEmergencyCircle(t)
+
+	withdrawal, err := circle.RequestEmergencyWithdrawal("w-1", users[2], 1000000, "new phone")
+	if err != nil {
+		t.Fatalf("RequestEmergencyWithdrawal() error = %v", err)
+	}
+	if err := circle.VoteOnWithdrawal("w-1", users[1], false); err != nil {
+		t.Fatalf("VoteOnWithdrawal() error = %v", err)
+	}
+
+	if withdrawal.Status() != WithdrawalRejected {
+		t.Errorf("Status() = %s, want rejected once quorum is out of reach", withdrawal.Status())
+	}
+	if circle.PoolBalance() != 3000000 {
+		t.Errorf("PoolBalance() = %d, want 3000000 untouched", circle.PoolBalance())
+	}
+}
+
+func TestCircle_EmergencyWithdrawalNeedsAnotherAdmin(t *testing.T) {
+	users := []valueobject.UserID{valueobject.GenerateUserID()}
+	circle, err := NewCircle(
+		valueobject.GenerateCircleID(), users[0], "Solo Admin", "", CircleTypeEmergency,
+		valueobject.MustNewMoney(1000000, valueobject.NGN), FrequencyMonthly, 2, 2, true, "SOLO2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+	users = fillCircle(t, circle, users)
+	payRound(t, circle, users)
+
+	if _, err := circle.RequestEmergencyWithdrawal("w-1", users[0], 100000, "car repair"); err != ErrNoEligibleVoters {
+		t.Errorf("RequestEmergencyWithdrawal() by sole admin = %v, want %v", err, ErrNoEligibleVoters)
+	}
+
+	rotational, _ := newTestCircle(t, 2)
+	if _, err := rotational.RequestEmergencyWithdrawal("w-2", users[0], 100000, "car repair"); err != ErrNotEmergencyCircle {
+		t.Errorf("RequestEmergencyWithdrawal() on rotational = %v, want %v", err, ErrNotEmergencyCircle)
+	}
+}
@@ -0,0 +1,115 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Fixed-target errors
+var (
+	ErrNotTargetCircle   = errors.New("circle is not a fixed-target circle")
+	ErrInvalidTarget     = errors.New("target amount must be positive and the date in the future")
+	ErrInvalidPayoutMode = errors.New("invalid target payout mode")
+	ErrTargetNotSet      = errors.New("fixed-target circle needs a target before it can start")
+	ErrTargetNotDue      = errors.New("circle has not reached its target date")
+)
+
+// TargetPayoutMode decides how a fixed-target pool is shared at maturity
+type TargetPayoutMode string
+
+const (
+	TargetPayoutProRata         TargetPayoutMode = "pro_rata"         // Pool split in proportion to contributions
+	TargetPayoutPerContribution TargetPayoutMode = "per_contribution" // Own contributions back, surplus shared equally
+)
+
+func (m TargetPayoutMode) IsValid() bool {
+	return m == TargetPayoutProRata || m == TargetPayoutPerContribution
+}
+
+// Getters
+func (c *Circle) TargetAmount() int64            { return c.targetAmount }
+func (c *Circle) TargetDate() *time.Time         { return c.targetDate }
+func (c *Circle) TargetPayout() TargetPayoutMode { return c.targetPayout }
+func (c *Circle) TargetReached() bool            { return c.targetReached }
+
+// SetSavingsTarget sets the goal a fixed-target circle pools towards and how the
+// pool is shared when the circle matures
+func (c *Circle) SetSavingsTarget(amount valueobject.Money, date time.Time, mode TargetPayoutMode) error {
+	if c.circleType != CircleTypeFixedTarget {
+		return ErrNotTargetCircle
+	}
+	if !c.status.IsRecruiting() {
+		return ErrAlreadyStarted
+	}
+	if !mode.IsValid() {
+		return ErrInvalidPayoutMode
+	}
+	if !amount.IsPositive() || amount.Currency() != c.contributionAmt.Currency() || !date.After(time.Now()) {
+		return ErrInvalidTarget
+	}
+
+	date = date.UTC()
+	c.targetAmount = amount.Amount()
+	c.targetDate = &date
+	c.targetPayout = mode
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewSavingsTargetSet(c.id.String(), c.targetAmount, date, string(mode)))
+
+	return nil
+}
+
+// IsMaturityDue reports whether a fixed-target circle has reached its target date
+func (c *Circle) IsMaturityDue(asOf time.Time) bool {
+	return c.circleType == CircleTypeFixedTarget &&
+		c.status.IsActive() &&
+		c.targetDate != nil &&
+		!asOf.Before(*c.targetDate)
+}
+
+// Mature distributes a fixed-target pool once its target date arrives, even if rounds
+// remain. Contributions still pending are waived.
+func (c *Circle) Mature(asOf time.Time) error {
+	if c.circleType != CircleTypeFixedTarget {
+		return ErrNotTargetCircle
+	}
+	if !c.status.IsActive() {
+		return ErrCircleNotActive
+	}
+	if !c.IsMaturityDue(asOf) {
+		return ErrTargetNotDue
+	}
+
+	for _, cont := range c.contributions {
+		if cont.IsPending() {
+			cont.Waive()
+		}
+	}
+
+	c.distributeFund(c.currentRound, DistributionTargetDate)
+	c.complete()
+	c.updatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// checkTargetReached records the first time the pool reaches its target
+func (c *Circle) checkTargetReached() {
+	if c.circleType != CircleTypeFixedTarget || c.targetReached || c.targetAmount <= 0 {
+		return
+	}
+	if c.poolBalance < c.targetAmount {
+		return
+	}
+
+	c.targetReached = true
+	c.RecordEvent(event.NewSavingsTargetReached(
+		c.id.String(),
+		c.currentRound,
+		c.targetAmount,
+		c.poolBalance,
+	))
+}
@@ -0,0 +1,166 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func newTargetCircle(t *testing.T, members int, mode TargetPayoutMode) (*Circle, []valueobject.UserID, time.Time) {
+	t.Helper()
+
+	users := []valueobject.UserID{valueobject.GenerateUserID()}
+	circle, err := NewCircle(
+		valueobject.GenerateCircleID(), users[0], "December Rice", "", CircleTypeFixedTarget,
+		valueobject.MustNewMoney(1000000, valueobject.NGN), FrequencyMonthly, members, members, false, "RICE2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+
+	targetDate := time.Now().AddDate(0, members, 0)
+	target := valueobject.MustNewMoney(int64(members*members)*1000000, valueobject.NGN)
+	if err := circle.SetSavingsTarget(target, targetDate, mode); err != nil {
+		t.Fatalf("SetSavingsTarget() error = %v", err)
+	}
+
+	return circle, fillCircle(t, circle, users), targetDate
+}
+
+func TestCircle_FixedTargetPoolsUntilFinalRound(t *testing.T) {
+	circle, users, _ := newTargetCircle(t, 3, TargetPayoutProRata)
+
+	payRound(t, circle, users)
+	if circle.PoolBalance() != 3000000 {
+		t.Fatalf("PoolBalance() after round 1 = %d, want 3000000 kept in the pool", circle.PoolBalance())
+	}
+	for _, e := range circle.DomainEvents() {
+		if _, ok := e.(*event.PayoutTriggered); ok {
+			t.Fatal("fixed-target circle paid out before maturity")
+		}
+	}
+
+	payRound(t, circle, users)
+	payRound(t, circle, users)
+
+	var reached bool
+	var distributed *event.CircleFundDistributed
+	payouts := 0
+	for _, e := range circle.DomainEvents() {
+		switch ev := e.(type) {
+		case *event.SavingsTargetReached:
+			reached = true
+		case *event.CircleFundDistributed:
+			distributed = ev
+		case *event.PayoutTriggered:
+			payouts++
+			if ev.Amount != 3000000 {
+				t.Errorf("payout to %s = %d, want 3000000", ev.UserID, ev.Amount)
+			}
+		}
+	}
+
+	if !reached || !circle.TargetReached() {
+		t.Error("SavingsTargetReached not recorded")
+	}
+	if distributed == nil || distributed.Reason != DistributionFinalRound || distributed.PoolBalance != 9000000 {
+		t.Fatalf("CircleFundDistributed = %+v, want final_round of 9000000", distributed)
+	}
+	if payouts != 3 {
+		t.Errorf("%d payouts, want 3", payouts)
+	}
+	if circle.Status() != CircleStatusCompleted || circle.PoolBalance() != 0 {
+		t.Errorf("Status() = %s, PoolBalance() = %d; want completed and empty", circle.Status(), circle.PoolBalance())
+	}
+}
+
+func TestCircle_FixedTargetMaturesAtTargetDate(t *testing.T) {
+	circle, users, targetDate := newTargetCircle(t, 3, TargetPayoutPerContribution)
+	payRound(t, circle, users)
+
+	if err := circle.Mature(time.Now()); err != ErrTargetNotDue {
+		t.Fatalf("Mature() before target date = %v, want %v", err, ErrTargetNotDue)
+	}
+	if circle.IsMaturityDue(targetDate.Add(-time.Minute)) {
+		t.Error("IsMaturityDue() before target date = true")
+	}
+
+	circle.DomainEvents()
+	if err := circle.Mature(targetDate); err != nil {
+		t.Fatalf("Mature() error = %v", err)
+	}
+
+	for _, e := range circle.DomainEvents() {
+		if d, ok := e.(*event.CircleFundDistributed); ok {
+			if d.Reason != DistributionTargetDate || len(d.Shares) != 3 {
+				t.Fatalf("CircleFundDistributed = %+v, want target_date to 3 members", d)
+			}
+			for _, share := range d.Shares {
+				if share.Amount != 1000000 {
+					t.Errorf("share for %s = %d, want 1000000", share.UserID, share.Amount)
+				}
+			}
+		}
+	}
+	for _, cont := range circle.Contributions() {
+		if cont.Round() == 2 && cont.Status() != ContributionWaived {
+			t.Errorf("round 2 contribution status = %s, want waived", cont.Status())
+		}
+	}
+	if circle.Status() != CircleStatusCompleted {
+		t.Errorf("Status() = %s, want completed", circle.Status())
+	}
+}
+
+func TestCircle_FixedTargetNeedsTargetToStart(t *testing.T) {
+	users := []valueobject.UserID{valueobject.GenerateUserID()}
+	circle, err := NewCircle(
+		valueobject.GenerateCircleID(), users[0], "School Fees", "", CircleTypeFixedTarget,
+		valueobject.MustNewMoney(1000000, valueobject.NGN), FrequencyMonthly, 2, 2, false, "FEES2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+
+	if _, err := circle.AddMember(valueobject.GenerateUserID()); err != nil {
+		t.Fatalf("AddMember() error = %v", err)
+	}
+	if circle.Status() != CircleStatusRecruiting {
+		t.Fatalf("Status() = %s, want recruiting until a target is set", circle.Status())
+	}
+	if err := circle.Start(); err != ErrTargetNotSet {
+		t.Errorf("Start() = %v, want %v", err, ErrTargetNotSet)
+	}
+
+	past := time.Now().AddDate(0, -1, 0)
+	if err := circle.SetSavingsTarget(valueobject.MustNewMoney(2000000, valueobject.NGN), past, TargetPayoutProRata); err != ErrInvalidTarget {
+		t.Errorf("SetSavingsTarget() in the past = %v, want %v", err, ErrInvalidTarget)
+	}
+}
+
+func TestSplitProRata(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   int64
+		weights []int64
+		want    []int64
+	}{
+		{"proportional", 900, []int64{100, 200, 300}, []int64{150, 300, 450}},
+		{"remainder to largest", 100, []int64{1, 1, 2}, []int64{25, 25, 50}},
+		{"rounding", 10, []int64{1, 1, 1}, []int64{4, 3, 3}},
+		{"no weights", 10, []int64{0, 0, 0}, []int64{4, 3, 3}},
+		{"large pool", 9e15, []int64{3e15, 6e15}, []int64{3e15, 6e15}},
+	}
+
+	for _, tt := range tests {
+		got := splitProRata(tt.total, tt.weights)
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: splitProRata() = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
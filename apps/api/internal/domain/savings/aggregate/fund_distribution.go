@@ -0,0 +1,175 @@
+package aggregate
+
+import (
+	"math/big"
+	"sort"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Reasons a pooled fund is distributed
+const (
+	DistributionFinalRound = "final_round" // Every round's contributions are in
+	DistributionTargetDate = "target_date" // A fixed-target circle reached its date
+	DistributionCancelled  = "cancelled"   // The members voted to cancel the circle
+	DistributionMemberExit = "member_removed"
+)
+
+// FundShare is what a member would receive if the pooled fund were distributed now
+type FundShare struct {
+	MemberID valueobject.MemberID
+	UserID   valueobject.UserID
+	Amount   int64
+}
+
+// FundShares splits the pool of a fixed-target or emergency circle between active members.
+//
+// Fixed-target circles pay pro-rata to each member's contributions, or return each member's
+// contributions with any surplus (late fees) shared equally. Emergency circles share the
+// fund pro-rata to contributions as if every withdrawal had been repaid, then deduct each
+// member's unrepaid withdrawals from their share.
+func (c *Circle) FundShares() []FundShare {
+	members := c.activeMembers()
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Position() < members[j].Position()
+	})
+
+	principals := make([]int64, len(members))
+	var totalPrincipal int64
+	for i, m := range members {
+		principals[i] = c.principalContributed(m.ID())
+		totalPrincipal += principals[i]
+	}
+
+	var amounts []int64
+	switch {
+	case c.circleType == CircleTypeEmergency:
+		outstanding := make([]int64, len(members))
+		var totalOutstanding int64
+		for i, m := range members {
+			outstanding[i] = c.outstandingWithdrawals(m.ID())
+			totalOutstanding += outstanding[i]
+		}
+
+		// A member who owes more than their share gets nothing, and the rest of
+		// the pool is split in proportion to what each other member is owed
+		claims := splitProRata(c.poolBalance+totalOutstanding, principals)
+		for i := range claims {
+			claims[i] -= outstanding[i]
+			if claims[i] < 0 {
+				claims[i] = 0
+			}
+		}
+		amounts = splitProRata(c.poolBalance, claims)
+
+	case c.targetPayout == TargetPayoutPerContribution && c.poolBalance >= totalPrincipal:
+		amounts = splitEqually(c.poolBalance-totalPrincipal, len(members))
+		for i := range amounts {
+			amounts[i] += principals[i]
+		}
+
+	default:
+		amounts = splitProRata(c.poolBalance, principals)
+	}
+
+	shares := make([]FundShare, len(members))
+	for i, m := range members {
+		shares[i] = FundShare{MemberID: m.ID(), UserID: m.UserID(), Amount: amounts[i]}
+	}
+	return shares
+}
+
+// distributeFund pays the pooled fund out to members
+func (c *Circle) distributeFund(round int, reason string) {
+	pool := c.poolBalance
+	shares := c.FundShares()
+
+	eventShares := make([]event.FundShare, 0, len(shares))
+	for _, share := range shares {
+		if share.Amount <= 0 {
+			continue
+		}
+
+		member := c.FindMemberByID(share.MemberID)
+		member.MarkReceived()
+
+		c.RecordEvent(event.NewPayoutTriggered(
+			c.id.String(),
+			member.ID().String(),
+			member.UserID().String(),
+			round,
+			share.Amount,
+			member.LienLoanID(),
+		))
+
+		eventShares = append(eventShares, event.FundShare{
+			MemberID: share.MemberID.String(),
+			UserID:   share.UserID.String(),
+			Amount:   share.Amount,
+		})
+	}
+
+	c.RecordEvent(event.NewCircleFundDistributed(
+		c.id.String(),
+		c.circleType.String(),
+		reason,
+		pool,
+		eventShares,
+	))
+
+	c.poolBalance = 0
+}
+
+// principalContributed sums a member's paid contributions, excluding late fees
+func (c *Circle) principalContributed(memberID valueobject.MemberID) int64 {
+	var total int64
+	for _, cont := range c.contributions {
+		if cont.MemberID().Equals(memberID) && cont.Status() == ContributionPaid {
+			total += cont.Amount().Amount()
+		}
+	}
+	return total
+}
+
+// splitProRata divides total in proportion to weights. Rounding remainders go to the
+// largest weight. With no weights the total is split equally.
+func splitProRata(total int64, weights []int64) []int64 {
+	var sum int64
+	largest := 0
+	for i, w := range weights {
+		sum += w
+		if w > weights[largest] {
+			largest = i
+		}
+	}
+	if sum <= 0 {
+		return splitEqually(total, len(weights))
+	}
+
+	shares := make([]int64, len(weights))
+	var allocated int64
+	for i, w := range weights {
+		// total*w can overflow int64 for large pools
+		share := new(big.Int).Mul(big.NewInt(total), big.NewInt(w))
+		share.Quo(share, big.NewInt(sum))
+		shares[i] = share.Int64()
+		allocated += shares[i]
+	}
+	shares[largest] += total - allocated
+
+	return shares
+}
+
+// splitEqually divides total evenly; the first share absorbs the remainder
+func splitEqually(total int64, n int) []int64 {
+	shares := make([]int64, n)
+	if n == 0 {
+		return shares
+	}
+	for i := range shares {
+		shares[i] = total / int64(n)
+	}
+	shares[0] += total % int64(n)
+	return shares
+}
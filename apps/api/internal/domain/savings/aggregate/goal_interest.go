@@ -0,0 +1,105 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Interest errors
+var (
+	ErrInterestAlreadyAccrued = errors.New("interest already accrued for this day")
+	ErrInvalidInterest        = errors.New("interest must not be negative and tax must not exceed it")
+	ErrInsufficientAccrual    = errors.New("capitalized interest exceeds accrued interest")
+)
+
+// InterestAccrualScale is the number of accrual units in one kobo.
+// Daily interest is accrued in these sub-kobo units so fractions carry over instead of being lost.
+const InterestAccrualScale = 10000
+
+// AccruedInterest is interest accrued and not yet capitalized, in InterestAccrualScale units
+func (g *SavingsGoal) AccruedInterest() int64 { return g.accruedInterest }
+
+// InterestEarned is the net interest capitalized into the goal so far
+func (g *SavingsGoal) InterestEarned() int64 { return g.interestEarned }
+
+// LastAccruedOn is the last day interest was accrued for
+func (g *SavingsGoal) LastAccruedOn() time.Time { return g.lastAccruedOn }
+
+// InterestPeriodStart is the first day of the interest not yet capitalized
+func (g *SavingsGoal) InterestPeriodStart() time.Time { return g.interestPeriodStart }
+
+// AccrueInterest adds a day's interest, in InterestAccrualScale units. Each day accrues once.
+func (g *SavingsGoal) AccrueInterest(day time.Time, amount int64) error {
+	if !g.IsActive() {
+		return ErrGoalNotActive
+	}
+	if amount < 0 {
+		return ErrInvalidInterest
+	}
+
+	day = truncateToDay(day)
+	if !g.lastAccruedOn.IsZero() && !day.After(g.lastAccruedOn) {
+		return ErrInterestAlreadyAccrued
+	}
+
+	if g.interestPeriodStart.IsZero() {
+		g.interestPeriodStart = day
+	}
+	g.accruedInterest += amount
+	g.lastAccruedOn = day
+	g.updatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// CapitalizeInterest adds accrued interest to the balance, net of withholding tax.
+// Any sub-kobo remainder stays accrued for the next period.
+func (g *SavingsGoal) CapitalizeInterest(gross, tax valueobject.Money, day time.Time) error {
+	if !g.IsActive() {
+		return ErrGoalNotActive
+	}
+	if gross.Currency() != g.balance.Currency() || tax.Currency() != g.balance.Currency() {
+		return ErrInvalidInterest
+	}
+	if gross.Amount() < 0 || tax.Amount() < 0 || tax.GreaterThan(gross) {
+		return ErrInvalidInterest
+	}
+	if gross.Amount()*InterestAccrualScale > g.accruedInterest {
+		return ErrInsufficientAccrual
+	}
+
+	day = truncateToDay(day)
+	periodStart := g.interestPeriodStart
+	if periodStart.IsZero() {
+		periodStart = day
+	}
+
+	net := gross.MustSubtract(tax)
+	g.accruedInterest -= gross.Amount() * InterestAccrualScale
+	g.balance = g.balance.MustAdd(net)
+	g.interestEarned += net.Amount()
+	g.interestPeriodStart = day.AddDate(0, 0, 1)
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewInterestCapitalized(
+		g.id.String(),
+		g.userID.String(),
+		gross.Amount(),
+		tax.Amount(),
+		net.Amount(),
+		g.balance.Amount(),
+		periodStart,
+		day,
+	))
+
+	g.recordProgress()
+	return nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,747 @@
+package aggregate
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Governance errors
+var (
+	ErrInvalidProposalAction   = errors.New("invalid proposal action")
+	ErrInvalidVotingPolicy     = errors.New("quorum and threshold must be between 1 and 100 percent")
+	ErrInvalidCircleRules      = errors.New("invalid circle rules")
+	ErrInvalidContributionAmt  = errors.New("contribution amount must be positive and in the circle's currency")
+	ErrProposalTargetRequired  = errors.New("proposal needs a member to remove")
+	ErrDuplicateProposal       = errors.New("an open proposal already covers this decision")
+	ErrProposalNotFound        = errors.New("proposal not found")
+	ErrProposalClosed          = errors.New("proposal is no longer open for voting")
+	ErrNotEligibleToVote       = errors.New("member is not eligible to vote on this proposal")
+	ErrAlreadyVotedOnProposal  = errors.New("member has already voted on this proposal")
+	ErrCannotRemoveAfterPayout = errors.New("member has received their payout and must keep contributing")
+	ErrLastPayoutRecipient     = errors.New("cannot remove the last member yet to receive a payout")
+	ErrCannotCancelAfterPayout = errors.New("circle cannot be cancelled after a rotational payout")
+)
+
+// ProposalVotingPeriod is how long members have to vote on a proposal
+const ProposalVotingPeriod = 72 * time.Hour
+
+// Circle rule limits
+const (
+	DefaultLateFeeBps = 500
+	MaxLateFeeBps     = 2000
+	MaxMissedLimit    = 12
+)
+
+// Reasons a member is removed from a circle
+const (
+	RemovalByVote         = "vote"
+	RemovalMissedPayments = "missed_payments"
+)
+
+// ProposalAction is a circle decision that needs a vote
+type ProposalAction string
+
+const (
+	ProposalRemoveMember       ProposalAction = "remove_member"
+	ProposalChangeContribution ProposalAction = "change_contribution"
+	ProposalCancelCircle       ProposalAction = "cancel_circle"
+	ProposalChangeRules        ProposalAction = "change_rules"
+)
+
+func (a ProposalAction) IsValid() bool {
+	switch a {
+	case ProposalRemoveMember, ProposalChangeContribution, ProposalCancelCircle, ProposalChangeRules:
+		return true
+	}
+	return false
+}
+
+// ProposalStatus tracks a proposal from opening to decision
+type ProposalStatus string
+
+const (
+	ProposalOpen     ProposalStatus = "open"
+	ProposalPassed   ProposalStatus = "passed"
+	ProposalRejected ProposalStatus = "rejected"
+	ProposalExpired  ProposalStatus = "expired" // Voting closed without a quorum
+	ProposalFailed   ProposalStatus = "failed"  // Passed, but could not be carried out
+)
+
+// VotingPolicy decides when a proposal passes. Quorum is the share of eligible members
+// who must vote; threshold is the share of votes cast that must approve.
+type VotingPolicy struct {
+	QuorumPercent    int
+	ThresholdPercent int
+}
+
+func (p VotingPolicy) IsValid() bool {
+	return p.QuorumPercent >= 1 && p.QuorumPercent <= 100 &&
+		p.ThresholdPercent >= 1 && p.ThresholdPercent <= 100
+}
+
+// DefaultVotingPolicy is the policy for an action until the circle sets its own
+func DefaultVotingPolicy(action ProposalAction) VotingPolicy {
+	switch action {
+	case ProposalRemoveMember:
+		return VotingPolicy{QuorumPercent: 50, ThresholdPercent: 67}
+	case ProposalChangeContribution:
+		return VotingPolicy{QuorumPercent: 60, ThresholdPercent: 75}
+	case ProposalCancelCircle:
+		return VotingPolicy{QuorumPercent: 75, ThresholdPercent: 75}
+	default:
+		return VotingPolicy{QuorumPercent: 50, ThresholdPercent: 60}
+	}
+}
+
+// CircleRules are the rules the circle enforces itself
+type CircleRules struct {
+	MaxMissedPayments int // Missed contributions before a member is removed; 0 never removes
+	LateFeeBps        int // Late fee on an overdue contribution
+}
+
+func (r CircleRules) IsValid() bool {
+	return r.MaxMissedPayments >= 0 && r.MaxMissedPayments <= MaxMissedLimit &&
+		r.LateFeeBps >= 0 && r.LateFeeBps <= MaxLateFeeBps
+}
+
+// DefaultCircleRules charges a 5% late fee and never removes members automatically
+func DefaultCircleRules() CircleRules {
+	return CircleRules{MaxMissedPayments: 0, LateFeeBps: DefaultLateFeeBps}
+}
+
+// ProposalTerms are what a proposal would change. Only the field for its action is used.
+type ProposalTerms struct {
+	TargetUserID *valueobject.UserID
+	Amount       int64
+	Rules        CircleRules
+}
+
+// ProposalVote is one member's vote on a proposal
+type ProposalVote struct {
+	VoterID valueobject.MemberID
+	Approve bool
+	VotedAt time.Time
+}
+
+// Proposal is a decision put to the circle's vote
+type Proposal struct {
+	id         string
+	action     ProposalAction
+	proposedBy valueobject.MemberID
+	target     *valueobject.MemberID
+	amount     int64
+	rules      CircleRules
+	policy     VotingPolicy
+	electorate []valueobject.MemberID
+	votes      []ProposalVote
+	status     ProposalStatus
+	reason     string
+	createdAt  time.Time
+	expiresAt  time.Time
+	decidedAt  *time.Time
+}
+
+func (p *Proposal) ID() string                         { return p.id }
+func (p *Proposal) Action() ProposalAction             { return p.action }
+func (p *Proposal) ProposedBy() valueobject.MemberID   { return p.proposedBy }
+func (p *Proposal) Target() *valueobject.MemberID      { return p.target }
+func (p *Proposal) Amount() int64                      { return p.amount }
+func (p *Proposal) Rules() CircleRules                 { return p.rules }
+func (p *Proposal) Policy() VotingPolicy               { return p.policy }
+func (p *Proposal) Electorate() []valueobject.MemberID { return p.electorate }
+func (p *Proposal) Votes() []ProposalVote              { return p.votes }
+func (p *Proposal) Status() ProposalStatus             { return p.status }
+func (p *Proposal) Reason() string                     { return p.reason }
+func (p *Proposal) CreatedAt() time.Time               { return p.createdAt }
+func (p *Proposal) ExpiresAt() time.Time               { return p.expiresAt }
+func (p *Proposal) DecidedAt() *time.Time              { return p.decidedAt }
+func (p *Proposal) IsOpen() bool                       { return p.status == ProposalOpen }
+
+// Tally counts the votes cast so far
+func (p *Proposal) Tally() (approvals, rejections int) {
+	for _, v := range p.votes {
+		if v.Approve {
+			approvals++
+		} else {
+			rejections++
+		}
+	}
+	return approvals, rejections
+}
+
+func (p *Proposal) canVote(memberID valueobject.MemberID) bool {
+	for _, id := range p.electorate {
+		if id.Equals(memberID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Proposal) hasVoted(memberID valueobject.MemberID) bool {
+	for _, v := range p.votes {
+		if v.VoterID.Equals(memberID) {
+			return true
+		}
+	}
+	return false
+}
+
+// outcome decides a proposal once the result cannot change, or when voting closes.
+// Before the close, it passes once the approvals alone meet the threshold of the whole
+// electorate, and is rejected once approvals could no longer reach it.
+func (p *Proposal) outcome(closing bool) ProposalStatus {
+	approvals, rejections := p.Tally()
+	eligible := len(p.electorate)
+	cast := approvals + rejections
+	quorate := cast*100 >= p.policy.QuorumPercent*eligible
+
+	if !closing && cast < eligible {
+		remaining := eligible - cast
+		switch {
+		case quorate && approvals*100 >= p.policy.ThresholdPercent*eligible:
+			return ProposalPassed
+		case (approvals+remaining)*100 < p.policy.ThresholdPercent*eligible:
+			return ProposalRejected
+		}
+		return ProposalOpen
+	}
+
+	switch {
+	case !quorate:
+		return ProposalExpired
+	case approvals*100 >= p.policy.ThresholdPercent*cast:
+		return ProposalPassed
+	default:
+		return ProposalRejected
+	}
+}
+
+// Getters
+func (c *Circle) CircleRules() CircleRules { return c.circleRules }
+func (c *Circle) Proposals() []*Proposal   { return c.proposals }
+
+// VotingPolicy returns the policy an action is decided under
+func (c *Circle) VotingPolicy(action ProposalAction) VotingPolicy {
+	if policy, ok := c.votingPolicies[action]; ok {
+		return policy
+	}
+	return DefaultVotingPolicy(action)
+}
+
+// SetVotingPolicy sets the quorum and threshold for an action. Policies are agreed
+// before the circle starts. The caller must have checked the user is an admin.
+func (c *Circle) SetVotingPolicy(action ProposalAction, policy VotingPolicy) error {
+	if !c.status.IsRecruiting() {
+		return ErrAlreadyStarted
+	}
+	if !action.IsValid() {
+		return ErrInvalidProposalAction
+	}
+	if !policy.IsValid() {
+		return ErrInvalidVotingPolicy
+	}
+
+	c.votingPolicies[action] = policy
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewVotingPolicySet(c.id.String(), string(action), policy.QuorumPercent, policy.ThresholdPercent))
+
+	return nil
+}
+
+// SetCircleRules sets the rules the circle enforces. Once the circle has started the
+// rules can only change by vote. The caller must have checked the user is an admin.
+func (c *Circle) SetCircleRules(rules CircleRules) error {
+	if !c.status.IsRecruiting() {
+		return ErrAlreadyStarted
+	}
+	return c.applyRules(rules)
+}
+
+func (c *Circle) applyRules(rules CircleRules) error {
+	if !rules.IsValid() {
+		return ErrInvalidCircleRules
+	}
+
+	c.circleRules = rules
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewCircleRulesChanged(c.id.String(), rules.MaxMissedPayments, rules.LateFeeBps))
+
+	return nil
+}
+
+// lateFee is the fee on an overdue contribution of amount under the circle's rules
+func (c *Circle) lateFee(amount int64) int64 {
+	return amount * int64(c.circleRules.LateFeeBps) / 10000
+}
+
+// Propose puts a decision to the vote of the circle's active members. A member being
+// removed cannot vote on it. The proposer's vote is counted as an approval.
+func (c *Circle) Propose(proposalID string, userID valueobject.UserID, action ProposalAction, terms ProposalTerms) (*Proposal, error) {
+	if !c.status.IsRecruiting() && !c.status.IsActive() {
+		return nil, ErrCircleClosed
+	}
+	if !action.IsValid() {
+		return nil, ErrInvalidProposalAction
+	}
+
+	proposer := c.FindMemberByUserID(userID)
+	if proposer == nil {
+		return nil, ErrNotMember
+	}
+
+	proposal := &Proposal{
+		id:         proposalID,
+		action:     action,
+		proposedBy: proposer.ID(),
+		policy:     c.VotingPolicy(action),
+		votes:      make([]ProposalVote, 0),
+		status:     ProposalOpen,
+		createdAt:  time.Now().UTC(),
+	}
+	proposal.expiresAt = proposal.createdAt.Add(ProposalVotingPeriod)
+
+	targetUserID := ""
+	switch action {
+	case ProposalRemoveMember:
+		if terms.TargetUserID == nil {
+			return nil, ErrProposalTargetRequired
+		}
+		target := c.FindMemberByUserID(*terms.TargetUserID)
+		if target == nil {
+			return nil, ErrNotMember
+		}
+		if err := c.canRemove(target); err != nil {
+			return nil, err
+		}
+		targetID := target.ID()
+		proposal.target = &targetID
+		targetUserID = target.UserID().String()
+	case ProposalChangeContribution:
+		if terms.Amount <= 0 || terms.Amount == c.contributionAmt.Amount() {
+			return nil, ErrInvalidContributionAmt
+		}
+		proposal.amount = terms.Amount
+	case ProposalCancelCircle:
+		if err := c.canCancel(); err != nil {
+			return nil, err
+		}
+	case ProposalChangeRules:
+		if !terms.Rules.IsValid() {
+			return nil, ErrInvalidCircleRules
+		}
+		proposal.rules = terms.Rules
+	}
+
+	for _, p := range c.proposals {
+		if p.IsOpen() && p.action == action && (p.target == nil || p.target.Equals(*proposal.target)) {
+			return nil, ErrDuplicateProposal
+		}
+	}
+
+	for _, m := range c.activeMembers() {
+		if proposal.target != nil && m.ID().Equals(*proposal.target) {
+			continue
+		}
+		proposal.electorate = append(proposal.electorate, m.ID())
+	}
+	if len(proposal.electorate) == 0 {
+		return nil, ErrNoEligibleVoters
+	}
+
+	c.proposals = append(c.proposals, proposal)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewProposalOpened(
+		c.id.String(),
+		proposalID,
+		string(action),
+		userID.String(),
+		targetUserID,
+		proposal.amount,
+		len(proposal.electorate),
+		proposal.expiresAt,
+	))
+
+	if proposal.canVote(proposer.ID()) {
+		c.castVote(proposal, proposer, true)
+	}
+
+	return proposal, nil
+}
+
+// VoteOnProposal records a member's vote. The proposal is carried out as soon as it passes.
+func (c *Circle) VoteOnProposal(proposalID string, userID valueobject.UserID, approve bool) error {
+	proposal := c.FindProposal(proposalID)
+	if proposal == nil {
+		return ErrProposalNotFound
+	}
+	if !proposal.IsOpen() || time.Now().UTC().After(proposal.expiresAt) {
+		return ErrProposalClosed
+	}
+
+	voter := c.FindMemberByUserID(userID)
+	if voter == nil {
+		return ErrNotMember
+	}
+	if !proposal.canVote(voter.ID()) {
+		return ErrNotEligibleToVote
+	}
+	if proposal.hasVoted(voter.ID()) {
+		return ErrAlreadyVotedOnProposal
+	}
+
+	c.castVote(proposal, voter, approve)
+	return nil
+}
+
+func (c *Circle) castVote(proposal *Proposal, voter *Member, approve bool) {
+	now := time.Now().UTC()
+	proposal.votes = append(proposal.votes, ProposalVote{VoterID: voter.ID(), Approve: approve, VotedAt: now})
+	c.updatedAt = now
+
+	c.RecordEvent(event.NewProposalVoted(c.id.String(), proposal.id, voter.ID().String(), approve))
+
+	if status := proposal.outcome(false); status != ProposalOpen {
+		c.decide(proposal, status)
+	}
+}
+
+// CloseExpiredProposals closes proposals whose voting period ended by asOf, deciding
+// each on the votes cast. Proposals without a quorum expire.
+func (c *Circle) CloseExpiredProposals(asOf time.Time) []*Proposal {
+	closed := make([]*Proposal, 0)
+	for _, p := range c.proposals {
+		if p.IsOpen() && !asOf.Before(p.expiresAt) {
+			c.decide(p, p.outcome(true))
+			closed = append(closed, p)
+		}
+	}
+	return closed
+}
+
+// HasExpiredProposals reports whether any open proposal's voting period ended by asOf
+func (c *Circle) HasExpiredProposals(asOf time.Time) bool {
+	for _, p := range c.proposals {
+		if p.IsOpen() && !asOf.Before(p.expiresAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// decide closes a proposal and carries it out if it passed. A passed proposal that
+// is no longer possible, e.g. the member has since pledged their payout, fails.
+func (c *Circle) decide(proposal *Proposal, status ProposalStatus) {
+	now := time.Now().UTC()
+	proposal.status = status
+	proposal.decidedAt = &now
+	c.updatedAt = now
+
+	if status == ProposalPassed {
+		if err := c.execute(proposal); err != nil {
+			proposal.status = ProposalFailed
+			proposal.reason = err.Error()
+		}
+	}
+
+	approvals, rejections := proposal.Tally()
+	c.RecordEvent(event.NewProposalDecided(
+		c.id.String(),
+		proposal.id,
+		string(proposal.action),
+		string(proposal.status),
+		approvals,
+		rejections,
+		proposal.reason,
+	))
+}
+
+func (c *Circle) execute(proposal *Proposal) error {
+	if !c.status.IsRecruiting() && !c.status.IsActive() {
+		return ErrCircleClosed
+	}
+
+	switch proposal.action {
+	case ProposalRemoveMember:
+		member := c.FindMemberByID(*proposal.target)
+		if member == nil || !member.IsActive() {
+			return ErrNotMember
+		}
+		if err := c.removeMember(member, RemovalByVote); err != nil {
+			return err
+		}
+		if c.status.IsActive() && c.isRoundComplete() {
+			c.completeRound()
+		}
+		return nil
+	case ProposalChangeContribution:
+		return c.changeContributionAmount(proposal.amount)
+	case ProposalCancelCircle:
+		return c.cancel()
+	case ProposalChangeRules:
+		return c.applyRules(proposal.rules)
+	}
+	return ErrInvalidProposalAction
+}
+
+func (c *Circle) FindProposal(proposalID string) *Proposal {
+	for _, p := range c.proposals {
+		if p.id == proposalID {
+			return p
+		}
+	}
+	return nil
+}
+
+// changeContributionAmount changes what members pay from the next scheduled round.
+// Contributions already scheduled keep their amount.
+func (c *Circle) changeContributionAmount(amount int64) error {
+	newAmt, err := valueobject.NewMoney(amount, c.contributionAmt.Currency())
+	if err != nil || !newAmt.IsPositive() {
+		return ErrInvalidContributionAmt
+	}
+
+	old := c.contributionAmt
+	c.contributionAmt = newAmt
+	c.updatedAt = time.Now().UTC()
+
+	effectiveRound := c.currentRound + 1
+	if c.status.IsRecruiting() {
+		effectiveRound = 1
+	}
+
+	c.RecordEvent(event.NewContributionAmountChanged(
+		c.id.String(),
+		old.Amount(),
+		newAmt.Amount(),
+		string(newAmt.Currency()),
+		effectiveRound,
+	))
+
+	return nil
+}
+
+// canRemove reports whether a member can be removed from the circle.
+// A rotational member who has been paid still owes the rest of the cycle.
+func (c *Circle) canRemove(member *Member) error {
+	if member.HasLien() {
+		return ErrMemberHasLien
+	}
+	if c.circleType != CircleTypeRotational || !c.status.IsActive() {
+		return nil
+	}
+	if member.HasReceived() {
+		return ErrCannotRemoveAfterPayout
+	}
+	if len(c.payoutCandidates()) <= 1 {
+		return ErrLastPayoutRecipient
+	}
+	return nil
+}
+
+// removeMember takes a member out of the circle and waives their pending contributions.
+//
+// Pooled circles pay the member their share of the fund straight away. A rotational member
+// removed mid-cycle gives up their payout and is refunded what they paid in, less arrears,
+// out of the following rounds' pools.
+func (c *Circle) removeMember(member *Member, reason string) error {
+	if err := c.canRemove(member); err != nil {
+		return err
+	}
+
+	var refundDue int64
+	if c.status.IsActive() {
+		switch c.circleType {
+		case CircleTypeRotational:
+			refundDue = c.principalContributed(member.ID()) - member.Arrears()
+			if refundDue < 0 {
+				refundDue = 0
+			}
+			member.refundDue = refundDue
+			c.closePayoutGap(member)
+		default:
+			c.payOutShare(member)
+		}
+	}
+
+	for _, cont := range c.GetPendingContributions(member.ID()) {
+		cont.Waive()
+	}
+
+	member.Remove()
+	if c.status.IsRecruiting() {
+		c.reorderPositions()
+	}
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewMemberRemoved(
+		c.id.String(),
+		member.ID().String(),
+		member.UserID().String(),
+		reason,
+		refundDue,
+	))
+
+	if member.IsAdmin() {
+		c.handOverAdmin(member)
+	}
+
+	return nil
+}
+
+// closePayoutGap moves members due after a removed rotational member up one place,
+// so every remaining round still has a recipient
+func (c *Circle) closePayoutGap(removed *Member) {
+	for _, m := range c.activeMembers() {
+		if m.Position() > removed.Position() {
+			m.UpdatePosition(m.Position() - 1)
+		}
+	}
+	c.totalRounds--
+}
+
+// payOutShare pays a member leaving a pooled circle their share of the fund
+func (c *Circle) payOutShare(member *Member) {
+	for _, share := range c.FundShares() {
+		if !share.MemberID.Equals(member.ID()) || share.Amount <= 0 {
+			continue
+		}
+
+		member.MarkReceived()
+		c.poolBalance -= share.Amount
+
+		c.RecordEvent(event.NewPayoutTriggered(
+			c.id.String(),
+			member.ID().String(),
+			member.UserID().String(),
+			c.currentRound,
+			share.Amount,
+			member.LienLoanID(),
+		))
+		c.RecordEvent(event.NewCircleFundDistributed(
+			c.id.String(),
+			c.circleType.String(),
+			DistributionMemberExit,
+			share.Amount,
+			[]event.FundShare{{MemberID: member.ID().String(), UserID: member.UserID().String(), Amount: share.Amount}},
+		))
+	}
+}
+
+// refundRemovedMembers pays removed rotational members back from a round's pool before
+// it goes to the recipient, spreading each refund evenly over roundsLeft rounds
+func (c *Circle) refundRemovedMembers(round int, roundsLeft int64) {
+	if roundsLeft < 1 {
+		roundsLeft = 1
+	}
+
+	for _, m := range c.members {
+		if m.refundDue <= 0 || c.poolBalance <= 0 {
+			continue
+		}
+
+		part := (m.refundDue + roundsLeft - 1) / roundsLeft
+		if part > c.poolBalance {
+			part = c.poolBalance
+		}
+
+		m.refundDue -= part
+		c.poolBalance -= part
+
+		c.RecordEvent(event.NewPayoutTriggered(
+			c.id.String(),
+			m.ID().String(),
+			m.UserID().String(),
+			round,
+			part,
+			"",
+		))
+	}
+}
+
+// handOverAdmin appoints the longest-standing member as admin when the last admin goes
+func (c *Circle) handOverAdmin(leaving *Member) {
+	candidates := c.activeMembers()
+	for _, m := range candidates {
+		if m.IsAdmin() {
+			return
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].JoinedAt().Before(candidates[j].JoinedAt())
+	})
+	successor := candidates[0]
+	successor.PromoteToAdmin()
+
+	c.RecordEvent(event.NewCircleAdminTransferred(
+		c.id.String(),
+		leaving.UserID().String(),
+		successor.ID().String(),
+		successor.UserID().String(),
+	))
+}
+
+// canCancel reports whether the circle can be cancelled. Once a rotational circle has
+// paid someone, the others are owed their turn.
+func (c *Circle) canCancel() error {
+	if c.circleType != CircleTypeRotational {
+		return nil
+	}
+	for _, m := range c.members {
+		if m.HasReceived() {
+			return ErrCannotCancelAfterPayout
+		}
+	}
+	return nil
+}
+
+// cancel ends the circle, returning the pool and reserve to members
+func (c *Circle) cancel() error {
+	if err := c.canCancel(); err != nil {
+		return err
+	}
+
+	for _, cont := range c.contributions {
+		if cont.IsPending() {
+			cont.Waive()
+		}
+	}
+
+	refunded := c.poolBalance
+	if c.circleType == CircleTypeRotational {
+		c.refundRemovedMembers(c.currentRound, 1)
+	}
+	if c.poolBalance > 0 {
+		c.distributeFund(c.currentRound, DistributionCancelled)
+	}
+
+	c.status = CircleStatusCancelled
+	c.nextPayoutDate = nil
+	c.releaseReserve("")
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewCircleCancelled(c.id.String(), c.currentRound, refunded))
+
+	return nil
+}
+
+// enforceMissedPaymentLimit removes a member who has missed more contributions than the
+// circle's rules allow. Members who cannot be removed stay and are handled as defaulters.
+func (c *Circle) enforceMissedPaymentLimit(member *Member) {
+	limit := c.circleRules.MaxMissedPayments
+	if limit == 0 || member.MissedPayments() < limit || !member.IsActive() {
+		return
+	}
+	_ = c.removeMember(member, RemovalMissedPayments)
+}
@@ -0,0 +1,144 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestCircle_VoteRemovesMemberAndRefundsThem(t *testing.T) {
+	circle, users := newTestCircle(t, 4)
+	users = fillCircle(t, circle, users)
+	payRound(t, circle, users)
+
+	if _, err := circle.Propose("p-0", users[1], ProposalRemoveMember, ProposalTerms{TargetUserID: &users[0]}); err != ErrCannotRemoveAfterPayout {
+		t.Errorf("Propose() removing a paid member = %v, want %v", err, ErrCannotRemoveAfterPayout)
+	}
+
+	proposal, err := circle.Propose("p-1", users[1], ProposalRemoveMember, ProposalTerms{TargetUserID: &users[3]})
+	if err != nil {
+		t.Fatalf("Propose() error = %v", err)
+	}
+	if len(proposal.Electorate()) != 3 {
+		t.Errorf("Electorate() = %d members, want 3 without the target", len(proposal.Electorate()))
+	}
+	if err := circle.VoteOnProposal("p-1", users[3], false); err != ErrNotEligibleToVote {
+		t.Errorf("VoteOnProposal() by target = %v, want %v", err, ErrNotEligibleToVote)
+	}
+
+	// 2 of 3 is short of a 67% threshold until the last vote is in
+	if err := circle.VoteOnProposal("p-1", users[2], true); err != nil {
+		t.Fatalf("VoteOnProposal() error = %v", err)
+	}
+	if proposal.Status() != ProposalOpen {
+		t.Fatalf("Status() after 2 of 3 = %s, want open", proposal.Status())
+	}
+	if err := circle.VoteOnProposal("p-1", users[0], true); err != nil {
+		t.Fatalf("VoteOnProposal() error = %v", err)
+	}
+	if proposal.Status() != ProposalPassed {
+		t.Fatalf("Status() after 3 of 3 = %s, want passed", proposal.Status())
+	}
+
+	removed := circle.FindPayee(users[3])
+	if removed == nil || removed.Status() != MemberStatusRemoved || removed.RefundDue() != 1000000 {
+		t.Fatalf("removed member = %+v, want removed with 1000000 refund due", removed)
+	}
+	if circle.TotalRounds() != 3 {
+		t.Errorf("TotalRounds() = %d, want 3", circle.TotalRounds())
+	}
+
+	// Round 2 refunds half of what the removed member paid in before paying out
+	circle.DomainEvents()
+	payRound(t, circle, users[:3])
+	if payout := lastPayout(t, circle.DomainEvents()); payout.UserID != users[1].String() || payout.Amount != 2500000 {
+		t.Errorf("round 2 payout = %d to %s, want 2500000 to users[1]", payout.Amount, payout.UserID)
+	}
+	if removed.RefundDue() != 500000 {
+		t.Errorf("RefundDue() after round 2 = %d, want 500000", removed.RefundDue())
+	}
+}
+
+func TestCircle_EnforcesMissedPaymentLimitAndLateFee(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	if err := circle.SetCircleRules(CircleRules{MaxMissedPayments: 1, LateFeeBps: 1000}); err != nil {
+		t.Fatalf("SetCircleRules() error = %v", err)
+	}
+	if got := circle.lateFee(1000000); got != 100000 {
+		t.Errorf("lateFee() = %d, want 100000 at 10%%", got)
+	}
+
+	users = fillCircle(t, circle, users)
+	if err := circle.SetCircleRules(DefaultCircleRules()); err != ErrAlreadyStarted {
+		t.Errorf("SetCircleRules() after start = %v, want %v", err, ErrAlreadyStarted)
+	}
+
+	payRound(t, circle, users[:2])
+	if _, err := circle.MarkMissedContributions(time.Now().AddDate(0, 1, 0)); err != nil {
+		t.Fatalf("MarkMissedContributions() error = %v", err)
+	}
+
+	if circle.IsMember(users[2]) {
+		t.Error("member over the missed payment limit is still active")
+	}
+	if circle.CurrentRound() != 2 || circle.TotalRounds() != 2 {
+		t.Errorf("round %d of %d, want round 2 of 2", circle.CurrentRound(), circle.TotalRounds())
+	}
+}
+
+func TestCircle_AdminHandoverAndCancellationVote(t *testing.T) {
+	circle, users := newTestCircle(t, 4)
+	for i := 0; i < 2; i++ {
+		user := valueobject.GenerateUserID()
+		if _, err := circle.AddMember(user); err != nil {
+			t.Fatalf("AddMember() error = %v", err)
+		}
+		users = append(users, user)
+	}
+	circle.DomainEvents()
+
+	if err := circle.RemoveMember(users[0]); err != nil {
+		t.Fatalf("RemoveMember() creator error = %v", err)
+	}
+	if !circle.IsAdmin(users[1]) {
+		t.Error("longest-standing member was not made admin when the creator left")
+	}
+	transferred := false
+	for _, e := range circle.DomainEvents() {
+		if _, ok := e.(*event.CircleAdminTransferred); ok {
+			transferred = true
+		}
+	}
+	if !transferred {
+		t.Error("no CircleAdminTransferred event recorded")
+	}
+	users = users[1:]
+
+	// One approval of two never reaches a quorum of 75%
+	proposal, err := circle.Propose("p-1", users[0], ProposalCancelCircle, ProposalTerms{})
+	if err != nil {
+		t.Fatalf("Propose() error = %v", err)
+	}
+	if _, err := circle.Propose("p-2", users[1], ProposalCancelCircle, ProposalTerms{}); err != ErrDuplicateProposal {
+		t.Errorf("Propose() duplicate = %v, want %v", err, ErrDuplicateProposal)
+	}
+	circle.CloseExpiredProposals(time.Now().Add(ProposalVotingPeriod + time.Minute))
+	if proposal.Status() != ProposalExpired {
+		t.Fatalf("Status() after voting period = %s, want expired", proposal.Status())
+	}
+
+	if err := circle.SetVotingPolicy(ProposalCancelCircle, VotingPolicy{QuorumPercent: 50, ThresholdPercent: 100}); err != nil {
+		t.Fatalf("SetVotingPolicy() error = %v", err)
+	}
+	if _, err := circle.Propose("p-3", users[0], ProposalCancelCircle, ProposalTerms{}); err != nil {
+		t.Fatalf("Propose() error = %v", err)
+	}
+	if err := circle.VoteOnProposal("p-3", users[1], true); err != nil {
+		t.Fatalf("VoteOnProposal() error = %v", err)
+	}
+	if circle.Status() != CircleStatusCancelled {
+		t.Errorf("Status() = %s, want cancelled", circle.Status())
+	}
+}
@@ -0,0 +1,411 @@
+package aggregate
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Missed contribution errors
+var (
+	ErrInvalidMissedPolicy      = errors.New("invalid missed contribution policy")
+	ErrMissedPolicyNotSupported = errors.New("missed contribution policy is not supported for this circle type")
+	ErrInvalidGracePeriod       = errors.New("grace period must be between 0 and 14 days")
+	ErrNoReserve                = errors.New("circle does not keep a reserve")
+	ErrInvalidReserveAmount     = errors.New("reserve amount must be positive")
+	ErrNoArrears                = errors.New("member has no arrears")
+	ErrInvalidArrearsPayment    = errors.New("payment must be positive and no more than the arrears")
+	ErrInvalidLienAmount        = errors.New("lien amount must be positive")
+)
+
+// Grace period limits, in days after a contribution's due date
+const (
+	DefaultGracePeriodDays = 3
+	MaxGracePeriodDays     = 14
+)
+
+// Contribution sources recorded on a missed contribution
+const (
+	coveredByReserve = "reserve"
+)
+
+// MissedContributionPolicy decides what happens to a round when a contribution is missed
+type MissedContributionPolicy string
+
+const (
+	MissedPolicyShortPayout      MissedContributionPolicy = "short_payout"       // Round advances and pays out what was collected
+	MissedPolicyCoverFromReserve MissedContributionPolicy = "cover_from_reserve" // Reserve makes up the shortfall while it can
+	MissedPolicyAutoDebit        MissedContributionPolicy = "auto_debit"         // Member's wallet is debited; short payout if that fails
+)
+
+func (p MissedContributionPolicy) IsValid() bool {
+	switch p {
+	case MissedPolicyShortPayout, MissedPolicyCoverFromReserve, MissedPolicyAutoDebit:
+		return true
+	}
+	return false
+}
+
+// Getters
+func (c *Circle) MissedPolicy() MissedContributionPolicy { return c.missedPolicy }
+func (c *Circle) GracePeriodDays() int                   { return c.gracePeriodDays }
+func (c *Circle) ReserveBalance() int64                  { return c.reserveBalance }
+
+// GracePeriod is how long after the due date a contribution can still be paid before it is missed
+func (c *Circle) GracePeriod() time.Duration {
+	return time.Duration(c.gracePeriodDays) * 24 * time.Hour
+}
+
+// SetMissedContributionPolicy chooses how missed contributions are handled and how long
+// members have to pay after the due date. Only rotational circles can keep a reserve.
+func (c *Circle) SetMissedContributionPolicy(policy MissedContributionPolicy, gracePeriodDays int) error {
+	if !c.status.IsRecruiting() {
+		return ErrAlreadyStarted
+	}
+	if !policy.IsValid() {
+		return ErrInvalidMissedPolicy
+	}
+	if policy == MissedPolicyCoverFromReserve && c.circleType != CircleTypeRotational {
+		return ErrMissedPolicyNotSupported
+	}
+	if gracePeriodDays < 0 || gracePeriodDays > MaxGracePeriodDays {
+		return ErrInvalidGracePeriod
+	}
+
+	c.missedPolicy = policy
+	c.gracePeriodDays = gracePeriodDays
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewMissedContributionPolicySet(c.id.String(), string(policy), gracePeriodDays))
+
+	return nil
+}
+
+// FundReserve adds a member's money to the reserve that covers missed contributions
+func (c *Circle) FundReserve(userID valueobject.UserID, amount int64, transactionID valueobject.TransactionID) error {
+	if c.missedPolicy != MissedPolicyCoverFromReserve {
+		return ErrNoReserve
+	}
+	if !c.status.IsRecruiting() && !c.status.IsActive() {
+		return ErrCircleNotActive
+	}
+	if !c.IsMember(userID) {
+		return ErrNotMember
+	}
+	if amount <= 0 {
+		return ErrInvalidReserveAmount
+	}
+
+	c.reserveBalance += amount
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewCircleReserveFunded(
+		c.id.String(),
+		userID.String(),
+		transactionID.String(),
+		amount,
+		c.reserveBalance,
+	))
+
+	return nil
+}
+
+// OverdueContributions returns this round's contributions still unpaid after the grace period
+func (c *Circle) OverdueContributions(asOf time.Time) []*Contribution {
+	overdue := make([]*Contribution, 0)
+	if !c.status.IsActive() {
+		return overdue
+	}
+
+	for _, cont := range c.contributions {
+		if cont.Round() == c.currentRound && cont.IsPastGrace(asOf, c.GracePeriod()) {
+			overdue = append(overdue, cont)
+		}
+	}
+	return overdue
+}
+
+// MarkMissedContributions marks this round's contributions missed once the grace period
+// has run out, so the round can complete without them.
+//
+// Reserve circles cover each shortfall while the reserve lasts; otherwise the round pays
+// out short and the member owes the difference. A member who misses a contribution after
+// receiving their payout is in default. Auto-debit circles are expected to have tried
+// the member's wallet first; anything still unpaid is treated as a short payout.
+func (c *Circle) MarkMissedContributions(asOf time.Time) ([]*Contribution, error) {
+	if !c.status.IsActive() {
+		return nil, ErrCircleNotActive
+	}
+
+	missed := c.OverdueContributions(asOf)
+	if len(missed) == 0 {
+		return missed, nil
+	}
+
+	for _, cont := range missed {
+		c.missContribution(cont)
+	}
+	c.updatedAt = time.Now().UTC()
+
+	if c.isRoundComplete() {
+		c.completeRound()
+	}
+
+	return missed, nil
+}
+
+func (c *Circle) missContribution(cont *Contribution) {
+	member := c.FindMemberByID(cont.MemberID())
+	member.RecordMissedPayment()
+	amount := cont.Amount().Amount()
+
+	coveredBy := ""
+	if c.missedPolicy == MissedPolicyCoverFromReserve && c.reserveBalance >= amount {
+		c.reserveBalance -= amount
+		c.poolBalance += amount
+		cont.MarkCovered()
+		coveredBy = coveredByReserve
+	} else {
+		cont.MarkMissed()
+	}
+
+	c.RecordEvent(event.NewContributionMissed(
+		c.id.String(),
+		cont.ID().String(),
+		member.ID().String(),
+		member.UserID().String(),
+		cont.Round(),
+		amount,
+		coveredBy,
+	))
+
+	// Pooled circles share the fund by what each member paid in, so a missed
+	// contribution only shrinks that member's share and nothing is owed
+	if c.circleType != CircleTypeRotational {
+		c.enforceMissedPaymentLimit(member)
+		return
+	}
+
+	member.AddArrears(amount)
+
+	if member.HasReceived() {
+		member.MarkDefaulted()
+		c.RecordEvent(event.NewMemberDefaulted(
+			c.id.String(),
+			member.ID().String(),
+			member.UserID().String(),
+			cont.Round(),
+			member.Arrears(),
+			string(c.contributionAmt.Currency()),
+		))
+	}
+
+	c.enforceMissedPaymentLimit(member)
+}
+
+// recordShortfall notes who was paid short by each contribution missed in a round,
+// so recovered arrears reach them. A recipient who missed their own contribution
+// simply received less and owes nothing.
+func (c *Circle) recordShortfall(round int, recipient *Member) {
+	for _, cont := range c.contributions {
+		if cont.Round() != round || cont.Status() != ContributionMissed {
+			continue
+		}
+		if cont.MemberID().Equals(recipient.ID()) {
+			cont.Waive()
+			recipient.SettleArrears(cont.Amount().Amount())
+			continue
+		}
+		recipientID := recipient.ID()
+		cont.shortfallTo = &recipientID
+	}
+}
+
+// SettleArrears pays off a member's missed contributions, oldest first. Money owed for a
+// covered contribution goes back to the reserve; money owed for a short payout goes to the
+// member who was paid short, or into the pool if their round has not paid out yet.
+func (c *Circle) SettleArrears(userID valueobject.UserID, amount int64, transactionID valueobject.TransactionID) error {
+	member, err := c.memberInArrears(userID)
+	if err != nil {
+		return err
+	}
+	if amount <= 0 || amount > member.Arrears() {
+		return ErrInvalidArrearsPayment
+	}
+
+	c.settleArrears(member, amount, transactionID, 0)
+	return nil
+}
+
+// ApplyDefaultLien settles a defaulted member's arrears from funds held on their wallet.
+// Anything held beyond the arrears is reported back so the wallet can release it.
+func (c *Circle) ApplyDefaultLien(userID valueobject.UserID, held int64, transactionID valueobject.TransactionID) error {
+	if !c.status.IsActive() && c.status != CircleStatusCompleted {
+		return ErrCircleNotActive
+	}
+	if held <= 0 {
+		return ErrInvalidLienAmount
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return ErrNotMember
+	}
+
+	amount := held
+	if amount > member.Arrears() {
+		amount = member.Arrears()
+	}
+
+	c.settleArrears(member, amount, transactionID, held)
+	return nil
+}
+
+func (c *Circle) memberInArrears(userID valueobject.UserID) (*Member, error) {
+	if !c.status.IsActive() && c.status != CircleStatusCompleted {
+		return nil, ErrCircleNotActive
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return nil, ErrNotMember
+	}
+	if member.Arrears() == 0 {
+		return nil, ErrNoArrears
+	}
+	return member, nil
+}
+
+func (c *Circle) settleArrears(member *Member, amount int64, transactionID valueobject.TransactionID, lienHeld int64) {
+	wasDefaulted := member.IsDefaulted()
+
+	owed := make([]*Contribution, 0)
+	for _, cont := range c.contributions {
+		if cont.MemberID().Equals(member.ID()) && cont.Outstanding() > 0 {
+			owed = append(owed, cont)
+		}
+	}
+	sort.SliceStable(owed, func(i, j int) bool {
+		return owed[i].Round() < owed[j].Round()
+	})
+
+	remaining := amount
+	toReserve := false
+	for _, cont := range owed {
+		if remaining == 0 {
+			break
+		}
+
+		part := cont.Outstanding()
+		if part > remaining {
+			part = remaining
+		}
+		remaining -= part
+
+		switch {
+		case cont.Status() == ContributionCovered:
+			c.reserveBalance += part
+			toReserve = true
+		case cont.shortfallTo != nil:
+			recipient := c.FindMemberByID(*cont.shortfallTo)
+			c.RecordEvent(event.NewShortfallRepaid(
+				c.id.String(),
+				cont.Round(),
+				recipient.ID().String(),
+				recipient.UserID().String(),
+				member.ID().String(),
+				part,
+			))
+			c.RecordEvent(event.NewPayoutTriggeredOfKind(
+				c.id.String(),
+				recipient.ID().String(),
+				recipient.UserID().String(),
+				cont.Round(),
+				part,
+				event.PayoutKindShortfallRepayment,
+				transactionID.String(),
+			))
+		default:
+			c.poolBalance += part
+		}
+
+		cont.Recover(part)
+	}
+
+	member.SettleArrears(amount)
+	member.RecordContribution(amount)
+	c.totalSaved += amount
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewArrearsSettled(
+		c.id.String(),
+		member.ID().String(),
+		member.UserID().String(),
+		transactionID.String(),
+		amount,
+		string(c.contributionAmt.Currency()),
+		member.Arrears(),
+		lienHeld,
+		wasDefaulted && !member.IsDefaulted(),
+	))
+
+	// A completed circle has no later rounds to cover, so repaid reserve goes straight back out
+	if toReserve && c.status == CircleStatusCompleted {
+		c.releaseReserve(transactionID.String())
+	}
+}
+
+// releaseReserve pays what is left in the reserve out to members with no arrears.
+// The source ID tells apart releases after the circle has ended.
+func (c *Circle) releaseReserve(sourceID string) {
+	if c.reserveBalance <= 0 {
+		return
+	}
+
+	members := make([]*Member, 0)
+	for _, m := range c.activeMembers() {
+		if m.Arrears() == 0 {
+			members = append(members, m)
+		}
+	}
+	if len(members) == 0 {
+		return
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Position() < members[j].Position()
+	})
+
+	reserve := c.reserveBalance
+	amounts := splitEqually(reserve, len(members))
+	shares := make([]event.FundShare, len(members))
+	for i, m := range members {
+		shares[i] = event.FundShare{MemberID: m.ID().String(), UserID: m.UserID().String(), Amount: amounts[i]}
+	}
+
+	c.reserveBalance = 0
+	c.RecordEvent(event.NewCircleReserveReleased(c.id.String(), reserve, shares))
+
+	for _, share := range shares {
+		if share.Amount <= 0 {
+			continue
+		}
+		c.RecordEvent(event.NewPayoutTriggeredOfKind(
+			c.id.String(),
+			share.MemberID,
+			share.UserID,
+			c.currentRound,
+			share.Amount,
+			event.PayoutKindReserveRelease,
+			sourceID,
+		))
+	}
+}
+
+// HasDefaulted reports whether a user is in default on this circle
+func (c *Circle) HasDefaulted(userID valueobject.UserID) bool {
+	member := c.FindMemberByUserID(userID)
+	return member != nil && member.IsDefaulted()
+}
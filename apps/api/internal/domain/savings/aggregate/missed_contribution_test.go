@@ -0,0 +1,193 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// pastGrace is a time after every contribution currently scheduled has run out of grace
+func pastGrace(circle *Circle) time.Time {
+	return time.Now().Add(8*24*time.Hour + circle.GracePeriod())
+}
+
+// payExcept has every member but skip contribute for the current round
+func payExcept(t *testing.T, circle *Circle, users []valueobject.UserID, skip valueobject.UserID) {
+	t.Helper()
+	for _, user := range users {
+		if !user.Equals(skip) {
+			payRound(t, circle, []valueobject.UserID{user})
+		}
+	}
+}
+
+func findEvent[T sharedevent.DomainEvent](events []sharedevent.DomainEvent) (T, bool) {
+	for _, e := range events {
+		if found, ok := e.(T); ok {
+			return found, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func TestCircle_MissedContributionPaysOutShort(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	users = fillCircle(t, circle, users)
+
+	payExcept(t, circle, users, users[2])
+	if missed, _ := circle.MarkMissedContributions(time.Now()); len(missed) != 0 {
+		t.Fatalf("MarkMissedContributions() within grace marked %d, want 0", len(missed))
+	}
+
+	circle.DomainEvents()
+	missed, err := circle.MarkMissedContributions(pastGrace(circle))
+	if err != nil {
+		t.Fatalf("MarkMissedContributions() error = %v", err)
+	}
+	if len(missed) != 1 || missed[0].Status() != ContributionMissed {
+		t.Fatalf("MarkMissedContributions() = %v, want users[2]'s contribution missed", missed)
+	}
+
+	events := circle.DomainEvents()
+	payout := lastPayout(t, events)
+	if payout.UserID != users[0].String() || payout.Amount != 2000000 {
+		t.Errorf("payout = %s/%d, want users[0] paid 2000000 short", payout.UserID, payout.Amount)
+	}
+	if circle.CurrentRound() != 2 {
+		t.Errorf("CurrentRound() = %d, want 2", circle.CurrentRound())
+	}
+
+	debtor := circle.FindMemberByUserID(users[2])
+	if debtor.Arrears() != 1000000 || debtor.MissedPayments() != 1 || debtor.IsDefaulted() {
+		t.Errorf("debtor arrears=%d missed=%d defaulted=%v, want 1000000/1/false", debtor.Arrears(), debtor.MissedPayments(), debtor.IsDefaulted())
+	}
+
+	if err := circle.SettleArrears(users[2], 2000000, valueobject.GenerateTransactionID()); err != ErrInvalidArrearsPayment {
+		t.Errorf("SettleArrears() above arrears = %v, want %v", err, ErrInvalidArrearsPayment)
+	}
+	if err := circle.SettleArrears(users[2], 1000000, valueobject.GenerateTransactionID()); err != nil {
+		t.Fatalf("SettleArrears() error = %v", err)
+	}
+
+	events = circle.DomainEvents()
+	repaid, ok := findEvent[*event.ShortfallRepaid](events)
+	if !ok {
+		t.Fatal("no ShortfallRepaid event recorded")
+	}
+	if repaid.UserID != users[0].String() || repaid.Amount != 1000000 || repaid.Round != 1 {
+		t.Errorf("ShortfallRepaid = %s/%d round %d, want users[0] 1000000 round 1", repaid.UserID, repaid.Amount, repaid.Round)
+	}
+	payout = lastPayout(t, events)
+	if payout.Kind != event.PayoutKindShortfallRepayment || payout.UserID != users[0].String() || payout.Amount != 1000000 {
+		t.Errorf("payout = %s %s/%d, want the shortfall repaid to users[0]", payout.Kind, payout.UserID, payout.Amount)
+	}
+	if missed[0].Status() != ContributionRecovered || debtor.Arrears() != 0 {
+		t.Errorf("after settlement status=%s arrears=%d, want recovered/0", missed[0].Status(), debtor.Arrears())
+	}
+}
+
+func TestCircle_DefaultAfterPayoutIsEscalated(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	users = fillCircle(t, circle, users)
+	payRound(t, circle, users)
+
+	// users[0] took the first payout and stops paying
+	payExcept(t, circle, users, users[0])
+	circle.DomainEvents()
+	if _, err := circle.MarkMissedContributions(pastGrace(circle)); err != nil {
+		t.Fatalf("MarkMissedContributions() error = %v", err)
+	}
+
+	defaulted, ok := findEvent[*event.MemberDefaulted](circle.DomainEvents())
+	if !ok {
+		t.Fatal("no MemberDefaulted event recorded")
+	}
+	if defaulted.UserID != users[0].String() || defaulted.Arrears != 1000000 {
+		t.Errorf("MemberDefaulted = %s/%d, want users[0] owing 1000000", defaulted.UserID, defaulted.Arrears)
+	}
+	if !circle.HasDefaulted(users[0]) {
+		t.Fatal("HasDefaulted() = false, want true")
+	}
+
+	// The wallet lien held more than owed; the excess is reported back for release
+	if err := circle.ApplyDefaultLien(users[0], 1500000, valueobject.GenerateTransactionID()); err != nil {
+		t.Fatalf("ApplyDefaultLien() error = %v", err)
+	}
+
+	settled, ok := findEvent[*event.ArrearsSettled](circle.DomainEvents())
+	if !ok {
+		t.Fatal("no ArrearsSettled event recorded")
+	}
+	if settled.Amount != 1000000 || settled.LienHeld != 1500000 || settled.Remaining != 0 || !settled.DefaultCleared {
+		t.Errorf("ArrearsSettled = %+v, want 1000000 applied from a 1500000 lien, default cleared", settled)
+	}
+	if circle.HasDefaulted(users[0]) {
+		t.Error("HasDefaulted() after settlement = true, want false")
+	}
+}
+
+func TestCircle_ReserveCoversMissedContribution(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	if err := circle.SetMissedContributionPolicy(MissedPolicyCoverFromReserve, 1); err != nil {
+		t.Fatalf("SetMissedContributionPolicy() error = %v", err)
+	}
+	if err := circle.FundReserve(users[0], 1000000, valueobject.GenerateTransactionID()); err != nil {
+		t.Fatalf("FundReserve() error = %v", err)
+	}
+	users = fillCircle(t, circle, users)
+
+	payExcept(t, circle, users, users[2])
+	circle.DomainEvents()
+	if _, err := circle.MarkMissedContributions(pastGrace(circle)); err != nil {
+		t.Fatalf("MarkMissedContributions() error = %v", err)
+	}
+	if payout := lastPayout(t, circle.DomainEvents()); payout.Amount != 3000000 {
+		t.Errorf("covered payout = %d, want 3000000", payout.Amount)
+	}
+	if circle.ReserveBalance() != 0 {
+		t.Errorf("ReserveBalance() = %d, want 0", circle.ReserveBalance())
+	}
+
+	// With the reserve spent, the next miss pays out short
+	payExcept(t, circle, users, users[2])
+	if _, err := circle.MarkMissedContributions(pastGrace(circle)); err != nil {
+		t.Fatalf("MarkMissedContributions() error = %v", err)
+	}
+	if payout := lastPayout(t, circle.DomainEvents()); payout.Amount != 2000000 {
+		t.Errorf("uncovered payout = %d, want 2000000", payout.Amount)
+	}
+
+	// Repaying the covered round refills the reserve before the shortfall is repaid
+	if err := circle.SettleArrears(users[2], 1000000, valueobject.GenerateTransactionID()); err != nil {
+		t.Fatalf("SettleArrears() error = %v", err)
+	}
+	if circle.ReserveBalance() != 1000000 {
+		t.Errorf("ReserveBalance() after repayment = %d, want 1000000", circle.ReserveBalance())
+	}
+}
+
+func TestCircle_SetMissedContributionPolicy(t *testing.T) {
+	circle, err := NewCircle(
+		valueobject.GenerateCircleID(), valueobject.GenerateUserID(), "December Rice", "", CircleTypeFixedTarget,
+		valueobject.MustNewMoney(1000000, valueobject.NGN), FrequencyMonthly, 3, 3, false, "RICE2345",
+	)
+	if err != nil {
+		t.Fatalf("NewCircle() error = %v", err)
+	}
+
+	if err := circle.SetMissedContributionPolicy(MissedPolicyCoverFromReserve, 3); err != ErrMissedPolicyNotSupported {
+		t.Errorf("reserve on fixed-target = %v, want %v", err, ErrMissedPolicyNotSupported)
+	}
+
+	rotational, _ := newTestCircle(t, 3)
+	if err := rotational.SetMissedContributionPolicy(MissedPolicyAutoDebit, MaxGracePeriodDays+1); err != ErrInvalidGracePeriod {
+		t.Errorf("long grace period = %v, want %v", err, ErrInvalidGracePeriod)
+	}
+	if err := rotational.SetMissedContributionPolicy("forgive", 3); err != ErrInvalidMissedPolicy {
+		t.Errorf("unknown policy = %v, want %v", err, ErrInvalidMissedPolicy)
+	}
+}
@@ -0,0 +1,545 @@
+package aggregate
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Payout ordering errors
+var (
+	ErrInvalidPayoutOrder      = errors.New("invalid payout order")
+	ErrPayoutOrderNotSupported = errors.New("payout ordering only applies to rotational circles")
+	ErrDrawSeedRequired        = errors.New("random draw needs a seed")
+	ErrTooManyRoundsForDraw    = errors.New("circle has more rounds than the draw seed chain covers")
+	ErrNotAuctionCircle        = errors.New("circle does not auction payouts")
+	ErrInvalidBidDiscount      = errors.New("bid discount must be positive and less than the payout")
+	ErrSwapsNotAllowed         = errors.New("position swaps are only allowed with fixed payout order")
+	ErrCannotSwapWithSelf      = errors.New("cannot swap position with yourself")
+	ErrSwapNotFound            = errors.New("position swap not found")
+	ErrSwapNotPending          = errors.New("position swap is no longer pending")
+	ErrSwapNotAgreed           = errors.New("position swap has not been agreed by the counterparty")
+	ErrNotSwapCounterparty     = errors.New("only the counterparty can respond to this swap")
+)
+
+// PayoutOrder decides who receives the pool each round of a rotational circle
+type PayoutOrder string
+
+const (
+	PayoutOrderFixed      PayoutOrder = "fixed"       // Position order, set by join order and swaps
+	PayoutOrderRandomDraw PayoutOrder = "random_draw" // Lots drawn each round from members yet to receive
+	PayoutOrderAuction    PayoutOrder = "auction"     // Highest discount bid wins the round
+)
+
+func (o PayoutOrder) String() string {
+	return string(o)
+}
+
+func (o PayoutOrder) IsValid() bool {
+	switch o {
+	case PayoutOrderFixed, PayoutOrderRandomDraw, PayoutOrderAuction:
+		return true
+	}
+	return false
+}
+
+// PayoutBid is a member's offer to take this round's payout at a discount
+type PayoutBid struct {
+	memberID valueobject.MemberID
+	round    int
+	discount int64
+	placedAt time.Time
+}
+
+func (b *PayoutBid) MemberID() valueobject.MemberID { return b.memberID }
+func (b *PayoutBid) Round() int                     { return b.round }
+func (b *PayoutBid) Discount() int64                { return b.discount }
+func (b *PayoutBid) PlacedAt() time.Time            { return b.placedAt }
+
+// PositionSwapStatus tracks a swap request through consent and approval
+type PositionSwapStatus string
+
+const (
+	SwapPending  PositionSwapStatus = "pending" // Awaiting the counterparty
+	SwapAgreed   PositionSwapStatus = "agreed"  // Awaiting the admin
+	SwapApproved PositionSwapStatus = "approved"
+	SwapRejected PositionSwapStatus = "rejected"
+)
+
+// PositionSwap is a request by two members to trade payout positions
+type PositionSwap struct {
+	id             string
+	requesterID    valueobject.MemberID
+	counterpartyID valueobject.MemberID
+	status         PositionSwapStatus
+	reviewedBy     string
+	reason         string
+	requestedAt    time.Time
+	resolvedAt     *time.Time
+}
+
+func (s *PositionSwap) ID() string                           { return s.id }
+func (s *PositionSwap) RequesterID() valueobject.MemberID    { return s.requesterID }
+func (s *PositionSwap) CounterpartyID() valueobject.MemberID { return s.counterpartyID }
+func (s *PositionSwap) Status() PositionSwapStatus           { return s.status }
+func (s *PositionSwap) ReviewedBy() string                   { return s.reviewedBy }
+func (s *PositionSwap) Reason() string                       { return s.reason }
+func (s *PositionSwap) RequestedAt() time.Time               { return s.requestedAt }
+func (s *PositionSwap) ResolvedAt() *time.Time               { return s.resolvedAt }
+func (s *PositionSwap) IsOpen() bool                         { return s.status == SwapPending || s.status == SwapAgreed }
+
+func (s *PositionSwap) resolve(status PositionSwapStatus, reviewedBy, reason string) {
+	now := time.Now().UTC()
+	s.status = status
+	s.reviewedBy = reviewedBy
+	s.reason = reason
+	s.resolvedAt = &now
+}
+
+// Getters
+func (c *Circle) PayoutOrder() PayoutOrder       { return c.payoutOrder }
+func (c *Circle) Bids() []*PayoutBid             { return c.bids }
+func (c *Circle) PositionSwaps() []*PositionSwap { return c.positionSwaps }
+
+// DrawSeedChainLength is how many times the secret draw seed is hashed to make its
+// commitment. Round r draws with the secret hashed DrawSeedChainLength-r times, so each
+// revealed round seed hashes forward to the commitment while the seeds of later rounds,
+// which are further back up the chain, stay unknown until their own draw.
+const DrawSeedChainLength = 1000
+
+// SetPayoutOrder chooses how a rotational circle orders its payouts. It can only change
+// before the first round. Random draws need a secret seed: only the end of its hash chain
+// is published now, and each draw reveals that round's seed so members can verify it.
+func (c *Circle) SetPayoutOrder(order PayoutOrder, seed string) error {
+	if !order.IsValid() {
+		return ErrInvalidPayoutOrder
+	}
+	if c.circleType != CircleTypeRotational {
+		return ErrPayoutOrderNotSupported
+	}
+	if !c.status.IsRecruiting() {
+		return ErrAlreadyStarted
+	}
+
+	commitment := ""
+	if order == PayoutOrderRandomDraw {
+		if seed == "" {
+			return ErrDrawSeedRequired
+		}
+		if c.maxMembers > DrawSeedChainLength {
+			return ErrTooManyRoundsForDraw
+		}
+		commitment = hashDrawSeed(seed, DrawSeedChainLength)
+	} else {
+		seed = ""
+	}
+
+	c.payoutOrder = order
+	c.drawSeed = seed
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPayoutOrderSet(c.id.String(), order.String(), commitment))
+
+	return nil
+}
+
+// PlaceBid offers to take the current round's payout at a discount.
+// A member's new bid replaces their earlier one for the round.
+func (c *Circle) PlaceBid(userID valueobject.UserID, discount int64) (*PayoutBid, error) {
+	if c.payoutOrder != PayoutOrderAuction {
+		return nil, ErrNotAuctionCircle
+	}
+	if !c.status.IsActive() {
+		return nil, ErrCircleNotActive
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil {
+		return nil, ErrNotMember
+	}
+	if member.HasReceived() {
+		return nil, ErrPayoutAlreadyReceived
+	}
+
+	if discount <= 0 || discount >= c.ExpectedPayout().Amount() {
+		return nil, ErrInvalidBidDiscount
+	}
+
+	bid := &PayoutBid{
+		memberID: member.ID(),
+		round:    c.currentRound,
+		discount: discount,
+		placedAt: time.Now().UTC(),
+	}
+
+	bids := make([]*PayoutBid, 0, len(c.bids)+1)
+	for _, b := range c.bids {
+		if !b.memberID.Equals(member.ID()) {
+			bids = append(bids, b)
+		}
+	}
+	c.bids = append(bids, bid)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPayoutBidPlaced(
+		c.id.String(),
+		member.ID().String(),
+		userID.String(),
+		c.currentRound,
+		discount,
+	))
+
+	return bid, nil
+}
+
+// RequestPositionSwap asks another member to trade payout positions.
+// The counterparty must agree and the admin must approve before positions change.
+func (c *Circle) RequestPositionSwap(swapID string, requesterUserID, counterpartyUserID valueobject.UserID) (*PositionSwap, error) {
+	if c.payoutOrder != PayoutOrderFixed || c.circleType != CircleTypeRotational {
+		return nil, ErrSwapsNotAllowed
+	}
+	if requesterUserID.Equals(counterpartyUserID) {
+		return nil, ErrCannotSwapWithSelf
+	}
+
+	requester, counterparty, err := c.swappableMembers(requesterUserID, counterpartyUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	swap := &PositionSwap{
+		id:             swapID,
+		requesterID:    requester.ID(),
+		counterpartyID: counterparty.ID(),
+		status:         SwapPending,
+		requestedAt:    time.Now().UTC(),
+	}
+	c.positionSwaps = append(c.positionSwaps, swap)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPositionSwapRequested(
+		c.id.String(),
+		swapID,
+		requester.ID().String(),
+		counterparty.ID().String(),
+		requester.Position(),
+		counterparty.Position(),
+	))
+
+	return swap, nil
+}
+
+// RespondToPositionSwap records the counterparty agreeing to or declining a swap
+func (c *Circle) RespondToPositionSwap(swapID string, userID valueobject.UserID, accept bool) error {
+	swap := c.FindPositionSwap(swapID)
+	if swap == nil {
+		return ErrSwapNotFound
+	}
+	if swap.status != SwapPending {
+		return ErrSwapNotPending
+	}
+
+	member := c.FindMemberByUserID(userID)
+	if member == nil || !member.ID().Equals(swap.counterpartyID) {
+		return ErrNotSwapCounterparty
+	}
+
+	c.updatedAt = time.Now().UTC()
+
+	if !accept {
+		swap.resolve(SwapRejected, userID.String(), "declined by counterparty")
+		c.RecordEvent(event.NewPositionSwapRejected(c.id.String(), swapID, userID.String(), swap.reason))
+		return nil
+	}
+
+	swap.status = SwapAgreed
+	c.RecordEvent(event.NewPositionSwapAgreed(c.id.String(), swapID, member.ID().String()))
+
+	return nil
+}
+
+// ApprovePositionSwap applies an agreed swap. The caller must have checked the approver is the admin.
+func (c *Circle) ApprovePositionSwap(swapID string, adminID valueobject.UserID) error {
+	swap := c.FindPositionSwap(swapID)
+	if swap == nil {
+		return ErrSwapNotFound
+	}
+	if swap.status != SwapAgreed {
+		return ErrSwapNotAgreed
+	}
+	if c.payoutOrder != PayoutOrderFixed {
+		return ErrSwapsNotAllowed
+	}
+
+	// Either member may have been paid or pledged their payout since the request
+	requester := c.FindMemberByID(swap.requesterID)
+	counterparty := c.FindMemberByID(swap.counterpartyID)
+	if requester == nil || counterparty == nil {
+		return ErrNotMember
+	}
+	if _, _, err := c.swappableMembers(requester.UserID(), counterparty.UserID()); err != nil {
+		return err
+	}
+
+	requesterPosition := requester.Position()
+	requester.UpdatePosition(counterparty.Position())
+	counterparty.UpdatePosition(requesterPosition)
+
+	swap.resolve(SwapApproved, adminID.String(), "")
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPositionSwapApproved(
+		c.id.String(),
+		swapID,
+		adminID.String(),
+		requester.ID().String(),
+		counterparty.ID().String(),
+		requester.Position(),
+		counterparty.Position(),
+	))
+
+	return nil
+}
+
+// RejectPositionSwap turns down an open swap. The caller must have checked the approver is the admin.
+func (c *Circle) RejectPositionSwap(swapID string, adminID valueobject.UserID, reason string) error {
+	swap := c.FindPositionSwap(swapID)
+	if swap == nil {
+		return ErrSwapNotFound
+	}
+	if !swap.IsOpen() {
+		return ErrSwapNotPending
+	}
+
+	swap.resolve(SwapRejected, adminID.String(), reason)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewPositionSwapRejected(c.id.String(), swapID, adminID.String(), reason))
+
+	return nil
+}
+
+func (c *Circle) FindPositionSwap(swapID string) *PositionSwap {
+	for _, s := range c.positionSwaps {
+		if s.id == swapID {
+			return s
+		}
+	}
+	return nil
+}
+
+// swappableMembers checks both members are still waiting for an unpledged payout
+func (c *Circle) swappableMembers(requesterUserID, counterpartyUserID valueobject.UserID) (*Member, *Member, error) {
+	if c.status != CircleStatusRecruiting && c.status != CircleStatusActive {
+		return nil, nil, ErrCircleNotActive
+	}
+
+	requester := c.FindMemberByUserID(requesterUserID)
+	counterparty := c.FindMemberByUserID(counterpartyUserID)
+	if requester == nil || counterparty == nil {
+		return nil, nil, ErrNotMember
+	}
+
+	// The current round's recipient is fixed once its contributions are being collected
+	for _, m := range []*Member{requester, counterparty} {
+		if m.HasReceived() || (c.status.IsActive() && m.Position() <= c.currentRound) {
+			return nil, nil, ErrPayoutAlreadyReceived
+		}
+		if m.HasLien() {
+			return nil, nil, ErrMemberHasLien
+		}
+	}
+
+	return requester, counterparty, nil
+}
+
+// selectRecipient picks the member paid this round and the amount they receive
+func (c *Circle) selectRecipient() (*Member, int64) {
+	switch c.payoutOrder {
+	case PayoutOrderRandomDraw:
+		return c.drawRecipient(), c.poolBalance
+	case PayoutOrderAuction:
+		return c.settleAuction()
+	default:
+		return c.FindMemberByPosition(c.currentRound), c.poolBalance
+	}
+}
+
+// payoutCandidates returns members yet to receive a payout, in position order
+func (c *Circle) payoutCandidates() []*Member {
+	candidates := make([]*Member, 0)
+	for _, m := range c.activeMembers() {
+		if !m.HasReceived() {
+			candidates = append(candidates, m)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Position() < candidates[j].Position()
+	})
+	return candidates
+}
+
+// drawRecipient draws lots among members yet to receive with this round's seed from the
+// hash chain. The round's contribution transaction IDs are mixed into the draw, so it
+// cannot be predicted from the seed alone.
+func (c *Circle) drawRecipient() *Member {
+	candidates := c.payoutCandidates()
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	transactionIDs := make([]string, 0)
+	for _, cont := range c.contributions {
+		if cont.Round() == c.currentRound && cont.TransactionID() != nil {
+			transactionIDs = append(transactionIDs, cont.TransactionID().String())
+		}
+	}
+	sort.Strings(transactionIDs)
+
+	candidateIDs := make([]string, len(candidates))
+	for i, m := range candidates {
+		candidateIDs[i] = m.ID().String()
+	}
+
+	seed := hashDrawSeed(c.drawSeed, DrawSeedChainLength-c.currentRound)
+	recipient := candidates[DrawIndex(seed, c.currentRound, transactionIDs, len(candidates))]
+	c.assignRoundPosition(recipient)
+
+	c.RecordEvent(event.NewPayoutDrawn(
+		c.id.String(),
+		c.currentRound,
+		seed,
+		transactionIDs,
+		candidateIDs,
+		recipient.ID().String(),
+		recipient.UserID().String(),
+	))
+
+	return recipient
+}
+
+// DrawIndex is the verifiable draw: SHA-256 of the seed, round and sorted transaction IDs,
+// reduced modulo the number of candidates
+func DrawIndex(seed string, round int, transactionIDs []string, candidates int) int {
+	if candidates <= 0 {
+		return 0
+	}
+	sum := sha256.Sum256([]byte(seed + ":" + strconv.Itoa(round) + ":" + strings.Join(transactionIDs, ",")))
+	return int(binary.BigEndian.Uint64(sum[:8]) % uint64(candidates))
+}
+
+// VerifyDrawSeed checks a round's revealed seed against the circle's published commitment:
+// hashing it once per round must reach the commitment
+func VerifyDrawSeed(commitment, seed string, round int) bool {
+	if round < 1 || round > DrawSeedChainLength {
+		return false
+	}
+	return hashDrawSeed(seed, round) == commitment
+}
+
+// hashDrawSeed applies hex-encoded SHA-256 to the seed the given number of times
+func hashDrawSeed(seed string, times int) string {
+	for i := 0; i < times; i++ {
+		sum := sha256.Sum256([]byte(seed))
+		seed = hex.EncodeToString(sum[:])
+	}
+	return seed
+}
+
+// settleAuction pays the highest bidder the pool less their discount and shares the
+// discount equally among the other members as dividend payouts; any remainder stays
+// with the winner.
+// Without bids the round falls back to position order among members yet to receive.
+func (c *Circle) settleAuction() (*Member, int64) {
+	var winning *PayoutBid
+	for _, b := range c.bids {
+		if b.round != c.currentRound {
+			continue
+		}
+		member := c.FindMemberByID(b.memberID)
+		if member == nil || !member.IsActive() || member.HasReceived() {
+			continue
+		}
+		if winning == nil || b.discount > winning.discount ||
+			(b.discount == winning.discount && b.placedAt.Before(winning.placedAt)) {
+			winning = b
+		}
+	}
+
+	if winning == nil {
+		candidates := c.payoutCandidates()
+		if len(candidates) == 0 {
+			return nil, c.poolBalance
+		}
+		c.assignRoundPosition(candidates[0])
+		return candidates[0], c.poolBalance
+	}
+
+	winner := c.FindMemberByID(winning.memberID)
+	c.assignRoundPosition(winner)
+
+	others := make([]*Member, 0)
+	recipients := make([]string, 0)
+	for _, m := range c.activeMembers() {
+		if !m.ID().Equals(winner.ID()) {
+			others = append(others, m)
+			recipients = append(recipients, m.UserID().String())
+		}
+	}
+
+	discount := winning.discount
+	if discount > c.poolBalance {
+		discount = c.poolBalance
+	}
+	var dividend int64
+	if len(others) > 0 {
+		dividend = discount / int64(len(others))
+	}
+	payout := c.poolBalance - dividend*int64(len(others))
+
+	c.RecordEvent(event.NewAuctionSettled(
+		c.id.String(),
+		c.currentRound,
+		winner.ID().String(),
+		winner.UserID().String(),
+		discount,
+		dividend,
+		recipients,
+	))
+
+	if dividend > 0 {
+		for _, m := range others {
+			c.RecordEvent(event.NewPayoutTriggeredOfKind(
+				c.id.String(),
+				m.ID().String(),
+				m.UserID().String(),
+				c.currentRound,
+				dividend,
+				event.PayoutKindAuctionDividend,
+				"",
+			))
+		}
+	}
+
+	return winner, payout
+}
+
+// assignRoundPosition moves the round's recipient into the round's position so
+// positions keep recording the order members were paid in
+func (c *Circle) assignRoundPosition(recipient *Member) {
+	if recipient.Position() == c.currentRound {
+		return
+	}
+	if holder := c.FindMemberByPosition(c.currentRound); holder != nil {
+		holder.UpdatePosition(recipient.Position())
+	}
+	recipient.UpdatePosition(c.currentRound)
+}
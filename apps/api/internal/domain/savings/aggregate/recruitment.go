@@ -0,0 +1,245 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Recruitment errors
+var (
+	ErrInvalidLocation         = errors.New("location is too long")
+	ErrInvalidPlannedStart     = errors.New("planned start date must be in the future")
+	ErrJoinApprovalRequired    = errors.New("circle requires admin approval to join")
+	ErrJoinApprovalNotRequired = errors.New("circle does not require approval to join")
+	ErrJoinRequestPending      = errors.New("user already has a pending join request")
+	ErrJoinRequestNotFound     = errors.New("join request not found")
+	ErrJoinRequestClosed       = errors.New("join request has already been decided")
+	ErrNotJoinRequester        = errors.New("only the requester can withdraw a join request")
+)
+
+// MaxLocationLength is the longest location a circle can be listed under
+const MaxLocationLength = 100
+
+// JoinRequestStatus represents the state of a request to join a circle
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending   JoinRequestStatus = "pending"
+	JoinRequestApproved  JoinRequestStatus = "approved"
+	JoinRequestDeclined  JoinRequestStatus = "declined"
+	JoinRequestWithdrawn JoinRequestStatus = "withdrawn"
+	JoinRequestLapsed    JoinRequestStatus = "lapsed" // The circle started or filled before a decision
+)
+
+// JoinRequest is a user's request to join a circle that approves its members
+type JoinRequest struct {
+	id          string
+	userID      valueobject.UserID
+	message     string
+	status      JoinRequestStatus
+	reviewedBy  string
+	reason      string
+	requestedAt time.Time
+	decidedAt   *time.Time
+}
+
+func (r *JoinRequest) ID() string                 { return r.id }
+func (r *JoinRequest) UserID() valueobject.UserID { return r.userID }
+func (r *JoinRequest) Message() string            { return r.message }
+func (r *JoinRequest) Status() JoinRequestStatus  { return r.status }
+func (r *JoinRequest) ReviewedBy() string         { return r.reviewedBy }
+func (r *JoinRequest) Reason() string             { return r.reason }
+func (r *JoinRequest) RequestedAt() time.Time     { return r.requestedAt }
+func (r *JoinRequest) DecidedAt() *time.Time      { return r.decidedAt }
+func (r *JoinRequest) IsPending() bool            { return r.status == JoinRequestPending }
+
+func (r *JoinRequest) decide(status JoinRequestStatus, reviewedBy, reason string) {
+	now := time.Now().UTC()
+	r.status = status
+	r.reviewedBy = reviewedBy
+	r.reason = reason
+	r.decidedAt = &now
+}
+
+// Getters
+func (c *Circle) Location() string             { return c.location }
+func (c *Circle) PlannedStartDate() *time.Time { return c.plannedStart }
+func (c *Circle) RequiresApproval() bool       { return c.joinApproval }
+func (c *Circle) JoinRequests() []*JoinRequest { return c.joinRequests }
+
+// PendingJoinRequests returns the join requests still awaiting an admin's decision
+func (c *Circle) PendingJoinRequests() []*JoinRequest {
+	pending := make([]*JoinRequest, 0)
+	for _, r := range c.joinRequests {
+		if r.IsPending() {
+			pending = append(pending, r)
+		}
+	}
+	return pending
+}
+
+// SetListing sets how a recruiting circle appears in discovery: where its members are,
+// when it plans to start and whether joining needs an admin's approval. Turning approval
+// off does not admit pending requesters; they stay pending until decided.
+func (c *Circle) SetListing(location string, plannedStartDate *time.Time, requiresApproval bool) error {
+	if !c.status.IsRecruiting() {
+		return ErrAlreadyStarted
+	}
+
+	location = strings.TrimSpace(location)
+	if len(location) > MaxLocationLength {
+		return ErrInvalidLocation
+	}
+	if plannedStartDate != nil && !plannedStartDate.After(time.Now().UTC()) {
+		return ErrInvalidPlannedStart
+	}
+
+	c.location = location
+	c.plannedStart = plannedStartDate
+	c.joinApproval = requiresApproval
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewCircleListingSet(c.id.String(), location, plannedStartDate, requiresApproval))
+
+	return nil
+}
+
+// Join adds a user who found the circle through discovery. Circles that approve their
+// members must be asked with RequestToJoin instead; invite codes still use AddMember.
+func (c *Circle) Join(userID valueobject.UserID) (*Member, error) {
+	if c.joinApproval {
+		return nil, ErrJoinApprovalRequired
+	}
+	return c.AddMember(userID)
+}
+
+// RequestToJoin asks the circle's admins to let a user join
+func (c *Circle) RequestToJoin(requestID string, userID valueobject.UserID, message string) (*JoinRequest, error) {
+	if !c.status.IsRecruiting() {
+		return nil, ErrCircleNotRecruiting
+	}
+	if !c.joinApproval {
+		return nil, ErrJoinApprovalNotRequired
+	}
+	if c.FindMemberByUserID(userID) != nil {
+		return nil, ErrAlreadyMember
+	}
+	if c.IsFull() {
+		return nil, ErrCircleFull
+	}
+	for _, r := range c.joinRequests {
+		if r.IsPending() && r.UserID().Equals(userID) {
+			return nil, ErrJoinRequestPending
+		}
+	}
+
+	request := &JoinRequest{
+		id:          requestID,
+		userID:      userID,
+		message:     strings.TrimSpace(message),
+		status:      JoinRequestPending,
+		requestedAt: time.Now().UTC(),
+	}
+	c.joinRequests = append(c.joinRequests, request)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewJoinRequested(
+		c.id.String(),
+		requestID,
+		userID.String(),
+		request.message,
+		c.AdminUserIDs(),
+	))
+
+	return request, nil
+}
+
+// ReviewJoinRequest lets an admin approve or decline a pending join request.
+// An approved requester becomes a member straight away; if that fills the
+// circle, it starts and the remaining requests lapse.
+func (c *Circle) ReviewJoinRequest(requestID string, adminID valueobject.UserID, approve bool, reason string) (*Member, error) {
+	if !c.IsAdmin(adminID) {
+		return nil, ErrNotAdmin
+	}
+
+	request := c.FindJoinRequest(requestID)
+	if request == nil {
+		return nil, ErrJoinRequestNotFound
+	}
+	if !request.IsPending() {
+		return nil, ErrJoinRequestClosed
+	}
+
+	if !approve {
+		c.decideJoinRequest(request, JoinRequestDeclined, adminID.String(), reason)
+		return nil, nil
+	}
+
+	// Record the approval before admitting the member, so the circle
+	// starting on a full house lapses only the requests still pending
+	c.decideJoinRequest(request, JoinRequestApproved, adminID.String(), reason)
+	member, err := c.AddMember(request.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if c.IsFull() {
+		c.lapseJoinRequests("circle is full")
+	}
+
+	return member, nil
+}
+
+// WithdrawJoinRequest lets a requester take back a pending join request
+func (c *Circle) WithdrawJoinRequest(requestID string, userID valueobject.UserID) error {
+	request := c.FindJoinRequest(requestID)
+	if request == nil {
+		return ErrJoinRequestNotFound
+	}
+	if !request.UserID().Equals(userID) {
+		return ErrNotJoinRequester
+	}
+	if !request.IsPending() {
+		return ErrJoinRequestClosed
+	}
+
+	c.decideJoinRequest(request, JoinRequestWithdrawn, "", "")
+	return nil
+}
+
+// FindJoinRequest finds a join request by ID
+func (c *Circle) FindJoinRequest(requestID string) *JoinRequest {
+	for _, r := range c.joinRequests {
+		if r.ID() == requestID {
+			return r
+		}
+	}
+	return nil
+}
+
+func (c *Circle) decideJoinRequest(request *JoinRequest, status JoinRequestStatus, reviewedBy, reason string) {
+	request.decide(status, reviewedBy, reason)
+	c.updatedAt = time.Now().UTC()
+
+	c.RecordEvent(event.NewJoinRequestDecided(
+		c.id.String(),
+		request.ID(),
+		request.UserID().String(),
+		string(status),
+		reviewedBy,
+		reason,
+	))
+}
+
+// lapseJoinRequests closes every pending request once the circle can no longer take them
+func (c *Circle) lapseJoinRequests(reason string) {
+	for _, r := range c.joinRequests {
+		if r.IsPending() {
+			c.decideJoinRequest(r, JoinRequestLapsed, "", reason)
+		}
+	}
+}
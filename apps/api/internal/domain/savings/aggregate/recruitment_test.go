@@ -0,0 +1,73 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestCircle_JoinRequestsNeedAdminApproval(t *testing.T) {
+	circle, users := newTestCircle(t, 3)
+	start := time.Now().UTC().AddDate(0, 0, 14)
+	if err := circle.SetListing("  Yaba, Lagos ", &start, true); err != nil {
+		t.Fatalf("SetListing() error = %v", err)
+	}
+	if circle.Location() != "Yaba, Lagos" {
+		t.Errorf("Location() = %q, want trimmed", circle.Location())
+	}
+
+	applicant := valueobject.GenerateUserID()
+	if _, err := circle.Join(applicant); err != ErrJoinApprovalRequired {
+		t.Fatalf("Join() = %v, want %v", err, ErrJoinApprovalRequired)
+	}
+
+	request, err := circle.RequestToJoin("r-1", applicant, "I save weekly at Tejuosho")
+	if err != nil {
+		t.Fatalf("RequestToJoin() error = %v", err)
+	}
+	if _, err := circle.RequestToJoin("r-2", applicant, ""); err != ErrJoinRequestPending {
+		t.Errorf("RequestToJoin() twice = %v, want %v", err, ErrJoinRequestPending)
+	}
+	if _, err := circle.ReviewJoinRequest("r-1", applicant, true, ""); err != ErrNotAdmin {
+		t.Errorf("ReviewJoinRequest() by non-admin = %v, want %v", err, ErrNotAdmin)
+	}
+
+	member, err := circle.ReviewJoinRequest("r-1", users[0], true, "")
+	if err != nil {
+		t.Fatalf("ReviewJoinRequest() error = %v", err)
+	}
+	if member == nil || !circle.IsMember(applicant) || request.Status() != JoinRequestApproved {
+		t.Fatalf("approved requester is not a member, request %s", request.Status())
+	}
+
+	// A pending request lapses once the last spot is filled and the circle starts
+	late := valueobject.GenerateUserID()
+	if _, err := circle.RequestToJoin("r-3", late, ""); err != nil {
+		t.Fatalf("RequestToJoin() error = %v", err)
+	}
+	if _, err := circle.AddMember(valueobject.GenerateUserID()); err != nil {
+		t.Fatalf("AddMember() by invite error = %v", err)
+	}
+	if !circle.Status().IsActive() {
+		t.Fatalf("Status() = %s, want active once full", circle.Status())
+	}
+	if got := circle.FindJoinRequest("r-3").Status(); got != JoinRequestLapsed {
+		t.Errorf("pending request after start = %s, want lapsed", got)
+	}
+}
+
+func TestCircle_SetListingValidation(t *testing.T) {
+	circle, _ := newTestCircle(t, 3)
+
+	past := time.Now().UTC().AddDate(0, 0, -1)
+	if err := circle.SetListing("Ikeja", &past, false); err != ErrInvalidPlannedStart {
+		t.Errorf("SetListing() past start = %v, want %v", err, ErrInvalidPlannedStart)
+	}
+	if _, err := circle.RequestToJoin("r-1", valueobject.GenerateUserID(), ""); err != ErrJoinApprovalNotRequired {
+		t.Errorf("RequestToJoin() without approval = %v, want %v", err, ErrJoinApprovalNotRequired)
+	}
+	if _, err := circle.Join(valueobject.GenerateUserID()); err != nil {
+		t.Errorf("Join() without approval error = %v", err)
+	}
+}
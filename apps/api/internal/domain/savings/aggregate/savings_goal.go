@@ -0,0 +1,422 @@
+package aggregate
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	sharedevent "hustlex/internal/domain/shared/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Savings goal errors
+var (
+	ErrInvalidGoalName       = errors.New("goal name is required")
+	ErrInvalidGoalTarget     = errors.New("goal target must be positive")
+	ErrInvalidGoalTargetDate = errors.New("goal target date must be in the future")
+	ErrInvalidGoalMode       = errors.New("invalid goal mode")
+	ErrGoalNotActive         = errors.New("savings goal is not active")
+	ErrGoalLocked            = errors.New("locked goal cannot be withdrawn from before its target date")
+	ErrGoalNotLocked         = errors.New("only a locked goal before its target date can be broken")
+	ErrInvalidGoalAmount     = errors.New("amount must be positive and in the goal's currency")
+	ErrInsufficientGoalFunds = errors.New("amount exceeds the goal balance")
+	ErrInvalidAutoSaveRule   = errors.New("invalid auto-save rule")
+)
+
+// EarlyBreakPenaltyBps is the share of a locked goal's balance forfeited when it is broken early
+const EarlyBreakPenaltyBps = 500
+
+// progressMilestones are the percentages at which a GoalProgressed event is recorded
+var progressMilestones = []int{25, 50, 75, 100}
+
+// GoalMode decides when money can leave a goal
+type GoalMode string
+
+const (
+	GoalModeFlexible GoalMode = "flexible" // Withdraw any time
+	GoalModeLocked   GoalMode = "locked"   // Withdraw from the target date; breaking early costs a penalty
+)
+
+func (m GoalMode) IsValid() bool {
+	return m == GoalModeFlexible || m == GoalModeLocked
+}
+
+// GoalStatus represents the state of a savings goal
+type GoalStatus string
+
+const (
+	GoalStatusActive GoalStatus = "active"
+	GoalStatusClosed GoalStatus = "closed" // Emptied by the owner
+	GoalStatusBroken GoalStatus = "broken" // Locked goal emptied early, with a penalty
+)
+
+// Deposit sources recorded on a goal deposit
+const (
+	GoalSourceManual    = "manual"
+	GoalSourceScheduled = "scheduled"
+	GoalSourceRoundUp   = "round_up"
+)
+
+// AutoSaveKind is how a goal saves automatically
+type AutoSaveKind string
+
+const (
+	AutoSaveFixed   AutoSaveKind = "fixed"    // A fixed amount on a schedule
+	AutoSaveRoundUp AutoSaveKind = "round_up" // The change from rounding up each wallet debit
+)
+
+// AutoSaveRule moves money into a goal without the owner acting
+type AutoSaveRule struct {
+	kind      AutoSaveKind
+	amount    valueobject.Money
+	frequency ContributionFrequency
+	roundUpTo int64
+	nextRunAt time.Time
+}
+
+func (r *AutoSaveRule) Kind() AutoSaveKind               { return r.kind }
+func (r *AutoSaveRule) Amount() valueobject.Money        { return r.amount }
+func (r *AutoSaveRule) Frequency() ContributionFrequency { return r.frequency }
+func (r *AutoSaveRule) RoundUpTo() int64                 { return r.roundUpTo }
+func (r *AutoSaveRule) NextRunAt() time.Time             { return r.nextRunAt }
+
+// SavingsGoal is the aggregate root for a personal savings goal.
+// A user's wallet savings balance is the sum of their goals' balances.
+type SavingsGoal struct {
+	sharedevent.AggregateRoot
+
+	id         valueobject.GoalID
+	userID     valueobject.UserID
+	name       string
+	target     valueobject.Money
+	targetDate time.Time
+	mode       GoalMode
+	balance    valueobject.Money
+	status     GoalStatus
+	autoSave   *AutoSaveRule
+	milestone  int
+
+	accruedInterest     int64
+	interestEarned      int64
+	lastAccruedOn       time.Time
+	interestPeriodStart time.Time
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewSavingsGoal opens a savings goal
+func NewSavingsGoal(
+	id valueobject.GoalID,
+	userID valueobject.UserID,
+	name string,
+	target valueobject.Money,
+	targetDate time.Time,
+	mode GoalMode,
+) (*SavingsGoal, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidGoalName
+	}
+	if !target.IsPositive() {
+		return nil, ErrInvalidGoalTarget
+	}
+	if !targetDate.After(time.Now()) {
+		return nil, ErrInvalidGoalTargetDate
+	}
+	if !mode.IsValid() {
+		return nil, ErrInvalidGoalMode
+	}
+
+	now := time.Now().UTC()
+	goal := &SavingsGoal{
+		id:         id,
+		userID:     userID,
+		name:       name,
+		target:     target,
+		targetDate: targetDate,
+		mode:       mode,
+		balance:    valueobject.Zero(target.Currency()),
+		status:     GoalStatusActive,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+
+	goal.RecordEvent(event.NewSavingsGoalCreated(
+		id.String(),
+		userID.String(),
+		name,
+		target.Amount(),
+		string(target.Currency()),
+		targetDate,
+		string(mode),
+	))
+
+	return goal, nil
+}
+
+// Getters
+func (g *SavingsGoal) ID() valueobject.GoalID        { return g.id }
+func (g *SavingsGoal) UserID() valueobject.UserID    { return g.userID }
+func (g *SavingsGoal) Name() string                  { return g.name }
+func (g *SavingsGoal) Target() valueobject.Money     { return g.target }
+func (g *SavingsGoal) TargetDate() time.Time         { return g.targetDate }
+func (g *SavingsGoal) Mode() GoalMode                { return g.mode }
+func (g *SavingsGoal) Balance() valueobject.Money    { return g.balance }
+func (g *SavingsGoal) Status() GoalStatus            { return g.status }
+func (g *SavingsGoal) AutoSave() *AutoSaveRule       { return g.autoSave }
+func (g *SavingsGoal) CreatedAt() time.Time          { return g.createdAt }
+func (g *SavingsGoal) UpdatedAt() time.Time          { return g.updatedAt }
+func (g *SavingsGoal) IsActive() bool                { return g.status == GoalStatusActive }
+func (g *SavingsGoal) IsAchieved() bool              { return g.balance.GreaterThanOrEqual(g.target) }
+func (g *SavingsGoal) IsMatured(asOf time.Time) bool { return !asOf.Before(g.targetDate) }
+
+// Progress is the percentage of the target saved, capped at 100
+func (g *SavingsGoal) Progress() int {
+	percent := int(g.balance.Amount() * 100 / g.target.Amount())
+	if percent > 100 {
+		return 100
+	}
+	return percent
+}
+
+// Deposit adds money to the goal. Passing a progress milestone records a GoalProgressed event.
+func (g *SavingsGoal) Deposit(amount valueobject.Money, source string) error {
+	if !g.IsActive() {
+		return ErrGoalNotActive
+	}
+	if !amount.IsPositive() || amount.Currency() != g.balance.Currency() {
+		return ErrInvalidGoalAmount
+	}
+
+	g.balance = g.balance.MustAdd(amount)
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewGoalDeposited(
+		g.id.String(),
+		g.userID.String(),
+		amount.Amount(),
+		source,
+		g.balance.Amount(),
+	))
+
+	g.recordProgress()
+	return nil
+}
+
+// Withdraw takes money out of the goal. Locked goals can only be withdrawn from once matured;
+// before then they must be broken.
+func (g *SavingsGoal) Withdraw(amount valueobject.Money, asOf time.Time) error {
+	if !g.IsActive() {
+		return ErrGoalNotActive
+	}
+	if g.mode == GoalModeLocked && !g.IsMatured(asOf) {
+		return ErrGoalLocked
+	}
+	if !amount.IsPositive() || amount.Currency() != g.balance.Currency() {
+		return ErrInvalidGoalAmount
+	}
+	if g.balance.LessThan(amount) {
+		return ErrInsufficientGoalFunds
+	}
+
+	g.balance = g.balance.MustSubtract(amount)
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewGoalWithdrawn(
+		g.id.String(),
+		g.userID.String(),
+		amount.Amount(),
+		0,
+		g.balance.Amount(),
+		false,
+		false,
+	))
+
+	return nil
+}
+
+// Close empties the goal and stops it. Locked goals can only be closed once matured.
+// Returns the amount paid out.
+func (g *SavingsGoal) Close(asOf time.Time) (valueobject.Money, error) {
+	if !g.IsActive() {
+		return valueobject.Money{}, ErrGoalNotActive
+	}
+	if g.mode == GoalModeLocked && !g.IsMatured(asOf) {
+		return valueobject.Money{}, ErrGoalLocked
+	}
+
+	payout := g.balance
+	g.empty(GoalStatusClosed)
+
+	g.RecordEvent(event.NewGoalWithdrawn(
+		g.id.String(),
+		g.userID.String(),
+		payout.Amount(),
+		0,
+		0,
+		true,
+		false,
+	))
+
+	return payout, nil
+}
+
+// Break empties a locked goal before its target date. The early break penalty is forfeited.
+// Returns the payout and the penalty.
+func (g *SavingsGoal) Break(asOf time.Time) (valueobject.Money, valueobject.Money, error) {
+	if !g.IsActive() {
+		return valueobject.Money{}, valueobject.Money{}, ErrGoalNotActive
+	}
+	if g.mode != GoalModeLocked || g.IsMatured(asOf) {
+		return valueobject.Money{}, valueobject.Money{}, ErrGoalNotLocked
+	}
+
+	balance := g.balance
+	penalty := valueobject.MustNewMoney(balance.Amount()*EarlyBreakPenaltyBps/10000, balance.Currency())
+	payout := balance.MustSubtract(penalty)
+	g.empty(GoalStatusBroken)
+
+	g.RecordEvent(event.NewGoalWithdrawn(
+		g.id.String(),
+		g.userID.String(),
+		payout.Amount(),
+		penalty.Amount(),
+		0,
+		true,
+		true,
+	))
+
+	return payout, penalty, nil
+}
+
+// SetFixedAutoSave saves a fixed amount on a schedule, starting at startAt
+func (g *SavingsGoal) SetFixedAutoSave(amount valueobject.Money, frequency ContributionFrequency, startAt time.Time) error {
+	if !g.IsActive() {
+		return ErrGoalNotActive
+	}
+	if !amount.IsPositive() || amount.Currency() != g.balance.Currency() {
+		return ErrInvalidAutoSaveRule
+	}
+	if frequency != FrequencyDaily && frequency != FrequencyWeekly && frequency != FrequencyMonthly {
+		return ErrInvalidAutoSaveRule
+	}
+
+	g.autoSave = &AutoSaveRule{
+		kind:      AutoSaveFixed,
+		amount:    amount,
+		frequency: frequency,
+		nextRunAt: startAt,
+	}
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewAutoSaveRuleSet(
+		g.id.String(),
+		g.userID.String(),
+		string(AutoSaveFixed),
+		amount.Amount(),
+		string(frequency),
+		0,
+	))
+
+	return nil
+}
+
+// SetRoundUpAutoSave saves the change from rounding each wallet debit up to a multiple of roundUpTo
+func (g *SavingsGoal) SetRoundUpAutoSave(roundUpTo int64) error {
+	if !g.IsActive() {
+		return ErrGoalNotActive
+	}
+	if roundUpTo <= 0 {
+		return ErrInvalidAutoSaveRule
+	}
+
+	g.autoSave = &AutoSaveRule{
+		kind:      AutoSaveRoundUp,
+		roundUpTo: roundUpTo,
+	}
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewAutoSaveRuleSet(
+		g.id.String(),
+		g.userID.String(),
+		string(AutoSaveRoundUp),
+		0,
+		"",
+		roundUpTo,
+	))
+
+	return nil
+}
+
+// ClearAutoSave stops saving automatically
+func (g *SavingsGoal) ClearAutoSave() {
+	if g.autoSave == nil {
+		return
+	}
+
+	g.autoSave = nil
+	g.updatedAt = time.Now().UTC()
+
+	g.RecordEvent(event.NewAutoSaveRuleSet(g.id.String(), g.userID.String(), "", 0, "", 0))
+}
+
+// IsAutoSaveDue reports whether a scheduled auto-save should run
+func (g *SavingsGoal) IsAutoSaveDue(asOf time.Time) bool {
+	return g.IsActive() && g.autoSave != nil && g.autoSave.kind == AutoSaveFixed && !asOf.Before(g.autoSave.nextRunAt)
+}
+
+// AdvanceAutoSave moves a scheduled auto-save to its next run, whether or not this run saved anything
+func (g *SavingsGoal) AdvanceAutoSave(asOf time.Time) {
+	if g.autoSave == nil || g.autoSave.kind != AutoSaveFixed {
+		return
+	}
+
+	next := g.autoSave.nextRunAt
+	for !next.After(asOf) {
+		next = g.autoSave.frequency.NextDueDate(next)
+	}
+	g.autoSave.nextRunAt = next
+	g.updatedAt = time.Now().UTC()
+}
+
+// RoundUp returns the change a wallet debit rounds up to; zero if the goal does not round up
+func (g *SavingsGoal) RoundUp(debit valueobject.Money) valueobject.Money {
+	zero := valueobject.Zero(g.balance.Currency())
+	if !g.IsActive() || g.autoSave == nil || g.autoSave.kind != AutoSaveRoundUp || debit.Currency() != g.balance.Currency() {
+		return zero
+	}
+
+	remainder := debit.Amount() % g.autoSave.roundUpTo
+	if remainder == 0 {
+		return zero
+	}
+	return valueobject.MustNewMoney(g.autoSave.roundUpTo-remainder, debit.Currency())
+}
+
+func (g *SavingsGoal) recordProgress() {
+	progress := g.Progress()
+	for _, milestone := range progressMilestones {
+		if progress < milestone || g.milestone >= milestone {
+			continue
+		}
+		g.milestone = milestone
+		g.RecordEvent(event.NewGoalProgressed(
+			g.id.String(),
+			g.userID.String(),
+			milestone,
+			g.balance.Amount(),
+			g.target.Amount(),
+		))
+	}
+}
+
+// empty stops the goal. Interest accrued but not yet capitalized is forfeited.
+func (g *SavingsGoal) empty(status GoalStatus) {
+	g.balance = valueobject.Zero(g.balance.Currency())
+	g.accruedInterest = 0
+	g.status = status
+	g.autoSave = nil
+	g.updatedAt = time.Now().UTC()
+}
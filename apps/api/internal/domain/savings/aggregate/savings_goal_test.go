@@ -0,0 +1,123 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/savings/event"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func newTestGoal(t *testing.T, mode GoalMode) *SavingsGoal {
+	t.Helper()
+
+	goal, err := NewSavingsGoal(
+		valueobject.GenerateGoalID(), valueobject.GenerateUserID(), "New Laptop",
+		valueobject.MustNewMoney(40000000, valueobject.NGN), time.Now().AddDate(0, 6, 0), mode,
+	)
+	if err != nil {
+		t.Fatalf("NewSavingsGoal() error = %v", err)
+	}
+	goal.DomainEvents()
+	return goal
+}
+
+func TestSavingsGoal_DepositRecordsMilestones(t *testing.T) {
+	goal := newTestGoal(t, GoalModeFlexible)
+
+	if err := goal.Deposit(valueobject.MustNewMoney(21000000, valueobject.NGN), GoalSourceManual); err != nil {
+		t.Fatalf("Deposit() error = %v", err)
+	}
+
+	var milestones []int
+	for _, e := range goal.DomainEvents() {
+		if progressed, ok := e.(*event.GoalProgressed); ok {
+			milestones = append(milestones, progressed.Percent)
+		}
+	}
+	if len(milestones) != 2 || milestones[0] != 25 || milestones[1] != 50 {
+		t.Errorf("milestones = %v, want [25 50]", milestones)
+	}
+
+	if err := goal.Deposit(valueobject.MustNewMoney(20000000, valueobject.NGN), GoalSourceManual); err != nil {
+		t.Fatalf("Deposit() error = %v", err)
+	}
+	progressed, ok := findEvent[*event.GoalProgressed](goal.DomainEvents())
+	if !ok || progressed.Percent != 75 {
+		t.Fatalf("first milestone after second deposit = %v, want 75", progressed)
+	}
+	if !goal.IsAchieved() || goal.Progress() != 100 {
+		t.Errorf("IsAchieved()=%v Progress()=%d, want achieved at 100", goal.IsAchieved(), goal.Progress())
+	}
+}
+
+func TestSavingsGoal_LockedGoalChargesEarlyBreakPenalty(t *testing.T) {
+	goal := newTestGoal(t, GoalModeLocked)
+	if err := goal.Deposit(valueobject.MustNewMoney(10000000, valueobject.NGN), GoalSourceManual); err != nil {
+		t.Fatalf("Deposit() error = %v", err)
+	}
+
+	if err := goal.Withdraw(valueobject.MustNewMoney(1000, valueobject.NGN), time.Now()); err != ErrGoalLocked {
+		t.Errorf("Withdraw() before target date = %v, want %v", err, ErrGoalLocked)
+	}
+
+	payout, penalty, err := goal.Break(time.Now())
+	if err != nil {
+		t.Fatalf("Break() error = %v", err)
+	}
+	if payout.Amount() != 9500000 || penalty.Amount() != 500000 {
+		t.Errorf("Break() = %d payout, %d penalty, want 9500000 and 500000", payout.Amount(), penalty.Amount())
+	}
+	if goal.Status() != GoalStatusBroken || !goal.Balance().IsZero() {
+		t.Errorf("after Break() status=%s balance=%d, want broken and empty", goal.Status(), goal.Balance().Amount())
+	}
+}
+
+func TestSavingsGoal_LockedGoalOpensAtTargetDate(t *testing.T) {
+	goal := newTestGoal(t, GoalModeLocked)
+	if err := goal.Deposit(valueobject.MustNewMoney(10000000, valueobject.NGN), GoalSourceManual); err != nil {
+		t.Fatalf("Deposit() error = %v", err)
+	}
+
+	matured := goal.TargetDate()
+	if _, _, err := goal.Break(matured); err != ErrGoalNotLocked {
+		t.Errorf("Break() at target date = %v, want %v", err, ErrGoalNotLocked)
+	}
+
+	payout, err := goal.Close(matured)
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if payout.Amount() != 10000000 || goal.Status() != GoalStatusClosed {
+		t.Errorf("Close() = %d, status %s, want full balance and closed", payout.Amount(), goal.Status())
+	}
+}
+
+func TestSavingsGoal_AutoSaveRules(t *testing.T) {
+	goal := newTestGoal(t, GoalModeFlexible)
+	start := time.Now()
+
+	if err := goal.SetFixedAutoSave(valueobject.MustNewMoney(100000, valueobject.NGN), FrequencyBiweekly, start); err != ErrInvalidAutoSaveRule {
+		t.Errorf("SetFixedAutoSave(biweekly) = %v, want %v", err, ErrInvalidAutoSaveRule)
+	}
+	if err := goal.SetFixedAutoSave(valueobject.MustNewMoney(100000, valueobject.NGN), FrequencyWeekly, start); err != nil {
+		t.Fatalf("SetFixedAutoSave() error = %v", err)
+	}
+	if !goal.IsAutoSaveDue(start) {
+		t.Fatal("IsAutoSaveDue() at start = false, want true")
+	}
+	goal.AdvanceAutoSave(start)
+	if goal.IsAutoSaveDue(start.AddDate(0, 0, 6)) || !goal.IsAutoSaveDue(start.AddDate(0, 0, 7)) {
+		t.Errorf("NextRunAt() = %v, want one week after %v", goal.AutoSave().NextRunAt(), start)
+	}
+
+	if err := goal.SetRoundUpAutoSave(10000); err != nil {
+		t.Fatalf("SetRoundUpAutoSave() error = %v", err)
+	}
+	if change := goal.RoundUp(valueobject.MustNewMoney(123450, valueobject.NGN)); change.Amount() != 6550 {
+		t.Errorf("RoundUp(123450) = %d, want 6550", change.Amount())
+	}
+	if change := goal.RoundUp(valueobject.MustNewMoney(120000, valueobject.NGN)); !change.IsZero() {
+		t.Errorf("RoundUp(120000) = %d, want 0", change.Amount())
+	}
+}
@@ -133,7 +133,16 @@ func NewContributionMade(circleID, contributionID, memberID string, round int, a
 	}
 }
 
-// PayoutTriggered is emitted when a payout is made to a member
+// Payout kinds for money paid out of a circle other than a member's share of the pool
+const (
+	PayoutKindAuctionDividend     = "auction_dividend"
+	PayoutKindEmergencyWithdrawal = "emergency_withdrawal"
+	PayoutKindShortfallRepayment  = "shortfall_repayment"
+	PayoutKindReserveRelease      = "reserve_release"
+)
+
+// PayoutTriggered is emitted when a payout is made to a member.
+// Kind is empty for a member's share of the pool; other kinds are paid without a fee or lien.
 type PayoutTriggered struct {
 	sharedevent.BaseEvent
 	CircleID    string `json:"circle_id"`
@@ -141,9 +150,12 @@ type PayoutTriggered struct {
 	UserID      string `json:"user_id"`
 	Round       int    `json:"round"`
 	Amount      int64  `json:"amount"`
+	LienLoanID  string `json:"lien_loan_id,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	SourceID    string `json:"source_id,omitempty"`
 }
 
-func NewPayoutTriggered(circleID, recipientID, userID string, round int, amount int64) *PayoutTriggered {
+func NewPayoutTriggered(circleID, recipientID, userID string, round int, amount int64, lienLoanID string) *PayoutTriggered {
 	return &PayoutTriggered{
 		BaseEvent: sharedevent.NewBaseEvent(
 			"PayoutTriggered",
@@ -155,9 +167,19 @@ func NewPayoutTriggered(circleID, recipientID, userID string, round int, amount
 		UserID:      userID,
 		Round:       round,
 		Amount:      amount,
+		LienLoanID:  lienLoanID,
 	}
 }
 
+// NewPayoutTriggeredOfKind triggers a payout other than a share of the pool.
+// The source ID tells apart several payouts of the same kind to a member in one round.
+func NewPayoutTriggeredOfKind(circleID, recipientID, userID string, round int, amount int64, kind, sourceID string) *PayoutTriggered {
+	payout := NewPayoutTriggered(circleID, recipientID, userID, round, amount, "")
+	payout.Kind = kind
+	payout.SourceID = sourceID
+	return payout
+}
+
 // RoundCompleted is emitted when a round is completed
 type RoundCompleted struct {
 	sharedevent.BaseEvent
@@ -201,3 +223,767 @@ func NewCircleCompleted(circleID string, totalRounds int, totalSaved int64) *Cir
 		TotalSaved:  totalSaved,
 	}
 }
+
+// PayoutLienPlaced is emitted when a member's payout is pledged as loan collateral
+type PayoutLienPlaced struct {
+	sharedevent.BaseEvent
+	CircleID string `json:"circle_id"`
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	LoanID   string `json:"loan_id"`
+}
+
+func NewPayoutLienPlaced(circleID, memberID, userID, loanID string) *PayoutLienPlaced {
+	return &PayoutLienPlaced{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PayoutLienPlaced",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		MemberID: memberID,
+		UserID:   userID,
+		LoanID:   loanID,
+	}
+}
+
+// PayoutLienReleased is emitted when a lien on a member's payout is lifted
+type PayoutLienReleased struct {
+	sharedevent.BaseEvent
+	CircleID string `json:"circle_id"`
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	LoanID   string `json:"loan_id"`
+}
+
+func NewPayoutLienReleased(circleID, memberID, userID, loanID string) *PayoutLienReleased {
+	return &PayoutLienReleased{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PayoutLienReleased",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		MemberID: memberID,
+		UserID:   userID,
+		LoanID:   loanID,
+	}
+}
+
+// PayoutLienSettled is emitted when part of a member's payout is paid to the loan it secures.
+// The reference is scoped to the payout, so the lender can apply it exactly once.
+type PayoutLienSettled struct {
+	sharedevent.BaseEvent
+	CircleID      string `json:"circle_id"`
+	MemberID      string `json:"member_id"`
+	UserID        string `json:"user_id"`
+	LoanID        string `json:"loan_id"`
+	Round         int    `json:"round"`
+	Amount        int64  `json:"amount"`
+	Reference     string `json:"reference"`
+	TransactionID string `json:"transaction_id"`
+}
+
+func NewPayoutLienSettled(circleID, memberID, userID, loanID string, round int, amount int64, reference, transactionID string) *PayoutLienSettled {
+	return &PayoutLienSettled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PayoutLienSettled",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:      circleID,
+		MemberID:      memberID,
+		UserID:        userID,
+		LoanID:        loanID,
+		Round:         round,
+		Amount:        amount,
+		Reference:     reference,
+		TransactionID: transactionID,
+	}
+}
+
+// PayoutOrderSet is emitted when a circle chooses how payout positions are decided.
+// For random draws only the end of the seed's SHA-256 hash chain is published up front.
+type PayoutOrderSet struct {
+	sharedevent.BaseEvent
+	CircleID       string `json:"circle_id"`
+	Order          string `json:"order"`
+	SeedCommitment string `json:"seed_commitment,omitempty"`
+}
+
+func NewPayoutOrderSet(circleID, order, seedCommitment string) *PayoutOrderSet {
+	return &PayoutOrderSet{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PayoutOrderSet",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		Order:          order,
+		SeedCommitment: seedCommitment,
+	}
+}
+
+// PayoutDrawn is emitted when a round's recipient is drawn by lot.
+// It carries every input to the draw so members can recompute the result. The seed is
+// this round's link in the hash chain; hashing it Round times gives the commitment.
+type PayoutDrawn struct {
+	sharedevent.BaseEvent
+	CircleID       string   `json:"circle_id"`
+	Round          int      `json:"round"`
+	Seed           string   `json:"seed"`
+	TransactionIDs []string `json:"transaction_ids"`
+	Candidates     []string `json:"candidates"`
+	MemberID       string   `json:"member_id"`
+	UserID         string   `json:"user_id"`
+}
+
+func NewPayoutDrawn(circleID string, round int, seed string, transactionIDs, candidates []string, memberID, userID string) *PayoutDrawn {
+	return &PayoutDrawn{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PayoutDrawn",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		Round:          round,
+		Seed:           seed,
+		TransactionIDs: transactionIDs,
+		Candidates:     candidates,
+		MemberID:       memberID,
+		UserID:         userID,
+	}
+}
+
+// PayoutBidPlaced is emitted when a member bids a discount for this round's payout
+type PayoutBidPlaced struct {
+	sharedevent.BaseEvent
+	CircleID string `json:"circle_id"`
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	Round    int    `json:"round"`
+	Discount int64  `json:"discount"`
+}
+
+func NewPayoutBidPlaced(circleID, memberID, userID string, round int, discount int64) *PayoutBidPlaced {
+	return &PayoutBidPlaced{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PayoutBidPlaced",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		MemberID: memberID,
+		UserID:   userID,
+		Round:    round,
+		Discount: discount,
+	}
+}
+
+// AuctionSettled is emitted when a round's auction is won.
+// The winning discount is shared equally among the other members as a dividend.
+type AuctionSettled struct {
+	sharedevent.BaseEvent
+	CircleID           string   `json:"circle_id"`
+	Round              int      `json:"round"`
+	WinnerMemberID     string   `json:"winner_member_id"`
+	WinnerUserID       string   `json:"winner_user_id"`
+	Discount           int64    `json:"discount"`
+	DividendPerMember  int64    `json:"dividend_per_member"`
+	DividendRecipients []string `json:"dividend_recipients"` // User IDs
+}
+
+func NewAuctionSettled(circleID string, round int, winnerMemberID, winnerUserID string, discount, dividendPerMember int64, dividendRecipients []string) *AuctionSettled {
+	return &AuctionSettled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"AuctionSettled",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:           circleID,
+		Round:              round,
+		WinnerMemberID:     winnerMemberID,
+		WinnerUserID:       winnerUserID,
+		Discount:           discount,
+		DividendPerMember:  dividendPerMember,
+		DividendRecipients: dividendRecipients,
+	}
+}
+
+// PositionSwapRequested is emitted when a member asks to trade payout positions
+type PositionSwapRequested struct {
+	sharedevent.BaseEvent
+	CircleID             string `json:"circle_id"`
+	SwapID               string `json:"swap_id"`
+	RequesterID          string `json:"requester_id"`
+	CounterpartyID       string `json:"counterparty_id"`
+	RequesterPosition    int    `json:"requester_position"`
+	CounterpartyPosition int    `json:"counterparty_position"`
+}
+
+func NewPositionSwapRequested(circleID, swapID, requesterID, counterpartyID string, requesterPosition, counterpartyPosition int) *PositionSwapRequested {
+	return &PositionSwapRequested{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PositionSwapRequested",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:             circleID,
+		SwapID:               swapID,
+		RequesterID:          requesterID,
+		CounterpartyID:       counterpartyID,
+		RequesterPosition:    requesterPosition,
+		CounterpartyPosition: counterpartyPosition,
+	}
+}
+
+// PositionSwapAgreed is emitted when the counterparty accepts a swap, pending admin approval
+type PositionSwapAgreed struct {
+	sharedevent.BaseEvent
+	CircleID       string `json:"circle_id"`
+	SwapID         string `json:"swap_id"`
+	CounterpartyID string `json:"counterparty_id"`
+}
+
+func NewPositionSwapAgreed(circleID, swapID, counterpartyID string) *PositionSwapAgreed {
+	return &PositionSwapAgreed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PositionSwapAgreed",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		SwapID:         swapID,
+		CounterpartyID: counterpartyID,
+	}
+}
+
+// PositionSwapApproved is emitted when the admin approves a swap and positions change
+type PositionSwapApproved struct {
+	sharedevent.BaseEvent
+	CircleID             string `json:"circle_id"`
+	SwapID               string `json:"swap_id"`
+	ApprovedBy           string `json:"approved_by"`
+	RequesterID          string `json:"requester_id"`
+	CounterpartyID       string `json:"counterparty_id"`
+	RequesterPosition    int    `json:"requester_position"`
+	CounterpartyPosition int    `json:"counterparty_position"`
+}
+
+func NewPositionSwapApproved(circleID, swapID, approvedBy, requesterID, counterpartyID string, requesterPosition, counterpartyPosition int) *PositionSwapApproved {
+	return &PositionSwapApproved{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PositionSwapApproved",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:             circleID,
+		SwapID:               swapID,
+		ApprovedBy:           approvedBy,
+		RequesterID:          requesterID,
+		CounterpartyID:       counterpartyID,
+		RequesterPosition:    requesterPosition,
+		CounterpartyPosition: counterpartyPosition,
+	}
+}
+
+// PositionSwapRejected is emitted when the counterparty or admin turns a swap down
+type PositionSwapRejected struct {
+	sharedevent.BaseEvent
+	CircleID   string `json:"circle_id"`
+	SwapID     string `json:"swap_id"`
+	RejectedBy string `json:"rejected_by"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+func NewPositionSwapRejected(circleID, swapID, rejectedBy, reason string) *PositionSwapRejected {
+	return &PositionSwapRejected{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"PositionSwapRejected",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:   circleID,
+		SwapID:     swapID,
+		RejectedBy: rejectedBy,
+		Reason:     reason,
+	}
+}
+
+// SavingsTargetSet is emitted when a fixed-target circle sets its goal
+type SavingsTargetSet struct {
+	sharedevent.BaseEvent
+	CircleID     string    `json:"circle_id"`
+	TargetAmount int64     `json:"target_amount"`
+	TargetDate   time.Time `json:"target_date"`
+	PayoutMode   string    `json:"payout_mode"`
+}
+
+func NewSavingsTargetSet(circleID string, targetAmount int64, targetDate time.Time, payoutMode string) *SavingsTargetSet {
+	return &SavingsTargetSet{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SavingsTargetSet",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		TargetAmount: targetAmount,
+		TargetDate:   targetDate,
+		PayoutMode:   payoutMode,
+	}
+}
+
+// SavingsTargetReached is emitted the first time a fixed-target pool reaches its goal
+type SavingsTargetReached struct {
+	sharedevent.BaseEvent
+	CircleID     string `json:"circle_id"`
+	Round        int    `json:"round"`
+	TargetAmount int64  `json:"target_amount"`
+	PoolBalance  int64  `json:"pool_balance"`
+}
+
+func NewSavingsTargetReached(circleID string, round int, targetAmount, poolBalance int64) *SavingsTargetReached {
+	return &SavingsTargetReached{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SavingsTargetReached",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		Round:        round,
+		TargetAmount: targetAmount,
+		PoolBalance:  poolBalance,
+	}
+}
+
+// FundShare is one member's share of a distributed pool
+type FundShare struct {
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	Amount   int64  `json:"amount"`
+}
+
+// CircleFundDistributed is emitted when a fixed-target or emergency pool is paid out to members.
+// A PayoutTriggered event is also recorded for each member with a share.
+type CircleFundDistributed struct {
+	sharedevent.BaseEvent
+	CircleID    string      `json:"circle_id"`
+	CircleType  string      `json:"circle_type"`
+	Reason      string      `json:"reason"`
+	PoolBalance int64       `json:"pool_balance"`
+	Shares      []FundShare `json:"shares"`
+}
+
+func NewCircleFundDistributed(circleID, circleType, reason string, poolBalance int64, shares []FundShare) *CircleFundDistributed {
+	return &CircleFundDistributed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleFundDistributed",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:    circleID,
+		CircleType:  circleType,
+		Reason:      reason,
+		PoolBalance: poolBalance,
+		Shares:      shares,
+	}
+}
+
+// CircleAdminAppointed is emitted when a member is made a circle admin
+type CircleAdminAppointed struct {
+	sharedevent.BaseEvent
+	CircleID    string `json:"circle_id"`
+	MemberID    string `json:"member_id"`
+	UserID      string `json:"user_id"`
+	AppointedBy string `json:"appointed_by"`
+}
+
+func NewCircleAdminAppointed(circleID, memberID, userID, appointedBy string) *CircleAdminAppointed {
+	return &CircleAdminAppointed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleAdminAppointed",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:    circleID,
+		MemberID:    memberID,
+		UserID:      userID,
+		AppointedBy: appointedBy,
+	}
+}
+
+// EmergencyWithdrawalRequested is emitted when a member asks to draw from an emergency fund
+type EmergencyWithdrawalRequested struct {
+	sharedevent.BaseEvent
+	CircleID     string `json:"circle_id"`
+	WithdrawalID string `json:"withdrawal_id"`
+	MemberID     string `json:"member_id"`
+	UserID       string `json:"user_id"`
+	Amount       int64  `json:"amount"`
+	Reason       string `json:"reason"`
+	Quorum       int    `json:"quorum"`
+}
+
+func NewEmergencyWithdrawalRequested(circleID, withdrawalID, memberID, userID string, amount int64, reason string, quorum int) *EmergencyWithdrawalRequested {
+	return &EmergencyWithdrawalRequested{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"EmergencyWithdrawalRequested",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		WithdrawalID: withdrawalID,
+		MemberID:     memberID,
+		UserID:       userID,
+		Amount:       amount,
+		Reason:       reason,
+		Quorum:       quorum,
+	}
+}
+
+// EmergencyWithdrawalVoted is emitted when an admin votes on a withdrawal request
+type EmergencyWithdrawalVoted struct {
+	sharedevent.BaseEvent
+	CircleID     string `json:"circle_id"`
+	WithdrawalID string `json:"withdrawal_id"`
+	VoterID      string `json:"voter_id"`
+	Approve      bool   `json:"approve"`
+}
+
+func NewEmergencyWithdrawalVoted(circleID, withdrawalID, voterID string, approve bool) *EmergencyWithdrawalVoted {
+	return &EmergencyWithdrawalVoted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"EmergencyWithdrawalVoted",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		WithdrawalID: withdrawalID,
+		VoterID:      voterID,
+		Approve:      approve,
+	}
+}
+
+// EmergencyWithdrawalApproved is emitted when the admin quorum approves a withdrawal
+// and the amount leaves the pool
+type EmergencyWithdrawalApproved struct {
+	sharedevent.BaseEvent
+	CircleID     string `json:"circle_id"`
+	WithdrawalID string `json:"withdrawal_id"`
+	MemberID     string `json:"member_id"`
+	UserID       string `json:"user_id"`
+	Amount       int64  `json:"amount"`
+}
+
+func NewEmergencyWithdrawalApproved(circleID, withdrawalID, memberID, userID string, amount int64) *EmergencyWithdrawalApproved {
+	return &EmergencyWithdrawalApproved{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"EmergencyWithdrawalApproved",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		WithdrawalID: withdrawalID,
+		MemberID:     memberID,
+		UserID:       userID,
+		Amount:       amount,
+	}
+}
+
+// EmergencyWithdrawalRejected is emitted when enough admins vote against a withdrawal
+type EmergencyWithdrawalRejected struct {
+	sharedevent.BaseEvent
+	CircleID     string `json:"circle_id"`
+	WithdrawalID string `json:"withdrawal_id"`
+	MemberID     string `json:"member_id"`
+	UserID       string `json:"user_id"`
+}
+
+func NewEmergencyWithdrawalRejected(circleID, withdrawalID, memberID, userID string) *EmergencyWithdrawalRejected {
+	return &EmergencyWithdrawalRejected{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"EmergencyWithdrawalRejected",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		WithdrawalID: withdrawalID,
+		MemberID:     memberID,
+		UserID:       userID,
+	}
+}
+
+// EmergencyWithdrawalRepaid is emitted when a member pays back into the emergency fund
+type EmergencyWithdrawalRepaid struct {
+	sharedevent.BaseEvent
+	CircleID      string `json:"circle_id"`
+	WithdrawalID  string `json:"withdrawal_id"`
+	MemberID      string `json:"member_id"`
+	UserID        string `json:"user_id"`
+	TransactionID string `json:"transaction_id"`
+	Amount        int64  `json:"amount"`
+	Outstanding   int64  `json:"outstanding"`
+}
+
+func NewEmergencyWithdrawalRepaid(circleID, withdrawalID, memberID, userID, transactionID string, amount, outstanding int64) *EmergencyWithdrawalRepaid {
+	return &EmergencyWithdrawalRepaid{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"EmergencyWithdrawalRepaid",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:      circleID,
+		WithdrawalID:  withdrawalID,
+		MemberID:      memberID,
+		UserID:        userID,
+		TransactionID: transactionID,
+		Amount:        amount,
+		Outstanding:   outstanding,
+	}
+}
+
+// MissedContributionPolicySet is emitted when a circle chooses how missed contributions are handled
+type MissedContributionPolicySet struct {
+	sharedevent.BaseEvent
+	CircleID        string `json:"circle_id"`
+	Policy          string `json:"policy"`
+	GracePeriodDays int    `json:"grace_period_days"`
+}
+
+func NewMissedContributionPolicySet(circleID, policy string, gracePeriodDays int) *MissedContributionPolicySet {
+	return &MissedContributionPolicySet{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MissedContributionPolicySet",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:        circleID,
+		Policy:          policy,
+		GracePeriodDays: gracePeriodDays,
+	}
+}
+
+// CircleReserveFunded is emitted when money is added to a circle's reserve
+type CircleReserveFunded struct {
+	sharedevent.BaseEvent
+	CircleID      string `json:"circle_id"`
+	UserID        string `json:"user_id"`
+	TransactionID string `json:"transaction_id"`
+	Amount        int64  `json:"amount"`
+	Reserve       int64  `json:"reserve_balance"`
+}
+
+func NewCircleReserveFunded(circleID, userID, transactionID string, amount, reserve int64) *CircleReserveFunded {
+	return &CircleReserveFunded{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleReserveFunded",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:      circleID,
+		UserID:        userID,
+		TransactionID: transactionID,
+		Amount:        amount,
+		Reserve:       reserve,
+	}
+}
+
+// ContributionMissed is emitted when a contribution is still unpaid after the grace period
+type ContributionMissed struct {
+	sharedevent.BaseEvent
+	CircleID       string `json:"circle_id"`
+	ContributionID string `json:"contribution_id"`
+	MemberID       string `json:"member_id"`
+	UserID         string `json:"user_id"`
+	Round          int    `json:"round"`
+	Amount         int64  `json:"amount"`
+	CoveredBy      string `json:"covered_by"` // "reserve" or "" for a short payout
+}
+
+func NewContributionMissed(circleID, contributionID, memberID, userID string, round int, amount int64, coveredBy string) *ContributionMissed {
+	return &ContributionMissed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ContributionMissed",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		ContributionID: contributionID,
+		MemberID:       memberID,
+		UserID:         userID,
+		Round:          round,
+		Amount:         amount,
+		CoveredBy:      coveredBy,
+	}
+}
+
+// MemberDefaulted is emitted when a member misses a contribution after already receiving their payout
+type MemberDefaulted struct {
+	sharedevent.BaseEvent
+	CircleID string `json:"circle_id"`
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	Round    int    `json:"round"`
+	Arrears  int64  `json:"arrears"`
+	Currency string `json:"currency"`
+}
+
+func NewMemberDefaulted(circleID, memberID, userID string, round int, arrears int64, currency string) *MemberDefaulted {
+	return &MemberDefaulted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MemberDefaulted",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		MemberID: memberID,
+		UserID:   userID,
+		Round:    round,
+		Arrears:  arrears,
+		Currency: currency,
+	}
+}
+
+// ShortfallRepaid is emitted when recovered arrears make up a short payout
+type ShortfallRepaid struct {
+	sharedevent.BaseEvent
+	CircleID    string `json:"circle_id"`
+	Round       int    `json:"round"`
+	RecipientID string `json:"recipient_id"`
+	UserID      string `json:"user_id"`
+	DebtorID    string `json:"debtor_id"`
+	Amount      int64  `json:"amount"`
+}
+
+func NewShortfallRepaid(circleID string, round int, recipientID, userID, debtorID string, amount int64) *ShortfallRepaid {
+	return &ShortfallRepaid{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ShortfallRepaid",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:    circleID,
+		Round:       round,
+		RecipientID: recipientID,
+		UserID:      userID,
+		DebtorID:    debtorID,
+		Amount:      amount,
+	}
+}
+
+// ArrearsSettled is emitted when a member pays off some or all of their missed contributions
+type ArrearsSettled struct {
+	sharedevent.BaseEvent
+	CircleID       string `json:"circle_id"`
+	MemberID       string `json:"member_id"`
+	UserID         string `json:"user_id"`
+	TransactionID  string `json:"transaction_id"`
+	Amount         int64  `json:"amount"`
+	Currency       string `json:"currency"`
+	Remaining      int64  `json:"remaining"`
+	LienHeld       int64  `json:"lien_held"` // Wallet funds held against the arrears; any excess is released
+	DefaultCleared bool   `json:"default_cleared"`
+}
+
+func NewArrearsSettled(circleID, memberID, userID, transactionID string, amount int64, currency string, remaining, lienHeld int64, defaultCleared bool) *ArrearsSettled {
+	return &ArrearsSettled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ArrearsSettled",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		MemberID:       memberID,
+		UserID:         userID,
+		TransactionID:  transactionID,
+		Amount:         amount,
+		Currency:       currency,
+		Remaining:      remaining,
+		LienHeld:       lienHeld,
+		DefaultCleared: defaultCleared,
+	}
+}
+
+// CircleReserveReleased is emitted when what is left in a completed circle's reserve
+// is shared between members in good standing
+type CircleReserveReleased struct {
+	sharedevent.BaseEvent
+	CircleID string      `json:"circle_id"`
+	Reserve  int64       `json:"reserve"`
+	Shares   []FundShare `json:"shares"`
+}
+
+func NewCircleReserveReleased(circleID string, reserve int64, shares []FundShare) *CircleReserveReleased {
+	return &CircleReserveReleased{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleReserveReleased",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		Reserve:  reserve,
+		Shares:   shares,
+	}
+}
+
+// AutoDebitMandateChanged is emitted when a member opts in to or out of auto-debit
+type AutoDebitMandateChanged struct {
+	sharedevent.BaseEvent
+	CircleID string `json:"circle_id"`
+	MemberID string `json:"member_id"`
+	UserID   string `json:"user_id"`
+	Enabled  bool   `json:"enabled"`
+}
+
+func NewAutoDebitMandateChanged(circleID, memberID, userID string, enabled bool) *AutoDebitMandateChanged {
+	return &AutoDebitMandateChanged{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"AutoDebitMandateChanged",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		MemberID: memberID,
+		UserID:   userID,
+		Enabled:  enabled,
+	}
+}
+
+// AutoDebitFailed is emitted when a mandated contribution could not be taken from
+// the member's wallet. The member and the circle's admins are told; the debit is
+// retried until the grace period ends.
+type AutoDebitFailed struct {
+	sharedevent.BaseEvent
+	CircleID       string    `json:"circle_id"`
+	ContributionID string    `json:"contribution_id"`
+	MemberID       string    `json:"member_id"`
+	UserID         string    `json:"user_id"`
+	AdminIDs       []string  `json:"admin_ids"`
+	Round          int       `json:"round"`
+	Amount         int64     `json:"amount"`
+	Attempt        int       `json:"attempt"`
+	Reason         string    `json:"reason"`
+	GraceEndsAt    time.Time `json:"grace_ends_at"`
+	FinalAttempt   bool      `json:"final_attempt"`
+}
+
+func NewAutoDebitFailed(circleID, contributionID, memberID, userID string, adminIDs []string, round int, amount int64, attempt int, reason string, graceEndsAt time.Time, finalAttempt bool) *AutoDebitFailed {
+	return &AutoDebitFailed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"AutoDebitFailed",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		ContributionID: contributionID,
+		MemberID:       memberID,
+		UserID:         userID,
+		AdminIDs:       adminIDs,
+		Round:          round,
+		Amount:         amount,
+		Attempt:        attempt,
+		Reason:         reason,
+		GraceEndsAt:    graceEndsAt,
+		FinalAttempt:   finalAttempt,
+	}
+}
@@ -0,0 +1,179 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+const (
+	AggregateTypeSavingsGoal = "SavingsGoal"
+)
+
+// SavingsGoalCreated is emitted when a user opens a savings goal
+type SavingsGoalCreated struct {
+	sharedevent.BaseEvent
+	GoalID     string    `json:"goal_id"`
+	UserID     string    `json:"user_id"`
+	Name       string    `json:"name"`
+	Target     int64     `json:"target"`
+	Currency   string    `json:"currency"`
+	TargetDate time.Time `json:"target_date"`
+	Mode       string    `json:"mode"`
+}
+
+func NewSavingsGoalCreated(goalID, userID, name string, target int64, currency string, targetDate time.Time, mode string) *SavingsGoalCreated {
+	return &SavingsGoalCreated{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"SavingsGoalCreated",
+			goalID,
+			AggregateTypeSavingsGoal,
+		),
+		GoalID:     goalID,
+		UserID:     userID,
+		Name:       name,
+		Target:     target,
+		Currency:   currency,
+		TargetDate: targetDate,
+		Mode:       mode,
+	}
+}
+
+// GoalDeposited is emitted when money is saved towards a goal
+type GoalDeposited struct {
+	sharedevent.BaseEvent
+	GoalID  string `json:"goal_id"`
+	UserID  string `json:"user_id"`
+	Amount  int64  `json:"amount"`
+	Source  string `json:"source"` // manual, scheduled, round_up
+	Balance int64  `json:"balance"`
+}
+
+func NewGoalDeposited(goalID, userID string, amount int64, source string, balance int64) *GoalDeposited {
+	return &GoalDeposited{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"GoalDeposited",
+			goalID,
+			AggregateTypeSavingsGoal,
+		),
+		GoalID:  goalID,
+		UserID:  userID,
+		Amount:  amount,
+		Source:  source,
+		Balance: balance,
+	}
+}
+
+// GoalProgressed is emitted when a goal passes a progress milestone
+type GoalProgressed struct {
+	sharedevent.BaseEvent
+	GoalID   string `json:"goal_id"`
+	UserID   string `json:"user_id"`
+	Percent  int    `json:"percent"`
+	Balance  int64  `json:"balance"`
+	Target   int64  `json:"target"`
+	Achieved bool   `json:"achieved"`
+}
+
+func NewGoalProgressed(goalID, userID string, percent int, balance, target int64) *GoalProgressed {
+	return &GoalProgressed{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"GoalProgressed",
+			goalID,
+			AggregateTypeSavingsGoal,
+		),
+		GoalID:   goalID,
+		UserID:   userID,
+		Percent:  percent,
+		Balance:  balance,
+		Target:   target,
+		Achieved: percent >= 100,
+	}
+}
+
+// GoalWithdrawn is emitted when money is taken out of a goal
+type GoalWithdrawn struct {
+	sharedevent.BaseEvent
+	GoalID  string `json:"goal_id"`
+	UserID  string `json:"user_id"`
+	Amount  int64  `json:"amount"`
+	Penalty int64  `json:"penalty"`
+	Balance int64  `json:"balance"`
+	Closed  bool   `json:"closed"`
+	Broken  bool   `json:"broken"`
+}
+
+func NewGoalWithdrawn(goalID, userID string, amount, penalty, balance int64, closed, broken bool) *GoalWithdrawn {
+	return &GoalWithdrawn{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"GoalWithdrawn",
+			goalID,
+			AggregateTypeSavingsGoal,
+		),
+		GoalID:  goalID,
+		UserID:  userID,
+		Amount:  amount,
+		Penalty: penalty,
+		Balance: balance,
+		Closed:  closed,
+		Broken:  broken,
+	}
+}
+
+// AutoSaveRuleSet is emitted when a goal's auto-save rule is set or cleared
+type AutoSaveRuleSet struct {
+	sharedevent.BaseEvent
+	GoalID    string `json:"goal_id"`
+	UserID    string `json:"user_id"`
+	Kind      string `json:"kind"` // fixed, round_up, or empty when cleared
+	Amount    int64  `json:"amount,omitempty"`
+	Frequency string `json:"frequency,omitempty"`
+	RoundUpTo int64  `json:"round_up_to,omitempty"`
+}
+
+func NewAutoSaveRuleSet(goalID, userID, kind string, amount int64, frequency string, roundUpTo int64) *AutoSaveRuleSet {
+	return &AutoSaveRuleSet{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"AutoSaveRuleSet",
+			goalID,
+			AggregateTypeSavingsGoal,
+		),
+		GoalID:    goalID,
+		UserID:    userID,
+		Kind:      kind,
+		Amount:    amount,
+		Frequency: frequency,
+		RoundUpTo: roundUpTo,
+	}
+}
+
+// InterestCapitalized is emitted when accrued interest is added to a goal's balance
+type InterestCapitalized struct {
+	sharedevent.BaseEvent
+	GoalID      string    `json:"goal_id"`
+	UserID      string    `json:"user_id"`
+	Gross       int64     `json:"gross"`
+	Tax         int64     `json:"tax"`
+	Net         int64     `json:"net"`
+	Balance     int64     `json:"balance"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+func NewInterestCapitalized(goalID, userID string, gross, tax, net, balance int64, periodStart, periodEnd time.Time) *InterestCapitalized {
+	return &InterestCapitalized{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"InterestCapitalized",
+			goalID,
+			AggregateTypeSavingsGoal,
+		),
+		GoalID:      goalID,
+		UserID:      userID,
+		Gross:       gross,
+		Tax:         tax,
+		Net:         net,
+		Balance:     balance,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+	}
+}
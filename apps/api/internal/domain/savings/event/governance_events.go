@@ -0,0 +1,228 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// ProposalOpened is emitted when a member puts a decision to the circle's vote
+type ProposalOpened struct {
+	sharedevent.BaseEvent
+	CircleID     string    `json:"circle_id"`
+	ProposalID   string    `json:"proposal_id"`
+	Action       string    `json:"action"`
+	ProposedBy   string    `json:"proposed_by"`
+	TargetUserID string    `json:"target_user_id,omitempty"`
+	Amount       int64     `json:"amount,omitempty"`
+	Electorate   int       `json:"electorate"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func NewProposalOpened(circleID, proposalID, action, proposedBy, targetUserID string, amount int64, electorate int, expiresAt time.Time) *ProposalOpened {
+	return &ProposalOpened{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ProposalOpened",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:     circleID,
+		ProposalID:   proposalID,
+		Action:       action,
+		ProposedBy:   proposedBy,
+		TargetUserID: targetUserID,
+		Amount:       amount,
+		Electorate:   electorate,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// ProposalVoted is emitted when a member votes on a proposal
+type ProposalVoted struct {
+	sharedevent.BaseEvent
+	CircleID   string `json:"circle_id"`
+	ProposalID string `json:"proposal_id"`
+	VoterID    string `json:"voter_id"`
+	Approve    bool   `json:"approve"`
+}
+
+func NewProposalVoted(circleID, proposalID, voterID string, approve bool) *ProposalVoted {
+	return &ProposalVoted{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ProposalVoted",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:   circleID,
+		ProposalID: proposalID,
+		VoterID:    voterID,
+		Approve:    approve,
+	}
+}
+
+// ProposalDecided is emitted when a proposal passes, fails or expires
+type ProposalDecided struct {
+	sharedevent.BaseEvent
+	CircleID   string `json:"circle_id"`
+	ProposalID string `json:"proposal_id"`
+	Action     string `json:"action"`
+	Status     string `json:"status"`
+	Approvals  int    `json:"approvals"`
+	Rejections int    `json:"rejections"`
+	Reason     string `json:"reason,omitempty"` // Why a passed proposal could not be carried out
+}
+
+func NewProposalDecided(circleID, proposalID, action, status string, approvals, rejections int, reason string) *ProposalDecided {
+	return &ProposalDecided{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ProposalDecided",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:   circleID,
+		ProposalID: proposalID,
+		Action:     action,
+		Status:     status,
+		Approvals:  approvals,
+		Rejections: rejections,
+		Reason:     reason,
+	}
+}
+
+// VotingPolicySet is emitted when a circle sets the quorum and threshold for an action
+type VotingPolicySet struct {
+	sharedevent.BaseEvent
+	CircleID  string `json:"circle_id"`
+	Action    string `json:"action"`
+	Quorum    int    `json:"quorum_percent"`
+	Threshold int    `json:"threshold_percent"`
+}
+
+func NewVotingPolicySet(circleID, action string, quorum, threshold int) *VotingPolicySet {
+	return &VotingPolicySet{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"VotingPolicySet",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:  circleID,
+		Action:    action,
+		Quorum:    quorum,
+		Threshold: threshold,
+	}
+}
+
+// CircleRulesChanged is emitted when a circle's enforced rules change
+type CircleRulesChanged struct {
+	sharedevent.BaseEvent
+	CircleID          string `json:"circle_id"`
+	MaxMissedPayments int    `json:"max_missed_payments"`
+	LateFeeBps        int    `json:"late_fee_bps"`
+}
+
+func NewCircleRulesChanged(circleID string, maxMissedPayments, lateFeeBps int) *CircleRulesChanged {
+	return &CircleRulesChanged{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleRulesChanged",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:          circleID,
+		MaxMissedPayments: maxMissedPayments,
+		LateFeeBps:        lateFeeBps,
+	}
+}
+
+// ContributionAmountChanged is emitted when a vote changes the contribution amount
+type ContributionAmountChanged struct {
+	sharedevent.BaseEvent
+	CircleID       string `json:"circle_id"`
+	OldAmount      int64  `json:"old_amount"`
+	NewAmount      int64  `json:"new_amount"`
+	Currency       string `json:"currency"`
+	EffectiveRound int    `json:"effective_round"`
+}
+
+func NewContributionAmountChanged(circleID string, oldAmount, newAmount int64, currency string, effectiveRound int) *ContributionAmountChanged {
+	return &ContributionAmountChanged{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"ContributionAmountChanged",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:       circleID,
+		OldAmount:      oldAmount,
+		NewAmount:      newAmount,
+		Currency:       currency,
+		EffectiveRound: effectiveRound,
+	}
+}
+
+// MemberRemoved is emitted when a member is voted out or removed under the circle's rules
+type MemberRemoved struct {
+	sharedevent.BaseEvent
+	CircleID  string `json:"circle_id"`
+	MemberID  string `json:"member_id"`
+	UserID    string `json:"user_id"`
+	Reason    string `json:"reason"` // vote, missed_payments
+	RefundDue int64  `json:"refund_due"`
+}
+
+func NewMemberRemoved(circleID, memberID, userID, reason string, refundDue int64) *MemberRemoved {
+	return &MemberRemoved{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"MemberRemoved",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:  circleID,
+		MemberID:  memberID,
+		UserID:    userID,
+		Reason:    reason,
+		RefundDue: refundDue,
+	}
+}
+
+// CircleAdminTransferred is emitted when the last admin leaves and a successor takes over
+type CircleAdminTransferred struct {
+	sharedevent.BaseEvent
+	CircleID   string `json:"circle_id"`
+	FromUserID string `json:"from_user_id"`
+	MemberID   string `json:"member_id"`
+	UserID     string `json:"user_id"`
+}
+
+func NewCircleAdminTransferred(circleID, fromUserID, memberID, userID string) *CircleAdminTransferred {
+	return &CircleAdminTransferred{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleAdminTransferred",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:   circleID,
+		FromUserID: fromUserID,
+		MemberID:   memberID,
+		UserID:     userID,
+	}
+}
+
+// CircleCancelled is emitted when a circle is cancelled by vote
+type CircleCancelled struct {
+	sharedevent.BaseEvent
+	CircleID string `json:"circle_id"`
+	Round    int    `json:"round"`
+	Refunded int64  `json:"refunded"`
+}
+
+func NewCircleCancelled(circleID string, round int, refunded int64) *CircleCancelled {
+	return &CircleCancelled{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleCancelled",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID: circleID,
+		Round:    round,
+		Refunded: refunded,
+	}
+}
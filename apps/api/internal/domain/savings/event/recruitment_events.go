@@ -0,0 +1,82 @@
+package event
+
+import (
+	"time"
+
+	sharedevent "hustlex/internal/domain/shared/event"
+)
+
+// CircleListingSet is emitted when a recruiting circle's discovery listing changes
+type CircleListingSet struct {
+	sharedevent.BaseEvent
+	CircleID         string     `json:"circle_id"`
+	Location         string     `json:"location,omitempty"`
+	PlannedStartDate *time.Time `json:"planned_start_date,omitempty"`
+	RequiresApproval bool       `json:"requires_approval"`
+}
+
+func NewCircleListingSet(circleID, location string, plannedStartDate *time.Time, requiresApproval bool) *CircleListingSet {
+	return &CircleListingSet{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"CircleListingSet",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:         circleID,
+		Location:         location,
+		PlannedStartDate: plannedStartDate,
+		RequiresApproval: requiresApproval,
+	}
+}
+
+// JoinRequested is emitted when a user asks to join a circle that approves its members
+type JoinRequested struct {
+	sharedevent.BaseEvent
+	CircleID  string   `json:"circle_id"`
+	RequestID string   `json:"request_id"`
+	UserID    string   `json:"user_id"`
+	Message   string   `json:"message,omitempty"`
+	AdminIDs  []string `json:"admin_ids"`
+}
+
+func NewJoinRequested(circleID, requestID, userID, message string, adminIDs []string) *JoinRequested {
+	return &JoinRequested{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"JoinRequested",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:  circleID,
+		RequestID: requestID,
+		UserID:    userID,
+		Message:   message,
+		AdminIDs:  adminIDs,
+	}
+}
+
+// JoinRequestDecided is emitted when a join request is approved, declined, withdrawn or lapses
+type JoinRequestDecided struct {
+	sharedevent.BaseEvent
+	CircleID   string `json:"circle_id"`
+	RequestID  string `json:"request_id"`
+	UserID     string `json:"user_id"`
+	Status     string `json:"status"`
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+func NewJoinRequestDecided(circleID, requestID, userID, status, reviewedBy, reason string) *JoinRequestDecided {
+	return &JoinRequestDecided{
+		BaseEvent: sharedevent.NewBaseEvent(
+			"JoinRequestDecided",
+			circleID,
+			AggregateTypeCircle,
+		),
+		CircleID:   circleID,
+		RequestID:  requestID,
+		UserID:     userID,
+		Status:     status,
+		ReviewedBy: reviewedBy,
+		Reason:     reason,
+	}
+}
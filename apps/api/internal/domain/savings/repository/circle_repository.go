@@ -25,6 +25,23 @@ type CircleRepository interface {
 	// FindByUserID retrieves circles a user is a member of
 	FindByUserID(ctx context.Context, userID valueobject.UserID, status *aggregate.CircleStatus) ([]*aggregate.Circle, error)
 
+	// FindDueForMaturity retrieves active fixed-target circles whose target date has passed
+	FindDueForMaturity(ctx context.Context, asOf time.Time) ([]*aggregate.Circle, error)
+
+	// FindWithOverdueContributions retrieves active circles with current-round contributions
+	// still pending after their due date
+	FindWithOverdueContributions(ctx context.Context, asOf time.Time) ([]*aggregate.Circle, error)
+
+	// FindWithDueAutoDebits retrieves active circles with mandated contributions due by asOf
+	// and still within their grace period
+	FindWithDueAutoDebits(ctx context.Context, asOf time.Time) ([]*aggregate.Circle, error)
+
+	// FindWithExpiredProposals retrieves circles with open proposals whose voting period ended by asOf
+	FindWithExpiredProposals(ctx context.Context, asOf time.Time) ([]*aggregate.Circle, error)
+
+	// HasDefaulted reports whether a user is in default on any circle
+	HasDefaulted(ctx context.Context, userID valueobject.UserID) (bool, error)
+
 	// List retrieves circles with filters
 	List(ctx context.Context, filter CircleFilter) ([]*CircleDTO, int64, error)
 
@@ -41,6 +58,9 @@ type CircleFilter struct {
 	Frequency  *aggregate.ContributionFrequency
 	Search     string
 	IsPublic   bool
+	Location   string     // Case-insensitive match on the circle's listed location
+	StartAfter *time.Time // Planned start date on or after, for recruiting circles
+	StartBy    *time.Time // Planned start date on or before, for recruiting circles
 	Offset     int
 	Limit      int
 }
@@ -64,6 +84,10 @@ type CircleDTO struct {
 	CreatorName     string
 	StartDate       *time.Time
 	NextPayoutDate  *time.Time
+	Location        string
+	PlannedStart    *time.Time
+	JoinApproval    bool
+	MemberUserIDs   []string // Active members, for trust signals in discovery listings
 	CreatedAt       time.Time
 }
 
@@ -146,8 +170,25 @@ type PayoutRepository interface {
 
 	// FindByUser retrieves payouts received by a user
 	FindByUser(ctx context.Context, userID valueobject.UserID) ([]*Payout, error)
+
+	// FindByCircleAndRound retrieves the payouts made for a round
+	FindByCircleAndRound(ctx context.Context, circleID valueobject.CircleID, round int) ([]*Payout, error)
+
+	// FindHeld retrieves payouts waiting on the recipient's wallet
+	FindHeld(ctx context.Context) ([]*Payout, error)
+
+	// Update persists changes to a payout record
+	Update(ctx context.Context, payout *Payout) error
 }
 
+// PayoutStatus represents the state of a payout
+type PayoutStatus string
+
+const (
+	PayoutStatusPaid PayoutStatus = "paid"
+	PayoutStatusHeld PayoutStatus = "held"
+)
+
 // Payout represents a payout record
 type Payout struct {
 	ID            string
@@ -156,10 +197,24 @@ type Payout struct {
 	MemberID      string
 	UserID        string
 	Round         int
+	Kind          string // Empty for a share of the pool
 	Amount        int64
+	Fee           int64
 	Currency      string
+	Status        PayoutStatus
+	HeldReason    string
 	TransactionID string
 	PaidAt        time.Time
+
+	// Set when part of the payout went to a loan holding a lien on it
+	LienLoanID        string
+	LienAmount        int64
+	LienTransactionID string
+}
+
+// NetAmount is what the recipient receives after the platform fee and any lien
+func (p *Payout) NetAmount() int64 {
+	return p.Amount - p.Fee - p.LienAmount
 }
 
 // SavingsStatisticsRepository defines the interface for savings statistics
@@ -167,6 +222,10 @@ type SavingsStatisticsRepository interface {
 	// GetUserStats gets savings statistics for a user
 	GetUserStats(ctx context.Context, userID valueobject.UserID) (*UserSavingsStats, error)
 
+	// GetUsersStats gets savings statistics for several users, keyed by user ID.
+	// Users with no savings history are omitted.
+	GetUsersStats(ctx context.Context, userIDs []string) (map[string]*UserSavingsStats, error)
+
 	// GetCircleStats gets statistics for a circle
 	GetCircleStats(ctx context.Context, circleID valueobject.CircleID) (*CircleStats, error)
 }
@@ -193,3 +252,23 @@ type CircleStats struct {
 	CompletionRate  float64
 	AverageLateFee  int64
 }
+
+// CircleActivityRepository defines the interface for a circle's activity log
+type CircleActivityRepository interface {
+	// Record stores an activity entry; recording the same entry twice is a no-op
+	Record(ctx context.Context, activity *CircleActivity) error
+
+	// FindByCircle retrieves a circle's activity, newest first
+	FindByCircle(ctx context.Context, circleID valueobject.CircleID, offset, limit int) ([]*CircleActivity, int64, error)
+}
+
+// CircleActivity is an entry in a circle's activity log
+type CircleActivity struct {
+	ID         string // ID of the event it records
+	CircleID   string
+	Type       string
+	UserID     string // Member the activity is about, if any
+	Summary    string
+	Amount     int64 // Kobo moved, if any
+	OccurredAt time.Time
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// SavingsGoalRepository defines the interface for savings goal persistence
+type SavingsGoalRepository interface {
+	// Save persists a savings goal aggregate
+	Save(ctx context.Context, goal *aggregate.SavingsGoal) error
+
+	// SaveWithEvents persists a savings goal and publishes domain events
+	SaveWithEvents(ctx context.Context, goal *aggregate.SavingsGoal) error
+
+	// FindByID retrieves a savings goal by ID
+	FindByID(ctx context.Context, id valueobject.GoalID) (*aggregate.SavingsGoal, error)
+
+	// FindByUserID retrieves a user's savings goals, optionally filtered by status
+	FindByUserID(ctx context.Context, userID valueobject.UserID, status *aggregate.GoalStatus) ([]*aggregate.SavingsGoal, error)
+
+	// FindDueAutoSaves retrieves active goals with a scheduled auto-save due by asOf
+	FindDueAutoSaves(ctx context.Context, asOf time.Time) ([]*aggregate.SavingsGoal, error)
+
+	// FindRoundUpGoals retrieves a user's active goals that round up wallet debits
+	FindRoundUpGoals(ctx context.Context, userID valueobject.UserID) ([]*aggregate.SavingsGoal, error)
+
+	// FindAccruing retrieves active goals with a balance or uncapitalized interest
+	FindAccruing(ctx context.Context) ([]*aggregate.SavingsGoal, error)
+}
+
+// InterestPostingRepository defines the interface for capitalized interest records
+type InterestPostingRepository interface {
+	// Record stores an interest posting
+	Record(ctx context.Context, posting *InterestPosting) error
+
+	// FindByUser retrieves a user's interest postings for periods ending between from and to
+	FindByUser(ctx context.Context, userID valueobject.UserID, from, to time.Time) ([]*InterestPosting, error)
+}
+
+// InterestPosting records interest capitalized into a savings goal
+type InterestPosting struct {
+	ID          string
+	GoalID      string
+	GoalName    string
+	UserID      string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	APR         int // basis points
+	DayCount    string
+	Gross       int64
+	Tax         int64
+	Net         int64
+	Currency    string
+	PostedAt    time.Time
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Fee schedule errors
+var (
+	ErrInvalidFeeBounds = errors.New("minimum fee must not be negative or exceed the maximum")
+)
+
+// FeeSchedule sets the platform fee taken from circle payouts.
+// Each circle type has its own rate; the fee is then held between a floor and a ceiling
+// and never exceeds the payout itself.
+type FeeSchedule struct {
+	rates  map[aggregate.CircleType]valueobject.BasisPoints
+	minFee int64
+	maxFee int64
+}
+
+// NewFeeSchedule creates a fee schedule. Circle types without a rate pay no fee.
+func NewFeeSchedule(rates map[aggregate.CircleType]valueobject.BasisPoints, minFee, maxFee int64) (*FeeSchedule, error) {
+	if minFee < 0 || maxFee < minFee {
+		return nil, ErrInvalidFeeBounds
+	}
+
+	copied := make(map[aggregate.CircleType]valueobject.BasisPoints, len(rates))
+	for circleType, rate := range rates {
+		copied[circleType] = rate
+	}
+
+	return &FeeSchedule{rates: copied, minFee: minFee, maxFee: maxFee}, nil
+}
+
+// DefaultFeeSchedule charges 1% on rotational payouts and 0.5% on fixed-target
+// distributions, between ₦50 and ₦2,500. Emergency funds are free.
+func DefaultFeeSchedule() *FeeSchedule {
+	schedule, _ := NewFeeSchedule(map[aggregate.CircleType]valueobject.BasisPoints{
+		aggregate.CircleTypeRotational:  mustBasisPoints(100),
+		aggregate.CircleTypeFixedTarget: mustBasisPoints(50),
+	}, 50*100, 2500*100)
+	return schedule
+}
+
+// PayoutFee returns the platform's fee on a payout
+func (s *FeeSchedule) PayoutFee(circleType aggregate.CircleType, payout valueobject.Money) valueobject.Money {
+	rate, ok := s.rates[circleType]
+	if !ok || rate.Value() == 0 || !payout.IsPositive() {
+		return valueobject.Zero(payout.Currency())
+	}
+
+	fee := payout.Amount() * int64(rate.Value()) / 10000
+	if fee < s.minFee {
+		fee = s.minFee
+	}
+	if fee > s.maxFee {
+		fee = s.maxFee
+	}
+	if fee > payout.Amount() {
+		fee = payout.Amount()
+	}
+
+	return valueobject.MustNewMoney(fee, payout.Currency())
+}
+
+func mustBasisPoints(value int) valueobject.BasisPoints {
+	bp, err := valueobject.NewBasisPoints(value)
+	if err != nil {
+		panic(err)
+	}
+	return bp
+}
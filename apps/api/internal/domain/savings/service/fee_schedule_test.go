@@ -0,0 +1,41 @@
+package service
+
+import (
+	"testing"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func TestFeeSchedule_PayoutFee(t *testing.T) {
+	schedule := DefaultFeeSchedule()
+
+	tests := []struct {
+		name       string
+		circleType aggregate.CircleType
+		payout     int64
+		want       int64
+	}{
+		{"rotational rate", aggregate.CircleTypeRotational, 10000000, 100000},
+		{"rotational floor", aggregate.CircleTypeRotational, 200000, 5000},
+		{"rotational ceiling", aggregate.CircleTypeRotational, 100000000, 250000},
+		{"fixed-target rate", aggregate.CircleTypeFixedTarget, 20000000, 100000},
+		{"emergency is free", aggregate.CircleTypeEmergency, 10000000, 0},
+		{"fee never exceeds payout", aggregate.CircleTypeRotational, 3000, 3000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee := schedule.PayoutFee(tt.circleType, valueobject.MustNewMoney(tt.payout, valueobject.NGN))
+			if fee.Amount() != tt.want {
+				t.Errorf("PayoutFee(%s, %d) = %d, want %d", tt.circleType, tt.payout, fee.Amount(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFeeSchedule_InvalidBounds(t *testing.T) {
+	if _, err := NewFeeSchedule(nil, 500, 100); err != ErrInvalidFeeBounds {
+		t.Errorf("NewFeeSchedule() with min > max = %v, want %v", err, ErrInvalidFeeBounds)
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Interest engine errors
+var (
+	ErrInvalidDayCount       = errors.New("invalid day-count convention")
+	ErrInvalidCapitalization = errors.New("invalid capitalization schedule")
+	ErrNoRateTiers           = errors.New("interest product needs at least one rate tier")
+	ErrUnknownProduct        = errors.New("no interest configured for this product")
+)
+
+// DayCountConvention decides how many days a year of interest is spread over
+type DayCountConvention string
+
+const (
+	DayCountActual365 DayCountConvention = "actual/365"    // Always 365 days
+	DayCountActual360 DayCountConvention = "actual/360"    // Always 360 days
+	DayCountActualAct DayCountConvention = "actual/actual" // 366 days in leap years
+)
+
+// DaysInYear returns the year length used to accrue interest on day
+func (c DayCountConvention) DaysInYear(day time.Time) int64 {
+	switch c {
+	case DayCountActual360:
+		return 360
+	case DayCountActualAct:
+		year := day.Year()
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 366
+		}
+		return 365
+	default:
+		return 365
+	}
+}
+
+func (c DayCountConvention) IsValid() bool {
+	return c == DayCountActual365 || c == DayCountActual360 || c == DayCountActualAct
+}
+
+// InterestProduct identifies a savings product with its own rates
+type InterestProduct string
+
+const (
+	ProductFlexibleGoal InterestProduct = "flexible_goal"
+	ProductLockedGoal   InterestProduct = "locked_goal"
+)
+
+// CapitalizationSchedule decides when accrued interest is added to the balance
+type CapitalizationSchedule string
+
+const (
+	CapitalizeMonthly    CapitalizationSchedule = "monthly"  // On the last day of each month
+	CapitalizeAtMaturity CapitalizationSchedule = "maturity" // Once, on the target date
+)
+
+// RateTier is the APR paid on savings locked for at least MinTenorDays
+type RateTier struct {
+	MinTenorDays int
+	APR          valueobject.BasisPoints
+}
+
+// ProductTerms are the interest terms of a savings product
+type ProductTerms struct {
+	Tiers          []RateTier
+	Capitalization CapitalizationSchedule
+}
+
+// InterestEngine accrues daily interest on savings goals and capitalizes it.
+// All amounts are integer kobo; daily accruals are floored in sub-kobo units
+// and capitalization floors to whole kobo, carrying the remainder.
+type InterestEngine struct {
+	products       map[InterestProduct]ProductTerms
+	convention     DayCountConvention
+	withholdingTax valueobject.BasisPoints
+}
+
+// NewInterestEngine creates an interest engine
+func NewInterestEngine(products map[InterestProduct]ProductTerms, convention DayCountConvention, withholdingTax valueobject.BasisPoints) (*InterestEngine, error) {
+	if !convention.IsValid() {
+		return nil, ErrInvalidDayCount
+	}
+
+	copied := make(map[InterestProduct]ProductTerms, len(products))
+	for product, terms := range products {
+		if len(terms.Tiers) == 0 {
+			return nil, ErrNoRateTiers
+		}
+		if terms.Capitalization != CapitalizeMonthly && terms.Capitalization != CapitalizeAtMaturity {
+			return nil, ErrInvalidCapitalization
+		}
+
+		tiers := append([]RateTier(nil), terms.Tiers...)
+		sort.Slice(tiers, func(i, j int) bool { return tiers[i].MinTenorDays < tiers[j].MinTenorDays })
+		copied[product] = ProductTerms{Tiers: tiers, Capitalization: terms.Capitalization}
+	}
+
+	return &InterestEngine{
+		products:       copied,
+		convention:     convention,
+		withholdingTax: withholdingTax,
+	}, nil
+}
+
+// DefaultInterestEngine pays 10% on flexible goals, capitalized monthly, and 12-15% on
+// locked goals by tenor, paid at maturity. Interest is Actual/365 with 10% withholding tax.
+func DefaultInterestEngine() *InterestEngine {
+	engine, _ := NewInterestEngine(map[InterestProduct]ProductTerms{
+		ProductFlexibleGoal: {
+			Tiers:          []RateTier{{MinTenorDays: 0, APR: mustBasisPoints(1000)}},
+			Capitalization: CapitalizeMonthly,
+		},
+		ProductLockedGoal: {
+			Tiers: []RateTier{
+				{MinTenorDays: 0, APR: mustBasisPoints(1200)},
+				{MinTenorDays: 90, APR: mustBasisPoints(1300)},
+				{MinTenorDays: 180, APR: mustBasisPoints(1400)},
+				{MinTenorDays: 365, APR: mustBasisPoints(1500)},
+			},
+			Capitalization: CapitalizeAtMaturity,
+		},
+	}, DayCountActual365, mustBasisPoints(1000))
+	return engine
+}
+
+// Convention returns the day-count convention interest accrues under
+func (e *InterestEngine) Convention() DayCountConvention {
+	return e.convention
+}
+
+// ProductFor returns the interest product and tenor in days of a goal
+func ProductFor(goal *aggregate.SavingsGoal) (InterestProduct, int) {
+	if goal.Mode() != aggregate.GoalModeLocked {
+		return ProductFlexibleGoal, 0
+	}
+	tenor := int(goal.TargetDate().Sub(goal.CreatedAt()).Hours() / 24)
+	return ProductLockedGoal, tenor
+}
+
+// APRFor returns the rate a goal earns: the highest tier its tenor qualifies for
+func (e *InterestEngine) APRFor(goal *aggregate.SavingsGoal) (valueobject.BasisPoints, error) {
+	product, tenor := ProductFor(goal)
+	terms, ok := e.products[product]
+	if !ok {
+		return valueobject.BasisPoints{}, ErrUnknownProduct
+	}
+
+	apr := terms.Tiers[0].APR
+	for _, tier := range terms.Tiers {
+		if tenor >= tier.MinTenorDays {
+			apr = tier.APR
+		}
+	}
+	return apr, nil
+}
+
+// DailyAccrual is a day's interest on a balance, in aggregate.InterestAccrualScale units, floored
+func (e *InterestEngine) DailyAccrual(balance valueobject.Money, apr valueobject.BasisPoints, day time.Time) int64 {
+	if !balance.IsPositive() || apr.Value() == 0 {
+		return 0
+	}
+
+	// Split the division so large balances cannot overflow once scaled
+	numerator := balance.Amount() * int64(apr.Value())
+	denominator := int64(10000) * e.convention.DaysInYear(day)
+	whole := numerator / denominator
+	remainder := numerator % denominator
+
+	return whole*aggregate.InterestAccrualScale + remainder*aggregate.InterestAccrualScale/denominator
+}
+
+// Accrue adds a day's interest to a goal
+func (e *InterestEngine) Accrue(goal *aggregate.SavingsGoal, day time.Time) error {
+	apr, err := e.APRFor(goal)
+	if err != nil {
+		return err
+	}
+	return goal.AccrueInterest(day, e.DailyAccrual(goal.Balance(), apr, day))
+}
+
+// IsCapitalizationDue reports whether a goal's accrued interest should be capitalized on day
+func (e *InterestEngine) IsCapitalizationDue(goal *aggregate.SavingsGoal, day time.Time) bool {
+	product, _ := ProductFor(goal)
+	terms, ok := e.products[product]
+	if !ok || goal.AccruedInterest() < aggregate.InterestAccrualScale {
+		return false
+	}
+
+	switch terms.Capitalization {
+	case CapitalizeAtMaturity:
+		return goal.IsMatured(day)
+	default:
+		return day.AddDate(0, 0, 1).Day() == 1 || goal.IsMatured(day)
+	}
+}
+
+// Capitalize adds a goal's accrued interest to its balance less withholding tax,
+// returning the gross interest and the tax withheld
+func (e *InterestEngine) Capitalize(goal *aggregate.SavingsGoal, day time.Time) (valueobject.Money, valueobject.Money, error) {
+	currency := goal.Balance().Currency()
+	gross := valueobject.MustNewMoney(goal.AccruedInterest()/aggregate.InterestAccrualScale, currency)
+	tax := e.WithholdingTax(gross)
+
+	if err := goal.CapitalizeInterest(gross, tax, day); err != nil {
+		return valueobject.Money{}, valueobject.Money{}, err
+	}
+	return gross, tax, nil
+}
+
+// WithholdingTax is the tax withheld from gross interest, rounded half up to the kobo
+func (e *InterestEngine) WithholdingTax(gross valueobject.Money) valueobject.Money {
+	tax := (gross.Amount()*int64(e.withholdingTax.Value()) + 5000) / 10000
+	return valueobject.MustNewMoney(tax, gross.Currency())
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/savings/aggregate"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+func newInterestGoal(t *testing.T, mode aggregate.GoalMode, targetDate time.Time, balance int64) *aggregate.SavingsGoal {
+	t.Helper()
+
+	goal, err := aggregate.NewSavingsGoal(
+		valueobject.GenerateGoalID(), valueobject.GenerateUserID(), "Rent",
+		valueobject.MustNewMoney(100000000, valueobject.NGN), targetDate, mode,
+	)
+	if err != nil {
+		t.Fatalf("NewSavingsGoal() error = %v", err)
+	}
+	if err := goal.Deposit(valueobject.MustNewMoney(balance, valueobject.NGN), aggregate.GoalSourceManual); err != nil {
+		t.Fatalf("Deposit() error = %v", err)
+	}
+	goal.DomainEvents()
+	return goal
+}
+
+func TestInterestEngine_DailyAccrual(t *testing.T) {
+	engine := DefaultInterestEngine()
+	apr := mustBasisPoints(1000)
+	balance := valueobject.MustNewMoney(1000000, valueobject.NGN)
+
+	// ₦10,000 at 10% over 365 days is 273.97 kobo a day
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := engine.DailyAccrual(balance, apr, day); got != 2739726 {
+		t.Errorf("DailyAccrual() = %d, want 2739726", got)
+	}
+
+	actual360, _ := NewInterestEngine(nil, DayCountActual360, mustBasisPoints(0))
+	if got := actual360.DailyAccrual(balance, apr, day); got != 2777777 {
+		t.Errorf("DailyAccrual() actual/360 = %d, want 2777777", got)
+	}
+}
+
+func TestInterestEngine_CapitalizesMonthlyNetOfTax(t *testing.T) {
+	engine := DefaultInterestEngine()
+	goal := newInterestGoal(t, aggregate.GoalModeFlexible, time.Now().AddDate(1, 0, 0), 36500000)
+
+	// ₦365,000 at 10% is exactly ₦100 a day
+	start := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	for day := start; day.Month() == time.April; day = day.AddDate(0, 0, 1) {
+		if err := engine.Accrue(goal, day); err != nil {
+			t.Fatalf("Accrue(%s) error = %v", day.Format("2006-01-02"), err)
+		}
+		want := day.Day() == 30
+		if got := engine.IsCapitalizationDue(goal, day); got != want {
+			t.Fatalf("IsCapitalizationDue(%s) = %v, want %v", day.Format("2006-01-02"), got, want)
+		}
+	}
+
+	if err := engine.Accrue(goal, start.AddDate(0, 0, 29)); err != aggregate.ErrInterestAlreadyAccrued {
+		t.Errorf("Accrue() twice = %v, want %v", err, aggregate.ErrInterestAlreadyAccrued)
+	}
+
+	gross, tax, err := engine.Capitalize(goal, start.AddDate(0, 0, 29))
+	if err != nil {
+		t.Fatalf("Capitalize() error = %v", err)
+	}
+	if gross.Amount() != 300000 || tax.Amount() != 30000 {
+		t.Errorf("Capitalize() = %d gross, %d tax, want 300000 and 30000", gross.Amount(), tax.Amount())
+	}
+	if goal.Balance().Amount() != 36770000 || goal.InterestEarned() != 270000 {
+		t.Errorf("balance=%d earned=%d, want 36770000 and 270000", goal.Balance().Amount(), goal.InterestEarned())
+	}
+}
+
+func TestInterestEngine_LockedGoalsUseTenorTiersAndPayAtMaturity(t *testing.T) {
+	engine := DefaultInterestEngine()
+	maturity := time.Now().AddDate(0, 0, 200)
+	goal := newInterestGoal(t, aggregate.GoalModeLocked, maturity, 1000000)
+
+	apr, err := engine.APRFor(goal)
+	if err != nil {
+		t.Fatalf("APRFor() error = %v", err)
+	}
+	if apr.Value() != 1400 {
+		t.Errorf("APRFor() 200-day lock = %d bps, want 1400", apr.Value())
+	}
+
+	day := time.Now()
+	if err := engine.Accrue(goal, day); err != nil {
+		t.Fatalf("Accrue() error = %v", err)
+	}
+	if engine.IsCapitalizationDue(goal, day) {
+		t.Error("IsCapitalizationDue() before maturity = true, want false")
+	}
+	if !engine.IsCapitalizationDue(goal, maturity) {
+		t.Error("IsCapitalizationDue() at maturity = false, want true")
+	}
+}
+
+func TestInterestEngine_WithholdingTaxRoundsHalfUp(t *testing.T) {
+	engine := DefaultInterestEngine()
+	if tax := engine.WithholdingTax(valueobject.MustNewMoney(15, valueobject.NGN)); tax.Amount() != 2 {
+		t.Errorf("WithholdingTax(15) = %d, want 2", tax.Amount())
+	}
+	if tax := engine.WithholdingTax(valueobject.MustNewMoney(14, valueobject.NGN)); tax.Amount() != 1 {
+		t.Errorf("WithholdingTax(14) = %d, want 1", tax.Amount())
+	}
+}
@@ -0,0 +1,58 @@
+package valueobject
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrInvalidLatitude  = errors.New("latitude must be between -90 and 90")
+	ErrInvalidLongitude = errors.New("longitude must be between -180 and 180")
+)
+
+// earthRadiusKm is the mean radius used for great-circle distances
+const earthRadiusKm = 6371.0
+
+// GeoPoint represents a position on the earth's surface
+type GeoPoint struct {
+	latitude  float64
+	longitude float64
+}
+
+// NewGeoPoint creates a new GeoPoint value object
+func NewGeoPoint(latitude, longitude float64) (GeoPoint, error) {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return GeoPoint{}, ErrInvalidLatitude
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return GeoPoint{}, ErrInvalidLongitude
+	}
+	return GeoPoint{latitude: latitude, longitude: longitude}, nil
+}
+
+// Latitude returns the latitude in degrees
+func (p GeoPoint) Latitude() float64 {
+	return p.latitude
+}
+
+// Longitude returns the longitude in degrees
+func (p GeoPoint) Longitude() float64 {
+	return p.longitude
+}
+
+// DistanceKm returns the great-circle distance to another point in kilometres
+func (p GeoPoint) DistanceKm(other GeoPoint) float64 {
+	lat1 := p.latitude * math.Pi / 180
+	lat2 := other.latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.longitude - p.longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+// Equals checks if two points are the same
+func (p GeoPoint) Equals(other GeoPoint) bool {
+	return p.latitude == other.latitude && p.longitude == other.longitude
+}
@@ -0,0 +1,47 @@
+package valueobject
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewGeoPoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		wantErr   error
+	}{
+		{"Lagos", 6.5244, 3.3792, nil},
+		{"poles and antimeridian", -90, 180, nil},
+		{"latitude too high", 91, 3.3792, ErrInvalidLatitude},
+		{"longitude too low", 6.5244, -181, ErrInvalidLongitude},
+		{"NaN latitude", math.NaN(), 0, ErrInvalidLatitude},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGeoPoint(tt.latitude, tt.longitude)
+			if err != tt.wantErr {
+				t.Errorf("NewGeoPoint() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeoPoint_DistanceKm(t *testing.T) {
+	lagos, _ := NewGeoPoint(6.5244, 3.3792)
+	abuja, _ := NewGeoPoint(9.0765, 7.3986)
+
+	if d := lagos.DistanceKm(lagos); d != 0 {
+		t.Errorf("DistanceKm() to itself = %v, want 0", d)
+	}
+
+	// Lagos to Abuja is roughly 525km as the crow flies
+	if d := lagos.DistanceKm(abuja); d < 515 || d > 535 {
+		t.Errorf("DistanceKm() Lagos to Abuja = %.1f, want about 525", d)
+	}
+	if lagos.DistanceKm(abuja) != abuja.DistanceKm(lagos) {
+		t.Error("DistanceKm() is not symmetric")
+	}
+}
@@ -280,3 +280,383 @@ func GenerateContributionID() ContributionID {
 func (id ContributionID) String() string { return id.value }
 func (id ContributionID) IsEmpty() bool  { return id.value == "" }
 func (id ContributionID) Equals(other ContributionID) bool { return id.value == other.value }
+
+// GoalID represents a unique savings goal identifier
+type GoalID struct {
+	value string
+}
+
+func NewGoalID(id string) (GoalID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return GoalID{}, ErrInvalidID
+	}
+	return GoalID{value: id}, nil
+}
+
+func GenerateGoalID() GoalID {
+	return GoalID{value: uuid.NewString()}
+}
+
+func (id GoalID) String() string { return id.value }
+func (id GoalID) IsEmpty() bool  { return id.value == "" }
+func (id GoalID) Equals(other GoalID) bool { return id.value == other.value }
+
+// DisputeID represents a unique gig dispute identifier
+type DisputeID struct {
+	value string
+}
+
+func NewDisputeID(id string) (DisputeID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return DisputeID{}, ErrInvalidID
+	}
+	return DisputeID{value: id}, nil
+}
+
+func GenerateDisputeID() DisputeID {
+	return DisputeID{value: uuid.NewString()}
+}
+
+func (id DisputeID) String() string { return id.value }
+func (id DisputeID) IsEmpty() bool  { return id.value == "" }
+func (id DisputeID) Equals(other DisputeID) bool { return id.value == other.value }
+
+// PackageID represents a unique service package identifier
+type PackageID struct {
+	value string
+}
+
+func NewPackageID(id string) (PackageID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return PackageID{}, ErrInvalidID
+	}
+	return PackageID{value: id}, nil
+}
+
+func GeneratePackageID() PackageID {
+	return PackageID{value: uuid.NewString()}
+}
+
+func (id PackageID) String() string { return id.value }
+func (id PackageID) IsEmpty() bool  { return id.value == "" }
+func (id PackageID) Equals(other PackageID) bool { return id.value == other.value }
+
+// BookingID represents a unique on-site booking identifier
+type BookingID struct {
+	value string
+}
+
+func NewBookingID(id string) (BookingID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return BookingID{}, ErrInvalidID
+	}
+	return BookingID{value: id}, nil
+}
+
+func GenerateBookingID() BookingID {
+	return BookingID{value: uuid.NewString()}
+}
+
+func (id BookingID) String() string { return id.value }
+func (id BookingID) IsEmpty() bool  { return id.value == "" }
+func (id BookingID) Equals(other BookingID) bool { return id.value == other.value }
+
+// ConversationID represents a unique conversation identifier
+type ConversationID struct {
+	value string
+}
+
+func NewConversationID(id string) (ConversationID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return ConversationID{}, ErrInvalidID
+	}
+	return ConversationID{value: id}, nil
+}
+
+func GenerateConversationID() ConversationID {
+	return ConversationID{value: uuid.NewString()}
+}
+
+func (id ConversationID) String() string { return id.value }
+func (id ConversationID) IsEmpty() bool  { return id.value == "" }
+func (id ConversationID) Equals(other ConversationID) bool { return id.value == other.value }
+
+// EvidenceID represents a unique dispute evidence identifier
+type EvidenceID struct {
+	value string
+}
+
+func NewEvidenceID(id string) (EvidenceID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return EvidenceID{}, ErrInvalidID
+	}
+	return EvidenceID{value: id}, nil
+}
+
+func GenerateEvidenceID() EvidenceID {
+	return EvidenceID{value: uuid.NewString()}
+}
+
+func (id EvidenceID) String() string { return id.value }
+func (id EvidenceID) IsEmpty() bool  { return id.value == "" }
+func (id EvidenceID) Equals(other EvidenceID) bool { return id.value == other.value }
+
+// KYCCheckID represents a unique identity verification check identifier
+type KYCCheckID struct {
+	value string
+}
+
+func NewKYCCheckID(id string) (KYCCheckID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return KYCCheckID{}, ErrInvalidID
+	}
+	return KYCCheckID{value: id}, nil
+}
+
+func GenerateKYCCheckID() KYCCheckID {
+	return KYCCheckID{value: uuid.NewString()}
+}
+
+func (id KYCCheckID) String() string { return id.value }
+func (id KYCCheckID) IsEmpty() bool  { return id.value == "" }
+func (id KYCCheckID) Equals(other KYCCheckID) bool { return id.value == other.value }
+
+// SwapRequestID represents a unique payout position swap request identifier
+type SwapRequestID struct {
+	value string
+}
+
+func NewSwapRequestID(id string) (SwapRequestID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return SwapRequestID{}, ErrInvalidID
+	}
+	return SwapRequestID{value: id}, nil
+}
+
+func GenerateSwapRequestID() SwapRequestID {
+	return SwapRequestID{value: uuid.NewString()}
+}
+
+func (id SwapRequestID) String() string { return id.value }
+func (id SwapRequestID) IsEmpty() bool  { return id.value == "" }
+func (id SwapRequestID) Equals(other SwapRequestID) bool { return id.value == other.value }
+
+// EmergencyWithdrawalID represents a unique emergency fund withdrawal request identifier
+type EmergencyWithdrawalID struct {
+	value string
+}
+
+func NewEmergencyWithdrawalID(id string) (EmergencyWithdrawalID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return EmergencyWithdrawalID{}, ErrInvalidID
+	}
+	return EmergencyWithdrawalID{value: id}, nil
+}
+
+func GenerateEmergencyWithdrawalID() EmergencyWithdrawalID {
+	return EmergencyWithdrawalID{value: uuid.NewString()}
+}
+
+func (id EmergencyWithdrawalID) String() string { return id.value }
+func (id EmergencyWithdrawalID) IsEmpty() bool  { return id.value == "" }
+func (id EmergencyWithdrawalID) Equals(other EmergencyWithdrawalID) bool { return id.value == other.value }
+
+// GovernanceProposalID represents a unique savings circle governance proposal identifier
+type GovernanceProposalID struct {
+	value string
+}
+
+func NewGovernanceProposalID(id string) (GovernanceProposalID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return GovernanceProposalID{}, ErrInvalidID
+	}
+	return GovernanceProposalID{value: id}, nil
+}
+
+func GenerateGovernanceProposalID() GovernanceProposalID {
+	return GovernanceProposalID{value: uuid.NewString()}
+}
+
+func (id GovernanceProposalID) String() string { return id.value }
+func (id GovernanceProposalID) IsEmpty() bool  { return id.value == "" }
+func (id GovernanceProposalID) Equals(other GovernanceProposalID) bool { return id.value == other.value }
+
+// JoinRequestID represents a unique savings circle join request identifier
+type JoinRequestID struct {
+	value string
+}
+
+func NewJoinRequestID(id string) (JoinRequestID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return JoinRequestID{}, ErrInvalidID
+	}
+	return JoinRequestID{value: id}, nil
+}
+
+func GenerateJoinRequestID() JoinRequestID {
+	return JoinRequestID{value: uuid.NewString()}
+}
+
+func (id JoinRequestID) String() string { return id.value }
+func (id JoinRequestID) IsEmpty() bool  { return id.value == "" }
+func (id JoinRequestID) Equals(other JoinRequestID) bool { return id.value == other.value }
+
+// MilestoneID represents a unique contract milestone identifier
+type MilestoneID struct {
+	value string
+}
+
+func NewMilestoneID(id string) (MilestoneID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return MilestoneID{}, ErrInvalidID
+	}
+	return MilestoneID{value: id}, nil
+}
+
+func GenerateMilestoneID() MilestoneID {
+	return MilestoneID{value: uuid.NewString()}
+}
+
+func (id MilestoneID) String() string { return id.value }
+func (id MilestoneID) IsEmpty() bool  { return id.value == "" }
+func (id MilestoneID) Equals(other MilestoneID) bool { return id.value == other.value }
+
+// DeliveryID represents a unique milestone delivery identifier
+type DeliveryID struct {
+	value string
+}
+
+func NewDeliveryID(id string) (DeliveryID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return DeliveryID{}, ErrInvalidID
+	}
+	return DeliveryID{value: id}, nil
+}
+
+func GenerateDeliveryID() DeliveryID {
+	return DeliveryID{value: uuid.NewString()}
+}
+
+func (id DeliveryID) String() string { return id.value }
+func (id DeliveryID) IsEmpty() bool  { return id.value == "" }
+func (id DeliveryID) Equals(other DeliveryID) bool { return id.value == other.value }
+
+// AddOnID represents a unique service package add-on identifier
+type AddOnID struct {
+	value string
+}
+
+func NewAddOnID(id string) (AddOnID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return AddOnID{}, ErrInvalidID
+	}
+	return AddOnID{value: id}, nil
+}
+
+func GenerateAddOnID() AddOnID {
+	return AddOnID{value: uuid.NewString()}
+}
+
+func (id AddOnID) String() string { return id.value }
+func (id AddOnID) IsEmpty() bool  { return id.value == "" }
+func (id AddOnID) Equals(other AddOnID) bool { return id.value == other.value }
+
+// BlackoutID represents a unique availability blackout identifier
+type BlackoutID struct {
+	value string
+}
+
+func NewBlackoutID(id string) (BlackoutID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return BlackoutID{}, ErrInvalidID
+	}
+	return BlackoutID{value: id}, nil
+}
+
+func GenerateBlackoutID() BlackoutID {
+	return BlackoutID{value: uuid.NewString()}
+}
+
+func (id BlackoutID) String() string { return id.value }
+func (id BlackoutID) IsEmpty() bool  { return id.value == "" }
+func (id BlackoutID) Equals(other BlackoutID) bool { return id.value == other.value }
+
+// InvitationID represents a unique gig invitation identifier
+type InvitationID struct {
+	value string
+}
+
+func NewInvitationID(id string) (InvitationID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return InvitationID{}, ErrInvalidID
+	}
+	return InvitationID{value: id}, nil
+}
+
+func GenerateInvitationID() InvitationID {
+	return InvitationID{value: uuid.NewString()}
+}
+
+func (id InvitationID) String() string { return id.value }
+func (id InvitationID) IsEmpty() bool  { return id.value == "" }
+func (id InvitationID) Equals(other InvitationID) bool { return id.value == other.value }
+
+// MessageID represents a unique conversation message identifier
+type MessageID struct {
+	value string
+}
+
+func NewMessageID(id string) (MessageID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return MessageID{}, ErrInvalidID
+	}
+	return MessageID{value: id}, nil
+}
+
+func GenerateMessageID() MessageID {
+	return MessageID{value: uuid.NewString()}
+}
+
+func (id MessageID) String() string { return id.value }
+func (id MessageID) IsEmpty() bool  { return id.value == "" }
+func (id MessageID) Equals(other MessageID) bool { return id.value == other.value }
+
+// PortfolioItemID represents a unique hustler portfolio item identifier
+type PortfolioItemID struct {
+	value string
+}
+
+func NewPortfolioItemID(id string) (PortfolioItemID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return PortfolioItemID{}, ErrInvalidID
+	}
+	return PortfolioItemID{value: id}, nil
+}
+
+func GeneratePortfolioItemID() PortfolioItemID {
+	return PortfolioItemID{value: uuid.NewString()}
+}
+
+func (id PortfolioItemID) String() string { return id.value }
+func (id PortfolioItemID) IsEmpty() bool  { return id.value == "" }
+func (id PortfolioItemID) Equals(other PortfolioItemID) bool { return id.value == other.value }
+
+// CertificateID represents a unique hustler certificate identifier
+type CertificateID struct {
+	value string
+}
+
+func NewCertificateID(id string) (CertificateID, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return CertificateID{}, ErrInvalidID
+	}
+	return CertificateID{value: id}, nil
+}
+
+func GenerateCertificateID() CertificateID {
+	return CertificateID{value: uuid.NewString()}
+}
+
+func (id CertificateID) String() string { return id.value }
+func (id CertificateID) IsEmpty() bool  { return id.value == "" }
+func (id CertificateID) Equals(other CertificateID) bool { return id.value == other.value }
@@ -13,6 +13,7 @@ import (
 var (
 	ErrInsufficientFunds    = errors.New("insufficient funds")
 	ErrInsufficientEscrow   = errors.New("insufficient escrow balance")
+	ErrInsufficientSavings  = errors.New("insufficient savings balance")
 	ErrWalletLocked         = errors.New("wallet is locked")
 	ErrInvalidAmount        = errors.New("amount must be positive")
 	ErrCurrencyMismatch     = errors.New("currency mismatch")
@@ -20,6 +21,8 @@ var (
 	ErrInvalidPIN           = errors.New("invalid transaction PIN")
 	ErrWalletSuspended      = errors.New("wallet is suspended")
 	ErrDailyLimitExceeded   = errors.New("daily transaction limit exceeded")
+	ErrAlreadyPledged       = errors.New("savings are already pledged for this reference")
+	ErrPledgeNotFound       = errors.New("no savings are pledged for this reference")
 )
 
 // WalletStatus represents the current state of a wallet
@@ -31,7 +34,15 @@ const (
 	WalletStatusSuspended WalletStatus = "suspended"
 )
 
-// Wallet is the aggregate root for wallet operations
+// SavingsPledge is savings from one goal locked as collateral
+type SavingsPledge struct {
+	GoalID string
+	Amount valueobject.Money
+}
+
+// Wallet is the aggregate root for wallet operations.
+// Savings are held per goal, and savings pledged as collateral are kept apart from
+// both the goals and escrow until they are released.
 type Wallet struct {
 	event.AggregateRoot
 
@@ -39,7 +50,8 @@ type Wallet struct {
 	userID           valueobject.UserID
 	availableBalance valueobject.Money
 	escrowBalance    valueobject.Money
-	savingsBalance   valueobject.Money
+	goalSavings      map[string]valueobject.Money // unpledged savings by goal ID
+	pledges          map[string]SavingsPledge     // pledged savings by reference
 	ledgerBalance    valueobject.Money
 	currency         valueobject.Currency
 	status           WalletStatus
@@ -60,7 +72,8 @@ func NewWallet(userID valueobject.UserID, currency valueobject.Currency) *Wallet
 		userID:           userID,
 		availableBalance: valueobject.Zero(currency),
 		escrowBalance:    valueobject.Zero(currency),
-		savingsBalance:   valueobject.Zero(currency),
+		goalSavings:      make(map[string]valueobject.Money),
+		pledges:          make(map[string]SavingsPledge),
 		ledgerBalance:    valueobject.Zero(currency),
 		currency:         currency,
 		status:           WalletStatusActive,
@@ -78,11 +91,14 @@ func NewWallet(userID valueobject.UserID, currency valueobject.Currency) *Wallet
 	return wallet
 }
 
-// Reconstitute recreates a wallet from persistence (no events recorded)
+// Reconstitute recreates a wallet from persistence (no events recorded).
+// The savings balance is the sum of goalSavings.
 func Reconstitute(
 	id valueobject.WalletID,
 	userID valueobject.UserID,
-	availableBalance, escrowBalance, savingsBalance, ledgerBalance valueobject.Money,
+	availableBalance, escrowBalance, ledgerBalance valueobject.Money,
+	goalSavings map[string]valueobject.Money,
+	pledges map[string]SavingsPledge,
 	currency valueobject.Currency,
 	status WalletStatus,
 	pinHash string,
@@ -90,12 +106,19 @@ func Reconstitute(
 	createdAt, updatedAt time.Time,
 	version int64,
 ) *Wallet {
+	if goalSavings == nil {
+		goalSavings = make(map[string]valueobject.Money)
+	}
+	if pledges == nil {
+		pledges = make(map[string]SavingsPledge)
+	}
 	return &Wallet{
 		id:               id,
 		userID:           userID,
 		availableBalance: availableBalance,
 		escrowBalance:    escrowBalance,
-		savingsBalance:   savingsBalance,
+		goalSavings:      goalSavings,
+		pledges:          pledges,
 		ledgerBalance:    ledgerBalance,
 		currency:         currency,
 		status:           status,
@@ -193,6 +216,16 @@ func (w *Wallet) Debit(amount valueobject.Money, destination, reference, descrip
 
 // HoldInEscrow moves funds from available to escrow
 func (w *Wallet) HoldInEscrow(amount valueobject.Money, reference, reason string) error {
+	return w.holdInEscrow(amount, reference, reason, "")
+}
+
+// HoldLien moves funds from available to escrow against a debt. The transaction posting
+// the hold travels with the event so the creditor can settle against it.
+func (w *Wallet) HoldLien(amount valueobject.Money, reference, reason string, transactionID valueobject.TransactionID) error {
+	return w.holdInEscrow(amount, reference, reason, transactionID.String())
+}
+
+func (w *Wallet) holdInEscrow(amount valueobject.Money, reference, reason, transactionID string) error {
 	if err := w.validateActive(); err != nil {
 		return err
 	}
@@ -216,7 +249,7 @@ func (w *Wallet) HoldInEscrow(amount valueobject.Money, reference, reason string
 	w.escrowBalance = newEscrow
 	w.touch()
 
-	w.RecordEvent(walletEvent.NewFundsHeldInEscrow(
+	held := walletEvent.NewFundsHeldInEscrow(
 		w.id.String(),
 		w.userID.String(),
 		amount.Amount(),
@@ -224,7 +257,9 @@ func (w *Wallet) HoldInEscrow(amount valueobject.Money, reference, reason string
 		reason,
 		newAvailable.Amount(),
 		newEscrow.Amount(),
-	))
+	)
+	held.TransactionID = transactionID
+	w.RecordEvent(held)
 
 	return nil
 }
@@ -271,8 +306,9 @@ func (w *Wallet) ReleaseFromEscrow(amount valueobject.Money, reference string, t
 	return nil
 }
 
-// MoveToSavings moves funds from available to savings balance
-func (w *Wallet) MoveToSavings(amount valueobject.Money) error {
+// MoveToSavings moves funds from available into a savings goal.
+// Savings are always held for a goal, so the savings balance is the sum of the owner's goals.
+func (w *Wallet) MoveToSavings(amount valueobject.Money, goalID string) error {
 	if err := w.validateActive(); err != nil {
 		return err
 	}
@@ -281,19 +317,33 @@ func (w *Wallet) MoveToSavings(amount valueobject.Money) error {
 		return err
 	}
 
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
 	if w.availableBalance.LessThan(amount) {
 		return ErrInsufficientFunds
 	}
 
 	w.availableBalance = w.availableBalance.MustSubtract(amount)
-	w.savingsBalance = w.savingsBalance.MustAdd(amount)
+	w.addGoalSavings(goalID, amount)
 	w.touch()
 
+	w.RecordEvent(walletEvent.NewSavingsMoved(
+		w.id.String(),
+		w.userID.String(),
+		amount.Amount(),
+		goalID,
+		true,
+		w.SavingsBalance().Amount(),
+	))
+
 	return nil
 }
 
-// WithdrawFromSavings moves funds from savings to available
-func (w *Wallet) WithdrawFromSavings(amount valueobject.Money) error {
+// WithdrawFromSavings moves funds from a savings goal back to available.
+// Only the goal's unpledged savings can be withdrawn.
+func (w *Wallet) WithdrawFromSavings(amount valueobject.Money, goalID string) error {
 	if err := w.validateActive(); err != nil {
 		return err
 	}
@@ -302,14 +352,137 @@ func (w *Wallet) WithdrawFromSavings(amount valueobject.Money) error {
 		return err
 	}
 
-	if w.savingsBalance.LessThan(amount) {
-		return errors.New("insufficient savings balance")
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	if w.GoalSavings(goalID).LessThan(amount) {
+		return ErrInsufficientSavings
 	}
 
-	w.savingsBalance = w.savingsBalance.MustSubtract(amount)
+	w.subtractGoalSavings(goalID, amount)
 	w.availableBalance = w.availableBalance.MustAdd(amount)
 	w.touch()
 
+	w.RecordEvent(walletEvent.NewSavingsMoved(
+		w.id.String(),
+		w.userID.String(),
+		amount.Amount(),
+		goalID,
+		false,
+		w.SavingsBalance().Amount(),
+	))
+
+	return nil
+}
+
+// CreditSavingsInterest adds interest capitalized into a savings goal to that goal's savings.
+// Locked and suspended wallets still earn interest.
+func (w *Wallet) CreditSavingsInterest(amount valueobject.Money, goalID, reference string) error {
+	if err := w.validateCurrency(amount); err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	w.addGoalSavings(goalID, amount)
+	w.ledgerBalance = w.ledgerBalance.MustAdd(amount)
+	w.touch()
+
+	w.RecordEvent(walletEvent.NewWalletCredited(
+		w.id.String(),
+		w.userID.String(),
+		amount.Amount(),
+		string(amount.Currency()),
+		"interest",
+		reference,
+		"Interest on savings goal "+goalID,
+		w.availableBalance.Amount(),
+	))
+
+	return nil
+}
+
+// PledgeSavings locks part of a goal's savings as collateral under a reference, usually
+// the loan ID. Pledged savings leave the goal's withdrawable savings but are not escrow,
+// so contract escrow can never draw on them.
+func (w *Wallet) PledgeSavings(amount valueobject.Money, goalID, reference string) error {
+	if err := w.validateActive(); err != nil {
+		return err
+	}
+
+	if err := w.validateCurrency(amount); err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	if _, exists := w.pledges[reference]; exists {
+		return ErrAlreadyPledged
+	}
+
+	if w.GoalSavings(goalID).LessThan(amount) {
+		return ErrInsufficientSavings
+	}
+
+	w.subtractGoalSavings(goalID, amount)
+	w.pledges[reference] = SavingsPledge{GoalID: goalID, Amount: amount}
+	w.touch()
+
+	w.RecordEvent(walletEvent.NewSavingsPledged(
+		w.id.String(),
+		w.userID.String(),
+		amount.Amount(),
+		goalID,
+		reference,
+		w.PledgedBalance().Amount(),
+	))
+
+	return nil
+}
+
+// ReleasePledgedSavings returns pledged savings to the goal they were pledged from.
+// Whatever is left of the pledge stays locked.
+func (w *Wallet) ReleasePledgedSavings(amount valueobject.Money, reference string) error {
+	if err := w.validateCurrency(amount); err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	pledge, exists := w.pledges[reference]
+	if !exists {
+		return ErrPledgeNotFound
+	}
+
+	remaining, err := pledge.Amount.Subtract(amount)
+	if err != nil {
+		return ErrInsufficientSavings
+	}
+
+	if remaining.IsZero() {
+		delete(w.pledges, reference)
+	} else {
+		w.pledges[reference] = SavingsPledge{GoalID: pledge.GoalID, Amount: remaining}
+	}
+	w.addGoalSavings(pledge.GoalID, amount)
+	w.touch()
+
+	w.RecordEvent(walletEvent.NewPledgedSavingsReleased(
+		w.id.String(),
+		w.userID.String(),
+		amount.Amount(),
+		pledge.GoalID,
+		reference,
+		w.PledgedBalance().Amount(),
+	))
+
 	return nil
 }
 
@@ -369,10 +542,9 @@ func (w *Wallet) ID() valueobject.WalletID              { return w.id }
 func (w *Wallet) UserID() valueobject.UserID            { return w.userID }
 func (w *Wallet) AvailableBalance() valueobject.Money   { return w.availableBalance }
 func (w *Wallet) EscrowBalance() valueobject.Money      { return w.escrowBalance }
-func (w *Wallet) SavingsBalance() valueobject.Money     { return w.savingsBalance }
 func (w *Wallet) LedgerBalance() valueobject.Money      { return w.ledgerBalance }
 func (w *Wallet) TotalBalance() valueobject.Money {
-	return w.availableBalance.MustAdd(w.escrowBalance).MustAdd(w.savingsBalance)
+	return w.availableBalance.MustAdd(w.escrowBalance).MustAdd(w.SavingsBalance()).MustAdd(w.PledgedBalance())
 }
 func (w *Wallet) Currency() valueobject.Currency { return w.currency }
 func (w *Wallet) Status() WalletStatus           { return w.status }
@@ -386,6 +558,63 @@ func (w *Wallet) IsActive() bool                 { return w.status == WalletStat
 func (w *Wallet) IsLocked() bool                 { return w.status == WalletStatusLocked }
 
 // Private helpers
+// SavingsBalance returns the unpledged savings across all goals
+func (w *Wallet) SavingsBalance() valueobject.Money {
+	total := valueobject.Zero(w.currency)
+	for _, amount := range w.goalSavings {
+		total = total.MustAdd(amount)
+	}
+	return total
+}
+
+// GoalSavings returns a goal's unpledged savings
+func (w *Wallet) GoalSavings(goalID string) valueobject.Money {
+	if amount, ok := w.goalSavings[goalID]; ok {
+		return amount
+	}
+	return valueobject.Zero(w.currency)
+}
+
+// GoalsSavings returns the unpledged savings of every goal, for persistence
+func (w *Wallet) GoalsSavings() map[string]valueobject.Money {
+	goals := make(map[string]valueobject.Money, len(w.goalSavings))
+	for goalID, amount := range w.goalSavings {
+		goals[goalID] = amount
+	}
+	return goals
+}
+
+// PledgedBalance returns the savings locked as collateral
+func (w *Wallet) PledgedBalance() valueobject.Money {
+	total := valueobject.Zero(w.currency)
+	for _, pledge := range w.pledges {
+		total = total.MustAdd(pledge.Amount)
+	}
+	return total
+}
+
+// Pledges returns the savings pledged under each reference, for persistence
+func (w *Wallet) Pledges() map[string]SavingsPledge {
+	pledges := make(map[string]SavingsPledge, len(w.pledges))
+	for reference, pledge := range w.pledges {
+		pledges[reference] = pledge
+	}
+	return pledges
+}
+
+func (w *Wallet) addGoalSavings(goalID string, amount valueobject.Money) {
+	w.goalSavings[goalID] = w.GoalSavings(goalID).MustAdd(amount)
+}
+
+func (w *Wallet) subtractGoalSavings(goalID string, amount valueobject.Money) {
+	remaining := w.GoalSavings(goalID).MustSubtract(amount)
+	if remaining.IsZero() {
+		delete(w.goalSavings, goalID)
+		return
+	}
+	w.goalSavings[goalID] = remaining
+}
+
 func (w *Wallet) touch() {
 	w.updatedAt = time.Now().UTC()
 	w.version++
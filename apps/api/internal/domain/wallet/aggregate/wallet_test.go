@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"hustlex/internal/domain/shared/valueobject"
+	walletEvent "hustlex/internal/domain/wallet/event"
 )
 
 func TestNewWallet(t *testing.T) {
@@ -202,6 +203,27 @@ func TestWallet_HoldInEscrow(t *testing.T) {
 	}
 }
 
+func TestWallet_HoldLien(t *testing.T) {
+	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
+	wallet.Credit(valueobject.MustNewMoney(10000, valueobject.NGN), "deposit", "REF", "Initial")
+	wallet.ClearEvents()
+
+	transactionID := valueobject.GenerateTransactionID()
+	if err := wallet.HoldLien(valueobject.MustNewMoney(4000, valueobject.NGN), "CIRCLE123", "circle_default", transactionID); err != nil {
+		t.Fatalf("HoldLien() unexpected error: %v", err)
+	}
+
+	if wallet.EscrowBalance().Amount() != 4000 {
+		t.Errorf("HoldLien() escrow = %d, want 4000", wallet.EscrowBalance().Amount())
+	}
+
+	events := wallet.DomainEvents()
+	held, ok := events[0].(walletEvent.FundsHeldInEscrow)
+	if !ok || held.TransactionID != transactionID.String() {
+		t.Errorf("HoldLien() event should carry transaction %s", transactionID)
+	}
+}
+
 func TestWallet_HoldInEscrow_InsufficientFunds(t *testing.T) {
 	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
 	credit := valueobject.MustNewMoney(5000, valueobject.NGN)
@@ -297,7 +319,7 @@ func TestWallet_MoveToSavings(t *testing.T) {
 
 	savingsAmount := valueobject.MustNewMoney(3000, valueobject.NGN)
 
-	err := wallet.MoveToSavings(savingsAmount)
+	err := wallet.MoveToSavings(savingsAmount, "GOAL-1")
 	if err != nil {
 		t.Fatalf("MoveToSavings() unexpected error: %v", err)
 	}
@@ -316,11 +338,11 @@ func TestWallet_WithdrawFromSavings(t *testing.T) {
 	wallet.Credit(credit, "deposit", "REF", "Initial")
 
 	savingsAmount := valueobject.MustNewMoney(5000, valueobject.NGN)
-	wallet.MoveToSavings(savingsAmount)
+	wallet.MoveToSavings(savingsAmount, "GOAL-1")
 
 	withdrawAmount := valueobject.MustNewMoney(2000, valueobject.NGN)
 
-	err := wallet.WithdrawFromSavings(withdrawAmount)
+	err := wallet.WithdrawFromSavings(withdrawAmount, "GOAL-1")
 	if err != nil {
 		t.Fatalf("WithdrawFromSavings() unexpected error: %v", err)
 	}
@@ -339,16 +361,123 @@ func TestWallet_WithdrawFromSavings_Insufficient(t *testing.T) {
 	wallet.Credit(credit, "deposit", "REF", "Initial")
 
 	savingsAmount := valueobject.MustNewMoney(3000, valueobject.NGN)
-	wallet.MoveToSavings(savingsAmount)
+	wallet.MoveToSavings(savingsAmount, "GOAL-1")
 
 	withdrawAmount := valueobject.MustNewMoney(5000, valueobject.NGN)
 
-	err := wallet.WithdrawFromSavings(withdrawAmount)
+	err := wallet.WithdrawFromSavings(withdrawAmount, "GOAL-1")
 	if err == nil {
 		t.Error("WithdrawFromSavings() should return error for insufficient savings")
 	}
 }
 
+func TestWallet_PledgeSavings(t *testing.T) {
+	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
+	wallet.Credit(valueobject.MustNewMoney(10000, valueobject.NGN), "deposit", "REF", "Initial")
+	wallet.MoveToSavings(valueobject.MustNewMoney(6000, valueobject.NGN), "GOAL-1")
+	wallet.MoveToSavings(valueobject.MustNewMoney(1000, valueobject.NGN), "GOAL-2")
+
+	pledge := valueobject.MustNewMoney(4000, valueobject.NGN)
+	if err := wallet.PledgeSavings(pledge, "GOAL-1", "LOAN-1"); err != nil {
+		t.Fatalf("PledgeSavings() unexpected error: %v", err)
+	}
+
+	if wallet.GoalSavings("GOAL-1").Amount() != 2000 {
+		t.Errorf("PledgeSavings() goal savings = %d, want 2000", wallet.GoalSavings("GOAL-1").Amount())
+	}
+	if wallet.PledgedBalance().Amount() != 4000 {
+		t.Errorf("PledgeSavings() pledged = %d, want 4000", wallet.PledgedBalance().Amount())
+	}
+	if !wallet.EscrowBalance().IsZero() {
+		t.Errorf("PledgeSavings() escrow = %d, want 0", wallet.EscrowBalance().Amount())
+	}
+
+	// Pledged savings cannot be withdrawn, pledged twice or released by contract escrow
+	if err := wallet.WithdrawFromSavings(valueobject.MustNewMoney(3000, valueobject.NGN), "GOAL-1"); err != ErrInsufficientSavings {
+		t.Errorf("WithdrawFromSavings() error = %v, want %v", err, ErrInsufficientSavings)
+	}
+	if err := wallet.PledgeSavings(valueobject.MustNewMoney(500, valueobject.NGN), "GOAL-2", "LOAN-1"); err != ErrAlreadyPledged {
+		t.Errorf("PledgeSavings() same reference error = %v, want %v", err, ErrAlreadyPledged)
+	}
+	if err := wallet.ReleaseFromEscrow(pledge, "CONTRACT-1", false, "HUSTLER-1"); err != ErrInsufficientEscrow {
+		t.Errorf("ReleaseFromEscrow() error = %v, want %v", err, ErrInsufficientEscrow)
+	}
+
+	if err := wallet.ReleasePledgedSavings(pledge, "LOAN-1"); err != nil {
+		t.Fatalf("ReleasePledgedSavings() unexpected error: %v", err)
+	}
+
+	if wallet.GoalSavings("GOAL-1").Amount() != 6000 {
+		t.Errorf("ReleasePledgedSavings() goal savings = %d, want 6000", wallet.GoalSavings("GOAL-1").Amount())
+	}
+	if !wallet.PledgedBalance().IsZero() {
+		t.Errorf("ReleasePledgedSavings() pledged = %d, want 0", wallet.PledgedBalance().Amount())
+	}
+	if err := wallet.ReleasePledgedSavings(pledge, "LOAN-1"); err != ErrPledgeNotFound {
+		t.Errorf("ReleasePledgedSavings() twice error = %v, want %v", err, ErrPledgeNotFound)
+	}
+}
+
+func TestWallet_PledgeSavings_Insufficient(t *testing.T) {
+	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
+	wallet.Credit(valueobject.MustNewMoney(10000, valueobject.NGN), "deposit", "REF", "Initial")
+	wallet.MoveToSavings(valueobject.MustNewMoney(5000, valueobject.NGN), "GOAL-1")
+
+	// Another goal's savings cannot back the pledge
+	err := wallet.PledgeSavings(valueobject.MustNewMoney(1000, valueobject.NGN), "GOAL-2", "LOAN-1")
+	if err != ErrInsufficientSavings {
+		t.Errorf("PledgeSavings() error = %v, want %v", err, ErrInsufficientSavings)
+	}
+}
+
+func TestWallet_SavingsMatchGoals(t *testing.T) {
+	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
+	wallet.Credit(valueobject.MustNewMoney(20000, valueobject.NGN), "deposit", "REF", "Initial")
+	ngn := func(amount int64) valueobject.Money { return valueobject.MustNewMoney(amount, valueobject.NGN) }
+
+	steps := []func() error{
+		func() error { return wallet.MoveToSavings(ngn(6000), "GOAL-1") },
+		func() error { return wallet.MoveToSavings(ngn(3000), "GOAL-2") },
+		func() error { return wallet.CreditSavingsInterest(ngn(45), "GOAL-1", "INT-1") },
+		func() error { return wallet.PledgeSavings(ngn(5000), "GOAL-1", "LOAN-1") },
+		func() error { return wallet.HoldInEscrow(ngn(4000), "CONTRACT-1", "Gig escrow") },
+		func() error { return wallet.WithdrawFromSavings(ngn(1000), "GOAL-2") },
+		func() error { return wallet.ReleasePledgedSavings(ngn(2000), "LOAN-1") },
+		func() error { return wallet.ReleaseFromEscrow(ngn(4000), "CONTRACT-1", false, "HUSTLER-1") },
+		func() error { return wallet.WithdrawFromSavings(ngn(3045), "GOAL-1") },
+	}
+
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d error = %v", i+1, err)
+		}
+
+		goals := valueobject.Zero(valueobject.NGN)
+		for _, amount := range wallet.GoalsSavings() {
+			goals = goals.MustAdd(amount)
+		}
+		if !wallet.SavingsBalance().Equals(goals) {
+			t.Errorf("step %d: savings = %d, goals = %d", i+1, wallet.SavingsBalance().Amount(), goals.Amount())
+		}
+
+		pledged := valueobject.Zero(valueobject.NGN)
+		for _, pledge := range wallet.Pledges() {
+			pledged = pledged.MustAdd(pledge.Amount)
+		}
+		if !wallet.PledgedBalance().Equals(pledged) {
+			t.Errorf("step %d: pledged = %d, pledges = %d", i+1, wallet.PledgedBalance().Amount(), pledged.Amount())
+		}
+
+		if !wallet.TotalBalance().Equals(wallet.LedgerBalance()) {
+			t.Errorf("step %d: total = %d, ledger = %d", i+1, wallet.TotalBalance().Amount(), wallet.LedgerBalance().Amount())
+		}
+	}
+
+	if wallet.GoalSavings("GOAL-1").Amount() != 0 || wallet.GoalSavings("GOAL-2").Amount() != 2000 || wallet.PledgedBalance().Amount() != 3000 {
+		t.Errorf("goals = %v, pledged = %d", wallet.GoalsSavings(), wallet.PledgedBalance().Amount())
+	}
+}
+
 func TestWallet_Lock_Unlock(t *testing.T) {
 	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
 	wallet.ClearEvents()
@@ -466,7 +595,7 @@ func TestWallet_TotalBalance(t *testing.T) {
 	wallet.HoldInEscrow(escrowAmount, "CONTRACT", "escrow")
 
 	savingsAmount := valueobject.MustNewMoney(2000, valueobject.NGN)
-	wallet.MoveToSavings(savingsAmount)
+	wallet.MoveToSavings(savingsAmount, "GOAL-1")
 
 	// available: 5000, escrow: 3000, savings: 2000 = total 10000
 	total := wallet.TotalBalance()
@@ -493,15 +622,17 @@ func TestReconstitute(t *testing.T) {
 	available := valueobject.MustNewMoney(5000, valueobject.NGN)
 	escrow := valueobject.MustNewMoney(2000, valueobject.NGN)
 	savings := valueobject.MustNewMoney(1000, valueobject.NGN)
-	ledger := valueobject.MustNewMoney(8000, valueobject.NGN)
+	pledged := valueobject.MustNewMoney(500, valueobject.NGN)
+	ledger := valueobject.MustNewMoney(8500, valueobject.NGN)
 
 	wallet := Reconstitute(
 		walletID,
 		userID,
 		available,
 		escrow,
-		savings,
 		ledger,
+		map[string]valueobject.Money{"GOAL-1": savings},
+		map[string]SavingsPledge{"LOAN-1": {GoalID: "GOAL-1", Amount: pledged}},
 		valueobject.NGN,
 		WalletStatusActive,
 		"pin_hash",
@@ -526,6 +657,9 @@ func TestReconstitute(t *testing.T) {
 	if wallet.SavingsBalance().Amount() != 1000 {
 		t.Errorf("Reconstitute() savings = %d, want 1000", wallet.SavingsBalance().Amount())
 	}
+	if wallet.PledgedBalance().Amount() != 500 {
+		t.Errorf("Reconstitute() pledged = %d, want 500", wallet.PledgedBalance().Amount())
+	}
 	if wallet.Version() != 5 {
 		t.Errorf("Reconstitute() version = %d, want 5", wallet.Version())
 	}
@@ -540,3 +674,21 @@ func TestReconstitute(t *testing.T) {
 func timeNow() time.Time {
 	return time.Now().UTC()
 }
+
+func TestWallet_CreditSavingsInterest(t *testing.T) {
+	wallet := NewWallet(valueobject.GenerateUserID(), valueobject.NGN)
+	wallet.Credit(valueobject.MustNewMoney(10000, valueobject.NGN), "deposit", "REF", "Initial")
+	wallet.MoveToSavings(valueobject.MustNewMoney(6000, valueobject.NGN), "GOAL-1")
+	wallet.Lock("review")
+
+	if err := wallet.CreditSavingsInterest(valueobject.MustNewMoney(45, valueobject.NGN), "GOAL-1", "INT-1"); err != nil {
+		t.Fatalf("CreditSavingsInterest() on locked wallet error = %v", err)
+	}
+
+	if wallet.SavingsBalance().Amount() != 6045 {
+		t.Errorf("CreditSavingsInterest() savings = %d, want 6045", wallet.SavingsBalance().Amount())
+	}
+	if wallet.AvailableBalance().Amount() != 4000 {
+		t.Errorf("CreditSavingsInterest() available = %d, want 4000", wallet.AvailableBalance().Amount())
+	}
+}
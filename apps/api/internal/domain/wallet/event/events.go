@@ -97,6 +97,7 @@ type FundsHeldInEscrow struct {
 	Reason         string    `json:"reason"`
 	NewAvailable   int64     `json:"new_available_balance"`
 	NewEscrow      int64     `json:"new_escrow_balance"`
+	TransactionID  string    `json:"transaction_id,omitempty"` // Set when the hold is a lien
 	HeldAt         time.Time `json:"held_at"`
 }
 
@@ -141,6 +142,81 @@ func NewFundsReleasedFromEscrow(walletID, userID string, amount int64, reference
 	}
 }
 
+// SavingsMoved is raised when funds move between available and a savings goal
+type SavingsMoved struct {
+	event.BaseEvent
+	WalletID   string    `json:"wallet_id"`
+	UserID     string    `json:"user_id"`
+	Amount     int64     `json:"amount"`
+	GoalID     string    `json:"goal_id"`
+	ToSavings  bool      `json:"to_savings"`
+	NewSavings int64     `json:"new_savings"`
+	MovedAt    time.Time `json:"moved_at"`
+}
+
+func NewSavingsMoved(walletID, userID string, amount int64, goalID string, toSavings bool, newSavings int64) SavingsMoved {
+	return SavingsMoved{
+		BaseEvent:  event.NewBaseEvent("SavingsMoved", walletID, AggregateTypeWallet),
+		WalletID:   walletID,
+		UserID:     userID,
+		Amount:     amount,
+		GoalID:     goalID,
+		ToSavings:  toSavings,
+		NewSavings: newSavings,
+		MovedAt:    time.Now().UTC(),
+	}
+}
+
+// SavingsPledged is raised when part of a goal's savings is locked as collateral
+type SavingsPledged struct {
+	event.BaseEvent
+	WalletID   string    `json:"wallet_id"`
+	UserID     string    `json:"user_id"`
+	Amount     int64     `json:"amount"`
+	GoalID     string    `json:"goal_id"`
+	Reference  string    `json:"reference"` // loan_id
+	NewPledged int64     `json:"new_pledged_balance"`
+	PledgedAt  time.Time `json:"pledged_at"`
+}
+
+func NewSavingsPledged(walletID, userID string, amount int64, goalID, reference string, newPledged int64) SavingsPledged {
+	return SavingsPledged{
+		BaseEvent:  event.NewBaseEvent("SavingsPledged", walletID, AggregateTypeWallet),
+		WalletID:   walletID,
+		UserID:     userID,
+		Amount:     amount,
+		GoalID:     goalID,
+		Reference:  reference,
+		NewPledged: newPledged,
+		PledgedAt:  time.Now().UTC(),
+	}
+}
+
+// PledgedSavingsReleased is raised when pledged savings return to their goal
+type PledgedSavingsReleased struct {
+	event.BaseEvent
+	WalletID   string    `json:"wallet_id"`
+	UserID     string    `json:"user_id"`
+	Amount     int64     `json:"amount"`
+	GoalID     string    `json:"goal_id"`
+	Reference  string    `json:"reference"`
+	NewPledged int64     `json:"new_pledged_balance"`
+	ReleasedAt time.Time `json:"released_at"`
+}
+
+func NewPledgedSavingsReleased(walletID, userID string, amount int64, goalID, reference string, newPledged int64) PledgedSavingsReleased {
+	return PledgedSavingsReleased{
+		BaseEvent:  event.NewBaseEvent("PledgedSavingsReleased", walletID, AggregateTypeWallet),
+		WalletID:   walletID,
+		UserID:     userID,
+		Amount:     amount,
+		GoalID:     goalID,
+		Reference:  reference,
+		NewPledged: newPledged,
+		ReleasedAt: time.Now().UTC(),
+	}
+}
+
 // WalletLocked is raised when a wallet is locked
 type WalletLocked struct {
 	event.BaseEvent
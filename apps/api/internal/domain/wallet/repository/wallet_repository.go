@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"hustlex/internal/domain/shared/valueobject"
 	"hustlex/internal/domain/wallet/aggregate"
@@ -12,6 +13,7 @@ import (
 var (
 	ErrWalletNotFound         = errors.New("wallet not found")
 	ErrConcurrentModification = errors.New("concurrent modification detected")
+	ErrDuplicateReference     = errors.New("transaction reference already recorded")
 )
 
 // WalletRepository defines the interface for wallet persistence
@@ -35,6 +37,12 @@ type WalletRepository interface {
 	// SaveWithEvents saves the wallet and publishes domain events atomically
 	// This ensures events are only published if persistence succeeds
 	SaveWithEvents(ctx context.Context, wallet *aggregate.Wallet) error
+
+	// SaveWithTransaction saves the wallet, records the transaction that moved its money
+	// and publishes domain events atomically. If the transaction's reference is already
+	// recorded nothing is saved and ErrDuplicateReference is returned, so a retried
+	// credit or debit cannot be applied twice
+	SaveWithTransaction(ctx context.Context, wallet *aggregate.Wallet, tx *Transaction) error
 }
 
 // TransactionRepository defines the interface for transaction persistence
@@ -47,6 +55,10 @@ type TransactionRepository interface {
 
 	// Save persists a transaction record
 	Save(ctx context.Context, tx *Transaction) error
+
+	// SumOutflowsSince totals the pending and completed transfers out and withdrawals
+	// from a wallet since a point in time, for daily KYC limits
+	SumOutflowsSince(ctx context.Context, walletID valueobject.WalletID, since time.Time) (int64, error)
 }
 
 // Transaction represents a persisted transaction record
@@ -91,6 +103,7 @@ const (
 	TransactionTypeLoanRepayment     TransactionType = "loan_repayment"
 	TransactionTypeRefund            TransactionType = "refund"
 	TransactionTypeFee               TransactionType = "fee"
+	TransactionTypeInterest          TransactionType = "interest"
 )
 
 // TransactionStatus represents the status of a transaction
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"hustlex/internal/domain/wallet/aggregate"
+)
+
+// ErrBalanceLimitExceeded is returned when money coming in would take a wallet past its KYC balance cap
+var ErrBalanceLimitExceeded = errors.New("wallet balance limit exceeded")
+
+// KYCLimits caps what a wallet can move and hold at one KYC level.
+// Amounts are in kobo; zero means no cap.
+type KYCLimits struct {
+	SingleMax  int64 // largest single transfer or withdrawal
+	DailyMax   int64 // total transfers and withdrawals in 24 hours
+	MaxBalance int64 // most the wallet can hold
+}
+
+// DefaultKYCLimits follows the CBN tiered KYC limits for wallets, keyed by KYC level
+// (0 none, 1 phone, 2 BVN/NIN, 3 address and ID document)
+func DefaultKYCLimits() map[int]KYCLimits {
+	return map[int]KYCLimits{
+		0: {SingleMax: 5000000, DailyMax: 5000000, MaxBalance: 30000000},   // ₦50k, ₦50k, ₦300k
+		1: {SingleMax: 5000000, DailyMax: 5000000, MaxBalance: 30000000},   // ₦50k, ₦50k, ₦300k
+		2: {SingleMax: 20000000, DailyMax: 20000000, MaxBalance: 50000000}, // ₦200k, ₦200k, ₦500k
+		3: {SingleMax: 100000000, DailyMax: 500000000, MaxBalance: 0},      // ₦1m, ₦5m, no cap
+	}
+}
+
+// KYCLimitPolicy decides what a wallet may do at its owner's KYC level
+type KYCLimitPolicy struct {
+	limits map[int]KYCLimits
+}
+
+// NewKYCLimitPolicy creates a KYC limit policy. Nil limits use DefaultKYCLimits.
+func NewKYCLimitPolicy(limits map[int]KYCLimits) *KYCLimitPolicy {
+	if limits == nil {
+		limits = DefaultKYCLimits()
+	}
+	return &KYCLimitPolicy{limits: limits}
+}
+
+// LimitsFor returns the limits of the highest configured level at or below the given one
+func (p *KYCLimitPolicy) LimitsFor(level int) KYCLimits {
+	for l := level; l >= 0; l-- {
+		if limits, ok := p.limits[l]; ok {
+			return limits
+		}
+	}
+	return KYCLimits{}
+}
+
+// CheckOutflow checks a transfer or withdrawal against the single and daily caps.
+// sentToday is what the wallet has already sent in the last 24 hours.
+func (p *KYCLimitPolicy) CheckOutflow(level int, amount, sentToday int64) error {
+	limits := p.LimitsFor(level)
+	if limits.SingleMax > 0 && amount > limits.SingleMax {
+		return fmt.Errorf("%w: ₦%.2f per transaction at your verification level", ErrTransferLimitExceeded, float64(limits.SingleMax)/100)
+	}
+	if limits.DailyMax > 0 && sentToday+amount > limits.DailyMax {
+		return fmt.Errorf("%w: ₦%.2f per day at your verification level", aggregate.ErrDailyLimitExceeded, float64(limits.DailyMax)/100)
+	}
+	return nil
+}
+
+// CheckInflow checks money coming into a wallet against the balance cap
+func (p *KYCLimitPolicy) CheckInflow(level int, amount, balance int64) error {
+	limits := p.LimitsFor(level)
+	if limits.MaxBalance > 0 && balance+amount > limits.MaxBalance {
+		return fmt.Errorf("%w: ₦%.2f at your verification level", ErrBalanceLimitExceeded, float64(limits.MaxBalance)/100)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"hustlex/internal/domain/wallet/aggregate"
+)
+
+func TestKYCLimitPolicy_CheckOutflow(t *testing.T) {
+	policy := NewKYCLimitPolicy(nil)
+
+	tests := []struct {
+		name      string
+		level     int
+		amount    int64
+		sentToday int64
+		wantErr   error
+	}{
+		{"tier 1 within limits", 1, 2000000, 1000000, nil},
+		{"tier 1 single transfer too large", 1, 6000000, 0, ErrTransferLimitExceeded},
+		{"tier 1 daily total exceeded", 1, 3000000, 3000000, aggregate.ErrDailyLimitExceeded},
+		{"tier 2 allows what tier 1 refuses", 2, 6000000, 3000000, nil},
+		{"tier 3 daily total exceeded", 3, 100000000, 450000000, aggregate.ErrDailyLimitExceeded},
+		{"unknown higher level uses highest tier", 5, 100000000, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.CheckOutflow(tt.level, tt.amount, tt.sentToday)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CheckOutflow() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckOutflow() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKYCLimitPolicy_CheckInflow(t *testing.T) {
+	policy := NewKYCLimitPolicy(nil)
+
+	if err := policy.CheckInflow(1, 5000000, 26000000); !errors.Is(err, ErrBalanceLimitExceeded) {
+		t.Errorf("CheckInflow() tier 1 over cap error = %v, want %v", err, ErrBalanceLimitExceeded)
+	}
+	if err := policy.CheckInflow(2, 5000000, 26000000); err != nil {
+		t.Errorf("CheckInflow() tier 2 error = %v, want nil", err)
+	}
+	if err := policy.CheckInflow(3, 1000000000, 1000000000); err != nil {
+		t.Errorf("CheckInflow() tier 3 has no balance cap, error = %v", err)
+	}
+}
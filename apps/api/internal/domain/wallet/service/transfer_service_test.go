@@ -65,6 +65,10 @@ func (m *mockWalletRepository) SaveWithEvents(ctx context.Context, wallet *aggre
 	return m.Save(ctx, wallet)
 }
 
+func (m *mockWalletRepository) SaveWithTransaction(ctx context.Context, wallet *aggregate.Wallet, tx *repository.Transaction) error {
+	return m.Save(ctx, wallet)
+}
+
 func (m *mockWalletRepository) addWallet(w *aggregate.Wallet) {
 	m.wallets[w.UserID().String()] = w
 }
@@ -0,0 +1,93 @@
+// Package kyc provides identity verification adapters for Nigerian KYC providers
+package kyc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/identity/service"
+)
+
+// DefaultTimeout bounds a single provider call
+const DefaultTimeout = 30 * time.Second
+
+// maxResponseSize caps how much of a provider response is read
+const maxResponseSize = 1 << 20
+
+func defaultClient(client *http.Client) *http.Client {
+	if client != nil {
+		return client
+	}
+	return &http.Client{Timeout: DefaultTimeout}
+}
+
+// newJSONRequest builds a request with an optional JSON body
+func newJSONRequest(ctx context.Context, method, url string, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// do sends a request and returns the response status and body. Transport errors,
+// rate limits and server errors are reported as service.ErrProviderUnavailable so
+// the next provider can be tried.
+func do(client *http.Client, req *http.Request) (int, []byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", service.ErrProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", service.ErrProviderUnavailable, err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return resp.StatusCode, body, fmt.Errorf("%w: status %d", service.ErrProviderUnavailable, resp.StatusCode)
+	}
+
+	return resp.StatusCode, body, nil
+}
+
+// parseDate reads a date of birth in any of the layouts providers use
+func parseDate(value string) *time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	for _, layout := range []string{"2006-01-02", "02-01-2006", "02-Jan-2006", "02/01/2006", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+func formatDate(t *time.Time, layout string) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,110 @@
+package kyc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+)
+
+// DojahConfig holds Dojah credentials
+type DojahConfig struct {
+	BaseURL   string // e.g. https://api.dojah.io
+	AppID     string
+	SecretKey string
+}
+
+// DojahVerifier looks up BVNs and NINs with Dojah.
+// It implements service.IdentityVerifier.
+type DojahVerifier struct {
+	config DojahConfig
+	client *http.Client
+}
+
+// NewDojahVerifier creates a Dojah adapter. A nil client uses DefaultTimeout.
+func NewDojahVerifier(config DojahConfig, client *http.Client) *DojahVerifier {
+	return &DojahVerifier{
+		config: config,
+		client: defaultClient(client),
+	}
+}
+
+// Name identifies the provider on stored checks
+func (v *DojahVerifier) Name() string { return "dojah" }
+
+// Supports returns true for BVN and NIN checks
+func (v *DojahVerifier) Supports(checkType aggregate.KYCCheckType) bool {
+	return checkType == aggregate.KYCCheckBVN || checkType == aggregate.KYCCheckNIN
+}
+
+type dojahResponse struct {
+	Entity *struct {
+		FirstName   string `json:"first_name"`
+		MiddleName  string `json:"middle_name"`
+		LastName    string `json:"last_name"`
+		DateOfBirth string `json:"date_of_birth"`
+		Phone       string `json:"phone_number1"` // BVN records
+		NINPhone    string `json:"phone_number"`  // NIN records
+	} `json:"entity"`
+	Error string `json:"error"`
+}
+
+// Verify looks the BVN or NIN up. Dojah answers unknown numbers with a 404 or
+// a 400 carrying an error message.
+func (v *DojahVerifier) Verify(ctx context.Context, req service.VerificationRequest) (*service.VerificationResult, error) {
+	var path string
+	switch req.CheckType {
+	case aggregate.KYCCheckBVN:
+		path = "/api/v1/kyc/bvn/full?bvn=" + url.QueryEscape(req.IDNumber)
+	case aggregate.KYCCheckNIN:
+		path = "/api/v1/kyc/nin?nin=" + url.QueryEscape(req.IDNumber)
+	default:
+		return nil, aggregate.ErrKYCCheckNotSupported
+	}
+
+	httpReq, err := newJSONRequest(ctx, http.MethodGet, strings.TrimRight(v.config.BaseURL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("AppId", v.config.AppID)
+	httpReq.Header.Set("Authorization", v.config.SecretKey)
+
+	status, raw, err := do(v.client, httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &service.VerificationResult{Raw: raw}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusBadRequest:
+		return result, nil
+	default:
+		return nil, fmt.Errorf("dojah: unexpected status %d", status)
+	}
+
+	var resp dojahResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("dojah: %w", err)
+	}
+	if resp.Entity == nil {
+		return result, nil
+	}
+
+	result.Found = true
+	result.FirstName = resp.Entity.FirstName
+	result.MiddleName = resp.Entity.MiddleName
+	result.LastName = resp.Entity.LastName
+	result.DateOfBirth = parseDate(resp.Entity.DateOfBirth)
+	result.Phone = resp.Entity.Phone
+	if result.Phone == "" {
+		result.Phone = resp.Entity.NINPhone
+	}
+
+	return result, nil
+}
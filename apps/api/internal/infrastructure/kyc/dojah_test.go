@@ -0,0 +1,80 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+)
+
+var _ service.IdentityVerifier = (*DojahVerifier)(nil)
+
+func newTestDojah(t *testing.T, handler http.HandlerFunc) *DojahVerifier {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewDojahVerifier(DojahConfig{BaseURL: server.URL, AppID: "app-1", SecretKey: "secret"}, server.Client())
+}
+
+func TestDojahVerifier_VerifyNIN(t *testing.T) {
+	v := newTestDojah(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/kyc/nin" || r.URL.Query().Get("nin") != "12345678901" {
+			t.Errorf("request = %s %s", r.Method, r.URL)
+		}
+		if r.Header.Get("AppId") != "app-1" || r.Header.Get("Authorization") != "secret" {
+			t.Errorf("headers = %v", r.Header)
+		}
+		w.Write([]byte(`{"entity":{"first_name":"Chinedu","middle_name":"Emeka","last_name":"Eze","date_of_birth":"1988-11-02","phone_number":"08031234567"}}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckNIN, IDNumber: "12345678901"})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !result.Found || result.FirstName != "Chinedu" || result.MiddleName != "Emeka" || result.LastName != "Eze" || result.Phone != "08031234567" {
+		t.Errorf("Verify() = %+v", result)
+	}
+	if result.DateOfBirth == nil || result.DateOfBirth.Format("2006-01-02") != "1988-11-02" {
+		t.Errorf("Verify() DateOfBirth = %v", result.DateOfBirth)
+	}
+}
+
+func TestDojahVerifier_NotFound(t *testing.T) {
+	v := newTestDojah(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/kyc/bvn/full" {
+			t.Errorf("path = %s, want /api/v1/kyc/bvn/full", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"BVN not found"}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22200000000"})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if result.Found {
+		t.Error("Verify() should report an unknown number as not found")
+	}
+}
+
+func TestDojahVerifier_Unavailable(t *testing.T) {
+	v := newTestDojah(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"})
+	if !errors.Is(err, service.ErrProviderUnavailable) {
+		t.Errorf("Verify() error = %v, want %v", err, service.ErrProviderUnavailable)
+	}
+}
+
+func TestDojahVerifier_Supports(t *testing.T) {
+	v := NewDojahVerifier(DojahConfig{}, nil)
+	if v.Supports(aggregate.KYCCheckAddress) || v.Supports(aggregate.KYCCheckIDDocument) {
+		t.Error("Dojah adapter only supports BVN and NIN lookups")
+	}
+}
@@ -0,0 +1,203 @@
+package kyc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+)
+
+// Smile ID job types
+const (
+	smileJobEnhancedKYC          = 5
+	smileJobDocumentVerification = 6
+)
+
+// SmileIDConfig holds Smile ID credentials
+type SmileIDConfig struct {
+	BaseURL   string // e.g. https://api.smileidentity.com
+	PartnerID string
+	APIKey    string
+}
+
+// SmileIDVerifier verifies BVNs, NINs and ID documents with Smile ID.
+// It implements service.IdentityVerifier.
+type SmileIDVerifier struct {
+	config SmileIDConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSmileIDVerifier creates a Smile ID adapter. A nil client uses DefaultTimeout.
+func NewSmileIDVerifier(config SmileIDConfig, client *http.Client) *SmileIDVerifier {
+	return &SmileIDVerifier{
+		config: config,
+		client: defaultClient(client),
+		now:    time.Now,
+	}
+}
+
+// Name identifies the provider on stored checks
+func (v *SmileIDVerifier) Name() string { return "smile_id" }
+
+// Supports returns true for BVN, NIN and ID document checks
+func (v *SmileIDVerifier) Supports(checkType aggregate.KYCCheckType) bool {
+	switch checkType {
+	case aggregate.KYCCheckBVN, aggregate.KYCCheckNIN, aggregate.KYCCheckIDDocument:
+		return true
+	}
+	return false
+}
+
+// smileRequest is the body shared by Smile ID's verification endpoints
+type smileRequest struct {
+	PartnerID     string             `json:"partner_id"`
+	Timestamp     string             `json:"timestamp"`
+	Signature     string             `json:"signature"`
+	Country       string             `json:"country"`
+	IDType        string             `json:"id_type"`
+	IDNumber      string             `json:"id_number"`
+	FirstName     string             `json:"first_name,omitempty"`
+	LastName      string             `json:"last_name,omitempty"`
+	DOB           string             `json:"dob,omitempty"`
+	PhoneNumber   string             `json:"phone_number,omitempty"`
+	Images        []smileImage       `json:"images,omitempty"`
+	PartnerParams smilePartnerParams `json:"partner_params"`
+}
+
+type smileImage struct {
+	ImageTypeID int    `json:"image_type_id"` // 0 selfie, 1 ID card front
+	Image       string `json:"image"`
+}
+
+type smilePartnerParams struct {
+	JobID   string `json:"job_id"`
+	UserID  string `json:"user_id"`
+	JobType int    `json:"job_type"`
+}
+
+type smileResponse struct {
+	SmileJobID string `json:"SmileJobID"`
+	ResultCode string `json:"ResultCode"`
+	ResultText string `json:"ResultText"`
+	Actions    struct {
+		VerifyDocument string `json:"Verify_Document"`
+		SelfieToIDCard string `json:"Selfie_To_ID_Card_Compare"`
+	} `json:"Actions"`
+	FullData struct {
+		FirstName   string `json:"FirstName"`
+		MiddleName  string `json:"MiddleName"`
+		LastName    string `json:"LastName"`
+		DateOfBirth string `json:"DateOfBirth"`
+		PhoneNumber string `json:"PhoneNumber"`
+	} `json:"FullData"`
+}
+
+// Smile ID result codes
+const (
+	smileIDNotFound      = "1013"
+	smileDocumentInvalid = "0811"
+)
+
+// Verify runs the check as an Enhanced KYC or Document Verification job
+func (v *SmileIDVerifier) Verify(ctx context.Context, req service.VerificationRequest) (*service.VerificationResult, error) {
+	timestamp := v.now().UTC().Format(time.RFC3339)
+	body := smileRequest{
+		PartnerID:   v.config.PartnerID,
+		Timestamp:   timestamp,
+		Signature:   v.signature(timestamp),
+		Country:     "NG",
+		IDNumber:    req.IDNumber,
+		FirstName:   req.FirstName,
+		LastName:    req.LastName,
+		DOB:         formatDate(req.DateOfBirth, "2006-01-02"),
+		PhoneNumber: req.Phone,
+		PartnerParams: smilePartnerParams{
+			JobID:   req.Reference,
+			UserID:  req.Reference,
+			JobType: smileJobEnhancedKYC,
+		},
+	}
+
+	path := "/v1/id_verification"
+	switch req.CheckType {
+	case aggregate.KYCCheckBVN:
+		body.IDType = "BVN"
+	case aggregate.KYCCheckNIN:
+		body.IDType = "NIN_V2"
+	case aggregate.KYCCheckIDDocument:
+		path = "/v1/document_verification"
+		body.IDType = smileDocumentType(req.DocumentType)
+		body.PartnerParams.JobType = smileJobDocumentVerification
+		body.Images = []smileImage{
+			{ImageTypeID: 0, Image: req.SelfieImageRef},
+			{ImageTypeID: 1, Image: req.DocumentImageRef},
+		}
+	default:
+		return nil, aggregate.ErrKYCCheckNotSupported
+	}
+
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, strings.TrimRight(v.config.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	status, raw, err := do(v.client, httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("smile id: unexpected status %d", status)
+	}
+
+	var resp smileResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("smile id: %w", err)
+	}
+
+	result := &service.VerificationResult{
+		ProviderRef: resp.SmileJobID,
+		FirstName:   resp.FullData.FirstName,
+		MiddleName:  resp.FullData.MiddleName,
+		LastName:    resp.FullData.LastName,
+		DateOfBirth: parseDate(resp.FullData.DateOfBirth),
+		Phone:       resp.FullData.PhoneNumber,
+		Raw:         raw,
+	}
+
+	if req.CheckType == aggregate.KYCCheckIDDocument {
+		result.Found = resp.ResultCode != smileDocumentInvalid && resp.Actions.VerifyDocument == "Passed"
+		result.FaceMatched = resp.Actions.SelfieToIDCard == "Passed"
+	} else {
+		result.Found = resp.ResultCode != smileIDNotFound
+	}
+
+	return result, nil
+}
+
+// signature is Smile ID's request signature: base64(HMAC-SHA256(timestamp + partner ID + "sid_request"))
+func (v *SmileIDVerifier) signature(timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(v.config.APIKey))
+	mac.Write([]byte(timestamp + v.config.PartnerID + "sid_request"))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func smileDocumentType(documentType string) string {
+	switch documentType {
+	case "passport":
+		return "PASSPORT"
+	case "drivers_license":
+		return "DRIVERS_LICENSE"
+	case "voters_card":
+		return "VOTER_ID"
+	}
+	return "IDENTITY_CARD"
+}
@@ -0,0 +1,133 @@
+package kyc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+)
+
+var _ service.IdentityVerifier = (*SmileIDVerifier)(nil)
+
+func newTestSmileID(t *testing.T, handler http.HandlerFunc) *SmileIDVerifier {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	v := NewSmileIDVerifier(SmileIDConfig{BaseURL: server.URL, PartnerID: "2048", APIKey: "secret"}, server.Client())
+	v.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return v
+}
+
+func TestSmileIDVerifier_VerifyBVN(t *testing.T) {
+	dob := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	v := newTestSmileID(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/id_verification" {
+			t.Errorf("request = %s %s, want POST /v1/id_verification", r.Method, r.URL.Path)
+		}
+
+		var body smileRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body.IDType != "BVN" || body.IDNumber != "22212345678" || body.DOB != "1990-05-17" {
+			t.Errorf("body = %+v", body)
+		}
+		if body.PartnerParams.JobType != smileJobEnhancedKYC {
+			t.Errorf("job_type = %d, want %d", body.PartnerParams.JobType, smileJobEnhancedKYC)
+		}
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(body.Timestamp + "2048sid_request"))
+		if want := base64.StdEncoding.EncodeToString(mac.Sum(nil)); body.Signature != want {
+			t.Errorf("signature = %s, want %s", body.Signature, want)
+		}
+
+		w.Write([]byte(`{"SmileJobID":"0000123","ResultCode":"1012","FullData":{"FirstName":"ADAEZE","MiddleName":"NGOZI","LastName":"OKAFOR","DateOfBirth":"17-05-1990"}}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{
+		Reference:   "ref-1",
+		CheckType:   aggregate.KYCCheckBVN,
+		IDNumber:    "22212345678",
+		FirstName:   "Adaeze",
+		LastName:    "Okafor",
+		DateOfBirth: &dob,
+	})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !result.Found || result.ProviderRef != "0000123" {
+		t.Errorf("Verify() = %+v, want found with job ID", result)
+	}
+	if result.FirstName != "ADAEZE" || result.MiddleName != "NGOZI" || result.LastName != "OKAFOR" {
+		t.Errorf("Verify() names = %s %s %s", result.FirstName, result.MiddleName, result.LastName)
+	}
+	if result.DateOfBirth == nil || !result.DateOfBirth.Equal(dob) {
+		t.Errorf("Verify() DateOfBirth = %v, want %v", result.DateOfBirth, dob)
+	}
+	if len(result.Raw) == 0 {
+		t.Error("Verify() should keep the raw response")
+	}
+}
+
+func TestSmileIDVerifier_NotFound(t *testing.T) {
+	v := newTestSmileID(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"SmileJobID":"0000124","ResultCode":"1013","ResultText":"ID Number Not Found"}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckNIN, IDNumber: "12345678901"})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if result.Found {
+		t.Error("Verify() should report an unknown number as not found")
+	}
+}
+
+func TestSmileIDVerifier_Document(t *testing.T) {
+	v := newTestSmileID(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/document_verification" {
+			t.Errorf("path = %s, want /v1/document_verification", r.URL.Path)
+		}
+		var body smileRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.IDType != "PASSPORT" || len(body.Images) != 2 {
+			t.Errorf("body = %+v", body)
+		}
+		w.Write([]byte(`{"SmileJobID":"0000125","ResultCode":"0810","Actions":{"Verify_Document":"Passed","Selfie_To_ID_Card_Compare":"Passed"},"FullData":{"FirstName":"ADAEZE","LastName":"OKAFOR"}}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{
+		CheckType:        aggregate.KYCCheckIDDocument,
+		DocumentType:     "passport",
+		DocumentImageRef: "doc",
+		SelfieImageRef:   "selfie",
+	})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !result.Found || !result.FaceMatched {
+		t.Errorf("Verify() = %+v, want found and face matched", result)
+	}
+}
+
+func TestSmileIDVerifier_Unavailable(t *testing.T) {
+	v := newTestSmileID(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"})
+	if !errors.Is(err, service.ErrProviderUnavailable) {
+		t.Errorf("Verify() error = %v, want %v", err, service.ErrProviderUnavailable)
+	}
+}
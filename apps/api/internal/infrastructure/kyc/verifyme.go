@@ -0,0 +1,195 @@
+package kyc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+)
+
+// VerifyMeConfig holds VerifyMe credentials
+type VerifyMeConfig struct {
+	BaseURL   string // e.g. https://vapi.verifyme.ng
+	SecretKey string
+}
+
+// VerifyMeVerifier verifies BVNs, NINs and residential addresses with VerifyMe.
+// It implements service.IdentityVerifier.
+type VerifyMeVerifier struct {
+	config VerifyMeConfig
+	client *http.Client
+}
+
+// NewVerifyMeVerifier creates a VerifyMe adapter. A nil client uses DefaultTimeout.
+func NewVerifyMeVerifier(config VerifyMeConfig, client *http.Client) *VerifyMeVerifier {
+	return &VerifyMeVerifier{
+		config: config,
+		client: defaultClient(client),
+	}
+}
+
+// Name identifies the provider on stored checks
+func (v *VerifyMeVerifier) Name() string { return "verifyme" }
+
+// Supports returns true for BVN, NIN and address checks
+func (v *VerifyMeVerifier) Supports(checkType aggregate.KYCCheckType) bool {
+	switch checkType {
+	case aggregate.KYCCheckBVN, aggregate.KYCCheckNIN, aggregate.KYCCheckAddress:
+		return true
+	}
+	return false
+}
+
+type verifyMeIdentityRequest struct {
+	FirstName string `json:"firstname"`
+	LastName  string `json:"lastname"`
+	DOB       string `json:"dob,omitempty"`
+}
+
+type verifyMeIdentityResponse struct {
+	Status string `json:"status"`
+	Data   *struct {
+		FirstName  string `json:"firstname"`
+		MiddleName string `json:"middlename"`
+		LastName   string `json:"lastname"`
+		BirthDate  string `json:"birthdate"`
+		Phone      string `json:"phone"`
+	} `json:"data"`
+}
+
+type verifyMeAddressRequest struct {
+	Reference string            `json:"reference"`
+	Street    string            `json:"street"`
+	LGA       string            `json:"lga"`
+	State     string            `json:"state"`
+	City      string            `json:"city"`
+	Applicant verifyMeApplicant `json:"applicant"`
+}
+
+type verifyMeApplicant struct {
+	FirstName string `json:"firstname"`
+	LastName  string `json:"lastname"`
+	Phone     string `json:"phone"`
+	DOB       string `json:"dob,omitempty"`
+}
+
+type verifyMeAddressResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ID     int64 `json:"id"`
+		Status struct {
+			Status string `json:"status"` // VERIFIED, NOT_VERIFIED, PENDING
+		} `json:"status"`
+	} `json:"data"`
+}
+
+// Verify runs the check. Address visits take days; a PENDING answer is
+// reported as service.ErrProviderUnavailable so no failed attempt is recorded
+// and the user can check again once the visit is done.
+func (v *VerifyMeVerifier) Verify(ctx context.Context, req service.VerificationRequest) (*service.VerificationResult, error) {
+	switch req.CheckType {
+	case aggregate.KYCCheckBVN:
+		return v.verifyIdentity(ctx, "bvn", req)
+	case aggregate.KYCCheckNIN:
+		return v.verifyIdentity(ctx, "nin", req)
+	case aggregate.KYCCheckAddress:
+		return v.verifyAddress(ctx, req)
+	}
+	return nil, aggregate.ErrKYCCheckNotSupported
+}
+
+func (v *VerifyMeVerifier) verifyIdentity(ctx context.Context, idType string, req service.VerificationRequest) (*service.VerificationResult, error) {
+	endpoint := fmt.Sprintf("%s/v1/verifications/identities/%s/%s", strings.TrimRight(v.config.BaseURL, "/"), idType, url.PathEscape(req.IDNumber))
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, endpoint, verifyMeIdentityRequest{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		DOB:       formatDate(req.DateOfBirth, "02-01-2006"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+v.config.SecretKey)
+
+	status, raw, err := do(v.client, httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &service.VerificationResult{Raw: raw}
+	switch status {
+	case http.StatusOK, http.StatusCreated:
+	case http.StatusNotFound:
+		return result, nil
+	default:
+		return nil, fmt.Errorf("verifyme: unexpected status %d", status)
+	}
+
+	var resp verifyMeIdentityResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("verifyme: %w", err)
+	}
+	if resp.Status != "success" || resp.Data == nil {
+		return result, nil
+	}
+
+	result.Found = true
+	result.FirstName = resp.Data.FirstName
+	result.MiddleName = resp.Data.MiddleName
+	result.LastName = resp.Data.LastName
+	result.DateOfBirth = parseDate(resp.Data.BirthDate)
+	result.Phone = resp.Data.Phone
+
+	return result, nil
+}
+
+func (v *VerifyMeVerifier) verifyAddress(ctx context.Context, req service.VerificationRequest) (*service.VerificationResult, error) {
+	httpReq, err := newJSONRequest(ctx, http.MethodPost, strings.TrimRight(v.config.BaseURL, "/")+"/v1/verifications/addresses", verifyMeAddressRequest{
+		Reference: req.Reference,
+		Street:    req.Address.Street,
+		LGA:       req.Address.LGA,
+		State:     req.Address.State,
+		City:      req.Address.City,
+		Applicant: verifyMeApplicant{
+			FirstName: req.FirstName,
+			LastName:  req.LastName,
+			Phone:     req.Phone,
+			DOB:       formatDate(req.DateOfBirth, "02-01-2006"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+v.config.SecretKey)
+
+	status, raw, err := do(v.client, httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK && status != http.StatusCreated {
+		return nil, fmt.Errorf("verifyme: unexpected status %d", status)
+	}
+
+	var resp verifyMeAddressResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("verifyme: %w", err)
+	}
+
+	switch resp.Data.Status.Status {
+	case "VERIFIED", "NOT_VERIFIED":
+	default:
+		return nil, fmt.Errorf("%w: address verification %s", service.ErrProviderUnavailable, strings.ToLower(resp.Data.Status.Status))
+	}
+
+	return &service.VerificationResult{
+		Found:          true,
+		ProviderRef:    strconv.FormatInt(resp.Data.ID, 10),
+		AddressMatched: resp.Data.Status.Status == "VERIFIED",
+		Raw:            raw,
+	}, nil
+}
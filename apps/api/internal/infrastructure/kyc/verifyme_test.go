@@ -0,0 +1,132 @@
+package kyc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+)
+
+var _ service.IdentityVerifier = (*VerifyMeVerifier)(nil)
+
+func newTestVerifyMe(t *testing.T, handler http.HandlerFunc) *VerifyMeVerifier {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewVerifyMeVerifier(VerifyMeConfig{BaseURL: server.URL, SecretKey: "secret"}, server.Client())
+}
+
+func TestVerifyMeVerifier_VerifyBVN(t *testing.T) {
+	dob := time.Date(1992, 1, 9, 0, 0, 0, 0, time.UTC)
+	v := newTestVerifyMe(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/verifications/identities/bvn/22212345678" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Authorization = %s", r.Header.Get("Authorization"))
+		}
+		var body verifyMeIdentityRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.FirstName != "Tunde" || body.DOB != "09-01-1992" {
+			t.Errorf("body = %+v", body)
+		}
+		w.Write([]byte(`{"status":"success","data":{"firstname":"TUNDE","middlename":"","lastname":"BAKARE","birthdate":"09-01-1992","phone":"08031234567"}}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{
+		CheckType:   aggregate.KYCCheckBVN,
+		IDNumber:    "22212345678",
+		FirstName:   "Tunde",
+		LastName:    "Bakare",
+		DateOfBirth: &dob,
+	})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !result.Found || result.FirstName != "TUNDE" || result.LastName != "BAKARE" || result.Phone != "08031234567" {
+		t.Errorf("Verify() = %+v", result)
+	}
+	if result.DateOfBirth == nil || !result.DateOfBirth.Equal(dob) {
+		t.Errorf("Verify() DateOfBirth = %v, want %v", result.DateOfBirth, dob)
+	}
+}
+
+func TestVerifyMeVerifier_NotFound(t *testing.T) {
+	v := newTestVerifyMe(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":"error","message":"NIN not found"}`))
+	})
+
+	result, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckNIN, IDNumber: "12345678901"})
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if result.Found {
+		t.Error("Verify() should report an unknown number as not found")
+	}
+}
+
+func TestVerifyMeVerifier_Address(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		wantMatched bool
+		wantErr     error
+	}{
+		{"verified", "VERIFIED", true, nil},
+		{"not verified", "NOT_VERIFIED", false, nil},
+		{"pending visit", "PENDING", false, service.ErrProviderUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newTestVerifyMe(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/verifications/addresses" {
+					t.Errorf("path = %s, want /v1/verifications/addresses", r.URL.Path)
+				}
+				var body verifyMeAddressRequest
+				json.NewDecoder(r.Body).Decode(&body)
+				if body.Street != "12 Allen Avenue" || body.LGA != "Ikeja" || body.Applicant.Phone != "+2348012345678" {
+					t.Errorf("body = %+v", body)
+				}
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"status":"success","data":{"id":881,"status":{"status":"` + tt.status + `"}}}`))
+			})
+
+			result, err := v.Verify(context.Background(), service.VerificationRequest{
+				CheckType: aggregate.KYCCheckAddress,
+				Phone:     "+2348012345678",
+				Address:   service.AddressDetails{Street: "12 Allen Avenue", City: "Ikeja", LGA: "Ikeja", State: "Lagos"},
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if result.AddressMatched != tt.wantMatched || result.ProviderRef != "881" {
+				t.Errorf("Verify() = %+v, want matched %v", result, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func TestVerifyMeVerifier_Unavailable(t *testing.T) {
+	v := newTestVerifyMe(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := v.Verify(context.Background(), service.VerificationRequest{CheckType: aggregate.KYCCheckBVN, IDNumber: "22212345678"})
+	if !errors.Is(err, service.ErrProviderUnavailable) {
+		t.Errorf("Verify() error = %v, want %v", err, service.ErrProviderUnavailable)
+	}
+}
@@ -0,0 +1,461 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"hustlex/internal/domain/gig/aggregate"
+	"hustlex/internal/domain/gig/repository"
+	"hustlex/internal/domain/shared/valueobject"
+)
+
+// Search tuning
+const (
+	// searchLanguage is the text search configuration used for the document column
+	searchLanguage = "english"
+
+	// trigramWeight scales title similarity against full-text rank so that a close
+	// misspelling still ranks, but below an exact word match
+	trigramWeight = 0.5
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+)
+
+// GigSearchRepository implements repository.GigSearchRepository for PostgreSQL.
+// Open gigs are copied into gig_search_index, whose generated tsvector weights the
+// title over tags over description. Typos are caught with pg_trgm and on-site gigs
+// are filtered by distance with earthdistance.
+type GigSearchRepository struct {
+	db *DB
+}
+
+// NewGigSearchRepository creates a new PostgreSQL gig search repository
+func NewGigSearchRepository(db *DB) repository.GigSearchRepository {
+	return &GigSearchRepository{db: db}
+}
+
+// IndexGig adds or refreshes a gig in the search index
+func (r *GigSearchRepository) IndexGig(ctx context.Context, gig *aggregate.Gig) error {
+	query := `
+		INSERT INTO gig_search_index (
+			gig_id, client_id, title, description, category, skill_id,
+			tags, tags_text, budget_min, budget_max, currency,
+			delivery_days, deadline, is_remote, location,
+			latitude, longitude, status, is_featured, proposal_count,
+			created_at, indexed_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+			$12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+		)
+		ON CONFLICT (gig_id) DO UPDATE SET
+			title = EXCLUDED.title,
+			description = EXCLUDED.description,
+			category = EXCLUDED.category,
+			skill_id = EXCLUDED.skill_id,
+			tags = EXCLUDED.tags,
+			tags_text = EXCLUDED.tags_text,
+			budget_min = EXCLUDED.budget_min,
+			budget_max = EXCLUDED.budget_max,
+			currency = EXCLUDED.currency,
+			delivery_days = EXCLUDED.delivery_days,
+			deadline = EXCLUDED.deadline,
+			is_remote = EXCLUDED.is_remote,
+			location = EXCLUDED.location,
+			latitude = EXCLUDED.latitude,
+			longitude = EXCLUDED.longitude,
+			status = EXCLUDED.status,
+			is_featured = EXCLUDED.is_featured,
+			proposal_count = EXCLUDED.proposal_count,
+			indexed_at = EXCLUDED.indexed_at
+	`
+
+	var skillID *string
+	if s := gig.SkillID(); s != nil {
+		id := s.String()
+		skillID = &id
+	}
+
+	var latitude, longitude sql.NullFloat64
+	if point := gig.Coordinates(); point != nil {
+		latitude = sql.NullFloat64{Float64: point.Latitude(), Valid: true}
+		longitude = sql.NullFloat64{Float64: point.Longitude(), Valid: true}
+	}
+
+	tags := gig.Tags()
+	if tags == nil {
+		tags = []string{}
+	}
+	tagsJSON, _ := json.Marshal(tags)
+
+	_, err := r.db.ExecContext(ctx, query,
+		gig.ID().String(),
+		gig.ClientID().String(),
+		gig.Title(),
+		gig.Description(),
+		gig.Category(),
+		skillID,
+		tagsJSON,
+		strings.Join(tags, " "),
+		gig.Budget().Min().Amount(),
+		gig.Budget().Max().Amount(),
+		string(gig.Currency()),
+		gig.DeliveryDays(),
+		gig.Deadline(),
+		gig.IsRemote(),
+		nullString(gig.Location()),
+		latitude,
+		longitude,
+		gig.Status().String(),
+		gig.IsFeatured(),
+		gig.ProposalCount(),
+		gig.CreatedAt(),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to index gig: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveGig removes a gig from the search index
+func (r *GigSearchRepository) RemoveGig(ctx context.Context, gigID valueobject.GigID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM gig_search_index WHERE gig_id = $1`, gigID.String())
+	if err != nil {
+		return fmt.Errorf("failed to remove gig from search index: %w", err)
+	}
+	return nil
+}
+
+// Search finds gigs matching the query text and filters, best match first unless
+// another order is asked for
+func (r *GigSearchRepository) Search(ctx context.Context, query string, filter repository.GigFilter) ([]*repository.GigSearchResult, int64, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		query = strings.TrimSpace(filter.SearchQuery)
+	}
+
+	limit := filter.Limit
+	if limit < 1 || limit > maxSearchLimit {
+		limit = defaultSearchLimit
+	}
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	args := &sqlArgs{}
+	conditions := searchConditions(args, query, filter, facetNone)
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM gig_search_index s WHERE ` + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args.values...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count gig search results: %w", err)
+	}
+	if total == 0 {
+		return []*repository.GigSearchResult{}, 0, nil
+	}
+
+	score := "0::float8"
+	if query != "" {
+		q := args.add(query)
+		score = fmt.Sprintf(
+			"ts_rank_cd(s.document, websearch_to_tsquery('%s', %s), 32) + %g * word_similarity(%s, s.title)",
+			searchLanguage, q, trigramWeight, q,
+		)
+	}
+
+	distance := "NULL::float8"
+	if filter.Near != nil {
+		distance = fmt.Sprintf("earth_distance(%s, ll_to_earth(s.latitude, s.longitude)) / 1000.0", earthPoint(args, *filter.Near))
+	}
+
+	selectQuery := fmt.Sprintf(`
+		SELECT
+			s.gig_id, s.title, s.description, s.category, s.tags,
+			s.budget_min, s.budget_max, s.currency, s.delivery_days,
+			s.is_remote, COALESCE(s.location, ''), COALESCE(u.full_name, ''),
+			%s AS score, %s AS distance_km, s.created_at
+		FROM gig_search_index s
+		LEFT JOIN users u ON u.id = s.client_id
+		WHERE %s
+		ORDER BY %s
+		LIMIT %s OFFSET %s
+	`, score, distance, where, searchOrder(query, filter), args.add(limit), args.add(offset))
+
+	rows, err := r.db.QueryContext(ctx, selectQuery, args.values...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search gigs: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*repository.GigSearchResult, 0, limit)
+	for rows.Next() {
+		var (
+			result     repository.GigSearchResult
+			tagsJSON   []byte
+			distanceKm sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&result.GigID,
+			&result.Title,
+			&result.Description,
+			&result.Category,
+			&tagsJSON,
+			&result.BudgetMin,
+			&result.BudgetMax,
+			&result.Currency,
+			&result.DeliveryDays,
+			&result.IsRemote,
+			&result.Location,
+			&result.ClientName,
+			&result.Score,
+			&distanceKm,
+			&result.CreatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan gig search result: %w", err)
+		}
+
+		if err := json.Unmarshal(tagsJSON, &result.Tags); err != nil {
+			result.Tags = []string{}
+		}
+		if distanceKm.Valid {
+			d := distanceKm.Float64
+			result.DistanceKm = &d
+		}
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read gig search results: %w", err)
+	}
+
+	return results, total, nil
+}
+
+// Facets counts matching gigs per category and per budget band. Each facet ignores
+// its own filter so the counts show what choosing a different value would return.
+func (r *GigSearchRepository) Facets(ctx context.Context, query string, filter repository.GigFilter) (*repository.GigSearchFacets, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		query = strings.TrimSpace(filter.SearchQuery)
+	}
+
+	categories, err := r.categoryFacet(ctx, query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	bands, err := r.budgetBandFacet(ctx, query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.GigSearchFacets{
+		Categories:  categories,
+		BudgetBands: bands,
+	}, nil
+}
+
+func (r *GigSearchRepository) categoryFacet(ctx context.Context, query string, filter repository.GigFilter) ([]repository.FacetCount, error) {
+	args := &sqlArgs{}
+	conditions := searchConditions(args, query, filter, facetCategory)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT s.category, COUNT(*)
+		FROM gig_search_index s
+		WHERE `+strings.Join(conditions, " AND ")+`
+		GROUP BY s.category
+		ORDER BY COUNT(*) DESC, s.category
+	`, args.values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count gigs by category: %w", err)
+	}
+	defer rows.Close()
+
+	return scanFacetCounts(rows)
+}
+
+func (r *GigSearchRepository) budgetBandFacet(ctx context.Context, query string, filter repository.GigFilter) ([]repository.FacetCount, error) {
+	args := &sqlArgs{}
+	conditions := searchConditions(args, query, filter, facetBudget)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+budgetBandCase(args)+` AS band, COUNT(*)
+		FROM gig_search_index s
+		WHERE `+strings.Join(conditions, " AND ")+`
+		GROUP BY band
+	`, args.values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count gigs by budget band: %w", err)
+	}
+	defer rows.Close()
+
+	counts, err := scanFacetCounts(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report every band in order, including empty ones
+	byBand := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		byBand[c.Value] = c.Count
+	}
+	bands := make([]repository.FacetCount, len(repository.BudgetBands))
+	for i, band := range repository.BudgetBands {
+		bands[i] = repository.FacetCount{Value: band.Key, Count: byBand[band.Key]}
+	}
+
+	return bands, nil
+}
+
+func scanFacetCounts(rows *sql.Rows) ([]repository.FacetCount, error) {
+	counts := make([]repository.FacetCount, 0)
+	for rows.Next() {
+		var c repository.FacetCount
+		if err := rows.Scan(&c.Value, &c.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan facet count: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read facet counts: %w", err)
+	}
+	return counts, nil
+}
+
+// sqlArgs collects positional query arguments
+type sqlArgs struct {
+	values []interface{}
+}
+
+// add appends an argument and returns its placeholder
+func (a *sqlArgs) add(v interface{}) string {
+	a.values = append(a.values, v)
+	return fmt.Sprintf("$%d", len(a.values))
+}
+
+// facet names the filter a facet count leaves out
+type facet int
+
+const (
+	facetNone facet = iota
+	facetCategory
+	facetBudget
+)
+
+// searchConditions builds the WHERE clause shared by search and facet queries
+func searchConditions(args *sqlArgs, query string, filter repository.GigFilter, skip facet) []string {
+	status := aggregate.GigStatusOpen
+	if filter.Status != nil {
+		status = *filter.Status
+	}
+	conditions := []string{"s.status = " + args.add(status.String())}
+
+	if query != "" {
+		q := args.add(query)
+		conditions = append(conditions, fmt.Sprintf(
+			"(s.document @@ websearch_to_tsquery('%s', %s) OR %s <%% s.title OR %s <%% s.tags_text)",
+			searchLanguage, q, q, q,
+		))
+	}
+
+	if filter.Category != "" && skip != facetCategory {
+		conditions = append(conditions, "s.category = "+args.add(filter.Category))
+	}
+	if len(filter.SkillIDs) > 0 {
+		skills := make([]string, len(filter.SkillIDs))
+		for i, id := range filter.SkillIDs {
+			skills[i] = args.add(id.String())
+		}
+		conditions = append(conditions, "s.skill_id IN ("+strings.Join(skills, ", ")+")")
+	}
+
+	if skip != facetBudget {
+		if filter.MinBudget > 0 {
+			conditions = append(conditions, "s.budget_max >= "+args.add(filter.MinBudget))
+		}
+		if filter.MaxBudget > 0 {
+			conditions = append(conditions, "s.budget_min <= "+args.add(filter.MaxBudget))
+		}
+		if band := repository.FindBudgetBand(filter.BudgetBand); band != nil {
+			conditions = append(conditions, budgetBandCondition(args, *band))
+		}
+	}
+
+	if filter.IsRemote != nil {
+		conditions = append(conditions, "s.is_remote = "+args.add(*filter.IsRemote))
+	}
+	if filter.Location != "" {
+		conditions = append(conditions, "s.location ILIKE "+args.add("%"+filter.Location+"%"))
+	}
+	if filter.ExcludeUserID != nil {
+		conditions = append(conditions, "s.client_id <> "+args.add(filter.ExcludeUserID.String()))
+	}
+
+	if filter.Near != nil && filter.RadiusKm > 0 {
+		origin := earthPoint(args, *filter.Near)
+		radius := args.add(filter.RadiusKm * 1000)
+		conditions = append(conditions,
+			"NOT s.is_remote",
+			"s.latitude IS NOT NULL",
+			fmt.Sprintf("earth_box(%s, %s) @> ll_to_earth(s.latitude, s.longitude)", origin, radius),
+			fmt.Sprintf("earth_distance(%s, ll_to_earth(s.latitude, s.longitude)) <= %s", origin, radius),
+		)
+	}
+
+	return conditions
+}
+
+// searchOrder picks the ORDER BY clause. Relevance is the default when there is
+// query text, newest otherwise.
+func searchOrder(query string, filter repository.GigFilter) string {
+	switch filter.SortBy {
+	case "newest":
+		return "s.created_at DESC"
+	case "budget_high":
+		return "s.budget_max DESC, s.created_at DESC"
+	case "budget_low":
+		return "s.budget_min ASC, s.created_at DESC"
+	case "deadline":
+		return "s.deadline ASC NULLS LAST, s.created_at DESC"
+	case "popular":
+		return "s.proposal_count DESC, s.created_at DESC"
+	case "distance":
+		if filter.Near != nil {
+			return "distance_km ASC NULLS LAST, s.created_at DESC"
+		}
+	}
+
+	if query != "" {
+		return "score DESC, s.is_featured DESC, s.created_at DESC"
+	}
+	return "s.is_featured DESC, s.created_at DESC"
+}
+
+func earthPoint(args *sqlArgs, point valueobject.GeoPoint) string {
+	return fmt.Sprintf("ll_to_earth(%s, %s)", args.add(point.Latitude()), args.add(point.Longitude()))
+}
+
+func budgetBandCondition(args *sqlArgs, band repository.BudgetBand) string {
+	if band.Max == 0 {
+		return "s.budget_max >= " + args.add(band.Min)
+	}
+	return fmt.Sprintf("(s.budget_max >= %s AND s.budget_max < %s)", args.add(band.Min), args.add(band.Max))
+}
+
+// budgetBandCase maps a gig's maximum budget to its budget band key
+func budgetBandCase(args *sqlArgs) string {
+	var b strings.Builder
+	b.WriteString("CASE")
+	for _, band := range repository.BudgetBands {
+		fmt.Fprintf(&b, " WHEN %s THEN %s", budgetBandCondition(args, band), args.add(band.Key))
+	}
+	b.WriteString(" END")
+	return b.String()
+}
@@ -3,9 +3,12 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"io"
 
@@ -185,6 +188,28 @@ func VerifyPIN(pin, encodedHash string) bool {
 	return VerifyPassword(pin, encodedHash)
 }
 
+// KeyedHasher computes HMAC-SHA256 digests of identifiers such as BVNs and NINs,
+// so they can be checked for reuse across accounts without being stored.
+// Use a key separate from the encryption key.
+type KeyedHasher struct {
+	key []byte
+}
+
+// NewKeyedHasher creates a keyed hasher. Key must be at least 32 bytes.
+func NewKeyedHasher(key []byte) (*KeyedHasher, error) {
+	if len(key) < 32 {
+		return nil, errors.New("key must be at least 32 bytes")
+	}
+	return &KeyedHasher{key: key}, nil
+}
+
+// Hash returns the hex-encoded HMAC-SHA256 of value
+func (h *KeyedHasher) Hash(value string) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 // MaskPII masks personally identifiable information for logging
 func MaskPII(value string, showLast int) string {
 	if len(value) <= showLast {
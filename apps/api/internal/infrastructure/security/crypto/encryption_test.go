@@ -269,3 +269,28 @@ func TestNewAESEncryptionServiceFromPassword_InvalidSalt(t *testing.T) {
 		t.Error("NewAESEncryptionServiceFromPassword() should error on short salt")
 	}
 }
+
+func TestKeyedHasher(t *testing.T) {
+	key, _ := GenerateKey()
+	hasher, err := NewKeyedHasher(key)
+	if err != nil {
+		t.Fatalf("NewKeyedHasher() error = %v", err)
+	}
+
+	if hasher.Hash("bvn:22212345678") != hasher.Hash("bvn:22212345678") {
+		t.Error("Hash() should be deterministic")
+	}
+	if hasher.Hash("bvn:22212345678") == hasher.Hash("bvn:22212345679") {
+		t.Error("Hash() should differ for different values")
+	}
+
+	otherKey, _ := GenerateKey()
+	other, _ := NewKeyedHasher(otherKey)
+	if hasher.Hash("bvn:22212345678") == other.Hash("bvn:22212345678") {
+		t.Error("Hash() should depend on the key")
+	}
+
+	if _, err := NewKeyedHasher([]byte("short")); err == nil {
+		t.Error("NewKeyedHasher() should reject short keys")
+	}
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"hustlex/internal/application/identity/command"
+	"hustlex/internal/application/identity/handler"
+	"hustlex/internal/application/identity/query"
+	"hustlex/internal/domain/identity/aggregate"
+	"hustlex/internal/domain/identity/service"
+	"hustlex/internal/infrastructure/security/validation"
+	"hustlex/internal/interface/http/middleware"
+	"hustlex/internal/interface/http/response"
+)
+
+// KYCHandler handles identity verification HTTP requests
+type KYCHandler struct {
+	kycHandler   *handler.KYCHandler
+	queryHandler *query.KYCQueryHandler
+}
+
+// NewKYCHandler creates a new KYC HTTP handler
+func NewKYCHandler(
+	kycHandler *handler.KYCHandler,
+	queryHandler *query.KYCQueryHandler,
+) *KYCHandler {
+	return &KYCHandler{
+		kycHandler:   kycHandler,
+		queryHandler: queryHandler,
+	}
+}
+
+// GetStatus handles GET /api/me/kyc
+func (h *KYCHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	result, err := h.queryHandler.HandleGetKYCStatus(r.Context(), query.GetKYCStatus{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		writeKYCError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// VerifyIdentityNumber handles POST /api/me/kyc/identity
+func (h *KYCHandler) VerifyIdentityNumber(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Type   string `json:"type"`
+		Number string `json:"number"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	v := validation.NewValidator()
+	v.Required("type", req.Type).
+		OneOf("type", req.Type, []string{string(aggregate.KYCCheckBVN), string(aggregate.KYCCheckNIN)}).
+		Required("number", req.Number)
+	if req.Type == string(aggregate.KYCCheckNIN) {
+		v.NIN("number", req.Number)
+	} else {
+		v.BVN("number", req.Number)
+	}
+
+	if v.HasErrors() {
+		response.ValidationError(w, v.Errors().Errors)
+		return
+	}
+
+	result, err := h.kycHandler.HandleVerifyIdentityNumber(r.Context(), command.VerifyIdentityNumber{
+		UserID: userID.String(),
+		Type:   req.Type,
+		Number: req.Number,
+	})
+	if err != nil {
+		writeKYCError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// VerifyIDDocument handles POST /api/me/kyc/id-document
+func (h *KYCHandler) VerifyIDDocument(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		DocumentType     string `json:"document_type"`
+		DocumentNumber   string `json:"document_number"`
+		DocumentImageRef string `json:"document_image_ref"`
+		SelfieImageRef   string `json:"selfie_image_ref"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	v := validation.NewValidator()
+	v.Required("document_type", req.DocumentType).
+		OneOf("document_type", req.DocumentType, []string{"national_id", "passport", "drivers_license", "voters_card"}).
+		Required("document_number", req.DocumentNumber).
+		MaxLength("document_number", req.DocumentNumber, 30).
+		SafeString("document_number", req.DocumentNumber).
+		Required("document_image_ref", req.DocumentImageRef).
+		Required("selfie_image_ref", req.SelfieImageRef)
+
+	if v.HasErrors() {
+		response.ValidationError(w, v.Errors().Errors)
+		return
+	}
+
+	result, err := h.kycHandler.HandleVerifyIDDocument(r.Context(), command.VerifyIDDocument{
+		UserID:           userID.String(),
+		DocumentType:     req.DocumentType,
+		DocumentNumber:   req.DocumentNumber,
+		DocumentImageRef: req.DocumentImageRef,
+		SelfieImageRef:   req.SelfieImageRef,
+	})
+	if err != nil {
+		writeKYCError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// VerifyAddress handles POST /api/me/kyc/address
+func (h *KYCHandler) VerifyAddress(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Street string `json:"street"`
+		City   string `json:"city"`
+		LGA    string `json:"lga"`
+		State  string `json:"state"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	v := validation.NewValidator()
+	v.Required("street", req.Street).
+		MaxLength("street", req.Street, 200).
+		SafeString("street", req.Street).
+		Required("city", req.City).
+		SafeString("city", req.City).
+		SafeString("lga", req.LGA).
+		Required("state", req.State).
+		SafeString("state", req.State)
+
+	if v.HasErrors() {
+		response.ValidationError(w, v.Errors().Errors)
+		return
+	}
+
+	result, err := h.kycHandler.HandleVerifyAddress(r.Context(), command.VerifyAddress{
+		UserID: userID.String(),
+		Street: req.Street,
+		City:   req.City,
+		LGA:    req.LGA,
+		State:  req.State,
+	})
+	if err != nil {
+		writeKYCError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// writeKYCError maps application errors to HTTP responses
+func writeKYCError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		response.NotFound(w, "user not found")
+	case errors.Is(err, aggregate.ErrAlreadyKYCVerified), errors.Is(err, service.ErrIDNumberInUse):
+		response.Conflict(w, err.Error())
+	case errors.Is(err, aggregate.ErrKYCLevelRequired),
+		errors.Is(err, aggregate.ErrKYCCheckNotSupported),
+		errors.Is(err, aggregate.ErrTooManyKYCAttempts),
+		errors.Is(err, service.ErrDateOfBirthRequired),
+		errors.Is(err, service.ErrIDNumberRequired),
+		errors.Is(err, service.ErrKYCDocumentRequired),
+		errors.Is(err, service.ErrKYCAddressRequired):
+		response.UnprocessableEntity(w, err.Error())
+	case errors.Is(err, service.ErrProviderUnavailable), errors.Is(err, service.ErrNoVerifier):
+		response.ServiceUnavailable(w, "identity verification is temporarily unavailable, try again later")
+	default:
+		response.BadRequest(w, "identity verification failed")
+	}
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"hustlex/internal/application/credit/command"
+	"hustlex/internal/application/credit/handler"
+	"hustlex/internal/application/credit/query"
+	"hustlex/internal/domain/credit/aggregate"
+	"hustlex/internal/infrastructure/security/audit"
+	"hustlex/internal/infrastructure/security/validation"
+	"hustlex/internal/interface/http/middleware"
+	"hustlex/internal/interface/http/response"
+)
+
+// UnderwritingHandler handles manual loan underwriting HTTP requests
+type UnderwritingHandler struct {
+	loanHandler  *handler.LoanHandler
+	queryHandler *query.CreditQueryHandler
+	auditLogger  audit.AuditLogger
+}
+
+// NewUnderwritingHandler creates a new underwriting HTTP handler
+func NewUnderwritingHandler(
+	loanHandler *handler.LoanHandler,
+	queryHandler *query.CreditQueryHandler,
+	auditLogger audit.AuditLogger,
+) *UnderwritingHandler {
+	return &UnderwritingHandler{
+		loanHandler:  loanHandler,
+		queryHandler: queryHandler,
+		auditLogger:  auditLogger,
+	}
+}
+
+// GetQueue handles GET /api/admin/loans/underwriting-queue
+func (h *UnderwritingHandler) GetQueue(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	result, err := h.queryHandler.HandleGetUnderwritingQueue(r.Context(), query.GetUnderwritingQueue{
+		Status: q.Get("status"),
+		Page:   parseIntQuery(q.Get("page"), 1),
+		Limit:  parseIntQuery(q.Get("limit"), 20),
+	})
+	if err != nil {
+		response.InternalError(w)
+		return
+	}
+
+	response.Paginated(w, result.Loans, result.Page, result.Limit, result.Total)
+}
+
+// Approve handles POST /api/admin/loans/{id}/approve
+func (h *UnderwritingHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	adminID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	v := validation.NewValidator()
+	v.Required("reason", req.Reason).
+		SafeString("reason", req.Reason)
+
+	if v.HasErrors() {
+		response.ValidationError(w, v.Errors().Errors)
+		return
+	}
+
+	loanID := r.PathValue("id")
+	result, err := h.loanHandler.HandleApproveLoan(r.Context(), command.ApproveLoan{
+		LoanID:  loanID,
+		AdminID: adminID.String(),
+		Reason:  req.Reason,
+	})
+
+	h.logDecision(r, adminID.String(), loanID, "approve", err, map[string]interface{}{
+		"reason": req.Reason,
+	})
+
+	if err != nil {
+		writeUnderwritingError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// Reject handles POST /api/admin/loans/{id}/reject
+func (h *UnderwritingHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	adminID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	v := validation.NewValidator()
+	v.Required("reason", req.Reason).
+		SafeString("reason", req.Reason)
+
+	if v.HasErrors() {
+		response.ValidationError(w, v.Errors().Errors)
+		return
+	}
+
+	loanID := r.PathValue("id")
+	err = h.loanHandler.HandleRejectLoan(r.Context(), command.RejectLoan{
+		LoanID:  loanID,
+		AdminID: adminID.String(),
+		Reason:  req.Reason,
+	})
+
+	h.logDecision(r, adminID.String(), loanID, "reject", err, map[string]interface{}{
+		"reason": req.Reason,
+	})
+
+	if err != nil {
+		writeUnderwritingError(w, err)
+		return
+	}
+
+	response.Success(w, map[string]string{"status": aggregate.LoanStatusRejected.String()})
+}
+
+// CounterOffer handles POST /api/admin/loans/{id}/counter-offer
+func (h *UnderwritingHandler) CounterOffer(w http.ResponseWriter, r *http.Request) {
+	adminID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Amount       int64  `json:"amount"`
+		TenureMonths int    `json:"tenure_months"`
+		Reason       string `json:"reason"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	v := validation.NewValidator()
+	v.Positive("amount", req.Amount).
+		Positive("tenure_months", int64(req.TenureMonths)).
+		Required("reason", req.Reason).
+		SafeString("reason", req.Reason)
+
+	if v.HasErrors() {
+		response.ValidationError(w, v.Errors().Errors)
+		return
+	}
+
+	loanID := r.PathValue("id")
+	result, err := h.loanHandler.HandleCounterOfferLoan(r.Context(), command.CounterOfferLoan{
+		LoanID:       loanID,
+		AdminID:      adminID.String(),
+		Amount:       req.Amount,
+		TenureMonths: req.TenureMonths,
+		Reason:       req.Reason,
+	})
+
+	h.logDecision(r, adminID.String(), loanID, "counter_offer", err, map[string]interface{}{
+		"amount":        req.Amount,
+		"tenure_months": req.TenureMonths,
+		"reason":        req.Reason,
+	})
+
+	if err != nil {
+		writeUnderwritingError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// RespondToCounterOffer handles POST /api/loans/{id}/counter-offer/respond
+func (h *UnderwritingHandler) RespondToCounterOffer(w http.ResponseWriter, r *http.Request) {
+	userID, err := middleware.GetUserID(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Accept bool `json:"accept"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, "invalid request body")
+		return
+	}
+
+	loanID := r.PathValue("id")
+	result, err := h.loanHandler.HandleRespondToCounterOffer(r.Context(), command.RespondToCounterOffer{
+		LoanID: loanID,
+		UserID: userID.String(),
+		Accept: req.Accept,
+	})
+
+	h.logDecision(r, userID.String(), loanID, "counter_offer_response", err, map[string]interface{}{
+		"accept": req.Accept,
+	})
+
+	if err != nil {
+		writeUnderwritingError(w, err)
+		return
+	}
+
+	response.Success(w, result)
+}
+
+// logDecision records an underwriting decision in the audit trail
+func (h *UnderwritingHandler) logDecision(r *http.Request, actorID, loanID, decision string, err error, metadata map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+
+	outcome := audit.OutcomeSuccess
+	message := "Loan underwriting decision recorded"
+	if err != nil {
+		outcome = audit.OutcomeFailure
+		message = "Loan underwriting decision failed"
+	}
+
+	metadata["decision"] = decision
+
+	h.auditLogger.LogDataChange(r.Context(), audit.AuditEvent{
+		EventAction:    audit.ActionUpdate,
+		EventOutcome:   outcome,
+		ActorUserID:    actorID,
+		ActorIPAddress: getClientIP(r),
+		ActorUserAgent: r.UserAgent(),
+		TargetType:     "loan",
+		TargetID:       loanID,
+		Message:        message,
+		Component:      "underwriting_handler",
+		Metadata:       metadata,
+	})
+}
+
+// writeUnderwritingError maps application errors to HTTP responses
+func writeUnderwritingError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, handler.ErrLoanNotFound):
+		response.NotFound(w, "loan not found")
+	case errors.Is(err, handler.ErrUnauthorized), errors.Is(err, aggregate.ErrSameApprover):
+		response.Forbidden(w, err.Error())
+	case errors.Is(err, aggregate.ErrInvalidCounterOffer),
+		errors.Is(err, aggregate.ErrNoCounterOffer),
+		errors.Is(err, aggregate.ErrDecisionReasonNeeded),
+		errors.Is(err, aggregate.ErrLoanExceedsLimit),
+		errors.Is(err, aggregate.ErrInvalidTenure),
+		errors.Is(err, aggregate.ErrBelowMinimumAmount):
+		response.UnprocessableEntity(w, err.Error())
+	default:
+		response.BadRequest(w, "underwriting action failed")
+	}
+}
@@ -21,11 +21,14 @@ type Config struct {
 	TxnRateLimiter   ratelimit.RateLimiter
 	OTPRateLimiter   ratelimit.RateLimiter
 	PINRateLimiter   ratelimit.RateLimiter
+	KYCRateLimiter   ratelimit.RateLimiter
 }
 
 // Handlers holds all HTTP handlers
 type Handlers struct {
 	Wallet       *handler.WalletHandler
+	Underwriting *handler.UnderwritingHandler
+	KYC          *handler.KYCHandler
 	// Auth         *handler.AuthHandler
 	// Gig          *handler.GigHandler
 	// Circle       *handler.CircleHandler
@@ -134,6 +137,19 @@ func (r *Router) setupAuthRoutes() {
 	r.mux.HandleFunc("GET /api/auth/me", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("PUT /api/auth/profile", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("PUT /api/auth/password", r.protectedHandler(notImplemented))
+
+	// KYC tiers - identity lookups are paid per call, so they are rate limited
+	if r.handlers.KYC != nil {
+		r.mux.HandleFunc("GET /api/me/kyc", r.protectedHandler(r.handlers.KYC.GetStatus))
+		r.mux.HandleFunc("POST /api/me/kyc/identity", r.rateLimitedProtectedHandler(r.config.KYCRateLimiter, r.handlers.KYC.VerifyIdentityNumber))
+		r.mux.HandleFunc("POST /api/me/kyc/id-document", r.rateLimitedProtectedHandler(r.config.KYCRateLimiter, r.handlers.KYC.VerifyIDDocument))
+		r.mux.HandleFunc("POST /api/me/kyc/address", r.rateLimitedProtectedHandler(r.config.KYCRateLimiter, r.handlers.KYC.VerifyAddress))
+	} else {
+		r.mux.HandleFunc("GET /api/me/kyc", r.protectedHandler(notImplemented))
+		r.mux.HandleFunc("POST /api/me/kyc/identity", r.protectedHandler(notImplemented))
+		r.mux.HandleFunc("POST /api/me/kyc/id-document", r.protectedHandler(notImplemented))
+		r.mux.HandleFunc("POST /api/me/kyc/address", r.protectedHandler(notImplemented))
+	}
 }
 
 // setupWalletRoutes configures wallet routes
@@ -169,6 +185,7 @@ func (r *Router) setupWalletRoutes() {
 func (r *Router) setupGigRoutes() {
 	// Public gig listing
 	r.mux.HandleFunc("GET /api/gigs", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/gigs/search", r.optionalAuthHandler(notImplemented))
 	r.mux.HandleFunc("GET /api/gigs/{id}", r.optionalAuthHandler(notImplemented))
 
 	// Protected gig routes
@@ -181,6 +198,39 @@ func (r *Router) setupGigRoutes() {
 	r.mux.HandleFunc("GET /api/gigs/{id}/proposals", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("POST /api/proposals/{id}/accept", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("POST /api/proposals/{id}/reject", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/proposals/{id}/label", r.protectedHandler(notImplemented))
+
+	// Invitations and shortlists
+	r.mux.HandleFunc("GET /api/invitations", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/gigs/{id}/visibility", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/gigs/{id}/invitations", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/gigs/{id}/invitations", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/gigs/{id}/invitations/accept", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/gigs/{id}/invitations/decline", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/gigs/{id}/shortlist/messages", r.protectedHandler(notImplemented))
+
+	// Service packages
+	r.mux.HandleFunc("GET /api/packages", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/packages/{id}", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/users/{id}/packages", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/packages", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/packages/{id}", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/packages/{id}/status", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/packages/{id}/orders", r.protectedHandler(notImplemented))
+
+	// Availability and on-site bookings
+	r.mux.HandleFunc("GET /api/users/{id}/availability", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/users/{id}/availability/slots", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/availability", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/availability/service-area", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/availability/blackouts", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("DELETE /api/availability/blackouts/{id}", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/bookings", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/bookings", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/bookings/{id}", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/bookings/{id}/reschedule", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/bookings/{id}/cancel", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/bookings/{id}/complete", r.protectedHandler(notImplemented))
 
 	// Contracts
 	r.mux.HandleFunc("GET /api/contracts", r.protectedHandler(notImplemented))
@@ -189,12 +239,47 @@ func (r *Router) setupGigRoutes() {
 	r.mux.HandleFunc("POST /api/contracts/{id}/accept", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("POST /api/contracts/{id}/dispute", r.protectedHandler(notImplemented))
 
+	// Contract milestones
+	r.mux.HandleFunc("POST /api/contracts/{id}/milestones/{milestoneId}/fund", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/contracts/{id}/milestones/{milestoneId}/deliver", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/contracts/{id}/milestones/{milestoneId}/approve", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/contracts/{id}/milestones/{milestoneId}/revisions", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/contracts/{id}/milestones/{milestoneId}/dispute", r.protectedHandler(notImplemented))
+
+	// Disputes
+	r.mux.HandleFunc("GET /api/contracts/{id}/disputes", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/disputes/{id}", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/disputes/{id}/evidence", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/disputes/{id}/evidence/conversation", r.protectedHandler(notImplemented))
+
+	// Conversations; the stream endpoint serves SSE and upgrades to WebSocket when asked
+	r.mux.HandleFunc("GET /api/conversations", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/conversations", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/conversations/stream", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/conversations/{id}/messages", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/conversations/{id}/messages", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/conversations/{id}/read", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/conversations/{id}/typing", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/conversations/{id}/export", r.protectedHandler(notImplemented))
+
 	// Reviews
 	r.mux.HandleFunc("POST /api/contracts/{id}/review", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("GET /api/users/{id}/reviews", r.publicHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/reviews/{id}/response", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/reviews/{id}/flags", r.protectedHandler(notImplemented))
+
+	// Skill tree, portfolios and the evidence behind skill badges
+	r.mux.HandleFunc("GET /api/skills/tree", r.publicHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/users/{id}/portfolio", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/me/portfolio", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/me/portfolio/{id}", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("DELETE /api/me/portfolio/{id}", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/users/{id}/skills/{skillId}/endorsements", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/me/skills/{skillId}/certificates", r.protectedHandler(notImplemented))
 
 	// My gigs and contracts
 	r.mux.HandleFunc("GET /api/me/gigs", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/me/recommended-gigs", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("GET /api/me/contracts", r.protectedHandler(notImplemented))
 }
 
@@ -216,9 +301,56 @@ func (r *Router) setupCircleRoutes() {
 	r.mux.HandleFunc("POST /api/circles/{id}/contribute", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("GET /api/circles/{id}/contributions", r.protectedHandler(notImplemented))
 
+	// Payout order, auction bids and position swaps
+	r.mux.HandleFunc("PUT /api/circles/{id}/payout-order", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/bids", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/position-swaps", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/position-swaps/{swapId}/respond", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/position-swaps/{swapId}/review", r.protectedHandler(notImplemented))
+
+	// Fixed-target progress and emergency funds
+	r.mux.HandleFunc("GET /api/circles/{id}/target", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/circles/{id}/fund", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/admins", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/withdrawals", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/withdrawals/{withdrawalId}/votes", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/withdrawals/{withdrawalId}/repayments", r.protectedHandler(notImplemented))
+
+	// Missed contributions, reserve and arrears
+	r.mux.HandleFunc("PUT /api/circles/{id}/missed-policy", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/reserve", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/arrears", r.protectedHandler(notImplemented))
+
+	// Contribution auto-debit mandate
+	r.mux.HandleFunc("PUT /api/circles/{id}/auto-debit", r.protectedHandler(notImplemented))
+
+	// Circle governance
+	r.mux.HandleFunc("GET /api/circles/{id}/governance", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/circles/{id}/governance", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/proposals", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/proposals/{proposalId}/votes", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/circles/{id}/activity", r.protectedHandler(notImplemented))
+
+	// Circle discovery and join requests
+	r.mux.HandleFunc("GET /api/circles/discover", r.optionalAuthHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/circles/{id}/listing", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/circles/{id}/join-requests", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/join-requests", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/circles/{id}/join-requests/{requestId}/review", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("DELETE /api/circles/{id}/join-requests/{requestId}", r.protectedHandler(notImplemented))
+
 	// My circles
 	r.mux.HandleFunc("GET /api/me/circles", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("GET /api/me/circles/stats", r.protectedHandler(notImplemented))
+
+	// Personal savings goals
+	r.mux.HandleFunc("GET /api/me/goals", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/me/goals", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/me/goals/{id}/deposits", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/me/goals/{id}/withdrawals", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("POST /api/me/goals/{id}/close", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("PUT /api/me/goals/{id}/auto-save", r.protectedHandler(notImplemented))
+	r.mux.HandleFunc("GET /api/me/interest-statement", r.protectedHandler(notImplemented))
 }
 
 // setupCreditRoutes configures credit and loan routes
@@ -233,6 +365,11 @@ func (r *Router) setupCreditRoutes() {
 	r.mux.HandleFunc("POST /api/loans/apply", r.protectedHandler(notImplemented))
 	r.mux.HandleFunc("POST /api/loans/{id}/repay", r.protectedHandler(notImplemented))
 
+	// Counter-offers from underwriting
+	if r.handlers.Underwriting != nil {
+		r.mux.HandleFunc("POST /api/loans/{id}/counter-offer/respond", r.protectedHandler(r.handlers.Underwriting.RespondToCounterOffer))
+	}
+
 	// Loan stats
 	r.mux.HandleFunc("GET /api/me/loan-stats", r.protectedHandler(notImplemented))
 }
@@ -273,17 +410,47 @@ func (r *Router) setupAdminRoutes() {
 	r.mux.HandleFunc("GET /api/admin/users/{id}", adminMiddleware(notImplemented))
 	r.mux.HandleFunc("PUT /api/admin/users/{id}/status", adminMiddleware(notImplemented))
 
+	// Underwriting decisions can be taken by admins or dedicated underwriters
+	underwriterMiddleware := func(h http.HandlerFunc) http.HandlerFunc {
+		return r.protectedHandler(middleware.RequireRoles("admin", "underwriter")(h).ServeHTTP)
+	}
+
 	// Loan management
 	r.mux.HandleFunc("GET /api/admin/loans", adminMiddleware(notImplemented))
 	r.mux.HandleFunc("GET /api/admin/loans/overdue", adminMiddleware(notImplemented))
-	r.mux.HandleFunc("POST /api/admin/loans/{id}/approve", adminMiddleware(notImplemented))
-	r.mux.HandleFunc("POST /api/admin/loans/{id}/reject", adminMiddleware(notImplemented))
+	if r.handlers.Underwriting != nil {
+		r.mux.HandleFunc("GET /api/admin/loans/underwriting-queue", underwriterMiddleware(r.handlers.Underwriting.GetQueue))
+		r.mux.HandleFunc("POST /api/admin/loans/{id}/approve", underwriterMiddleware(r.handlers.Underwriting.Approve))
+		r.mux.HandleFunc("POST /api/admin/loans/{id}/reject", underwriterMiddleware(r.handlers.Underwriting.Reject))
+		r.mux.HandleFunc("POST /api/admin/loans/{id}/counter-offer", underwriterMiddleware(r.handlers.Underwriting.CounterOffer))
+	} else {
+		r.mux.HandleFunc("POST /api/admin/loans/{id}/approve", underwriterMiddleware(notImplemented))
+		r.mux.HandleFunc("POST /api/admin/loans/{id}/reject", underwriterMiddleware(notImplemented))
+	}
 	r.mux.HandleFunc("POST /api/admin/loans/{id}/disburse", adminMiddleware(notImplemented))
 	r.mux.HandleFunc("POST /api/admin/loans/{id}/default", adminMiddleware(notImplemented))
 
 	// Circle management
 	r.mux.HandleFunc("GET /api/admin/circles", adminMiddleware(notImplemented))
 
+	// Gig disputes are assigned by admins and decided by the assigned mediator
+	mediatorMiddleware := func(h http.HandlerFunc) http.HandlerFunc {
+		return r.protectedHandler(middleware.RequireRoles("admin", "mediator")(h).ServeHTTP)
+	}
+	r.mux.HandleFunc("GET /api/admin/disputes", mediatorMiddleware(notImplemented))
+	r.mux.HandleFunc("POST /api/admin/disputes/{id}/mediator", adminMiddleware(notImplemented))
+	r.mux.HandleFunc("POST /api/admin/disputes/{id}/response-requests", mediatorMiddleware(notImplemented))
+	r.mux.HandleFunc("POST /api/admin/disputes/{id}/resolve", mediatorMiddleware(notImplemented))
+
+	// Flagged and held gig reviews
+	r.mux.HandleFunc("GET /api/admin/reviews/moderation", adminMiddleware(notImplemented))
+	r.mux.HandleFunc("POST /api/admin/reviews/{id}/moderate", adminMiddleware(notImplemented))
+
+	// Skill catalog and certificate review
+	r.mux.HandleFunc("POST /api/admin/skills", adminMiddleware(notImplemented))
+	r.mux.HandleFunc("GET /api/admin/skill-certificates", adminMiddleware(notImplemented))
+	r.mux.HandleFunc("POST /api/admin/skill-certificates/{id}/review", adminMiddleware(notImplemented))
+
 	// Statistics
 	r.mux.HandleFunc("GET /api/admin/stats/overview", adminMiddleware(notImplemented))
 	r.mux.HandleFunc("GET /api/admin/stats/loans", adminMiddleware(notImplemented))
@@ -5,6 +5,7 @@ package jobs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -23,12 +24,19 @@ const (
 	TypeSavingsProcessContribution  = "savings:process_contribution"
 	TypeSavingsProcessPayout        = "savings:process_payout"
 	TypeSavingsCircleMatured        = "savings:circle_matured"
+	TypeSavingsMissedContributions  = "savings:missed_contributions"
+	TypeSavingsReleaseHeldPayouts   = "savings:release_held_payouts"
+	TypeSavingsAutoDebit            = "savings:auto_debit"
+	TypeSavingsGoalAutoSave         = "savings:goal_auto_save"
+	TypeSavingsInterestAccrual      = "savings:interest_accrual"
+	TypeSavingsCloseProposals       = "savings:close_proposals"
 
 	// Loan Tasks
 	TypeLoanPaymentReminder   = "loan:payment_reminder"
 	TypeLoanProcessRepayment  = "loan:process_repayment"
 	TypeLoanCheckDefault      = "loan:check_default"
 	TypeLoanUpdateCreditScore = "loan:update_credit_score"
+	TypeLoanBureauReport      = "loan:bureau_report"
 
 	// Notification Tasks
 	TypeNotificationPush  = "notification:push"
@@ -41,6 +49,10 @@ const (
 	TypeGigContractAutoComplete  = "gig:contract_auto_complete"
 	TypeGigEscrowRelease         = "gig:escrow_release"
 	TypeGigReviewReminder        = "gig:review_reminder"
+	TypeGigResolveDisputes       = "gig:resolve_disputes"
+	TypeGigBookingReminder       = "gig:booking_reminder"
+	TypeGigRevealReviews         = "gig:reveal_reviews"
+	TypeGigAutoApproveOverdue    = "gig:auto_approve_overdue"
 
 	// User Tasks
 	TypeUserCreditScoreRecalc = "user:credit_score_recalc"
@@ -101,6 +113,11 @@ type LoanCheckDefaultPayload struct {
 	OutstandingBal int64    `json:"outstanding_balance"`
 }
 
+// LoanBureauReportPayload for the monthly credit bureau submission
+type LoanBureauReportPayload struct {
+	Period string `json:"period,omitempty"` // YYYY-MM; defaults to the previous month
+}
+
 // NotificationPushPayload for push notifications
 type NotificationPushPayload struct {
 	UserID      string            `json:"user_id"`
@@ -154,6 +171,25 @@ type GigEscrowReleasePayload struct {
 	Reference    string `json:"reference"`
 }
 
+// GigReviewReminderPayload for reminding both parties of a client's review deadline
+type GigReviewReminderPayload struct {
+	ContractID  string    `json:"contract_id"`
+	MilestoneID string    `json:"milestone_id"`
+	DueAt       time.Time `json:"due_at"`
+}
+
+// GigBookingReminderPayload for reminding both parties of an upcoming on-site visit
+type GigBookingReminderPayload struct {
+	BookingID string    `json:"booking_id"`
+	StartsAt  time.Time `json:"starts_at"`
+}
+
+// GigContractAutoCompletePayload for approving delivered work the client left unreviewed
+type GigContractAutoCompletePayload struct {
+	ContractID  string `json:"contract_id"`
+	MilestoneID string `json:"milestone_id"`
+}
+
 // UserCreditScoreRecalcPayload for credit score recalculation
 type UserCreditScoreRecalcPayload struct {
 	UserID  string `json:"user_id"`
@@ -209,6 +245,15 @@ func NewLoanCheckDefaultTask(payload LoanCheckDefaultPayload) (*asynq.Task, erro
 	return asynq.NewTask(TypeLoanCheckDefault, data, asynq.MaxRetry(3), asynq.Queue("critical")), nil
 }
 
+// NewLoanBureauReportTask creates a credit bureau reporting task
+func NewLoanBureauReportTask(payload LoanBureauReportPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return asynq.NewTask(TypeLoanBureauReport, data, asynq.MaxRetry(5)), nil
+}
+
 // NewNotificationPushTask creates a push notification task
 func NewNotificationPushTask(payload NotificationPushPayload) (*asynq.Task, error) {
 	data, err := json.Marshal(payload)
@@ -254,6 +299,33 @@ func NewGigDeadlineReminderTask(payload GigDeadlineReminderPayload) (*asynq.Task
 	return asynq.NewTask(TypeGigDeadlineReminder, data, asynq.MaxRetry(3)), nil
 }
 
+// NewGigReviewReminderTask creates a review deadline reminder task
+func NewGigReviewReminderTask(payload GigReviewReminderPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeGigReviewReminder, data, asynq.MaxRetry(3)), nil
+}
+
+// NewGigBookingReminderTask creates an on-site visit reminder task
+func NewGigBookingReminderTask(payload GigBookingReminderPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeGigBookingReminder, data, asynq.MaxRetry(3)), nil
+}
+
+// NewGigContractAutoCompleteTask creates a task that auto-approves an unreviewed delivery
+func NewGigContractAutoCompleteTask(payload GigContractAutoCompletePayload) (*asynq.Task, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeGigContractAutoComplete, data, asynq.MaxRetry(5), asynq.Queue("critical")), nil
+}
+
 // NewGigEscrowReleaseTask creates an escrow release task
 func NewGigEscrowReleaseTask(payload GigEscrowReleasePayload) (*asynq.Task, error) {
 	data, err := json.Marshal(payload)
@@ -276,10 +348,99 @@ func NewUserCreditScoreRecalcTask(payload UserCreditScoreRecalcPayload) (*asynq.
 // Task Handler
 // =============================================================================
 
+// BureauReporter builds and submits the monthly credit bureau files.
+// The credit application's BureauHandler satisfies this interface.
+type BureauReporter interface {
+	ReportPeriod(ctx context.Context, period time.Time) error
+}
+
+// CircleMaturer distributes fixed-target savings circles that reached their target date.
+// The savings application's FundHandler satisfies this interface.
+type CircleMaturer interface {
+	MatureDueCircles(ctx context.Context, asOf time.Time) error
+}
+
+// MissedContributionProcessor marks contributions missed once their grace period ends.
+// The savings application's DefaultHandler satisfies this interface.
+type MissedContributionProcessor interface {
+	ProcessMissedContributions(ctx context.Context, asOf time.Time) error
+}
+
+// CirclePayoutProcessor disburses circle payouts into recipients' wallets.
+// The savings application's PayoutHandler satisfies this interface.
+type CirclePayoutProcessor interface {
+	ProcessPayout(ctx context.Context, circleID, userID string, round int, amount int64) error
+	ReleaseHeldPayouts(ctx context.Context) error
+}
+
+// AutoDebitProcessor takes mandated contributions from members' wallets.
+// The savings application's AutoDebitHandler satisfies this interface.
+type AutoDebitProcessor interface {
+	ProcessAutoDebits(ctx context.Context, asOf time.Time) error
+	ProcessContribution(ctx context.Context, circleID, userID string) error
+}
+
+// GoalAutoSaver runs scheduled auto-saves into savings goals.
+// The savings application's GoalHandler satisfies this interface.
+type GoalAutoSaver interface {
+	ProcessAutoSaves(ctx context.Context, asOf time.Time) error
+}
+
+// InterestAccruer accrues and capitalizes interest on savings goals.
+// The savings application's InterestHandler satisfies this interface.
+type InterestAccruer interface {
+	AccrueDaily(ctx context.Context, asOf time.Time) error
+}
+
+// ProposalCloser decides circle proposals whose voting period has ended.
+// The savings application's GovernanceHandler satisfies this interface.
+type ProposalCloser interface {
+	CloseExpiredProposals(ctx context.Context, asOf time.Time) error
+}
+
+// DisputeResolver decides gig disputes whose response deadline has passed.
+// The gig application's DisputeHandler satisfies this interface.
+type DisputeResolver interface {
+	ResolveOverdueDisputes(ctx context.Context, asOf time.Time) error
+}
+
+// ReviewRevealer reveals gig reviews whose blind window has closed.
+// The gig application's ReviewModerationHandler satisfies this interface.
+type ReviewRevealer interface {
+	RevealDueReviews(ctx context.Context, asOf time.Time) error
+}
+
+// ReviewDeadlineProcessor reminds parties of review deadlines and auto-approves
+// delivered work once the client's review window closes.
+// The gig application's ReviewDeadlineHandler satisfies this interface.
+type ReviewDeadlineProcessor interface {
+	SendReviewReminder(ctx context.Context, contractID, milestoneID string, dueAt time.Time) error
+	AutoApproveMilestone(ctx context.Context, contractID, milestoneID string) error
+	AutoApproveOverdueMilestones(ctx context.Context, asOf time.Time) error
+}
+
+// BookingReminderProcessor reminds both parties of an upcoming on-site visit.
+// The gig application's BookingReminderHandler satisfies this interface.
+type BookingReminderProcessor interface {
+	SendBookingReminder(ctx context.Context, bookingID string, startsAt time.Time) error
+}
+
 // TaskHandler processes background tasks
 type TaskHandler struct {
-	db     *gorm.DB
-	client *asynq.Client
+	db                 *gorm.DB
+	client             *asynq.Client
+	bureauReporter     BureauReporter
+	circleMaturer      CircleMaturer
+	missedContribution MissedContributionProcessor
+	payoutProcessor    CirclePayoutProcessor
+	autoDebit          AutoDebitProcessor
+	goalAutoSaver      GoalAutoSaver
+	interestAccruer    InterestAccruer
+	proposalCloser     ProposalCloser
+	disputeResolver    DisputeResolver
+	reviewRevealer     ReviewRevealer
+	reviewDeadline     ReviewDeadlineProcessor
+	bookingReminder    BookingReminderProcessor
 	// Add service dependencies
 }
 
@@ -314,6 +475,73 @@ func (h *TaskHandler) EnqueueTaskIn(ctx context.Context, task *asynq.Task, delay
 	return h.client.EnqueueContext(ctx, task, opts...)
 }
 
+// ScheduleReviewDeadline queues the reminders and the auto-approval for a delivered
+// milestone. Task IDs are derived from the deadline, so scheduling the same delivery
+// twice queues nothing new. It satisfies the gig application's ReviewScheduler.
+func (h *TaskHandler) ScheduleReviewDeadline(ctx context.Context, contractID, milestoneID string, dueAt time.Time, reminders []time.Time) error {
+	key := fmt.Sprintf("%s:%s:%d", contractID, milestoneID, dueAt.Unix())
+	now := time.Now()
+
+	for i, remindAt := range reminders {
+		if remindAt.Before(now) {
+			continue
+		}
+		task, err := NewGigReviewReminderTask(GigReviewReminderPayload{
+			ContractID:  contractID,
+			MilestoneID: milestoneID,
+			DueAt:       dueAt,
+		})
+		if err != nil {
+			return err
+		}
+		taskID := asynq.TaskID(fmt.Sprintf("%s:%s:%d", TypeGigReviewReminder, key, i))
+		if _, err := h.EnqueueTaskAt(ctx, task, remindAt, taskID); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+
+	task, err := NewGigContractAutoCompleteTask(GigContractAutoCompletePayload{
+		ContractID:  contractID,
+		MilestoneID: milestoneID,
+	})
+	if err != nil {
+		return err
+	}
+	taskID := asynq.TaskID(fmt.Sprintf("%s:%s", TypeGigContractAutoComplete, key))
+	if _, err := h.EnqueueTaskAt(ctx, task, dueAt, taskID); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return err
+	}
+
+	return nil
+}
+
+// ScheduleBookingReminders queues the reminders for a booked on-site visit. Task IDs
+// are derived from the slot, so a moved booking gets fresh reminders while the old
+// ones find the slot changed and send nothing. It satisfies the gig application's
+// BookingScheduler.
+func (h *TaskHandler) ScheduleBookingReminders(ctx context.Context, bookingID string, startsAt time.Time, reminders []time.Time) error {
+	now := time.Now()
+
+	for i, remindAt := range reminders {
+		if remindAt.Before(now) {
+			continue
+		}
+		task, err := NewGigBookingReminderTask(GigBookingReminderPayload{
+			BookingID: bookingID,
+			StartsAt:  startsAt,
+		})
+		if err != nil {
+			return err
+		}
+		taskID := asynq.TaskID(fmt.Sprintf("%s:%s:%d:%d", TypeGigBookingReminder, bookingID, startsAt.Unix(), i))
+		if _, err := h.EnqueueTaskAt(ctx, task, remindAt, taskID); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // =============================================================================
 // Task Processors
 // =============================================================================
@@ -361,198 +589,125 @@ func (h *TaskHandler) HandleSavingsContributionReminder(ctx context.Context, t *
 	return nil
 }
 
-// HandleSavingsProcessContribution processes automatic contributions
+// HandleSavingsProcessContribution takes a member's mandated contribution from their wallet
 func (h *TaskHandler) HandleSavingsProcessContribution(ctx context.Context, t *asynq.Task) error {
 	var payload SavingsProcessContributionPayload
 	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
 		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
+	if h.autoDebit == nil {
+		return fmt.Errorf("auto-debit processor not configured: %w", asynq.SkipRetry)
+	}
+
 	log.Printf("[SAVINGS] Processing auto-contribution for user %s in circle %s (₦%.2f)",
 		payload.UserID, payload.CircleID, float64(payload.Amount)/100)
 
-	// Start transaction
-	tx := h.db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// 1. Check user's wallet balance
-	var wallet struct {
-		ID      string
-		Balance int64
-	}
-	if err := tx.Table("wallets").Where("user_id = ?", payload.UserID).First(&wallet).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("wallet not found: %w", err)
-	}
-
-	if wallet.Balance < payload.Amount {
-		tx.Rollback()
-		// Notify user of insufficient funds
-		pushPayload := NotificationPushPayload{
-			UserID: payload.UserID,
-			Title:  "Auto-Save Failed",
-			Body:   "Your auto-contribution couldn't be processed due to insufficient funds.",
-			Data: map[string]string{
-				"type":      "auto_save_failed",
-				"circle_id": payload.CircleID,
-			},
-		}
-		pushTask, _ := NewNotificationPushTask(pushPayload)
-		h.EnqueueTask(ctx, pushTask)
-		return fmt.Errorf("insufficient balance: have %d, need %d", wallet.Balance, payload.Amount)
-	}
-
-	// 2. Deduct from wallet
-	if err := tx.Table("wallets").Where("id = ?", wallet.ID).
-		Update("balance", gorm.Expr("balance - ?", payload.Amount)).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to deduct from wallet: %w", err)
-	}
-
-	// 3. Create wallet transaction
-	walletTx := map[string]interface{}{
-		"id":             generateUUID(),
-		"wallet_id":      wallet.ID,
-		"type":           "savings_contribution",
-		"amount":         -payload.Amount,
-		"balance_before": wallet.Balance,
-		"balance_after":  wallet.Balance - payload.Amount,
-		"reference":      payload.Reference,
-		"status":         "completed",
-		"description":    "Auto-contribution to savings circle",
-		"created_at":     time.Now(),
-	}
-	if err := tx.Table("wallet_transactions").Create(walletTx).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to create transaction: %w", err)
-	}
-
-	// 4. Record contribution
-	contribution := map[string]interface{}{
-		"id":        generateUUID(),
-		"circle_id": payload.CircleID,
-		"user_id":   payload.UserID,
-		"amount":    payload.Amount,
-		"reference": payload.Reference,
-		"status":    "completed",
-		"paid_at":   time.Now(),
-		"created_at": time.Now(),
+	if err := h.autoDebit.ProcessContribution(ctx, payload.CircleID, payload.UserID); err != nil {
+		return fmt.Errorf("failed to process auto-contribution: %w", err)
 	}
-	if err := tx.Table("contributions").Create(contribution).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to record contribution: %w", err)
+
+	log.Printf("[SAVINGS] Auto-contribution completed successfully")
+	return nil
+}
+
+// HandleSavingsAutoDebit collects every mandated contribution that is due.
+// Failed debits are retried on later runs until the grace period ends.
+func (h *TaskHandler) HandleSavingsAutoDebit(ctx context.Context, t *asynq.Task) error {
+	if h.autoDebit == nil {
+		return fmt.Errorf("auto-debit processor not configured: %w", asynq.SkipRetry)
 	}
 
-	// 5. Update circle total collected
-	if err := tx.Table("savings_circles").Where("id = ?", payload.CircleID).
-		Update("total_collected", gorm.Expr("total_collected + ?", payload.Amount)).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update circle total: %w", err)
+	log.Printf("[SAVINGS] Processing contribution auto-debits")
+
+	if err := h.autoDebit.ProcessAutoDebits(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to process auto-debits: %w", err)
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	return nil
+}
+
+// HandleSavingsGoalAutoSave runs scheduled auto-saves into savings goals
+func (h *TaskHandler) HandleSavingsGoalAutoSave(ctx context.Context, t *asynq.Task) error {
+	if h.goalAutoSaver == nil {
+		return fmt.Errorf("goal auto-saver not configured: %w", asynq.SkipRetry)
 	}
 
-	// 6. Notify user of successful contribution
-	pushPayload := NotificationPushPayload{
-		UserID: payload.UserID,
-		Title:  "Auto-Save Successful",
-		Body:   fmt.Sprintf("₦%.2f has been saved to your circle!", float64(payload.Amount)/100),
-		Data: map[string]string{
-			"type":      "auto_save_success",
-			"circle_id": payload.CircleID,
-		},
+	log.Printf("[SAVINGS] Running savings goal auto-saves")
+
+	if err := h.goalAutoSaver.ProcessAutoSaves(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to run goal auto-saves: %w", err)
 	}
-	pushTask, _ := NewNotificationPushTask(pushPayload)
-	h.EnqueueTask(ctx, pushTask)
 
-	log.Printf("[SAVINGS] Auto-contribution completed successfully")
 	return nil
 }
 
-// HandleSavingsProcessPayout processes rotational payouts
-func (h *TaskHandler) HandleSavingsProcessPayout(ctx context.Context, t *asynq.Task) error {
-	var payload SavingsProcessPayoutPayload
-	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
-		return fmt.Errorf("failed to unmarshal payload: %w", err)
+// HandleSavingsInterestAccrual accrues interest on savings goals for the day that just ended
+func (h *TaskHandler) HandleSavingsInterestAccrual(ctx context.Context, t *asynq.Task) error {
+	if h.interestAccruer == nil {
+		return fmt.Errorf("interest accruer not configured: %w", asynq.SkipRetry)
 	}
 
-	log.Printf("[SAVINGS] Processing payout for user %s from circle %s (₦%.2f)",
-		payload.RecipientID, payload.CircleID, float64(payload.Amount)/100)
+	day := time.Now().UTC().AddDate(0, 0, -1)
+	log.Printf("[SAVINGS] Accruing savings interest for %s", day.Format("2006-01-02"))
 
-	tx := h.db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// 1. Get recipient's wallet
-	var wallet struct {
-		ID      string
-		Balance int64
+	if err := h.interestAccruer.AccrueDaily(ctx, day); err != nil {
+		return fmt.Errorf("failed to accrue savings interest: %w", err)
 	}
-	if err := tx.Table("wallets").Where("user_id = ?", payload.RecipientID).First(&wallet).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("recipient wallet not found: %w", err)
+
+	return nil
+}
+
+// HandleSavingsCloseProposals decides circle proposals whose voting period has ended
+func (h *TaskHandler) HandleSavingsCloseProposals(ctx context.Context, t *asynq.Task) error {
+	if h.proposalCloser == nil {
+		return fmt.Errorf("proposal closer not configured: %w", asynq.SkipRetry)
 	}
 
-	// 2. Credit recipient's wallet
-	if err := tx.Table("wallets").Where("id = ?", wallet.ID).
-		Update("balance", gorm.Expr("balance + ?", payload.Amount)).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to credit wallet: %w", err)
+	log.Printf("[SAVINGS] Closing expired circle proposals")
+
+	if err := h.proposalCloser.CloseExpiredProposals(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to close expired proposals: %w", err)
 	}
 
-	// 3. Create wallet transaction
-	reference := fmt.Sprintf("PAYOUT-%s-%d", payload.CircleID[:8], payload.PayoutRound)
-	walletTx := map[string]interface{}{
-		"id":             generateUUID(),
-		"wallet_id":      wallet.ID,
-		"type":           "savings_payout",
-		"amount":         payload.Amount,
-		"balance_before": wallet.Balance,
-		"balance_after":  wallet.Balance + payload.Amount,
-		"reference":      reference,
-		"status":         "completed",
-		"description":    fmt.Sprintf("Savings circle payout (Round %d)", payload.PayoutRound),
-		"created_at":     time.Now(),
+	return nil
+}
+
+// HandleSavingsProcessPayout disburses a circle payout into the recipient's wallet.
+// Disbursement is idempotent per circle, round and member, so retries never pay twice.
+func (h *TaskHandler) HandleSavingsProcessPayout(ctx context.Context, t *asynq.Task) error {
+	var payload SavingsProcessPayoutPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
-	if err := tx.Table("wallet_transactions").Create(walletTx).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to create transaction: %w", err)
+
+	if h.payoutProcessor == nil {
+		return fmt.Errorf("payout processor not configured: %w", asynq.SkipRetry)
 	}
 
-	// 4. Update circle current round
-	if err := tx.Table("savings_circles").Where("id = ?", payload.CircleID).
-		Update("current_round", payload.PayoutRound).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update circle round: %w", err)
+	log.Printf("[SAVINGS] Processing payout for user %s from circle %s (₦%.2f)",
+		payload.RecipientID, payload.CircleID, float64(payload.Amount)/100)
+
+	if err := h.payoutProcessor.ProcessPayout(ctx, payload.CircleID, payload.RecipientID, payload.PayoutRound, payload.Amount); err != nil {
+		return fmt.Errorf("failed to process payout: %w", err)
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	log.Printf("[SAVINGS] Payout completed successfully")
+	return nil
+}
+
+// HandleSavingsReleaseHeldPayouts retries payouts held while the recipient's wallet was locked
+func (h *TaskHandler) HandleSavingsReleaseHeldPayouts(ctx context.Context, t *asynq.Task) error {
+	if h.payoutProcessor == nil {
+		return fmt.Errorf("payout processor not configured: %w", asynq.SkipRetry)
 	}
 
-	// 5. Notify recipient
-	pushPayload := NotificationPushPayload{
-		UserID: payload.RecipientID,
-		Title:  "🎉 Payout Received!",
-		Body:   fmt.Sprintf("You've received ₦%.2f from your savings circle!", float64(payload.Amount)/100),
-		Data: map[string]string{
-			"type":      "payout_received",
-			"circle_id": payload.CircleID,
-		},
+	log.Printf("[SAVINGS] Releasing held payouts")
+
+	if err := h.payoutProcessor.ReleaseHeldPayouts(ctx); err != nil {
+		return fmt.Errorf("failed to release held payouts: %w", err)
 	}
-	pushTask, _ := NewNotificationPushTask(pushPayload)
-	h.EnqueueTask(ctx, pushTask)
 
-	log.Printf("[SAVINGS] Payout completed successfully")
 	return nil
 }
 
@@ -661,6 +816,71 @@ func (h *TaskHandler) HandleLoanCheckDefault(ctx context.Context, t *asynq.Task)
 	return nil
 }
 
+// HandleSavingsCircleMatured pays out fixed-target circles whose target date has passed
+func (h *TaskHandler) HandleSavingsCircleMatured(ctx context.Context, t *asynq.Task) error {
+	if h.circleMaturer == nil {
+		return fmt.Errorf("circle maturer not configured: %w", asynq.SkipRetry)
+	}
+
+	log.Printf("[SAVINGS] Maturing fixed-target circles")
+
+	if err := h.circleMaturer.MatureDueCircles(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to mature circles: %w", err)
+	}
+
+	return nil
+}
+
+// HandleSavingsMissedContributions applies each circle's missed contribution policy
+// to contributions still unpaid after their grace period
+func (h *TaskHandler) HandleSavingsMissedContributions(ctx context.Context, t *asynq.Task) error {
+	if h.missedContribution == nil {
+		return fmt.Errorf("missed contribution processor not configured: %w", asynq.SkipRetry)
+	}
+
+	log.Printf("[SAVINGS] Processing missed contributions")
+
+	if err := h.missedContribution.ProcessMissedContributions(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to process missed contributions: %w", err)
+	}
+
+	return nil
+}
+
+// HandleLoanBureauReport generates and submits the monthly credit bureau files
+func (h *TaskHandler) HandleLoanBureauReport(ctx context.Context, t *asynq.Task) error {
+	var payload LoanBureauReportPayload
+	if len(t.Payload()) > 0 {
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+	}
+
+	if h.bureauReporter == nil {
+		return fmt.Errorf("bureau reporter not configured: %w", asynq.SkipRetry)
+	}
+
+	// Report the month that has just closed unless a specific month was requested
+	now := time.Now().UTC()
+	period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+	if payload.Period != "" {
+		parsed, err := time.Parse("2006-01", payload.Period)
+		if err != nil {
+			return fmt.Errorf("invalid reporting period %q: %w", payload.Period, asynq.SkipRetry)
+		}
+		period = parsed
+	}
+
+	log.Printf("[LOAN] Generating credit bureau files for %s", period.Format("2006-01"))
+
+	if err := h.bureauReporter.ReportPeriod(ctx, period); err != nil {
+		return fmt.Errorf("failed to report to credit bureaus: %w", err)
+	}
+
+	log.Printf("[LOAN] Credit bureau files for %s submitted", period.Format("2006-01"))
+	return nil
+}
+
 // HandleNotificationPush sends push notifications
 func (h *TaskHandler) HandleNotificationPush(ctx context.Context, t *asynq.Task) error {
 	var payload NotificationPushPayload
@@ -740,92 +960,136 @@ func (h *TaskHandler) HandleNotificationEmail(ctx context.Context, t *asynq.Task
 	return nil
 }
 
-// HandleGigEscrowRelease releases escrow funds to freelancer
+// HandleGigEscrowRelease releases a delivered contract's escrow once the client's review
+// window has closed. Escrow moves through the gig application, which charges the
+// milestone's own platform fee; tasks queued before review deadlines existed drain here.
 func (h *TaskHandler) HandleGigEscrowRelease(ctx context.Context, t *asynq.Task) error {
 	var payload GigEscrowReleasePayload
 	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
 		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
-	log.Printf("[GIG] Releasing escrow for contract %s to freelancer %s (₦%.2f)",
-		payload.ContractID, payload.FreelancerID, float64(payload.Amount)/100)
+	if h.reviewDeadline == nil {
+		return fmt.Errorf("review deadline processor not configured: %w", asynq.SkipRetry)
+	}
 
-	tx := h.db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
+	log.Printf("[GIG] Releasing escrow for contract %s to freelancer %s", payload.ContractID, payload.FreelancerID)
 
-	// 1. Get freelancer's wallet
-	var wallet struct {
-		ID            string
-		Balance       int64
-		EscrowBalance int64
+	if err := h.reviewDeadline.AutoApproveMilestone(ctx, payload.ContractID, ""); err != nil {
+		return fmt.Errorf("failed to release escrow: %w", err)
 	}
-	if err := tx.Table("wallets").Where("user_id = ?", payload.FreelancerID).First(&wallet).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("wallet not found: %w", err)
+
+	return nil
+}
+
+// HandleGigReviewReminder reminds both parties that delivered work will soon be approved
+func (h *TaskHandler) HandleGigReviewReminder(ctx context.Context, t *asynq.Task) error {
+	var payload GigReviewReminderPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
-	// Calculate platform fee (10%)
-	platformFee := payload.Amount / 10
-	netAmount := payload.Amount - platformFee
+	if h.reviewDeadline == nil {
+		return fmt.Errorf("review deadline processor not configured: %w", asynq.SkipRetry)
+	}
 
-	// 2. Credit freelancer's main balance
-	if err := tx.Table("wallets").Where("id = ?", wallet.ID).
-		Updates(map[string]interface{}{
-			"balance":        gorm.Expr("balance + ?", netAmount),
-			"escrow_balance": gorm.Expr("escrow_balance - ?", payload.Amount),
-		}).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update wallet: %w", err)
+	log.Printf("[GIG] Sending review reminder for milestone %s on contract %s (due %s)",
+		payload.MilestoneID, payload.ContractID, payload.DueAt.Format(time.RFC3339))
+
+	if err := h.reviewDeadline.SendReviewReminder(ctx, payload.ContractID, payload.MilestoneID, payload.DueAt); err != nil {
+		return fmt.Errorf("failed to send review reminder: %w", err)
 	}
 
-	// 3. Create wallet transaction
-	walletTx := map[string]interface{}{
-		"id":             generateUUID(),
-		"wallet_id":      wallet.ID,
-		"type":           "escrow_release",
-		"amount":         netAmount,
-		"balance_before": wallet.Balance,
-		"balance_after":  wallet.Balance + netAmount,
-		"reference":      payload.Reference,
-		"status":         "completed",
-		"description":    fmt.Sprintf("Escrow release (Fee: ₦%.2f)", float64(platformFee)/100),
-		"metadata":       map[string]interface{}{"platform_fee": platformFee, "contract_id": payload.ContractID},
-		"created_at":     time.Now(),
+	return nil
+}
+
+// HandleGigContractAutoComplete approves delivered work the client did not review in time
+// and releases its escrow to the hustler
+func (h *TaskHandler) HandleGigContractAutoComplete(ctx context.Context, t *asynq.Task) error {
+	var payload GigContractAutoCompletePayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
-	if err := tx.Table("wallet_transactions").Create(walletTx).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to create transaction: %w", err)
+
+	if h.reviewDeadline == nil {
+		return fmt.Errorf("review deadline processor not configured: %w", asynq.SkipRetry)
 	}
 
-	// 4. Update contract status
-	if err := tx.Table("contracts").Where("id = ?", payload.ContractID).
-		Update("payment_released", true).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update contract: %w", err)
+	log.Printf("[GIG] Auto-approving milestone %s on contract %s", payload.MilestoneID, payload.ContractID)
+
+	if err := h.reviewDeadline.AutoApproveMilestone(ctx, payload.ContractID, payload.MilestoneID); err != nil {
+		return fmt.Errorf("failed to auto-approve milestone: %w", err)
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	return nil
+}
+
+// HandleGigBookingReminder reminds both parties that an on-site visit is coming up
+func (h *TaskHandler) HandleGigBookingReminder(ctx context.Context, t *asynq.Task) error {
+	var payload GigBookingReminderPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
-	// 5. Notify freelancer
-	pushPayload := NotificationPushPayload{
-		UserID: payload.FreelancerID,
-		Title:  "💰 Payment Received!",
-		Body:   fmt.Sprintf("₦%.2f has been released to your wallet!", float64(netAmount)/100),
-		Data: map[string]string{
-			"type":        "payment_received",
-			"contract_id": payload.ContractID,
-		},
+	if h.bookingReminder == nil {
+		return fmt.Errorf("booking reminder processor not configured: %w", asynq.SkipRetry)
+	}
+
+	log.Printf("[GIG] Sending booking reminder for booking %s (starts %s)",
+		payload.BookingID, payload.StartsAt.Format(time.RFC3339))
+
+	if err := h.bookingReminder.SendBookingReminder(ctx, payload.BookingID, payload.StartsAt); err != nil {
+		return fmt.Errorf("failed to send booking reminder: %w", err)
+	}
+
+	return nil
+}
+
+// HandleGigResolveDisputes decides disputes in favour of the party that responded
+// when the other side has missed its deadline
+func (h *TaskHandler) HandleGigResolveDisputes(ctx context.Context, t *asynq.Task) error {
+	if h.disputeResolver == nil {
+		return fmt.Errorf("dispute resolver not configured: %w", asynq.SkipRetry)
+	}
+
+	log.Printf("[GIG] Resolving disputes past their response deadline")
+
+	if err := h.disputeResolver.ResolveOverdueDisputes(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to resolve overdue disputes: %w", err)
+	}
+
+	return nil
+}
+
+// HandleGigRevealReviews reveals reviews whose counterpart never arrived
+// before the review window closed
+func (h *TaskHandler) HandleGigRevealReviews(ctx context.Context, t *asynq.Task) error {
+	if h.reviewRevealer == nil {
+		return fmt.Errorf("review revealer not configured: %w", asynq.SkipRetry)
+	}
+
+	log.Printf("[GIG] Revealing reviews past their blind window")
+
+	if err := h.reviewRevealer.RevealDueReviews(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to reveal due reviews: %w", err)
+	}
+
+	return nil
+}
+
+// HandleGigAutoApproveOverdue approves delivered work whose review window closed
+// without its auto-approval task having run
+func (h *TaskHandler) HandleGigAutoApproveOverdue(ctx context.Context, t *asynq.Task) error {
+	if h.reviewDeadline == nil {
+		return fmt.Errorf("review deadline processor not configured: %w", asynq.SkipRetry)
+	}
+
+	log.Printf("[GIG] Auto-approving deliveries past their review deadline")
+
+	if err := h.reviewDeadline.AutoApproveOverdueMilestones(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to auto-approve overdue deliveries: %w", err)
 	}
-	pushTask, _ := NewNotificationPushTask(pushPayload)
-	h.EnqueueTask(ctx, pushTask)
 
-	log.Printf("[GIG] Escrow released successfully")
 	return nil
 }
 
@@ -939,12 +1203,26 @@ func NewWorkerServer(redisAddr string, db *gorm.DB, concurrency int) *WorkerServ
 	mux.HandleFunc(TypeSavingsContributionReminder, handler.HandleSavingsContributionReminder)
 	mux.HandleFunc(TypeSavingsProcessContribution, handler.HandleSavingsProcessContribution)
 	mux.HandleFunc(TypeSavingsProcessPayout, handler.HandleSavingsProcessPayout)
+	mux.HandleFunc(TypeSavingsCircleMatured, handler.HandleSavingsCircleMatured)
+	mux.HandleFunc(TypeSavingsMissedContributions, handler.HandleSavingsMissedContributions)
+	mux.HandleFunc(TypeSavingsReleaseHeldPayouts, handler.HandleSavingsReleaseHeldPayouts)
+	mux.HandleFunc(TypeSavingsAutoDebit, handler.HandleSavingsAutoDebit)
+	mux.HandleFunc(TypeSavingsGoalAutoSave, handler.HandleSavingsGoalAutoSave)
+	mux.HandleFunc(TypeSavingsInterestAccrual, handler.HandleSavingsInterestAccrual)
+	mux.HandleFunc(TypeSavingsCloseProposals, handler.HandleSavingsCloseProposals)
 	mux.HandleFunc(TypeLoanPaymentReminder, handler.HandleLoanPaymentReminder)
 	mux.HandleFunc(TypeLoanCheckDefault, handler.HandleLoanCheckDefault)
+	mux.HandleFunc(TypeLoanBureauReport, handler.HandleLoanBureauReport)
 	mux.HandleFunc(TypeNotificationPush, handler.HandleNotificationPush)
 	mux.HandleFunc(TypeNotificationSMS, handler.HandleNotificationSMS)
 	mux.HandleFunc(TypeNotificationEmail, handler.HandleNotificationEmail)
 	mux.HandleFunc(TypeGigEscrowRelease, handler.HandleGigEscrowRelease)
+	mux.HandleFunc(TypeGigReviewReminder, handler.HandleGigReviewReminder)
+	mux.HandleFunc(TypeGigContractAutoComplete, handler.HandleGigContractAutoComplete)
+	mux.HandleFunc(TypeGigResolveDisputes, handler.HandleGigResolveDisputes)
+	mux.HandleFunc(TypeGigRevealReviews, handler.HandleGigRevealReviews)
+	mux.HandleFunc(TypeGigAutoApproveOverdue, handler.HandleGigAutoApproveOverdue)
+	mux.HandleFunc(TypeGigBookingReminder, handler.HandleGigBookingReminder)
 	mux.HandleFunc(TypeUserCreditScoreRecalc, handler.HandleUserCreditScoreRecalc)
 
 	return &WorkerServer{
@@ -954,6 +1232,66 @@ func NewWorkerServer(redisAddr string, db *gorm.DB, concurrency int) *WorkerServ
 	}
 }
 
+// SetBureauReporter wires the credit bureau reporting service into the worker
+func (w *WorkerServer) SetBureauReporter(reporter BureauReporter) {
+	w.handler.bureauReporter = reporter
+}
+
+// SetCircleMaturer wires fixed-target circle maturity into the worker
+func (w *WorkerServer) SetCircleMaturer(maturer CircleMaturer) {
+	w.handler.circleMaturer = maturer
+}
+
+// SetMissedContributionProcessor wires missed contribution handling into the worker
+func (w *WorkerServer) SetMissedContributionProcessor(processor MissedContributionProcessor) {
+	w.handler.missedContribution = processor
+}
+
+// SetCirclePayoutProcessor wires circle payout disbursement into the worker
+func (w *WorkerServer) SetCirclePayoutProcessor(processor CirclePayoutProcessor) {
+	w.handler.payoutProcessor = processor
+}
+
+// SetAutoDebitProcessor wires contribution auto-debit into the worker
+func (w *WorkerServer) SetAutoDebitProcessor(processor AutoDebitProcessor) {
+	w.handler.autoDebit = processor
+}
+
+// SetGoalAutoSaver wires savings goal auto-saves into the worker
+func (w *WorkerServer) SetGoalAutoSaver(saver GoalAutoSaver) {
+	w.handler.goalAutoSaver = saver
+}
+
+// SetInterestAccruer wires savings interest accrual into the worker
+func (w *WorkerServer) SetInterestAccruer(accruer InterestAccruer) {
+	w.handler.interestAccruer = accruer
+}
+
+// SetProposalCloser wires circle proposal expiry into the worker
+func (w *WorkerServer) SetProposalCloser(closer ProposalCloser) {
+	w.handler.proposalCloser = closer
+}
+
+// SetDisputeResolver wires gig dispute deadline enforcement into the worker
+func (w *WorkerServer) SetDisputeResolver(resolver DisputeResolver) {
+	w.handler.disputeResolver = resolver
+}
+
+// SetReviewRevealer wires the reveal of blind gig reviews into the worker
+func (w *WorkerServer) SetReviewRevealer(revealer ReviewRevealer) {
+	w.handler.reviewRevealer = revealer
+}
+
+// SetReviewDeadlineProcessor wires review reminders and auto-approval of delivered gig work into the worker
+func (w *WorkerServer) SetReviewDeadlineProcessor(processor ReviewDeadlineProcessor) {
+	w.handler.reviewDeadline = processor
+}
+
+// SetBookingReminderProcessor wires on-site visit reminders into the worker
+func (w *WorkerServer) SetBookingReminderProcessor(processor BookingReminderProcessor) {
+	w.handler.bookingReminder = processor
+}
+
 // Start starts the worker server
 func (w *WorkerServer) Start() error {
 	log.Println("[WORKER] Starting background job worker...")
@@ -1024,6 +1362,83 @@ func (s *Scheduler) RegisterScheduledJobs() error {
 		return fmt.Errorf("failed to register credit recalc: %w", err)
 	}
 
+	// Take mandated contributions every six hours, retrying failures within the grace period
+	if _, err := s.scheduler.Register("0 */6 * * *", asynq.NewTask(
+		TypeSavingsAutoDebit, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register auto-debit: %w", err)
+	}
+
+	// Run savings goal auto-saves every hour
+	if _, err := s.scheduler.Register("15 * * * *", asynq.NewTask(
+		TypeSavingsGoalAutoSave, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register goal auto-save: %w", err)
+	}
+
+	// Accrue the previous day's savings interest just after midnight
+	if _, err := s.scheduler.Register("5 0 * * *", asynq.NewTask(
+		TypeSavingsInterestAccrual, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register interest accrual: %w", err)
+	}
+
+	// Close circle proposals whose voting period has ended, every hour
+	if _, err := s.scheduler.Register("30 * * * *", asynq.NewTask(
+		TypeSavingsCloseProposals, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register proposal closing: %w", err)
+	}
+
+	// Mature fixed-target savings circles at 1 AM
+	if _, err := s.scheduler.Register("0 1 * * *", asynq.NewTask(
+		TypeSavingsCircleMatured, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register circle maturity: %w", err)
+	}
+
+	// Mark contributions missed once their grace period ends, at 1:30 AM
+	if _, err := s.scheduler.Register("30 1 * * *", asynq.NewTask(
+		TypeSavingsMissedContributions, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register missed contributions: %w", err)
+	}
+
+	// Retry payouts held on locked wallets every hour
+	if _, err := s.scheduler.Register("0 * * * *", asynq.NewTask(
+		TypeSavingsReleaseHeldPayouts, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register held payout release: %w", err)
+	}
+
+	// Decide gig disputes whose response deadline has passed, every hour
+	if _, err := s.scheduler.Register("45 * * * *", asynq.NewTask(
+		TypeGigResolveDisputes, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register dispute resolution: %w", err)
+	}
+
+	// Reveal gig reviews whose blind window has closed, every hour
+	if _, err := s.scheduler.Register("50 * * * *", asynq.NewTask(
+		TypeGigRevealReviews, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register review reveal: %w", err)
+	}
+
+	// Auto-approve deliveries whose review deadline passed unscheduled, every hour
+	if _, err := s.scheduler.Register("55 * * * *", asynq.NewTask(
+		TypeGigAutoApproveOverdue, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register overdue review approval: %w", err)
+	}
+
+	// Credit bureau submissions at 2 AM on the 1st of each month
+	if _, err := s.scheduler.Register("0 2 1 * *", asynq.NewTask(
+		TypeLoanBureauReport, nil,
+	)); err != nil {
+		return fmt.Errorf("failed to register bureau report: %w", err)
+	}
+
 	// Cleanup expired OTPs every hour
 	if _, err := s.scheduler.Register("0 * * * *", asynq.NewTask(
 		TypeSystemCleanupExpiredOTPs, nil,
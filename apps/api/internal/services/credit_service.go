@@ -521,49 +521,6 @@ func (s *CreditService) RequestLoan(ctx context.Context, input RequestLoanInput)
 	return loan, nil
 }
 
-// ApproveLoan approves a pending loan and disburses funds
-func (s *CreditService) ApproveLoan(ctx context.Context, loanID uuid.UUID, walletService *WalletService) (*models.Loan, error) {
-	var loan models.Loan
-	if err := s.db.WithContext(ctx).Where("id = ?", loanID).First(&loan).Error; err != nil {
-		return nil, ErrLoanNotFound
-	}
-
-	if loan.Status != "pending" {
-		return nil, errors.New("loan is not pending")
-	}
-
-	// Update loan status
-	loan.Status = "active"
-	loan.DisbursedAt = timePtr(time.Now().UTC())
-
-	if err := s.db.WithContext(ctx).Save(&loan).Error; err != nil {
-		return nil, err
-	}
-
-	// Disburse to wallet
-	_, err := walletService.Deposit(ctx, DepositInput{
-		UserID:     loan.UserID,
-		AmountKobo: loan.Amount,
-		Reference:  "LOAN-" + loan.ID.String()[:8],
-		Channel:    "loan_disbursement",
-	})
-
-	if err != nil {
-		// Rollback loan status
-		loan.Status = "pending"
-		loan.DisbursedAt = nil
-		s.db.WithContext(ctx).Save(&loan)
-		return nil, err
-	}
-
-	// Update credit score stats (loan approved)
-	s.db.WithContext(ctx).Model(&models.CreditScore{}).
-		Where("user_id = ?", loan.UserID).
-		Update("total_gigs_accepted", gorm.Expr("total_gigs_accepted + 1"))
-
-	return &loan, nil
-}
-
 // RepayLoan processes a loan repayment
 func (s *CreditService) RepayLoan(ctx context.Context, loanID uuid.UUID, amountKobo int64, walletService *WalletService, pin string) (*models.LoanRepayment, error) {
 	if amountKobo <= 0 {
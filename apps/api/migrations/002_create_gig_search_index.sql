@@ -0,0 +1,92 @@
+-- Migration: Create Gig Search Index
+-- Description: Full-text, fuzzy and radius search over open gigs
+-- Author: HustleX Engineering
+-- Date: 2026
+
+-- ============================================================================
+-- UP Migration
+-- ============================================================================
+
+-- Trigram matching for misspelled search terms
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Great-circle distance for on-site gigs (earthdistance depends on cube)
+CREATE EXTENSION IF NOT EXISTS cube;
+CREATE EXTENSION IF NOT EXISTS earthdistance;
+
+-- One row per open gig, kept in sync from gig domain events
+CREATE TABLE gig_search_index (
+    gig_id          VARCHAR(64) PRIMARY KEY,
+    client_id       VARCHAR(64) NOT NULL,
+    title           TEXT NOT NULL,
+    description     TEXT NOT NULL DEFAULT '',
+    category        VARCHAR(100) NOT NULL,
+    skill_id        VARCHAR(64),
+    tags            JSONB NOT NULL DEFAULT '[]',
+    tags_text       TEXT NOT NULL DEFAULT '',
+    budget_min      BIGINT NOT NULL,
+    budget_max      BIGINT NOT NULL,
+    currency        VARCHAR(3) NOT NULL,
+    delivery_days   INTEGER NOT NULL,
+    deadline        TIMESTAMPTZ,
+    is_remote       BOOLEAN NOT NULL DEFAULT TRUE,
+    location        VARCHAR(255),
+    latitude        DOUBLE PRECISION,
+    longitude       DOUBLE PRECISION,
+    status          VARCHAR(20) NOT NULL,
+    is_featured     BOOLEAN NOT NULL DEFAULT FALSE,
+    proposal_count  INTEGER NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ NOT NULL,
+    indexed_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Title matches rank above tags, tags above description
+    document TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(tags_text, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'C')
+    ) STORED,
+
+    CONSTRAINT gig_search_budget_range CHECK (budget_min <= budget_max),
+    CONSTRAINT gig_search_coordinates CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+-- ============================================================================
+-- Indexes
+-- ============================================================================
+
+-- Full-text search
+CREATE INDEX idx_gig_search_document ON gig_search_index USING GIN (document);
+
+-- Fuzzy matching on titles and tags
+CREATE INDEX idx_gig_search_title_trgm ON gig_search_index USING GIN (title gin_trgm_ops);
+CREATE INDEX idx_gig_search_tags_trgm ON gig_search_index USING GIN (tags_text gin_trgm_ops);
+
+-- Radius filtering for on-site gigs
+CREATE INDEX idx_gig_search_location ON gig_search_index USING GIST (ll_to_earth(latitude, longitude))
+    WHERE latitude IS NOT NULL AND NOT is_remote;
+
+-- Filters and sorting
+CREATE INDEX idx_gig_search_status_created ON gig_search_index (status, created_at DESC);
+CREATE INDEX idx_gig_search_category ON gig_search_index (category);
+CREATE INDEX idx_gig_search_budget ON gig_search_index (budget_max, budget_min);
+CREATE INDEX idx_gig_search_client ON gig_search_index (client_id);
+
+-- ============================================================================
+-- Comments for Documentation
+-- ============================================================================
+
+COMMENT ON TABLE gig_search_index IS 'Search projection of open gigs, maintained from gig domain events';
+
+COMMENT ON COLUMN gig_search_index.tags_text IS 'Tags joined with spaces, for the document and trigram matching';
+COMMENT ON COLUMN gig_search_index.document IS 'Weighted tsvector: title (A), tags (B), description (C)';
+COMMENT ON COLUMN gig_search_index.latitude IS 'Set for on-site gigs pinned to a location';
+
+-- ============================================================================
+-- DOWN Migration
+-- ============================================================================
+
+-- To rollback, run:
+-- DROP TABLE IF EXISTS gig_search_index CASCADE;
+-- DROP EXTENSION IF EXISTS earthdistance;
+-- DROP EXTENSION IF EXISTS cube;
+-- DROP EXTENSION IF EXISTS pg_trgm;